@@ -0,0 +1,106 @@
+package archive
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// formatNDJSON is one workflow as JSON per line
+	formatNDJSON = "ndjson"
+	// formatTar is a tar of one JSON file per workflow
+	formatTar = "tar"
+)
+
+func NewExportCommand() *cobra.Command {
+	var (
+		selector string
+		format   string
+		filters  archiveFilters
+	)
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "export workflows in the archive",
+		Example: `# Export the workflows in the archive as NDJSON:
+  argo archive export > archive.ndjson
+
+# Export the workflows that finished in the last 30 days as a tar of JSON files:
+  argo archive export --finished-after 30d --format tar > archive.tar
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			req, err := filters.toRequest()
+			errors.CheckError(err)
+			req.ListOptions = &metav1.ListOptions{LabelSelector: selector}
+			stream, err := serviceClient.ExportArchivedWorkflows(ctx, req)
+			errors.CheckError(err)
+			exported, err := exportWorkflows(stream.Recv, os.Stdout, format)
+			errors.CheckError(err)
+			_, _ = fmt.Fprintf(os.Stderr, "%d workflows exported\n", exported)
+		},
+	}
+	command.Flags().StringVar(&format, "format", formatNDJSON, "Format to export as. One of: ndjson|tar")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	filters.addFlags(command, "export")
+	return command
+}
+
+// exportWorkflows writes each workflow received until the end of the stream, and returns how many were written
+func exportWorkflows(recv func() (*wfv1.Workflow, error), out io.Writer, format string) (int, error) {
+	var write func(wf *wfv1.Workflow) error
+	// closeWriter finishes writing, once every workflow is written
+	closeWriter := func() error { return nil }
+	switch format {
+	case formatNDJSON:
+		encoder := json.NewEncoder(out)
+		write = func(wf *wfv1.Workflow) error { return encoder.Encode(wf) }
+	case formatTar:
+		tw := tar.NewWriter(out)
+		closeWriter = tw.Close
+		write = func(wf *wfv1.Workflow) error {
+			data, err := json.Marshal(wf)
+			if err != nil {
+				return err
+			}
+			err = tw.WriteHeader(&tar.Header{
+				Name:    fmt.Sprintf("%s/%s-%s.json", wf.Namespace, wf.Name, wf.UID),
+				Mode:    0o644,
+				Size:    int64(len(data)),
+				ModTime: wf.Status.FinishedAt.Time,
+			})
+			if err != nil {
+				return err
+			}
+			_, err = tw.Write(data)
+			return err
+		}
+	default:
+		return 0, fmt.Errorf("unknown format %q, must be one of: %s, %s", format, formatNDJSON, formatTar)
+	}
+	exported := 0
+	for {
+		wf, err := recv()
+		if err == io.EOF {
+			return exported, closeWriter()
+		}
+		if err != nil {
+			return exported, err
+		}
+		if err := write(wf); err != nil {
+			return exported, err
+		}
+		exported++
+	}
+}
@@ -0,0 +1,50 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_exportWorkflows(t *testing.T) {
+	workflows := []*wfv1.Workflow{
+		{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid", Labels: map[string]string{"my-label": "foo"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other-wf", Namespace: "my-ns", UID: "other-uid"}},
+	}
+	for _, format := range []string{formatNDJSON, formatTar} {
+		t.Run(format, func(t *testing.T) {
+			remaining := workflows
+			recv := func() (*wfv1.Workflow, error) {
+				if len(remaining) == 0 {
+					return nil, io.EOF
+				}
+				wf := remaining[0]
+				remaining = remaining[1:]
+				return wf, nil
+			}
+			out := &bytes.Buffer{}
+			exported, err := exportWorkflows(recv, out, format)
+			require.NoError(t, err)
+			assert.Equal(t, 2, exported)
+
+			var imported []*wfv1.Workflow
+			err = readWorkflows(out, format, func(wf *wfv1.Workflow) error {
+				imported = append(imported, wf)
+				return nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, workflows, imported)
+		})
+	}
+	t.Run("UnknownFormat", func(t *testing.T) {
+		_, err := exportWorkflows(nil, &bytes.Buffer{}, "csv")
+		assert.Error(t, err)
+		assert.Error(t, readWorkflows(&bytes.Buffer{}, "csv", nil))
+	})
+}
@@ -0,0 +1,47 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+)
+
+// archiveFilters are the flags that filter the workflows in the archive
+type archiveFilters struct {
+	finishedAfter  string
+	finishedBefore string
+	request        workflowarchivepkg.ListArchivedWorkflowsRequest
+}
+
+// addFlags adds the flags to the command, verb is what the command does with the workflows, e.g. "list"
+func (f *archiveFilters) addFlags(command *cobra.Command, verb string) {
+	command.Flags().StringSliceVar(&f.request.Phases, "status", []string{}, "Filter by status (comma separated)")
+	command.Flags().StringVar(&f.finishedAfter, "finished-after", "", fmt.Sprintf("Only %s workflows that finished after a time, either RFC3339 or a duration ago (e.g. 2023-01-01T00:00:00Z, 3h, 7d)", verb))
+	command.Flags().StringVar(&f.finishedBefore, "finished-before", "", fmt.Sprintf("Only %s workflows that finished before a time, either RFC3339 or a duration ago (e.g. 2023-01-01T00:00:00Z, 3h, 7d)", verb))
+	command.Flags().StringVar(&f.request.MinDuration, "min-duration", "", fmt.Sprintf("Only %s workflows that ran for at least a duration (e.g. 10m, 3h, 1d)", verb))
+	command.Flags().StringVar(&f.request.MaxDuration, "max-duration", "", fmt.Sprintf("Only %s workflows that ran for at most a duration (e.g. 10m, 3h, 1d)", verb))
+	command.Flags().StringVar(&f.request.WorkflowTemplate, "workflow-template", "", fmt.Sprintf("Only %s workflows submitted from a workflow template", verb))
+	command.Flags().StringVar(&f.request.ClusterWorkflowTemplate, "cluster-workflow-template", "", fmt.Sprintf("Only %s workflows submitted from a cluster workflow template", verb))
+	command.Flags().StringVar(&f.request.CronWorkflow, "cron-workflow", "", fmt.Sprintf("Only %s workflows created by a cron workflow", verb))
+	command.Flags().StringVar(&f.request.MessageContains, "message", "", fmt.Sprintf("Only %s workflows whose message, e.g. why they failed, contains a string", verb))
+}
+
+// toRequest returns the request for the workflows in the namespace of the client that match the filters
+func (f *archiveFilters) toRequest() (*workflowarchivepkg.ListArchivedWorkflowsRequest, error) {
+	req := f.request
+	req.Namespace = client.Namespace()
+	var err error
+	req.MinFinishedAt, err = common.ParseTime(f.finishedAfter)
+	if err != nil {
+		return nil, err
+	}
+	req.MaxFinishedAt, err = common.ParseTime(f.finishedBefore)
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
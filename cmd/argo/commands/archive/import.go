@@ -0,0 +1,112 @@
+package archive
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func NewImportCommand() *cobra.Command {
+	var format string
+	command := &cobra.Command{
+		Use:   "import FILE...",
+		Short: "import workflows into the archive",
+		Long:  "Import workflows that were exported from an archive, keeping their UIDs and labels. Workflows that are already archived are replaced.",
+		Example: `# Import workflows from NDJSON:
+  argo archive import archive.ndjson
+
+# Import workflows from a tar of JSON files on stdin:
+  argo archive import --format tar - < archive.tar
+`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			stream, err := serviceClient.ImportArchivedWorkflows(ctx)
+			errors.CheckError(err)
+			for _, file := range args {
+				err := readWorkflowsFromFile(file, format, func(wf *wfv1.Workflow) error {
+					return stream.Send(&workflowarchivepkg.ImportArchivedWorkflowRequest{Workflow: wf})
+				})
+				// io.EOF means the server stopped the import, its error is returned by CloseAndRecv
+				if err == io.EOF {
+					break
+				}
+				errors.CheckError(err)
+			}
+			resp, err := stream.CloseAndRecv()
+			errors.CheckError(err)
+			fmt.Printf("%d workflows imported\n", resp.Imported)
+		},
+	}
+	command.Flags().StringVar(&format, "format", formatNDJSON, "Format to import from. One of: ndjson|tar")
+	return command
+}
+
+// readWorkflowsFromFile reads the workflows from the file, or stdin if the file is "-"
+func readWorkflowsFromFile(file, format string, f func(wf *wfv1.Workflow) error) error {
+	if file == "-" {
+		return readWorkflows(os.Stdin, format, f)
+	}
+	in, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	return readWorkflows(in, format, f)
+}
+
+// readWorkflows calls f with each workflow read, in the format that exportWorkflows writes
+func readWorkflows(in io.Reader, format string, f func(wf *wfv1.Workflow) error) error {
+	switch format {
+	case formatNDJSON:
+		decoder := json.NewDecoder(in)
+		for {
+			wf := &wfv1.Workflow{}
+			err := decoder.Decode(wf)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := f(wf); err != nil {
+				return err
+			}
+		}
+	case formatTar:
+		tr := tar.NewReader(in)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if header.Typeflag != tar.TypeReg || filepath.Ext(header.Name) != ".json" {
+				continue
+			}
+			wf := &wfv1.Workflow{}
+			if err := json.NewDecoder(tr).Decode(wf); err != nil {
+				return fmt.Errorf("failed to read %s: %w", header.Name, err)
+			}
+			if err := f(wf); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unknown format %q, must be one of: %s, %s", format, formatNDJSON, formatTar)
+	}
+}
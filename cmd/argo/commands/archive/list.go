@@ -3,7 +3,6 @@ package archive
 import (
 	"context"
 	"os"
-	"sort"
 
 	"github.com/argoproj/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -21,16 +20,27 @@ func NewListCommand() *cobra.Command {
 		selector  string
 		output    string
 		chunkSize int64
+		filters   archiveFilters
 	)
 	command := &cobra.Command{
 		Use:   "list",
 		Short: "list workflows in the archive",
+		Example: `# List the workflows in the archive:
+  argo archive list
+
+# List the workflows created by a cron workflow that failed in the last week, the longest running first:
+  argo archive list --cron-workflow my-cron --status Failed,Error --finished-after 7d --order-by=-duration
+
+# List the workflows that ran out of memory in January:
+  argo archive list --message OOMKilled --finished-after 2023-01-01T00:00:00Z --finished-before 2023-02-01T00:00:00Z
+`,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient(cmd.Context())
 			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
 			errors.CheckError(err)
-			namespace := client.Namespace()
-			workflows, err := listArchivedWorkflows(ctx, serviceClient, namespace, selector, chunkSize)
+			req, err := filters.toRequest()
+			errors.CheckError(err)
+			workflows, err := listArchivedWorkflowsWithFilters(ctx, serviceClient, req, selector, chunkSize)
 			errors.CheckError(err)
 			err = printer.PrintWorkflows(workflows, os.Stdout, printer.PrintOpts{Output: output, Namespace: true, UID: true})
 			errors.CheckError(err)
@@ -39,18 +49,27 @@ func NewListCommand() *cobra.Command {
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().Int64VarP(&chunkSize, "chunk-size", "", 0, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
+	filters.addFlags(command, "list")
+	command.Flags().StringVar(&filters.request.OrderBy, "order-by", "", "Order by startedAt, finishedAt or duration, prefixed with '-' for descending order (e.g. -duration). By default, the most recently started workflows are listed first.")
 	return command
 }
 
 func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, namespace string, labelSelector string, chunkSize int64) (wfv1.Workflows, error) {
+	return listArchivedWorkflowsWithFilters(ctx, serviceClient, &workflowarchivepkg.ListArchivedWorkflowsRequest{Namespace: namespace}, labelSelector, chunkSize)
+}
+
+// listArchivedWorkflowsWithFilters lists the archived workflows that match the filters of the request
+func listArchivedWorkflowsWithFilters(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, filters *workflowarchivepkg.ListArchivedWorkflowsRequest, labelSelector string, chunkSize int64) (wfv1.Workflows, error) {
 	listOpts := &metav1.ListOptions{
 		LabelSelector: labelSelector,
 		Limit:         chunkSize,
 	}
+	req := *filters
+	req.ListOptions = listOpts
 	var workflows wfv1.Workflows
 	for {
 		log.WithField("listOpts", listOpts).Debug()
-		resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{Namespace: namespace, ListOptions: listOpts})
+		resp, err := serviceClient.ListArchivedWorkflows(ctx, &req)
 		if err != nil {
 			return nil, err
 		}
@@ -60,7 +79,5 @@ func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg
 		}
 		listOpts.Continue = resp.Continue
 	}
-	sort.Sort(workflows)
-
 	return workflows, nil
 }
@@ -20,5 +20,7 @@ func NewArchiveCommand() *cobra.Command {
 	command.AddCommand(NewListLabelValueCommand())
 	command.AddCommand(NewResubmitCommand())
 	command.AddCommand(NewRetryCommand())
+	command.AddCommand(NewExportCommand())
+	command.AddCommand(NewImportCommand())
 	return command
 }
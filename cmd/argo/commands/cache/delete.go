@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+)
+
+func NewDeleteCommand() *cobra.Command {
+	var cacheType string
+	command := &cobra.Command{
+		Use:   "delete NAME KEY...",
+		Short: "delete entries of a memoization cache",
+		Example: `# Delete the "hello-world" entry of the "my-cache" ConfigMap cache, so that the template is run again:
+  argo cache delete my-cache hello-world -n argo
+`,
+		Args: cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewMemoizationServiceClient()
+			errors.CheckError(err)
+			for _, key := range args[1:] {
+				_, err := serviceClient.DeleteCacheEntry(ctx, &memoizationpkg.DeleteCacheEntryRequest{
+					Namespace: client.Namespace(),
+					Name:      args[0],
+					Key:       key,
+					Type:      toCacheType(cacheType),
+				})
+				errors.CheckError(err)
+				fmt.Printf("Cache entry %s deleted\n", key)
+			}
+		},
+	}
+	addTypeFlag(command, &cacheType)
+	return command
+}
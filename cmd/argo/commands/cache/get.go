@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+)
+
+func NewGetCommand() *cobra.Command {
+	var (
+		cacheType string
+		output    string
+	)
+	command := &cobra.Command{
+		Use:   "get NAME KEY",
+		Short: "display an entry of a memoization cache",
+		Example: `# Get the "hello-world" entry of the "my-cache" ConfigMap cache:
+  argo cache get my-cache hello-world -n argo
+`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewMemoizationServiceClient()
+			errors.CheckError(err)
+			entry, err := serviceClient.GetCacheEntry(ctx, &memoizationpkg.GetCacheEntryRequest{
+				Namespace: client.Namespace(),
+				Name:      args[0],
+				Key:       args[1],
+				Type:      toCacheType(cacheType),
+			})
+			errors.CheckError(err)
+			switch output {
+			case "name":
+				fmt.Println(entry.Key)
+			case "json":
+				outBytes, _ := json.MarshalIndent(entry, "", "    ")
+				fmt.Println(string(outBytes))
+			case "yaml":
+				outBytes, _ := yaml.Marshal(entry)
+				fmt.Print(string(outBytes))
+			case "wide", "":
+				fmt.Print(getEntryGet(entry, time.Now()))
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+		},
+	}
+	addTypeFlag(command, &cacheType)
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
+	return command
+}
+
+func getEntryGet(entry *memoizationpkg.CacheEntry, now time.Time) string {
+	const fmtStr = "%-30s %v\n"
+
+	out := ""
+	out += fmt.Sprintf(fmtStr, "Key:", entry.Key)
+	out += fmt.Sprintf(fmtStr, "Node:", entry.NodeId)
+	out += fmt.Sprintf(fmtStr, "Created:", relativeTime(entry.CreationTimestamp, now))
+	out += fmt.Sprintf(fmtStr, "Last Hit:", relativeTime(entry.LastHitTimestamp, now))
+	if entry.Outputs == nil {
+		return out
+	}
+	if len(entry.Outputs.Parameters) > 0 {
+		out += fmt.Sprintf(fmtStr, "Output Parameters:", len(entry.Outputs.Parameters))
+		b := &strings.Builder{}
+		w := tabwriter.NewWriter(b, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprint(w, "  NAME\tVALUE\n")
+		for _, param := range entry.Outputs.Parameters {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\n", param.Name, param.Value)
+		}
+		_ = w.Flush()
+		out += b.String()
+	}
+	if len(entry.Outputs.Artifacts) > 0 {
+		out += fmt.Sprintf(fmtStr, "Output Artifacts:", len(entry.Outputs.Artifacts))
+		for _, art := range entry.Outputs.Artifacts {
+			out += fmt.Sprintf("  %s\n", art.Name)
+		}
+	}
+	return out
+}
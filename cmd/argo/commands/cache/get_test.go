@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestGetEntryGet(t *testing.T) {
+	now := time.Now()
+	entry := &memoizationpkg.CacheEntry{
+		Key:               "hello-world",
+		NodeId:            "memoized-abc",
+		CreationTimestamp: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+		LastHitTimestamp:  &metav1.Time{Time: now.Add(-5 * time.Minute)},
+		Outputs: &wfv1.Outputs{
+			Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("world")}},
+			Artifacts:  []wfv1.Artifact{{Name: "main-logs"}},
+		},
+	}
+	assert.Equal(t, `Key:                           hello-world
+Node:                          memoized-abc
+Created:                       2h
+Last Hit:                      5m
+Output Parameters:             1
+  NAME    VALUE
+  hello   world
+Output Artifacts:              1
+  main-logs
+`, getEntryGet(entry, now))
+}
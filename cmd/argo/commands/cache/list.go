@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+)
+
+func NewListCommand() *cobra.Command {
+	var (
+		cacheType string
+		output    string
+	)
+	command := &cobra.Command{
+		Use:   "list NAME",
+		Short: "list the entries of a memoization cache",
+		Example: `# List the entries of the "my-cache" ConfigMap cache, in the namespace of the workflow controller:
+  argo cache list my-cache -n argo
+
+# List the entries of an artifact repository cache:
+  argo cache list my-cache --type artifact-repository
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewMemoizationServiceClient()
+			errors.CheckError(err)
+			entries, err := serviceClient.ListCacheEntries(ctx, &memoizationpkg.ListCacheEntriesRequest{
+				Namespace: client.Namespace(),
+				Name:      args[0],
+				Type:      toCacheType(cacheType),
+			})
+			errors.CheckError(err)
+			switch output {
+			case "", "wide":
+				printTable(entries.Items, time.Now())
+			case "name":
+				for _, entry := range entries.Items {
+					fmt.Println(entry.Key)
+				}
+			case "json":
+				outBytes, _ := json.MarshalIndent(entries.Items, "", "    ")
+				fmt.Println(string(outBytes))
+			case "yaml":
+				outBytes, _ := yaml.Marshal(entries.Items)
+				fmt.Print(string(outBytes))
+			default:
+				log.Fatalf("Unknown output mode: %s", output)
+			}
+		},
+	}
+	addTypeFlag(command, &cacheType)
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide|name|json|yaml")
+	return command
+}
+
+func printTable(entries []*memoizationpkg.CacheEntry, now time.Time) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "KEY\tNODE\tAGE\tLAST HIT\n")
+	for _, entry := range entries {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entry.Key, entry.NodeId, relativeTime(entry.CreationTimestamp, now), relativeTime(entry.LastHitTimestamp, now))
+	}
+	_ = w.Flush()
+}
+
+func relativeTime(t *metav1.Time, now time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return humanize.RelativeDurationShort(t.Time, now)
+}
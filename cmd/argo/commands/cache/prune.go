@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+)
+
+func NewPruneCommand() *cobra.Command {
+	var (
+		cacheType string
+		olderThan string
+	)
+	command := &cobra.Command{
+		Use:   "prune NAME",
+		Short: "delete the old entries of a memoization cache",
+		Example: `# Delete the entries of the "my-cache" ConfigMap cache created more than a week ago:
+  argo cache prune my-cache --older-than 7d -n argo
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewMemoizationServiceClient()
+			errors.CheckError(err)
+			res, err := serviceClient.PruneCache(ctx, &memoizationpkg.PruneCacheRequest{
+				Namespace: client.Namespace(),
+				Name:      args[0],
+				Type:      toCacheType(cacheType),
+				OlderThan: olderThan,
+			})
+			errors.CheckError(err)
+			for _, key := range res.Keys {
+				fmt.Printf("Cache entry %s deleted\n", key)
+			}
+			fmt.Printf("%d cache entries deleted\n", len(res.Keys))
+		},
+	}
+	addTypeFlag(command, &cacheType)
+	command.Flags().StringVar(&olderThan, "older-than", "", "Delete the entries created longer ago than this duration, e.g. 24h or 7d")
+	_ = command.MarkFlagRequired("older-than")
+	return command
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+)
+
+func NewCacheCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cache",
+		Short: "manage memoization caches",
+		Long:  "List, inspect and invalidate the entries of the memoization caches. ConfigMap caches are in the namespace of the workflow controller, artifact repository caches are in the default artifact repository of the namespace.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewGetCommand())
+	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewPruneCommand())
+	return command
+}
+
+// addTypeFlag adds the --type flag, which is the type of the cache
+func addTypeFlag(command *cobra.Command, cacheType *string) {
+	command.Flags().StringVar(cacheType, "type", "configmap", "The type of the cache. One of: configmap|artifact-repository")
+}
+
+// toCacheType returns the type of the cache from the value of the --type flag
+func toCacheType(cacheType string) string {
+	switch cacheType {
+	case "configmap":
+		return string(controllercache.ConfigMapCache)
+	case "artifact-repository":
+		return string(controllercache.ArtifactRepositoryCache)
+	default:
+		log.Fatalf("Unknown cache type: %s", cacheType)
+		return ""
+	}
+}
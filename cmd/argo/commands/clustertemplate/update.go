@@ -0,0 +1,94 @@
+package clustertemplate
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// NewUpdateCommand returns a new instance of an `argo cluster-template update` command
+func NewUpdateCommand() *cobra.Command {
+	var opts common.UpdateOpts
+	command := &cobra.Command{
+		Use:   "update FILE1 FILE2...",
+		Short: "update cluster workflow templates",
+		Long: `Update cluster workflow templates from files, replacing their specs.
+
+Every cluster workflow template is validated before any is updated. With --create, the cluster workflow templates that
+do not exist are created, like kubectl apply. With --dry-run, the differences between the existing cluster workflow
+templates and the files are printed instead.`,
+		Example: `# Update a cluster workflow template:
+  argo cluster-template update my-cwftmpl.yaml
+
+# Create or update the cluster workflow templates in a directory:
+  argo cluster-template update --create templates/*.yaml
+
+# Print the changes that would be made:
+  argo cluster-template update --dry-run my-cwftmpl.yaml
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewClusterWorkflowTemplateServiceClient()
+			if err != nil {
+				log.Fatal(err)
+			}
+			fileContents, err := util.ReadManifest(args...)
+			if err != nil {
+				log.Fatal(err)
+			}
+			var clusterWorkflowTemplates []wfv1.ClusterWorkflowTemplate
+			for _, body := range fileContents {
+				cwftmpls, err := unmarshalClusterWorkflowTemplates(body, opts.Strict)
+				if err != nil {
+					log.Fatalf("Failed to parse cluster workflow template: %v", err)
+				}
+				clusterWorkflowTemplates = append(clusterWorkflowTemplates, cwftmpls...)
+			}
+			if len(clusterWorkflowTemplates) == 0 {
+				log.Println("No cluster workflow template found in given files")
+				os.Exit(1)
+			}
+			if err := updateClusterWorkflowTemplates(ctx, os.Stdout, serviceClient, clusterWorkflowTemplates, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	common.AddUpdateFlags(command, &opts)
+	return command
+}
+
+// updateClusterWorkflowTemplates validates all the cluster workflow templates, and then updates or creates each of them
+func updateClusterWorkflowTemplates(ctx context.Context, out io.Writer, serviceClient clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient, clusterWorkflowTemplates []wfv1.ClusterWorkflowTemplate, opts common.UpdateOpts) error {
+	return common.UpdateObjects(ctx, out, clusterWorkflowTemplates, opts, common.Updater[wfv1.ClusterWorkflowTemplate]{
+		Kind: "cluster workflow template",
+		Lint: func(ctx context.Context, cwftmpl *wfv1.ClusterWorkflowTemplate) error {
+			_, err := serviceClient.LintClusterWorkflowTemplate(ctx, &clusterworkflowtemplate.ClusterWorkflowTemplateLintRequest{Template: cwftmpl.DeepCopy()})
+			return err
+		},
+		Get: func(ctx context.Context, cwftmpl *wfv1.ClusterWorkflowTemplate) (*wfv1.ClusterWorkflowTemplate, error) {
+			return serviceClient.GetClusterWorkflowTemplate(ctx, &clusterworkflowtemplate.ClusterWorkflowTemplateGetRequest{Name: cwftmpl.Name})
+		},
+		Create: func(ctx context.Context, cwftmpl *wfv1.ClusterWorkflowTemplate) (*wfv1.ClusterWorkflowTemplate, error) {
+			return serviceClient.CreateClusterWorkflowTemplate(ctx, &clusterworkflowtemplate.ClusterWorkflowTemplateCreateRequest{Template: cwftmpl})
+		},
+		Update: func(ctx context.Context, cwftmpl *wfv1.ClusterWorkflowTemplate) (*wfv1.ClusterWorkflowTemplate, error) {
+			return serviceClient.UpdateClusterWorkflowTemplate(ctx, &clusterworkflowtemplate.ClusterWorkflowTemplateUpdateRequest{Template: cwftmpl})
+		},
+		Print: func(_ context.Context, cwftmpl *wfv1.ClusterWorkflowTemplate) {
+			printClusterWorkflowTemplate(cwftmpl, opts.Output)
+		},
+	})
+}
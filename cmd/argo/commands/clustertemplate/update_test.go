@@ -0,0 +1,75 @@
+package clustertemplate
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestUpdateClusterWorkflowTemplates(t *testing.T) {
+	ctx := context.Background()
+	live := &wfv1.ClusterWorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", ResourceVersion: "123"},
+		Spec:       wfv1.WorkflowSpec{Entrypoint: "main"},
+	}
+	cwftmpls := []wfv1.ClusterWorkflowTemplate{
+		{ObjectMeta: metav1.ObjectMeta{Name: "existing"}, Spec: wfv1.WorkflowSpec{Entrypoint: "other"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "new"}, Spec: wfv1.WorkflowSpec{Entrypoint: "main"}},
+	}
+	newClient := func() *mocks.ClusterWorkflowTemplateServiceClient {
+		client := &mocks.ClusterWorkflowTemplateServiceClient{}
+		client.On("LintClusterWorkflowTemplate", mock.Anything, mock.Anything).Return(nil, nil)
+		client.On("GetClusterWorkflowTemplate", mock.Anything, &clusterworkflowtemplate.ClusterWorkflowTemplateGetRequest{Name: "existing"}).Return(live, nil)
+		client.On("GetClusterWorkflowTemplate", mock.Anything, &clusterworkflowtemplate.ClusterWorkflowTemplateGetRequest{Name: "new"}).Return(nil, status.Error(codes.NotFound, "not found"))
+		return client
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		client := &mocks.ClusterWorkflowTemplateServiceClient{}
+		client.On("LintClusterWorkflowTemplate", mock.Anything, mock.Anything).Return(nil, status.Error(codes.InvalidArgument, "invalid"))
+		err := updateClusterWorkflowTemplates(ctx, &bytes.Buffer{}, client, cwftmpls, common.UpdateOpts{Create: true})
+		assert.EqualError(t, err, "failed to validate cluster workflow template existing: rpc error: code = InvalidArgument desc = invalid")
+		client.AssertNotCalled(t, "UpdateClusterWorkflowTemplate", mock.Anything, mock.Anything)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		client := newClient()
+		client.On("UpdateClusterWorkflowTemplate", mock.Anything, mock.Anything).Return(live, nil)
+		err := updateClusterWorkflowTemplates(ctx, &bytes.Buffer{}, client, cwftmpls, common.UpdateOpts{Output: "name"})
+		assert.EqualError(t, err, "failed to get cluster workflow template new: rpc error: code = NotFound desc = not found")
+		client.AssertNotCalled(t, "CreateClusterWorkflowTemplate", mock.Anything, mock.Anything)
+	})
+	t.Run("Create", func(t *testing.T) {
+		client := newClient()
+		client.On("UpdateClusterWorkflowTemplate", mock.Anything, mock.MatchedBy(func(req *clusterworkflowtemplate.ClusterWorkflowTemplateUpdateRequest) bool {
+			return req.Template.ResourceVersion == "123" && req.Template.Spec.Entrypoint == "other"
+		})).Return(live, nil)
+		client.On("CreateClusterWorkflowTemplate", mock.Anything, mock.MatchedBy(func(req *clusterworkflowtemplate.ClusterWorkflowTemplateCreateRequest) bool {
+			return req.Template.Name == "new"
+		})).Return(&cwftmpls[1], nil)
+		err := updateClusterWorkflowTemplates(ctx, &bytes.Buffer{}, client, cwftmpls, common.UpdateOpts{Output: "name", Create: true})
+		require.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		client := newClient()
+		out := &bytes.Buffer{}
+		err := updateClusterWorkflowTemplates(ctx, out, client, cwftmpls, common.UpdateOpts{Create: true, DryRun: true})
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "-  entrypoint: main\n+  entrypoint: other\n")
+		assert.Contains(t, out.String(), "+++ new/new\n")
+		client.AssertNotCalled(t, "UpdateClusterWorkflowTemplate", mock.Anything, mock.Anything)
+		client.AssertNotCalled(t, "CreateClusterWorkflowTemplate", mock.Anything, mock.Anything)
+	})
+}
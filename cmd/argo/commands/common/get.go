@@ -85,11 +85,20 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 		out += fmt.Sprintf(fmtStr, "Duration:", humanize.RelativeDuration(wf.Status.StartedAt.Time, wf.Status.FinishedAt.Time))
 	}
 	if wf.Status.Phase == wfv1.WorkflowRunning {
-		if wf.Status.EstimatedDuration > 0 {
+		if wf.Status.EstimatedDurationP90 > 0 {
+			out += fmt.Sprintf(fmtStr, "EstimatedDuration:", fmt.Sprintf("%s (90%% within %s)", humanize.Duration(wf.Status.EstimatedDuration.ToDuration()), humanize.Duration(wf.Status.EstimatedDurationP90.ToDuration())))
+		} else if wf.Status.EstimatedDuration > 0 {
 			out += fmt.Sprintf(fmtStr, "EstimatedDuration:", humanize.Duration(wf.Status.EstimatedDuration.ToDuration()))
 		}
 	}
-	out += fmt.Sprintf(fmtStr, "Progress:", wf.Status.Progress)
+	if wf.Status.ProgressPercent > 0 {
+		out += fmt.Sprintf(fmtStr, "Progress:", fmt.Sprintf("%s (%d%%)", wf.Status.Progress, wf.Status.ProgressPercent))
+	} else {
+		out += fmt.Sprintf(fmtStr, "Progress:", wf.Status.Progress)
+	}
+	if wf.Status.Phase == wfv1.WorkflowRunning && wf.Status.EstimatedRemainingDuration > 0 {
+		out += fmt.Sprintf(fmtStr, "EstimatedRemaining:", humanize.Duration(wf.Status.EstimatedRemainingDuration.ToDuration()))
+	}
 	if !wf.Status.ResourcesDuration.IsZero() {
 		out += fmt.Sprintf(fmtStr, "ResourcesDuration:", wf.Status.ResourcesDuration)
 	}
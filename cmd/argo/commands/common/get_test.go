@@ -147,6 +147,19 @@ status:
 		output := PrintWorkflowHelper(&wf, GetFlags{})
 		assert.Regexp(t, `Progress: *1/2`, output)
 	})
+	t.Run("ProgressPercent", func(t *testing.T) {
+		var wf wfv1.Workflow
+		wfv1.MustUnmarshal(`
+status:
+  phase: Running
+  progress: 99/100
+  progressPercent: 12
+  estimatedRemainingDuration: 60
+`, &wf)
+		output := PrintWorkflowHelper(&wf, GetFlags{})
+		assert.Regexp(t, `Progress: *99/100 \(12%\)`, output)
+		assert.Regexp(t, `EstimatedRemaining: *1 minute`, output)
+	})
 	t.Run("EstimatedDuration", func(t *testing.T) {
 		var wf wfv1.Workflow
 		wfv1.MustUnmarshal(`
@@ -157,6 +170,17 @@ status:
 		output := PrintWorkflowHelper(&wf, GetFlags{})
 		assert.Regexp(t, `EstimatedDuration: *1 second`, output)
 	})
+	t.Run("EstimatedDurationP90", func(t *testing.T) {
+		var wf wfv1.Workflow
+		wfv1.MustUnmarshal(`
+status:
+  estimatedDuration: 60
+  estimatedDurationP90: 120
+  phase: Running
+`, &wf)
+		output := PrintWorkflowHelper(&wf, GetFlags{})
+		assert.Regexp(t, `EstimatedDuration: *1 minute 0 seconds \(90% within 2 minutes 0 seconds\)`, output)
+	})
 	t.Run("IndexOrdering", func(t *testing.T) {
 		var wf wfv1.Workflow
 		wfv1.MustUnmarshal(`apiVersion: argoproj.io/v1alpha1
@@ -0,0 +1,23 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	argotime "github.com/argoproj/pkg/time"
+)
+
+// ParseTime returns the time as RFC3339, the value is either RFC3339 or a duration before now
+func ParseTime(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return value, nil
+	}
+	t, err := argotime.ParseSince(value)
+	if err != nil {
+		return "", fmt.Errorf("%q is neither an RFC3339 time nor a duration: %w", value, err)
+	}
+	return t.UTC().Format(time.RFC3339), nil
+}
@@ -0,0 +1,187 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// UpdateOpts are the options of the commands that update templates and cron workflows
+type UpdateOpts struct {
+	Output string // --output
+	Strict bool   // --strict
+	Create bool   // --create
+	DryRun bool   // --dry-run
+}
+
+// AddUpdateFlags adds the flags of the update options to the command
+func AddUpdateFlags(command *cobra.Command, opts *UpdateOpts) {
+	command.Flags().StringVarP(&opts.Output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
+	command.Flags().BoolVar(&opts.Strict, "strict", true, "perform strict workflow validation")
+	command.Flags().BoolVar(&opts.Create, "create", false, "Create the objects that do not exist, rather than failing")
+	command.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the differences between the existing objects and the files, without updating them")
+}
+
+// Updater are the API calls that update objects of a kind
+type Updater[T any] struct {
+	Kind   string // the kind of the objects in messages, e.g. "workflow template"
+	Lint   func(ctx context.Context, obj *T) error
+	Get    func(ctx context.Context, obj *T) (*T, error)
+	Create func(ctx context.Context, obj *T) (*T, error)
+	Update func(ctx context.Context, obj *T) (*T, error)
+	// Prepare optionally prepares the object to replace the live object, after PrepareUpdate
+	Prepare func(live, obj *T)
+	Print   func(ctx context.Context, obj *T)
+}
+
+// UpdateObjects validates all the objects, and then updates or creates each of them, or prints the differences with
+// the live objects if it is a dry run
+func UpdateObjects[T any, P interface {
+	*T
+	metav1.Object
+}](ctx context.Context, out io.Writer, objs []T, opts UpdateOpts, updater Updater[T]) error {
+	for i := range objs {
+		obj := P(&objs[i])
+		if obj.GetName() == "" {
+			return fmt.Errorf("%s must have a name to be updated", updater.Kind)
+		}
+		if err := updater.Lint(ctx, &objs[i]); err != nil {
+			return fmt.Errorf("failed to validate %s %s: %w", updater.Kind, obj.GetName(), err)
+		}
+	}
+	for _, obj := range objs {
+		obj := obj
+		name := P(&obj).GetName()
+		live, err := updater.Get(ctx, &obj)
+		if IsNotFound(err) && opts.Create {
+			live = nil
+		} else if err != nil {
+			return fmt.Errorf("failed to get %s %s: %w", updater.Kind, name, err)
+		} else {
+			PrepareUpdate(P(live), P(&obj))
+			if updater.Prepare != nil {
+				updater.Prepare(live, &obj)
+			}
+		}
+		if opts.DryRun {
+			diff, err := Diff(name, live, &obj)
+			if err != nil {
+				return err
+			}
+			if diff == "" {
+				diff = fmt.Sprintf("%s %s is unchanged\n", updater.Kind, name)
+			}
+			_, _ = fmt.Fprint(out, diff)
+			continue
+		}
+		var updated *T
+		if live == nil {
+			updated, err = updater.Create(ctx, &obj)
+		} else {
+			updated, err = updater.Update(ctx, &obj)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update %s %s: %w", updater.Kind, name, err)
+		}
+		updater.Print(ctx, updated)
+	}
+	return nil
+}
+
+// ownedLabels are set by Argo when an object is created, and are kept when it is updated
+var ownedLabels = []string{
+	wfcommon.LabelKeyControllerInstanceID,
+	wfcommon.LabelKeyCreator,
+	wfcommon.LabelKeyCreatorEmail,
+	wfcommon.LabelKeyCreatorPreferredUsername,
+}
+
+// PrepareUpdate prepares the object to replace the live object, by setting its resource version to that of the live
+// object, and keeping the labels that were set by Argo when the live object was created
+func PrepareUpdate(live, obj metav1.Object) {
+	obj.SetResourceVersion(live.GetResourceVersion())
+	for _, key := range ownedLabels {
+		value, ok := live.GetLabels()[key]
+		if _, set := obj.GetLabels()[key]; !ok || set {
+			continue
+		}
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[key] = value
+		obj.SetLabels(labels)
+	}
+}
+
+// IsNotFound returns whether the error of an API call is that the object was not found
+func IsNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// generatedFields are the fields of the metadata that are set by Kubernetes, which are not diffed
+var generatedFields = []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "selfLink", "uid"}
+
+// Diff returns a unified diff of the YAML of the live object and the object that would replace it, without their
+// statuses or generated metadata, or an empty string if there is no difference. The live object is nil if the object
+// would be created.
+func Diff(name string, live, obj interface{}) (string, error) {
+	liveYAML, err := diffableYAML(live)
+	if err != nil {
+		return "", err
+	}
+	objYAML, err := diffableYAML(obj)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(liveYAML),
+		B:        splitLines(objYAML),
+		FromFile: "live/" + name,
+		ToFile:   "new/" + name,
+		Context:  3,
+	})
+}
+
+// splitLines splits the text into lines that keep their line endings, unlike difflib.SplitLines which adds an empty
+// line to text that ends with a newline
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func diffableYAML(obj interface{}) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	if fields == nil {
+		return "", nil
+	}
+	delete(fields, "status")
+	if metadata, ok := fields["metadata"].(map[string]interface{}); ok {
+		for _, field := range generatedFields {
+			delete(metadata, field)
+		}
+	}
+	data, err = yaml.Marshal(fields)
+	return string(data), err
+}
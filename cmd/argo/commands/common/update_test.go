@@ -0,0 +1,77 @@
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestPrepareUpdate(t *testing.T) {
+	live := &wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{
+		ResourceVersion: "123",
+		Labels:          map[string]string{wfcommon.LabelKeyCreator: "alice", wfcommon.LabelKeyControllerInstanceID: "my-id", "app": "old"},
+	}}
+	wftmpl := &wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{
+		Labels: map[string]string{wfcommon.LabelKeyCreator: "bob"},
+	}}
+	PrepareUpdate(live, wftmpl)
+	assert.Equal(t, "123", wftmpl.ResourceVersion)
+	assert.Equal(t, map[string]string{wfcommon.LabelKeyCreator: "bob", wfcommon.LabelKeyControllerInstanceID: "my-id"}, wftmpl.Labels)
+
+	wftmpl = &wfv1.WorkflowTemplate{}
+	PrepareUpdate(&wfv1.WorkflowTemplate{}, wftmpl)
+	assert.Nil(t, wftmpl.Labels)
+}
+
+func TestIsNotFound(t *testing.T) {
+	assert.True(t, IsNotFound(status.Error(codes.NotFound, "not found")))
+	assert.False(t, IsNotFound(status.Error(codes.Internal, "internal")))
+	assert.False(t, IsNotFound(fmt.Errorf("not found")))
+	assert.False(t, IsNotFound(nil))
+}
+
+func TestDiff(t *testing.T) {
+	live := &wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wftmpl", ResourceVersion: "123", UID: "my-uid"},
+		Spec:       wfv1.WorkflowSpec{Entrypoint: "main"},
+	}
+	wftmpl := &wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wftmpl"},
+		Spec:       wfv1.WorkflowSpec{Entrypoint: "main"},
+	}
+	t.Run("Unchanged", func(t *testing.T) {
+		diff, err := Diff("my-wftmpl", live, wftmpl)
+		require.NoError(t, err)
+		assert.Empty(t, diff)
+	})
+	t.Run("Changed", func(t *testing.T) {
+		wftmpl := wftmpl.DeepCopy()
+		wftmpl.Spec.Entrypoint = "other"
+		diff, err := Diff("my-wftmpl", live, wftmpl)
+		require.NoError(t, err)
+		assert.Equal(t, `--- live/my-wftmpl
++++ new/my-wftmpl
+@@ -2,4 +2,4 @@
+   name: my-wftmpl
+ spec:
+   arguments: {}
+-  entrypoint: main
++  entrypoint: other
+`, diff)
+	})
+	t.Run("Created", func(t *testing.T) {
+		var none *wfv1.WorkflowTemplate
+		diff, err := Diff("my-wftmpl", none, wftmpl)
+		require.NoError(t, err)
+		assert.Contains(t, diff, "+++ new/my-wftmpl\n")
+		assert.Contains(t, diff, "+  entrypoint: main\n")
+	})
+}
@@ -0,0 +1,186 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/cron"
+)
+
+// backfillPollInterval is how often to check whether running workflows have completed
+var backfillPollInterval = 10 * time.Second
+
+type backfillOpts struct {
+	parallelism int
+	dryRun      bool
+}
+
+// NewBackfillCommand returns a new instance of an `argo cron backfill` command
+func NewBackfillCommand() *cobra.Command {
+	var (
+		from string
+		to   string
+		opts backfillOpts
+	)
+	command := &cobra.Command{
+		Use:   "backfill CRON_WORKFLOW --from TIME [--to TIME]",
+		Short: "run a cron workflow for each time it was scheduled in the past",
+		Long: `Run a cron workflow for each time it was scheduled between two times, e.g. after an outage.
+
+Each workflow is named and annotated with its scheduled time as if it were run by the cron workflow, so the times that
+already have a workflow are skipped, as are the times in its blackout windows. Workflows are run with up to --parallelism workflows of the cron workflow running at
+a time, or one at a time if its concurrency policy is Forbid or Replace.`,
+		Example: `# Run a cron workflow for each time it was scheduled in the last day:
+  argo cron backfill my-cron --from 1d
+
+# Run a cron workflow for each time it was scheduled in a range, three at a time:
+  argo cron backfill my-cron --from 2023-01-01T00:00:00Z --to 2023-01-08T00:00:00Z --parallelism 3
+
+# Print the times that would be backfilled:
+  argo cron backfill my-cron --from 7d --dry-run
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if opts.parallelism < 1 {
+				errors.CheckError(fmt.Errorf("--parallelism must be at least 1"))
+			}
+			fromTime, err := parseTime(from)
+			errors.CheckError(err)
+			toTime := time.Now()
+			if to != "" {
+				toTime, err = parseTime(to)
+				errors.CheckError(err)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			cronWfClient, err := apiClient.NewCronWorkflowServiceClient()
+			errors.CheckError(err)
+			wfClient := apiClient.NewWorkflowServiceClient()
+			namespace := client.Namespace()
+			cronWf, err := cronWfClient.GetCronWorkflow(ctx, &cronworkflowpkg.GetCronWorkflowRequest{Name: args[0], Namespace: namespace})
+			errors.CheckError(err)
+			windows, err := getBlackoutWindows(ctx, cronWfClient, cronWf)
+			errors.CheckError(err)
+			runs, err := cron.GetScheduledRuns(cronWf, windows, fromTime, toTime)
+			errors.CheckError(err)
+			// the archive is only available with the Argo Server
+			archiveClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			if err != nil {
+				archiveClient = nil
+			}
+			errors.CheckError(backfill(ctx, os.Stdout, wfClient, archiveClient, cronWf, runs, opts))
+		},
+	}
+	command.Flags().StringVar(&from, "from", "", "Backfill the times scheduled from this time, either RFC3339 or a duration ago (e.g. 2023-01-01T00:00:00Z, 3h, 7d)")
+	command.Flags().StringVar(&to, "to", "", "Backfill the times scheduled up to this time, either RFC3339 or a duration ago. Defaults to now")
+	command.Flags().IntVar(&opts.parallelism, "parallelism", 1, "The maximum number of workflows of the cron workflow to run at a time")
+	command.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print the times that would be backfilled, without running any workflows")
+	_ = command.MarkFlagRequired("from")
+	return command
+}
+
+func parseTime(value string) (time.Time, error) {
+	value, err := common.ParseTime(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// backfill runs the workflows of the scheduled runs that have not already been run
+func backfill(ctx context.Context, out io.Writer, wfClient workflowpkg.WorkflowServiceClient, archiveClient workflowarchivepkg.ArchivedWorkflowServiceClient, cronWf *wfv1.CronWorkflow, runs []cron.ScheduledRun, opts backfillOpts) error {
+	existing, err := getExistingWorkflows(ctx, wfClient, archiveClient, cronWf)
+	if err != nil {
+		return err
+	}
+	maxRunning := opts.parallelism
+	switch cronWf.Spec.ConcurrencyPolicy {
+	case wfv1.ForbidConcurrent, wfv1.ReplaceConcurrent:
+		// a backfilled workflow must not replace an earlier one, so they are run one at a time
+		maxRunning = 1
+	}
+	for _, run := range runs {
+		wf, err := cron.NewScheduledWorkflow(cronWf, run.Schedule, run.Time)
+		if err != nil {
+			return err
+		}
+		if existing[wf.Name] {
+			_, _ = fmt.Fprintf(out, "%s: skipped, already run as %s\n", run.Time.Format(time.RFC3339), wf.Name)
+			continue
+		}
+		if opts.dryRun {
+			_, _ = fmt.Fprintf(out, "%s: would run %s\n", run.Time.Format(time.RFC3339), wf.Name)
+			continue
+		}
+		if err := waitForRunningWorkflows(ctx, wfClient, cronWf, maxRunning); err != nil {
+			return err
+		}
+		_, err = wfClient.CreateWorkflow(ctx, &workflowpkg.WorkflowCreateRequest{Namespace: cronWf.Namespace, Workflow: wf})
+		if status.Code(err) == codes.AlreadyExists {
+			_, _ = fmt.Fprintf(out, "%s: skipped, already run as %s\n", run.Time.Format(time.RFC3339), wf.Name)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to run %s: %w", wf.Name, err)
+		}
+		_, _ = fmt.Fprintf(out, "%s: running %s\n", run.Time.Format(time.RFC3339), wf.Name)
+	}
+	return nil
+}
+
+// getExistingWorkflows returns the names of the workflows of the cron workflow, both live and archived
+func getExistingWorkflows(ctx context.Context, wfClient workflowpkg.WorkflowServiceClient, archiveClient workflowarchivepkg.ArchivedWorkflowServiceClient, cronWf *wfv1.CronWorkflow) (map[string]bool, error) {
+	listOptions := &metav1.ListOptions{LabelSelector: wfcommon.LabelKeyCronWorkflow + "=" + cronWf.Name}
+	existing := make(map[string]bool)
+	wfList, err := wfClient.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: cronWf.Namespace, ListOptions: listOptions, Fields: "items.metadata.name"})
+	if err != nil {
+		return nil, err
+	}
+	for _, wf := range wfList.Items {
+		existing[wf.Name] = true
+	}
+	if archiveClient != nil {
+		wfList, err := archiveClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{Namespace: cronWf.Namespace, ListOptions: listOptions})
+		if err != nil {
+			return nil, err
+		}
+		for _, wf := range wfList.Items {
+			existing[wf.Name] = true
+		}
+	}
+	return existing, nil
+}
+
+// waitForRunningWorkflows waits until fewer than maxRunning workflows of the cron workflow are running
+func waitForRunningWorkflows(ctx context.Context, wfClient workflowpkg.WorkflowServiceClient, cronWf *wfv1.CronWorkflow, maxRunning int) error {
+	listOptions := &metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s,%s!=true", wfcommon.LabelKeyCronWorkflow, cronWf.Name, wfcommon.LabelKeyCompleted)}
+	for {
+		wfList, err := wfClient.ListWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: cronWf.Namespace, ListOptions: listOptions, Fields: "items.metadata.name"})
+		if err != nil {
+			return err
+		}
+		if len(wfList.Items) < maxRunning {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backfillPollInterval):
+		}
+	}
+}
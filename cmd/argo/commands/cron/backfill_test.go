@@ -0,0 +1,80 @@
+package cron
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/cron"
+)
+
+func TestBackfill(t *testing.T) {
+	backfillPollInterval = time.Millisecond
+	cronWf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf", Namespace: "my-ns"},
+		Spec:       wfv1.CronWorkflowSpec{Schedule: "0 * * * *", Timezone: "UTC", ConcurrencyPolicy: wfv1.ForbidConcurrent},
+	}
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	runs, err := cron.GetScheduledRuns(cronWf, nil, from, from.Add(3*time.Hour))
+	assert.NoError(t, err)
+	newWorkflowList := func(names ...string) *wfv1.WorkflowList {
+		wfList := &wfv1.WorkflowList{}
+		for _, name := range names {
+			wfList.Items = append(wfList.Items, wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name}})
+		}
+		return wfList
+	}
+	isListRequest := func(labelSelector string) interface{} {
+		return mock.MatchedBy(func(req *workflowpkg.WorkflowListRequest) bool {
+			return req.ListOptions.LabelSelector == labelSelector
+		})
+	}
+	isCreateRequest := func(name string) interface{} {
+		return mock.MatchedBy(func(req *workflowpkg.WorkflowCreateRequest) bool {
+			return req.Workflow.Name == name
+		})
+	}
+
+	t.Run("Backfill", func(t *testing.T) {
+		wfClient := &mocks.WorkflowServiceClient{}
+		// the workflow of the first hour exists, and the last was created since it was listed
+		wfClient.On("ListWorkflows", mock.Anything, isListRequest("workflows.argoproj.io/cron-workflow=my-cwf")).Return(newWorkflowList("my-cwf-1672531200"), nil)
+		// the cron workflow is running a workflow the first time the running workflows are listed
+		wfClient.On("ListWorkflows", mock.Anything, isListRequest("workflows.argoproj.io/cron-workflow=my-cwf,workflows.argoproj.io/completed!=true")).Return(newWorkflowList("running"), nil).Once()
+		wfClient.On("ListWorkflows", mock.Anything, isListRequest("workflows.argoproj.io/cron-workflow=my-cwf,workflows.argoproj.io/completed!=true")).Return(newWorkflowList(), nil)
+		wfClient.On("CreateWorkflow", mock.Anything, isCreateRequest("my-cwf-1672534800")).Return(&wfv1.Workflow{}, nil)
+		wfClient.On("CreateWorkflow", mock.Anything, isCreateRequest("my-cwf-1672538400")).Return(&wfv1.Workflow{}, nil)
+		wfClient.On("CreateWorkflow", mock.Anything, isCreateRequest("my-cwf-1672542000")).Return(nil, status.Error(codes.AlreadyExists, "already exists"))
+		out := &bytes.Buffer{}
+		err := backfill(context.Background(), out, wfClient, nil, cronWf, runs, backfillOpts{parallelism: 2})
+		if assert.NoError(t, err) {
+			assert.Equal(t, `2023-01-01T00:00:00Z: skipped, already run as my-cwf-1672531200
+2023-01-01T01:00:00Z: running my-cwf-1672534800
+2023-01-01T02:00:00Z: running my-cwf-1672538400
+2023-01-01T03:00:00Z: skipped, already run as my-cwf-1672542000
+`, out.String())
+		}
+		wfClient.AssertNumberOfCalls(t, "CreateWorkflow", 3)
+		wfClient.AssertNumberOfCalls(t, "ListWorkflows", 5)
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		wfClient := &mocks.WorkflowServiceClient{}
+		wfClient.On("ListWorkflows", mock.Anything, mock.Anything).Return(newWorkflowList(), nil)
+		out := &bytes.Buffer{}
+		err := backfill(context.Background(), out, wfClient, nil, cronWf, runs[:1], backfillOpts{parallelism: 1, dryRun: true})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "2023-01-01T00:00:00Z: would run my-cwf-1672531200\n", out.String())
+		}
+		wfClient.AssertNotCalled(t, "CreateWorkflow", mock.Anything, mock.Anything)
+	})
+}
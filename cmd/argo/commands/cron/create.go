@@ -110,7 +110,7 @@ func CreateCronWorkflows(ctx context.Context, filePaths []string, cliOpts *cliCr
 		if err != nil {
 			log.Fatalf("Failed to create cron workflow: %v", err)
 		}
-		fmt.Print(getCronWorkflowGet(created))
+		fmt.Print(getCronWorkflowGet(created, getUpcomingBlackoutWindows(ctx, serviceClient, created)))
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/argoproj/pkg/errors"
 	"github.com/argoproj/pkg/humanize"
@@ -15,8 +16,12 @@ import (
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/cron"
 )
 
+// upcomingRuns is the number of upcoming runs to list
+const upcomingRuns = 5
+
 func NewGetCommand() *cobra.Command {
 	var output string
 
@@ -40,7 +45,7 @@ func NewGetCommand() *cobra.Command {
 					Namespace: namespace,
 				})
 				errors.CheckError(err)
-				printCronWorkflow(cronWf, output)
+				printCronWorkflow(cronWf, getUpcomingBlackoutWindows(ctx, serviceClient, cronWf), output)
 			}
 		},
 	}
@@ -49,7 +54,7 @@ func NewGetCommand() *cobra.Command {
 	return command
 }
 
-func printCronWorkflow(wf *wfv1.CronWorkflow, outFmt string) {
+func printCronWorkflow(wf *wfv1.CronWorkflow, blackoutWindows []wfv1.BlackoutWindow, outFmt string) {
 	switch outFmt {
 	case "name":
 		fmt.Println(wf.ObjectMeta.Name)
@@ -60,30 +65,52 @@ func printCronWorkflow(wf *wfv1.CronWorkflow, outFmt string) {
 		outBytes, _ := yaml.Marshal(wf)
 		fmt.Print(string(outBytes))
 	case "wide", "":
-		fmt.Print(getCronWorkflowGet(wf))
+		fmt.Print(getCronWorkflowGet(wf, blackoutWindows))
 	default:
 		log.Fatalf("Unknown output format: %s", outFmt)
 	}
 }
 
-func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
+// getCronWorkflowGet describes the cron workflow, and lists its upcoming runs that are not in the blackout windows
+func getCronWorkflowGet(cwf *wfv1.CronWorkflow, blackoutWindows []wfv1.BlackoutWindow) string {
 	const fmtStr = "%-30s %v\n"
 
 	out := ""
 	out += fmt.Sprintf(fmtStr, "Name:", cwf.ObjectMeta.Name)
 	out += fmt.Sprintf(fmtStr, "Namespace:", cwf.ObjectMeta.Namespace)
 	out += fmt.Sprintf(fmtStr, "Created:", humanize.Timestamp(cwf.ObjectMeta.CreationTimestamp.Time))
-	out += fmt.Sprintf(fmtStr, "Schedule:", cwf.Spec.Schedule)
+	if cwf.Spec.Schedule != "" {
+		out += fmt.Sprintf(fmtStr, "Schedule:", cwf.Spec.Schedule)
+	}
+	for _, schedule := range cwf.Spec.Schedules {
+		s := schedule.Schedule
+		if schedule.Timezone != "" {
+			s += " (" + schedule.Timezone + ")"
+		}
+		if schedule.Name != "" {
+			s = schedule.Name + ": " + s
+		}
+		out += fmt.Sprintf(fmtStr, "Schedule:", s)
+	}
 	out += fmt.Sprintf(fmtStr, "Suspended:", cwf.Spec.Suspend)
 	if cwf.Spec.Timezone != "" {
 		out += fmt.Sprintf(fmtStr, "Timezone:", cwf.Spec.Timezone)
 	}
+	if cwf.Spec.Jitter != nil {
+		out += fmt.Sprintf(fmtStr, "Jitter:", fmt.Sprintf("%s (runs are delayed by %s)", cwf.Spec.Jitter.Duration, cron.GetJitterOffset(cwf)))
+	}
 	if cwf.Spec.StartingDeadlineSeconds != nil {
 		out += fmt.Sprintf(fmtStr, "StartingDeadlineSeconds:", *cwf.Spec.StartingDeadlineSeconds)
 	}
 	if cwf.Spec.ConcurrencyPolicy != "" {
 		out += fmt.Sprintf(fmtStr, "ConcurrencyPolicy:", cwf.Spec.ConcurrencyPolicy)
 	}
+	for _, window := range cwf.Spec.BlackoutWindows {
+		out += fmt.Sprintf(fmtStr, "BlackoutWindow:", describeBlackoutWindow(window))
+	}
+	for _, calendar := range cwf.Spec.BlackoutCalendars {
+		out += fmt.Sprintf(fmtStr, "BlackoutCalendar:", calendar.Name+"/"+calendar.Key)
+	}
 	if cwf.Status.LastScheduledTime != nil {
 		out += fmt.Sprintf(fmtStr, "LastScheduledTime:", humanize.Timestamp(cwf.Status.LastScheduledTime.Time))
 	}
@@ -92,6 +119,12 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 	if err == nil {
 		out += fmt.Sprintf(fmtStr, "NextScheduledTime:", humanize.Timestamp(next)+" (assumes workflow-controller is in UTC)")
 	}
+	if runs, err := cron.GetUpcomingRuns(cwf, blackoutWindows, time.Now().UTC(), upcomingRuns); err == nil && len(runs) > 0 {
+		out += fmt.Sprintf(fmtStr, "Upcoming Runs:", "")
+		for _, run := range runs {
+			out += fmt.Sprintf(fmtStr, "  "+run.Time.Local().Format(time.RFC3339), run.Schedule.Name)
+		}
+	}
 
 	if len(cwf.Status.Active) > 0 {
 		var activeWfNames []string
@@ -100,8 +133,26 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 		}
 		out += fmt.Sprintf(fmtStr, "Active Workflows:", strings.Join(activeWfNames, ", "))
 	}
+	if strategy := cwf.Spec.StopStrategy; strategy != nil {
+		if strategy.ConsecutiveFailures > 0 {
+			out += fmt.Sprintf(fmtStr, "StopStrategy:", fmt.Sprintf("%d consecutive failures", strategy.ConsecutiveFailures))
+		}
+		if strategy.Expression != "" {
+			out += fmt.Sprintf(fmtStr, "StopStrategy:", strategy.Expression)
+		}
+	}
+	if cwf.Status.Succeeded > 0 || cwf.Status.Failed > 0 {
+		out += fmt.Sprintf(fmtStr, "Succeeded:", cwf.Status.Succeeded)
+		out += fmt.Sprintf(fmtStr, "Failed:", fmt.Sprintf("%d (%d consecutive)", cwf.Status.Failed, cwf.Status.ConsecutiveFailures))
+	}
+	if len(cwf.Status.RecentRuns) > 0 {
+		out += fmt.Sprintf(fmtStr, "Recent Runs:", "")
+		for _, run := range cwf.Status.RecentRuns {
+			out += fmt.Sprintf(fmtStr, "  "+run.Name+":", fmt.Sprintf("%s in %s, %s", run.Phase, run.Duration.Duration, humanize.Timestamp(run.FinishedAt.Time)))
+		}
+	}
 	if len(cwf.Status.Conditions) > 0 {
-		out += cwf.Status.Conditions.DisplayString(fmtStr, map[wfv1.ConditionType]string{wfv1.ConditionTypeSubmissionError: "✖"})
+		out += cwf.Status.Conditions.DisplayString(fmtStr, map[wfv1.ConditionType]string{wfv1.ConditionTypeSubmissionError: "✖", wfv1.ConditionTypeStopped: "✖"})
 	}
 	if len(cwf.Spec.WorkflowSpec.Arguments.Parameters) > 0 {
 		out += fmt.Sprintf(fmtStr, "Workflow Parameters:", "")
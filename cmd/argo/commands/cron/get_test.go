@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/cron"
 )
 
 var invalidCwf = `
@@ -55,7 +57,7 @@ Conditions:
 
 func TestPrintCronWorkflow(t *testing.T) {
 	var cronWf = v1alpha1.MustUnmarshalCronWorkflow(invalidCwf)
-	out := getCronWorkflowGet(cronWf)
+	out := getCronWorkflowGet(cronWf, nil)
 	assert.Contains(t, out, expectedOut)
 }
 
@@ -67,3 +69,75 @@ func TestNextRuntime(t *testing.T) {
 		assert.Greater(t, next.Unix(), time.Now().Unix())
 	}
 }
+
+func TestNextRuntimeMultipleSchedules(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{Spec: v1alpha1.CronWorkflowSpec{
+		Schedule:  "0 0 1 1 *",
+		Schedules: []v1alpha1.CronWorkflowSchedule{{Name: "every-minute", Schedule: "* * * * *", Timezone: "Asia/Tokyo"}},
+	}}
+	next, err := GetNextRuntime(cronWf)
+	if assert.NoError(t, err) {
+		assert.LessOrEqual(t, next.Unix(), time.Now().Add(1*time.Minute).Unix())
+	}
+	out := getCronWorkflowGet(cronWf, nil)
+	assert.Contains(t, out, "Schedule:                      0 0 1 1 *\n")
+	assert.Contains(t, out, "Schedule:                      every-minute: * * * * * (Asia/Tokyo)\n")
+	assert.Equal(t, "0 0 1 1 *,* * * * *", getSchedules(cronWf))
+
+	_, err = GetNextRuntime(&v1alpha1.CronWorkflow{})
+	assert.Error(t, err)
+}
+
+func TestPrintCronWorkflowBlackoutWindows(t *testing.T) {
+	now := time.Now()
+	cronWf := &v1alpha1.CronWorkflow{Spec: v1alpha1.CronWorkflowSpec{
+		Schedule: "0 * * * *",
+		BlackoutWindows: []v1alpha1.BlackoutWindow{{
+			Name:  "freeze",
+			Start: metav1.NewTime(now),
+			End:   metav1.NewTime(now.Add(24 * time.Hour)),
+		}},
+	}}
+	out := getCronWorkflowGet(cronWf, cronWf.Spec.BlackoutWindows)
+	assert.Contains(t, out, "BlackoutWindow:                freeze: ")
+	assert.Contains(t, out, "Upcoming Runs:")
+	next := now.Add(24 * time.Hour).Truncate(time.Hour)
+	if next.Before(now.Add(24 * time.Hour)) {
+		next = next.Add(time.Hour)
+	}
+	assert.Contains(t, out, "  "+next.Format(time.RFC3339), "the first upcoming run is after the blackout window")
+}
+
+func TestPrintCronWorkflowRecentRuns(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{
+		Spec: v1alpha1.CronWorkflowSpec{
+			Schedule:     "0 * * * *",
+			StopStrategy: &v1alpha1.StopStrategy{ConsecutiveFailures: 3},
+		},
+		Status: v1alpha1.CronWorkflowStatus{
+			RecentRuns: []v1alpha1.CronWorkflowRun{{
+				Name:       "my-cwf-1672531200",
+				Phase:      v1alpha1.WorkflowFailed,
+				FinishedAt: metav1.Now(),
+				Duration:   metav1.Duration{Duration: time.Minute},
+			}},
+			Succeeded:           2,
+			Failed:              1,
+			ConsecutiveFailures: 1,
+		},
+	}
+	out := getCronWorkflowGet(cronWf, nil)
+	assert.Contains(t, out, "StopStrategy:                  3 consecutive failures\n")
+	assert.Contains(t, out, "Succeeded:                     2\n")
+	assert.Contains(t, out, "Failed:                        1 (1 consecutive)\n")
+	assert.Contains(t, out, "  my-cwf-1672531200:           Failed in 1m0s, ")
+}
+
+func TestPrintCronWorkflowJitter(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf", Namespace: "argo"},
+		Spec:       v1alpha1.CronWorkflowSpec{Schedule: "0 * * * *", Jitter: &metav1.Duration{Duration: time.Hour}},
+	}
+	out := getCronWorkflowGet(cronWf, nil)
+	assert.Contains(t, out, "Jitter:                        1h0m0s (runs are delayed by "+cron.GetJitterOffset(cronWf).String()+")\n")
+}
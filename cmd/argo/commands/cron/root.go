@@ -21,6 +21,8 @@ func NewCronWorkflowCommand() *cobra.Command {
 	command.AddCommand(NewLintCommand())
 	command.AddCommand(NewSuspendCommand())
 	command.AddCommand(NewResumeCommand())
+	command.AddCommand(NewBackfillCommand())
+	command.AddCommand(NewUpdateCommand())
 
 	return command
 }
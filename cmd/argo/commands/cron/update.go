@@ -0,0 +1,103 @@
+package cron
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// NewUpdateCommand returns a new instance of an `argo cron update` command
+func NewUpdateCommand() *cobra.Command {
+	var opts common.UpdateOpts
+	command := &cobra.Command{
+		Use:   "update FILE1 FILE2...",
+		Short: "update cron workflows",
+		Long: `Update cron workflows from files, replacing their specs.
+
+Every cron workflow is validated before any is updated. The status of each cron workflow, such as its active workflows
+and run history, is kept. With --create, the cron workflows that do not exist are created, like kubectl apply. With
+--dry-run, the differences between the existing cron workflows and the files are printed instead.`,
+		Example: `# Update a cron workflow:
+  argo cron update my-cron.yaml
+
+# Create or update the cron workflows in a directory:
+  argo cron update --create crons/*.yaml
+
+# Print the changes that would be made:
+  argo cron update --dry-run my-cron.yaml
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewCronWorkflowServiceClient()
+			if err != nil {
+				log.Fatal(err)
+			}
+			fileContents, err := util.ReadManifest(args...)
+			if err != nil {
+				log.Fatal(err)
+			}
+			var cronWorkflows []wfv1.CronWorkflow
+			for _, body := range fileContents {
+				cronWorkflows = append(cronWorkflows, unmarshalCronWorkflows(body, opts.Strict)...)
+			}
+			if len(cronWorkflows) == 0 {
+				log.Println("No CronWorkflows found in given files")
+				os.Exit(1)
+			}
+			for i := range cronWorkflows {
+				if cronWorkflows[i].Namespace == "" {
+					cronWorkflows[i].Namespace = client.Namespace()
+				}
+			}
+			if err := updateCronWorkflows(ctx, os.Stdout, serviceClient, cronWorkflows, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	common.AddUpdateFlags(command, &opts)
+	return command
+}
+
+// updateCronWorkflows validates all the cron workflows, and then updates or creates each of them
+func updateCronWorkflows(ctx context.Context, out io.Writer, serviceClient cronworkflowpkg.CronWorkflowServiceClient, cronWorkflows []wfv1.CronWorkflow, opts common.UpdateOpts) error {
+	return common.UpdateObjects(ctx, out, cronWorkflows, opts, common.Updater[wfv1.CronWorkflow]{
+		Kind: "cron workflow",
+		Lint: func(ctx context.Context, cronWf *wfv1.CronWorkflow) error {
+			_, err := serviceClient.LintCronWorkflow(ctx, &cronworkflowpkg.LintCronWorkflowRequest{Namespace: cronWf.Namespace, CronWorkflow: cronWf.DeepCopy()})
+			return err
+		},
+		Get: func(ctx context.Context, cronWf *wfv1.CronWorkflow) (*wfv1.CronWorkflow, error) {
+			return serviceClient.GetCronWorkflow(ctx, &cronworkflowpkg.GetCronWorkflowRequest{Name: cronWf.Name, Namespace: cronWf.Namespace})
+		},
+		Prepare: func(live, cronWf *wfv1.CronWorkflow) {
+			// CronWorkflows have no status subresource, so the status is kept by updating it with the spec, as is the
+			// schedule that the controller last used, so that it can tell whether the schedule has changed
+			cronWf.Status = live.Status
+			if schedule := live.GetLatestSchedule(); schedule != "" {
+				cronWf.SetSchedule(schedule)
+			}
+		},
+		Create: func(ctx context.Context, cronWf *wfv1.CronWorkflow) (*wfv1.CronWorkflow, error) {
+			return serviceClient.CreateCronWorkflow(ctx, &cronworkflowpkg.CreateCronWorkflowRequest{Namespace: cronWf.Namespace, CronWorkflow: cronWf})
+		},
+		Update: func(ctx context.Context, cronWf *wfv1.CronWorkflow) (*wfv1.CronWorkflow, error) {
+			return serviceClient.UpdateCronWorkflow(ctx, &cronworkflowpkg.UpdateCronWorkflowRequest{Namespace: cronWf.Namespace, CronWorkflow: cronWf})
+		},
+		Print: func(ctx context.Context, cronWf *wfv1.CronWorkflow) {
+			printCronWorkflow(cronWf, getUpcomingBlackoutWindows(ctx, serviceClient, cronWf), opts.Output)
+		},
+	})
+}
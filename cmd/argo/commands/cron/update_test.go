@@ -0,0 +1,79 @@
+package cron
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestUpdateCronWorkflows(t *testing.T) {
+	ctx := context.Background()
+	live := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "argo", ResourceVersion: "123"},
+		Spec:       wfv1.CronWorkflowSpec{Schedule: "* * * * *", WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: "main"}},
+		Status:     wfv1.CronWorkflowStatus{Succeeded: 3},
+	}
+	live.SetSchedule("* * * * *")
+	cronWfs := []wfv1.CronWorkflow{
+		{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "argo"}, Spec: wfv1.CronWorkflowSpec{Schedule: "0 * * * *", WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: "other"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "argo"}, Spec: wfv1.CronWorkflowSpec{Schedule: "* * * * *", WorkflowSpec: wfv1.WorkflowSpec{Entrypoint: "main"}}},
+	}
+	newClient := func() *mocks.CronWorkflowServiceClient {
+		client := &mocks.CronWorkflowServiceClient{}
+		client.On("LintCronWorkflow", mock.Anything, mock.Anything).Return(nil, nil)
+		client.On("GetCronWorkflow", mock.Anything, &cronworkflowpkg.GetCronWorkflowRequest{Name: "existing", Namespace: "argo"}).Return(live, nil)
+		client.On("GetCronWorkflow", mock.Anything, &cronworkflowpkg.GetCronWorkflowRequest{Name: "new", Namespace: "argo"}).Return(nil, status.Error(codes.NotFound, "not found"))
+		return client
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		client := &mocks.CronWorkflowServiceClient{}
+		client.On("LintCronWorkflow", mock.Anything, mock.Anything).Return(nil, status.Error(codes.InvalidArgument, "invalid"))
+		err := updateCronWorkflows(ctx, &bytes.Buffer{}, client, cronWfs, common.UpdateOpts{Create: true})
+		assert.EqualError(t, err, "failed to validate cron workflow existing: rpc error: code = InvalidArgument desc = invalid")
+		client.AssertNotCalled(t, "UpdateCronWorkflow", mock.Anything, mock.Anything)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		client := newClient()
+		client.On("UpdateCronWorkflow", mock.Anything, mock.Anything).Return(live, nil)
+		err := updateCronWorkflows(ctx, &bytes.Buffer{}, client, cronWfs, common.UpdateOpts{Output: "name"})
+		assert.EqualError(t, err, "failed to get cron workflow new: rpc error: code = NotFound desc = not found")
+		client.AssertNotCalled(t, "CreateCronWorkflow", mock.Anything, mock.Anything)
+	})
+	t.Run("Create", func(t *testing.T) {
+		client := newClient()
+		client.On("UpdateCronWorkflow", mock.Anything, mock.MatchedBy(func(req *cronworkflowpkg.UpdateCronWorkflowRequest) bool {
+			cronWf := req.CronWorkflow
+			return cronWf.ResourceVersion == "123" && cronWf.Spec.WorkflowSpec.Entrypoint == "other" &&
+				cronWf.Status.Succeeded == 3 && cronWf.GetLatestSchedule() == "* * * * *"
+		})).Return(live, nil)
+		client.On("CreateCronWorkflow", mock.Anything, mock.MatchedBy(func(req *cronworkflowpkg.CreateCronWorkflowRequest) bool {
+			return req.CronWorkflow.Name == "new"
+		})).Return(&cronWfs[1], nil)
+		err := updateCronWorkflows(ctx, &bytes.Buffer{}, client, cronWfs, common.UpdateOpts{Output: "name", Create: true})
+		require.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		client := newClient()
+		out := &bytes.Buffer{}
+		err := updateCronWorkflows(ctx, out, client, cronWfs, common.UpdateOpts{Create: true, DryRun: true})
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "-  schedule: '* * * * *'\n+  schedule: 0 * * * *\n")
+		assert.Contains(t, out.String(), "+++ new/new\n")
+		client.AssertNotCalled(t, "UpdateCronWorkflow", mock.Anything, mock.Anything)
+		client.AssertNotCalled(t, "CreateCronWorkflow", mock.Anything, mock.Anything)
+	})
+}
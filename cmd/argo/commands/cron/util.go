@@ -1,19 +1,81 @@
 package cron
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"time"
 
-	"github.com/robfig/cron/v3"
+	log "github.com/sirupsen/logrus"
 
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	cronutil "github.com/argoproj/argo-workflows/v3/workflow/cron"
 )
 
-// GetNextRuntime returns the next time the workflow should run in local time. It assumes the workflow-controller is in
-// UTC, but nevertheless returns the time in the local timezone.
+// GetNextRuntime returns the next time the workflow should run on any of its schedules in local time. It assumes the
+// workflow-controller is in UTC, but nevertheless returns the time in the local timezone.
 func GetNextRuntime(cwf *v1alpha1.CronWorkflow) (time.Time, error) {
-	cronSchedule, err := cron.ParseStandard(cwf.Spec.GetScheduleString())
+	var next time.Time
+	for _, schedule := range cwf.Spec.GetSchedules() {
+		cronSchedule, err := cronutil.ParseSchedule(schedule)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if t := cronSchedule.Next(time.Now().UTC()); next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron workflow has no schedule")
+	}
+	return next.Local(), nil
+}
+
+// getSchedules returns the schedules of the workflow, separated by commas
+func getSchedules(cwf *v1alpha1.CronWorkflow) string {
+	schedules := make([]string, 0, len(cwf.Spec.Schedules)+1)
+	if cwf.Spec.Schedule != "" {
+		schedules = append(schedules, cwf.Spec.Schedule)
+	}
+	for _, schedule := range cwf.Spec.Schedules {
+		schedules = append(schedules, schedule.Schedule)
+	}
+	return strings.Join(schedules, ",")
+}
+
+// getBlackoutWindows returns the blackout windows of the workflow, including the events of its blackout calendars
+func getBlackoutWindows(ctx context.Context, serviceClient cronworkflowpkg.CronWorkflowServiceClient, cwf *v1alpha1.CronWorkflow) ([]v1alpha1.BlackoutWindow, error) {
+	if len(cwf.Spec.BlackoutCalendars) == 0 {
+		return cwf.Spec.BlackoutWindows, nil
+	}
+	resp, err := serviceClient.GetCronWorkflowBlackoutWindows(ctx, &cronworkflowpkg.GetCronWorkflowBlackoutWindowsRequest{Name: cwf.Name, Namespace: cwf.Namespace})
 	if err != nil {
-		return time.Time{}, err
+		return nil, fmt.Errorf("failed to get the blackout windows of cron workflow %s: %w", cwf.Name, err)
+	}
+	windows := make([]v1alpha1.BlackoutWindow, 0, len(resp.Windows))
+	for _, window := range resp.Windows {
+		windows = append(windows, *window)
+	}
+	return windows, nil
+}
+
+// getUpcomingBlackoutWindows returns the blackout windows of the workflow to list its upcoming runs with, which are only
+// its blackout windows if the events of its blackout calendars cannot be read
+func getUpcomingBlackoutWindows(ctx context.Context, serviceClient cronworkflowpkg.CronWorkflowServiceClient, cwf *v1alpha1.CronWorkflow) []v1alpha1.BlackoutWindow {
+	windows, err := getBlackoutWindows(ctx, serviceClient, cwf)
+	if err != nil {
+		log.WithError(err).Warn("Failed to read the blackout calendars, the upcoming runs only exclude the blackout windows")
+		return cwf.Spec.BlackoutWindows
+	}
+	return windows
+}
+
+// describeBlackoutWindow returns the name of the window, followed by when it starts and ends in local time
+func describeBlackoutWindow(window v1alpha1.BlackoutWindow) string {
+	s := window.Start.Local().Format(time.RFC3339) + " - " + window.End.Local().Format(time.RFC3339)
+	if window.Name != "" {
+		s = window.Name + ": " + s
 	}
-	return cronSchedule.Next(time.Now().UTC()).Local(), nil
+	return s
 }
@@ -8,10 +8,12 @@ import (
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/archive"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/auth"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cache"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/clustertemplate"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cron"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/executorplugin"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/sync"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/template"
 	cmdutil "github.com/argoproj/argo-workflows/v3/util/cmd"
 )
@@ -113,6 +115,9 @@ If your server is behind an ingress with a path (you'll be running "argo server
 	command.AddCommand(cron.NewCronWorkflowCommand())
 	command.AddCommand(clustertemplate.NewClusterTemplateCommand())
 	command.AddCommand(executorplugin.NewRootCommand())
+	command.AddCommand(sync.NewSyncCommand())
+	command.AddCommand(cache.NewCacheCommand())
+	command.AddCommand(NewStatsCommand())
 
 	client.AddKubectlFlagsToCmd(command)
 	client.AddAPIClientFlagsToCmd(command)
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowstatspkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowstats"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func NewStatsCommand() *cobra.Command {
+	var (
+		startedAfter  string
+		startedBefore string
+		output        string
+		req           workflowstatspkg.WorkflowStatsRequest
+	)
+	command := &cobra.Command{
+		Use:   "stats [GROUP]",
+		Short: "print the run statistics of live and archived workflows",
+		Long: `Print the run statistics of live and archived workflows, grouped by the workflow template they were submitted from,
+or by the cluster workflow template, the cron workflow or a label, and optionally by when they started.`,
+		Example: `# Print the statistics of the workflows submitted from each workflow template:
+  argo stats
+
+# Print the daily statistics of the workflows of a cron workflow over the last week:
+  argo stats my-cron --group-by cronWorkflow --bucket 1d --started-after 7d
+
+# Print the statistics of workflows grouped by a label:
+  argo stats --group-by team
+`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewWorkflowStatsServiceClient()
+			errors.CheckError(err)
+			req.Namespace = client.Namespace()
+			if len(args) > 0 {
+				req.Group = args[0]
+			}
+			req.StartedAfter, err = common.ParseTime(startedAfter)
+			errors.CheckError(err)
+			req.StartedBefore, err = common.ParseTime(startedBefore)
+			errors.CheckError(err)
+			res, err := serviceClient.GetWorkflowStats(ctx, &req)
+			errors.CheckError(err)
+			switch output {
+			case "", "wide":
+				printWorkflowStats(os.Stdout, res.Items, output)
+			case "json":
+				outBytes, _ := json.MarshalIndent(res.Items, "", "    ")
+				fmt.Println(string(outBytes))
+			case "yaml":
+				outBytes, _ := yaml.Marshal(res.Items)
+				fmt.Print(string(outBytes))
+			default:
+				log.Fatalf("Unknown output mode: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVar(&req.GroupBy, "group-by", "workflowTemplate", "Group workflows by workflowTemplate, clusterWorkflowTemplate, cronWorkflow, or the key of a label")
+	command.Flags().StringVar(&req.Bucket, "bucket", "", "Also group workflows by the time bucket they started in (e.g. 1h, 1d)")
+	command.Flags().StringVar(&startedAfter, "started-after", "", "Only aggregate workflows that started after a time, either RFC3339 or a duration ago (e.g. 2023-01-01T00:00:00Z, 3h, 7d)")
+	command.Flags().StringVar(&startedBefore, "started-before", "", "Only aggregate workflows that started before a time, either RFC3339 or a duration ago (e.g. 2023-01-01T00:00:00Z, 3h, 7d)")
+	command.Flags().StringVarP(&req.LabelSelector, "selector", "l", "", "Only aggregate workflows that match a label selector (e.g. -l key1=value1,key2=value2)")
+	command.Flags().Int32Var(&req.TopFailureMessages, "top-failure-messages", 5, "The number of the most common failure messages to print with -o wide")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide|json|yaml")
+	return command
+}
+
+func printWorkflowStats(out io.Writer, items []*workflowstatspkg.WorkflowStats, output string) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "GROUP\tBUCKET\tTOTAL\tSUCCEEDED\tFAILED\tSUCCESS RATE\tP50\tP95\tCPU\tMEMORY")
+	if output == "wide" {
+		_, _ = fmt.Fprint(w, "\tTOP FAILURE MESSAGE")
+	}
+	_, _ = fmt.Fprint(w, "\n")
+	for _, item := range items {
+		bucket := "-"
+		if item.BucketStart != nil {
+			bucket = item.BucketStart.UTC().Format(time.RFC3339)
+		}
+		var total int64
+		for _, count := range item.Phases {
+			total += count
+		}
+		succeeded := item.Phases[string(wfv1.WorkflowSucceeded)]
+		failed := item.Phases[string(wfv1.WorkflowFailed)] + item.Phases[string(wfv1.WorkflowError)]
+		successRate := "-"
+		if succeeded+failed > 0 {
+			successRate = fmt.Sprintf("%.0f%%", float64(succeeded)*100/float64(succeeded+failed))
+		}
+		var p50, p95 int64
+		if item.Durations != nil {
+			p50, p95 = item.Durations.P50, item.Durations.P95
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\t%s\t%s", item.Group, bucket, total, succeeded, failed, successRate,
+			seconds(p50), seconds(p95), seconds(item.ResourcesDuration["cpu"]), seconds(item.ResourcesDuration["memory"]))
+		if output == "wide" {
+			message := "-"
+			if len(item.TopFailureMessages) > 0 {
+				message = fmt.Sprintf("%s (%d)", item.TopFailureMessages[0].Message, item.TopFailureMessages[0].Count)
+			}
+			_, _ = fmt.Fprintf(w, "\t%s", message)
+		}
+		_, _ = fmt.Fprint(w, "\n")
+	}
+	_ = w.Flush()
+}
+
+func seconds(s int64) string {
+	return (time.Duration(s) * time.Second).String()
+}
@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowstatspkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowstats"
+)
+
+func Test_printWorkflowStats(t *testing.T) {
+	items := []*workflowstatspkg.WorkflowStats{
+		{
+			Group:              "my-wftmpl",
+			BucketStart:        &metav1.Time{Time: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)},
+			Phases:             map[string]int64{"Succeeded": 3, "Failed": 1},
+			Durations:          &workflowstatspkg.DurationPercentiles{P50: 60, P95: 150},
+			ResourcesDuration:  map[string]int64{"cpu": 90, "memory": 3600},
+			TopFailureMessages: []*workflowstatspkg.FailureMessage{{Message: "OOMKilled", Count: 1}},
+		},
+		{Group: "other-wftmpl", Phases: map[string]int64{"Running": 1}},
+	}
+	out := &bytes.Buffer{}
+	printWorkflowStats(out, items, "wide")
+	assert.Equal(t, `GROUP          BUCKET                 TOTAL   SUCCEEDED   FAILED   SUCCESS RATE   P50    P95     CPU     MEMORY   TOP FAILURE MESSAGE
+my-wftmpl      2023-03-01T00:00:00Z   4       3           1        75%            1m0s   2m30s   1m30s   1h0m0s   OOMKilled (1)
+other-wftmpl   -                      1       0           0        -              0s     0s      0s      0s       -
+`, out.String())
+}
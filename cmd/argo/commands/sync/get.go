@@ -0,0 +1,134 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
+	wfsync "github.com/argoproj/argo-workflows/v3/workflow/sync"
+)
+
+func NewGetCommand() *cobra.Command {
+	var output string
+
+	command := &cobra.Command{
+		Use:   "get LOCK...",
+		Short: "display the holders and waiters of a synchronization lock",
+		Example: `# Get a semaphore, whose limit is the "workflow" key of the "my-config" ConfigMap:
+  argo sync get ConfigMap/my-config/workflow
+
+# Get a mutex:
+  argo sync get Mutex/my-mutex
+
+# Get a rate limit:
+  argo sync get RateLimit/my-rate-limit
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewSyncServiceClient()
+			errors.CheckError(err)
+			namespace := client.Namespace()
+
+			for _, arg := range args {
+				name, err := wfsync.DecodeLockName(fmt.Sprintf("%s/%s", namespace, arg))
+				errors.CheckError(err)
+				lock, err := serviceClient.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{
+					Namespace: namespace,
+					Kind:      string(name.Kind),
+					Name:      name.ResourceName,
+					Key:       name.Key,
+				})
+				errors.CheckError(err)
+				printLock(lock, output)
+			}
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printLock(lock *syncpkg.SyncLock, outFmt string) {
+	switch outFmt {
+	case "name":
+		fmt.Println(lockName(lock))
+	case "json":
+		outBytes, _ := json.MarshalIndent(lock, "", "    ")
+		fmt.Println(string(outBytes))
+	case "yaml":
+		outBytes, _ := yaml.Marshal(lock)
+		fmt.Print(string(outBytes))
+	case "wide", "":
+		fmt.Print(getLockGet(lock, time.Now()))
+	default:
+		log.Fatalf("Unknown output format: %s", outFmt)
+	}
+}
+
+func getLockGet(lock *syncpkg.SyncLock, now time.Time) string {
+	const fmtStr = "%-30s %v\n"
+
+	out := ""
+	out += fmt.Sprintf(fmtStr, "Name:", lockName(lock))
+	out += fmt.Sprintf(fmtStr, "Namespace:", lock.Namespace)
+	out += fmt.Sprintf(fmtStr, "Kind:", lock.Kind)
+	out += fmt.Sprintf(fmtStr, "Limit:", limit(lock))
+	out += fmt.Sprintf(fmtStr, "Available:", available(lock))
+
+	out += fmt.Sprintf(fmtStr, "Holders:", len(lock.Holders))
+	if len(lock.Holders) > 0 {
+		out += holderTable(lock.Holders, false, now)
+	}
+	out += fmt.Sprintf(fmtStr, "Pending:", len(lock.Pending))
+	if len(lock.Pending) > 0 {
+		out += holderTable(lock.Pending, true, now)
+	}
+	return out
+}
+
+func holderTable(holders []*syncpkg.SyncLockHolder, pending bool, now time.Time) string {
+	b := &strings.Builder{}
+	w := tabwriter.NewWriter(b, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "  ")
+	if pending {
+		_, _ = fmt.Fprint(w, "#\t")
+	}
+	_, _ = fmt.Fprint(w, "WORKFLOW\tNODE")
+	if pending {
+		_, _ = fmt.Fprint(w, "\tWAITING")
+	}
+	_, _ = fmt.Fprint(w, "\n")
+	for i, holder := range holders {
+		_, _ = fmt.Fprint(w, "  ")
+		if pending {
+			_, _ = fmt.Fprintf(w, "%d\t", i+1)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s", holder.WorkflowName, holder.NodeId)
+		if pending {
+			waiting := "-"
+			if holder.WaitingSince != nil && !holder.WaitingSince.IsZero() {
+				waiting = humanize.RelativeDurationShort(holder.WaitingSince.Time, now)
+			}
+			_, _ = fmt.Fprintf(w, "\t%s", waiting)
+		}
+		_, _ = fmt.Fprint(w, "\n")
+	}
+	_ = w.Flush()
+	return b.String()
+}
@@ -0,0 +1,42 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
+)
+
+func TestGetLockGet(t *testing.T) {
+	now := time.Now()
+	lock := &syncpkg.SyncLock{
+		LockName:  "argo/ConfigMap/my-config/workflow",
+		Namespace: "argo",
+		Kind:      "ConfigMap",
+		Name:      "my-config",
+		Key:       "workflow",
+		Limit:     2,
+		Available: 1,
+		Holders:   []*syncpkg.SyncLockHolder{{Key: "argo/one", WorkflowName: "one"}},
+		Pending: []*syncpkg.SyncLockHolder{
+			{Key: "argo/two/two-123", WorkflowName: "two", NodeId: "two-123", WaitingSince: &metav1.Time{Time: now.Add(-5 * time.Minute)}},
+			{Key: "argo/three", WorkflowName: "three"},
+		},
+	}
+	assert.Equal(t, `Name:                          ConfigMap/my-config/workflow
+Namespace:                     argo
+Kind:                          ConfigMap
+Limit:                         2
+Available:                     1
+Holders:                       1
+  WORKFLOW   NODE
+  one        
+Pending:                       2
+  #   WORKFLOW   NODE      WAITING
+  1   two        two-123   5m
+  2   three                -
+`, getLockGet(lock, now))
+}
@@ -0,0 +1,106 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
+)
+
+type listFlags struct {
+	allNamespaces bool   // --all-namespaces
+	output        string // --output
+}
+
+func NewListCommand() *cobra.Command {
+	var listArgs listFlags
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "list synchronization locks",
+		Example: `# List the locks in the current namespace:
+  argo sync list
+
+# List the locks in all namespaces:
+  argo sync list -A
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewSyncServiceClient()
+			errors.CheckError(err)
+			namespace := client.Namespace()
+			if listArgs.allNamespaces {
+				namespace = ""
+			}
+			locks, err := serviceClient.ListSyncLocks(ctx, &syncpkg.ListSyncLocksRequest{Namespace: namespace})
+			errors.CheckError(err)
+			switch listArgs.output {
+			case "", "wide":
+				printTable(locks.Items, &listArgs)
+			case "name":
+				for _, lock := range locks.Items {
+					fmt.Println(lockName(lock))
+				}
+			case "json":
+				outBytes, _ := json.MarshalIndent(locks.Items, "", "    ")
+				fmt.Println(string(outBytes))
+			case "yaml":
+				outBytes, _ := yaml.Marshal(locks.Items)
+				fmt.Print(string(outBytes))
+			default:
+				log.Fatalf("Unknown output mode: %s", listArgs.output)
+			}
+		},
+	}
+	command.Flags().BoolVarP(&listArgs.allNamespaces, "all-namespaces", "A", false, "Show locks from all namespaces")
+	command.Flags().StringVarP(&listArgs.output, "output", "o", "", "Output format. One of: wide|name|json|yaml")
+	return command
+}
+
+func printTable(locks []*syncpkg.SyncLock, listArgs *listFlags) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	if listArgs.allNamespaces {
+		_, _ = fmt.Fprint(w, "NAMESPACE\t")
+	}
+	_, _ = fmt.Fprint(w, "LOCK\tLIMIT\tHOLDERS\tPENDING")
+	_, _ = fmt.Fprint(w, "\n")
+	for _, lock := range locks {
+		if listArgs.allNamespaces {
+			_, _ = fmt.Fprintf(w, "%s\t", lock.Namespace)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", lockName(lock), limit(lock), len(lock.Holders), len(lock.Pending))
+	}
+	_ = w.Flush()
+}
+
+// lockName returns the name of the lock as used by `argo sync get`
+func lockName(lock *syncpkg.SyncLock) string {
+	name := fmt.Sprintf("%s/%s", lock.Kind, lock.Name)
+	if lock.Key != "" {
+		name = fmt.Sprintf("%s/%s", name, lock.Key)
+	}
+	return name
+}
+
+// limit returns the limit of the lock, which is not known for a rate limit that is only held, not waited for
+func limit(lock *syncpkg.SyncLock) string {
+	if lock.Limit <= 0 {
+		return "-"
+	}
+	return fmt.Sprint(lock.Limit)
+}
+
+// available returns the number of permits of the lock that are not held, which is not known if the limit is not known
+func available(lock *syncpkg.SyncLock) string {
+	if lock.Limit <= 0 {
+		return "-"
+	}
+	return fmt.Sprint(lock.Available)
+}
@@ -0,0 +1,20 @@
+package sync
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewSyncCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "sync",
+		Short: "inspect synchronization locks",
+		Long:  "Inspect the semaphores, mutexes and rate limits held or waited for by workflows that have not completed.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewGetCommand())
+	return command
+}
@@ -18,6 +18,7 @@ func NewTemplateCommand() *cobra.Command {
 	command.AddCommand(NewCreateCommand())
 	command.AddCommand(NewDeleteCommand())
 	command.AddCommand(NewLintCommand())
+	command.AddCommand(NewUpdateCommand())
 
 	return command
 }
@@ -0,0 +1,95 @@
+package template
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// NewUpdateCommand returns a new instance of an `argo template update` command
+func NewUpdateCommand() *cobra.Command {
+	var opts common.UpdateOpts
+	command := &cobra.Command{
+		Use:   "update FILE1 FILE2...",
+		Short: "update workflow templates",
+		Long: `Update workflow templates from files, replacing their specs.
+
+Every workflow template is validated before any is updated. With --create, the workflow templates that do not exist are
+created, like kubectl apply. With --dry-run, the differences between the existing workflow templates and the files are
+printed instead.`,
+		Example: `# Update a workflow template:
+  argo template update my-wftmpl.yaml
+
+# Create or update the workflow templates in a directory:
+  argo template update --create templates/*.yaml
+
+# Print the changes that would be made:
+  argo template update --dry-run my-wftmpl.yaml
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+			if err != nil {
+				log.Fatal(err)
+			}
+			fileContents, err := util.ReadManifest(args...)
+			if err != nil {
+				log.Fatal(err)
+			}
+			var workflowTemplates []wfv1.WorkflowTemplate
+			for _, body := range fileContents {
+				workflowTemplates = append(workflowTemplates, unmarshalWorkflowTemplates(body, opts.Strict)...)
+			}
+			if len(workflowTemplates) == 0 {
+				log.Println("No workflow template found in given files")
+				os.Exit(1)
+			}
+			for i := range workflowTemplates {
+				if workflowTemplates[i].Namespace == "" {
+					workflowTemplates[i].Namespace = client.Namespace()
+				}
+			}
+			if err := updateWorkflowTemplates(ctx, os.Stdout, serviceClient, workflowTemplates, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	common.AddUpdateFlags(command, &opts)
+	return command
+}
+
+// updateWorkflowTemplates validates all the workflow templates, and then updates or creates each of them
+func updateWorkflowTemplates(ctx context.Context, out io.Writer, serviceClient workflowtemplatepkg.WorkflowTemplateServiceClient, workflowTemplates []wfv1.WorkflowTemplate, opts common.UpdateOpts) error {
+	return common.UpdateObjects(ctx, out, workflowTemplates, opts, common.Updater[wfv1.WorkflowTemplate]{
+		Kind: "workflow template",
+		Lint: func(ctx context.Context, wftmpl *wfv1.WorkflowTemplate) error {
+			_, err := serviceClient.LintWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateLintRequest{Namespace: wftmpl.Namespace, Template: wftmpl.DeepCopy()})
+			return err
+		},
+		Get: func(ctx context.Context, wftmpl *wfv1.WorkflowTemplate) (*wfv1.WorkflowTemplate, error) {
+			return serviceClient.GetWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateGetRequest{Name: wftmpl.Name, Namespace: wftmpl.Namespace})
+		},
+		Create: func(ctx context.Context, wftmpl *wfv1.WorkflowTemplate) (*wfv1.WorkflowTemplate, error) {
+			return serviceClient.CreateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateCreateRequest{Namespace: wftmpl.Namespace, Template: wftmpl})
+		},
+		Update: func(ctx context.Context, wftmpl *wfv1.WorkflowTemplate) (*wfv1.WorkflowTemplate, error) {
+			return serviceClient.UpdateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateUpdateRequest{Namespace: wftmpl.Namespace, Template: wftmpl})
+		},
+		Print: func(_ context.Context, wftmpl *wfv1.WorkflowTemplate) {
+			printWorkflowTemplate(wftmpl, opts.Output)
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package template
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestUpdateWorkflowTemplates(t *testing.T) {
+	ctx := context.Background()
+	live := &wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "argo", ResourceVersion: "123"},
+		Spec:       wfv1.WorkflowSpec{Entrypoint: "main"},
+	}
+	wftmpls := []wfv1.WorkflowTemplate{
+		{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "argo"}, Spec: wfv1.WorkflowSpec{Entrypoint: "other"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "argo"}, Spec: wfv1.WorkflowSpec{Entrypoint: "main"}},
+	}
+	newClient := func() *mocks.WorkflowTemplateServiceClient {
+		client := &mocks.WorkflowTemplateServiceClient{}
+		client.On("LintWorkflowTemplate", mock.Anything, mock.Anything).Return(nil, nil)
+		client.On("GetWorkflowTemplate", mock.Anything, &workflowtemplatepkg.WorkflowTemplateGetRequest{Name: "existing", Namespace: "argo"}).Return(live, nil)
+		client.On("GetWorkflowTemplate", mock.Anything, &workflowtemplatepkg.WorkflowTemplateGetRequest{Name: "new", Namespace: "argo"}).Return(nil, status.Error(codes.NotFound, "not found"))
+		return client
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		client := &mocks.WorkflowTemplateServiceClient{}
+		client.On("LintWorkflowTemplate", mock.Anything, mock.Anything).Return(nil, status.Error(codes.InvalidArgument, "invalid"))
+		err := updateWorkflowTemplates(ctx, &bytes.Buffer{}, client, wftmpls, common.UpdateOpts{Create: true})
+		assert.EqualError(t, err, "failed to validate workflow template existing: rpc error: code = InvalidArgument desc = invalid")
+		client.AssertNotCalled(t, "UpdateWorkflowTemplate", mock.Anything, mock.Anything)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		client := newClient()
+		client.On("UpdateWorkflowTemplate", mock.Anything, mock.Anything).Return(live, nil)
+		err := updateWorkflowTemplates(ctx, &bytes.Buffer{}, client, wftmpls, common.UpdateOpts{Output: "name"})
+		assert.EqualError(t, err, "failed to get workflow template new: rpc error: code = NotFound desc = not found")
+		client.AssertNotCalled(t, "CreateWorkflowTemplate", mock.Anything, mock.Anything)
+	})
+	t.Run("Create", func(t *testing.T) {
+		client := newClient()
+		client.On("UpdateWorkflowTemplate", mock.Anything, mock.MatchedBy(func(req *workflowtemplatepkg.WorkflowTemplateUpdateRequest) bool {
+			return req.Template.ResourceVersion == "123" && req.Template.Spec.Entrypoint == "other"
+		})).Return(live, nil)
+		client.On("CreateWorkflowTemplate", mock.Anything, mock.MatchedBy(func(req *workflowtemplatepkg.WorkflowTemplateCreateRequest) bool {
+			return req.Template.Name == "new"
+		})).Return(&wftmpls[1], nil)
+		err := updateWorkflowTemplates(ctx, &bytes.Buffer{}, client, wftmpls, common.UpdateOpts{Output: "name", Create: true})
+		require.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		client := newClient()
+		out := &bytes.Buffer{}
+		err := updateWorkflowTemplates(ctx, out, client, wftmpls, common.UpdateOpts{Create: true, DryRun: true})
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "-  entrypoint: main\n+  entrypoint: other\n")
+		assert.Contains(t, out.String(), "+++ new/new\n")
+		client.AssertNotCalled(t, "UpdateWorkflowTemplate", mock.Anything, mock.Anything)
+		client.AssertNotCalled(t, "CreateWorkflowTemplate", mock.Anything, mock.Anything)
+	})
+}
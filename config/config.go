@@ -62,12 +62,26 @@ type Config struct {
 	// NamespaceParallelism limits the max workflows that can execute at the same time in a namespace
 	NamespaceParallelism int `json:"namespaceParallelism,omitempty"`
 
+	// FairShare shares Parallelism between tenants in proportion to their shares, rather than admitting workflows
+	// in priority order. A tenant may use the share that other tenants are not using.
+	FairShare *FairShareConfig `json:"fairShare,omitempty"`
+
+	// Estimation configures how the durations of workflows and nodes are estimated
+	Estimation *EstimationConfig `json:"estimation,omitempty"`
+
+	// ProgressMode is Count (the default) or Duration. In Duration mode, the controller also reports the progress of
+	// workflows as a percentage of their estimated duration, and estimates their remaining duration.
+	ProgressMode ProgressMode `json:"progressMode,omitempty"`
+
 	// ResourceRateLimit limits the rate at which pods are created
 	ResourceRateLimit *ResourceRateLimit `json:"resourceRateLimit,omitempty"`
 
 	// Persistence contains the workflow persistence DB configuration
 	Persistence *PersistConfig `json:"persistence,omitempty"`
 
+	// Synchronization configures semaphores and mutexes stored in the persistence DB
+	Synchronization *SyncConfig `json:"synchronization,omitempty"`
+
 	// Links to related apps.
 	Links []*wfv1.Link `json:"links,omitempty"`
 
@@ -191,7 +205,14 @@ type PersistConfig struct {
 	ConnectionPool *ConnectionPool   `json:"connectionPool,omitempty"`
 	PostgreSQL     *PostgreSQLConfig `json:"postgresql,omitempty"`
 	MySQL          *MySQLConfig      `json:"mysql,omitempty"`
-	SkipMigration  bool              `json:"skipMigration,omitempty"`
+	SQLite         *SQLiteConfig     `json:"sqlite,omitempty"`
+	// ObjectStorage archives workflows to an artifact repository rather than a database. Node status offloading
+	// is not supported.
+	ObjectStorage *ObjectStorageConfig `json:"objectStorage,omitempty"`
+	SkipMigration bool                 `json:"skipMigration,omitempty"`
+	// ArchiveSpool stores the workflows that fail to be archived, e.g. because the database is unavailable, and retries
+	// archiving them with backoff
+	ArchiveSpool *ArchiveSpoolConfig `json:"archiveSpool,omitempty"`
 }
 
 func (c PersistConfig) GetArchiveLabelSelector() (labels.Selector, error) {
@@ -241,6 +262,68 @@ type MySQLConfig struct {
 	Options map[string]string `json:"options,omitempty"`
 }
 
+// SQLiteConfig configures a SQLite database, for clusters that cannot run a database server
+type SQLiteConfig struct {
+	// Path is the path of the database file. It should be on a persistent volume, and must not be shared by the
+	// controllers and Argo Servers of different clusters.
+	Path      string `json:"path"`
+	TableName string `json:"tableName,omitempty"`
+}
+
+// ObjectStorageConfig configures a workflow archive that stores workflows as objects in an artifact repository, for
+// clusters that cannot run a database
+type ObjectStorageConfig struct {
+	// ArtifactRepository is the repository the workflows are stored in. Its secrets are read from the namespace of
+	// the controller and the Argo Server.
+	ArtifactRepository wfv1.ArtifactRepository `json:"artifactRepository"`
+	// KeyPrefix is the prefix of the keys of the objects, defaults to "archived-workflows"
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+}
+
+// ArchiveSpoolConfig configures the spool of workflows that failed to be archived. By default, workflows are spooled to
+// ConfigMaps in the namespace of the controller.
+type ArchiveSpoolConfig struct {
+	// Directory spools workflows to files in this directory, e.g. on a persistent volume mounted in the controller,
+	// rather than to ConfigMaps
+	Directory string `json:"directory,omitempty"`
+	// MaxWorkflows is the maximum number of workflows in the spool, defaults to 1000. Workflows that fail to be
+	// archived when the spool is full are retried when the controller next resyncs them.
+	MaxWorkflows int `json:"maxWorkflows,omitempty"`
+	// InitialBackoff is how long to wait before retrying to archive the spooled workflows, defaults to 10s. It is
+	// doubled after each failed retry, up to MaxBackoff.
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+	// MaxBackoff is the maximum time between retries, defaults to 10m
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+func (c ArchiveSpoolConfig) GetMaxWorkflows() int {
+	if c.MaxWorkflows > 0 {
+		return c.MaxWorkflows
+	}
+	return 1000
+}
+
+func (c ArchiveSpoolConfig) GetInitialBackoff() time.Duration {
+	if c.InitialBackoff != nil {
+		return c.InitialBackoff.Duration
+	}
+	return 10 * time.Second
+}
+
+func (c ArchiveSpoolConfig) GetMaxBackoff() time.Duration {
+	if c.MaxBackoff != nil {
+		return c.MaxBackoff.Duration
+	}
+	return 10 * time.Minute
+}
+
+func (c ObjectStorageConfig) GetKeyPrefix() string {
+	if c.KeyPrefix != "" {
+		return c.KeyPrefix
+	}
+	return "archived-workflows"
+}
+
 // MetricsConfig defines a config for a metrics server
 type MetricsConfig struct {
 	// Enabled controls metric emission. Default is true, set "enabled: false" to turn off
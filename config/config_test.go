@@ -2,8 +2,10 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -31,3 +33,15 @@ func TestSanitize(t *testing.T) {
 		}
 	}
 }
+
+func TestSyncConfig(t *testing.T) {
+	var c *SyncConfig
+	assert.Equal(t, "default", c.GetControllerName("default", ""))
+	assert.Equal(t, "default-my-instance", c.GetControllerName("default", "my-instance"))
+	assert.Equal(t, 30*time.Second, c.GetHeartbeatPeriod())
+	assert.Equal(t, 5*time.Minute, c.GetLeaseDuration())
+	assert.Equal(t, 10*time.Second, c.GetPollPeriod())
+	c = &SyncConfig{ControllerName: "my-controller", LeaseDuration: &metav1.Duration{Duration: time.Minute}}
+	assert.Equal(t, "my-controller", c.GetControllerName("default", "my-instance"))
+	assert.Equal(t, time.Minute, c.GetLeaseDuration())
+}
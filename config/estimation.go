@@ -0,0 +1,34 @@
+package config
+
+type EstimationStrategy string
+
+const (
+	// EstimationStrategyLatest estimates durations from the most recent successful workflow
+	EstimationStrategyLatest EstimationStrategy = "Latest"
+	// EstimationStrategyStatistical estimates durations from the recent successful workflows in the archive
+	EstimationStrategyStatistical EstimationStrategy = "Statistical"
+)
+
+// EstimationConfig configures how the controller estimates the durations of workflows and their nodes.
+type EstimationConfig struct {
+	// Strategy is Latest (the default) or Statistical. The Statistical strategy estimates the median and the 90th
+	// percentile of the durations of the recent successful workflows in the workflow archive, so that a single
+	// unusually slow workflow does not skew the estimates. It requires the workflow archive.
+	Strategy EstimationStrategy `json:"strategy,omitempty"`
+	// Runs is the number of recent successful workflows that the Statistical strategy uses. Defaults to 10.
+	Runs int `json:"runs,omitempty"`
+}
+
+func (c *EstimationConfig) GetStrategy() EstimationStrategy {
+	if c == nil || c.Strategy == "" {
+		return EstimationStrategyLatest
+	}
+	return c.Strategy
+}
+
+func (c *EstimationConfig) GetRuns() int {
+	if c == nil || c.Runs <= 0 {
+		return 10
+	}
+	return c.Runs
+}
@@ -0,0 +1,24 @@
+package config
+
+// FairShareConfig shares the global parallelism between tenants, so that a tenant that submits many workflows does
+// not starve the other tenants.
+type FairShareConfig struct {
+	// TenantLabel is the label that identifies the tenant of a workflow. Workflows without the label, or all
+	// workflows if it is not set, belong to the tenant named after their namespace.
+	TenantLabel string `json:"tenantLabel,omitempty"`
+	// Shares are the weights of the tenants, by tenant name. A tenant with twice the share of another tenant may
+	// run twice as many workflows, when both tenants have workflows waiting to run.
+	Shares map[string]int `json:"shares,omitempty"`
+	// DefaultShare is the weight of the tenants that are not in Shares. Defaults to 1.
+	DefaultShare int `json:"defaultShare,omitempty"`
+}
+
+func (c *FairShareConfig) GetShare(tenant string) int {
+	if share, ok := c.Shares[tenant]; ok && share > 0 {
+		return share
+	}
+	if c.DefaultShare > 0 {
+		return c.DefaultShare
+	}
+	return 1
+}
@@ -0,0 +1,11 @@
+package config
+
+type ProgressMode string
+
+const (
+	// ProgressModeCount reports the progress of a workflow as the number of completed nodes
+	ProgressModeCount ProgressMode = "Count"
+	// ProgressModeDuration also reports the progress of a workflow as a percentage of its duration, weighting each
+	// node by its estimated duration, and an estimate of its remaining duration
+	ProgressModeDuration ProgressMode = "Duration"
+)
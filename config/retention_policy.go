@@ -1,7 +1,42 @@
 package config
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type RetentionPolicy struct {
 	Completed int `json:"completed,omitempty"`
 	Failed    int `json:"failed,omitempty"`
 	Errored   int `json:"errored,omitempty"`
+	// Rules retain the workflows that match them separately. Each workflow is retained by the first rule that matches
+	// it and has a count for its phase, otherwise by the counts above.
+	Rules []RetentionRule `json:"rules,omitempty"`
+	// DryRun logs the workflows that would be deleted by the retention policy, instead of deleting them
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// RetentionRule is the number of completed workflows to keep of the workflows that match the rule
+type RetentionRule struct {
+	// Name is used in the logs, and defaults to the index of the rule
+	Name string `json:"name,omitempty"`
+	// Namespace matches the workflows in a namespace
+	Namespace string `json:"namespace,omitempty"`
+	// Selector matches the workflows with labels
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// WorkflowTemplate matches the workflows submitted from a workflow template
+	WorkflowTemplate string `json:"workflowTemplate,omitempty"`
+	// ClusterWorkflowTemplate matches the workflows submitted from a cluster workflow template
+	ClusterWorkflowTemplate string `json:"clusterWorkflowTemplate,omitempty"`
+	// CronWorkflow matches the workflows created by a cron workflow
+	CronWorkflow string `json:"cronWorkflow,omitempty"`
+	// GroupBy keeps the counts below of each group of workflows in each namespace, rather than of all the workflows that
+	// match the rule. One of workflowTemplate, clusterWorkflowTemplate, cronWorkflow, or the key of a label. Workflows
+	// without the label of the group do not match the rule.
+	GroupBy string `json:"groupBy,omitempty"`
+	// Completed is the number of succeeded workflows to keep
+	Completed *int `json:"completed,omitempty"`
+	// Failed is the number of failed workflows to keep
+	Failed *int `json:"failed,omitempty"`
+	// Errored is the number of errored workflows to keep
+	Errored *int `json:"errored,omitempty"`
 }
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyncConfig contains the configuration for database backed semaphores and mutexes.
+// Database locks are stored using the persistence session, so persistence must be configured to use them.
+type SyncConfig struct {
+	// ControllerName uniquely identifies this controller amongst all controllers sharing the database.
+	// Defaults to the persistence cluster name, suffixed with the instance ID if one is set.
+	ControllerName string `json:"controllerName,omitempty"`
+	// HeartbeatPeriod is how often this controller records that it is still alive. Defaults to 30s.
+	HeartbeatPeriod *metav1.Duration `json:"heartbeatPeriod,omitempty"`
+	// LeaseDuration is how long after its last heartbeat a controller is considered gone, at which point
+	// any locks it holds or is waiting for are released. Defaults to 5m.
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+	// PollPeriod is how often waiting workflows are re-checked for locks released by other controllers. Defaults to 10s.
+	PollPeriod *metav1.Duration `json:"pollPeriod,omitempty"`
+}
+
+func (c *SyncConfig) GetControllerName(clusterName, instanceID string) string {
+	if c != nil && c.ControllerName != "" {
+		return c.ControllerName
+	}
+	if instanceID != "" {
+		return clusterName + "-" + instanceID
+	}
+	return clusterName
+}
+
+func (c *SyncConfig) GetHeartbeatPeriod() time.Duration {
+	if c == nil || c.HeartbeatPeriod == nil {
+		return 30 * time.Second
+	}
+	return c.HeartbeatPeriod.Duration
+}
+
+func (c *SyncConfig) GetLeaseDuration() time.Duration {
+	if c == nil || c.LeaseDuration == nil {
+		return 5 * time.Minute
+	}
+	return c.LeaseDuration.Duration
+}
+
+func (c *SyncConfig) GetPollPeriod() time.Duration {
+	if c == nil || c.PollPeriod == nil {
+		return 10 * time.Second
+	}
+	return c.PollPeriod.Duration
+}
@@ -5,7 +5,18 @@ import "upper.io/db.v3/lib/sqlbuilder"
 // represent a straight forward change that is compatible with all database providers
 type ansiSQLChange string
 
-func (s ansiSQLChange) apply(session sqlbuilder.Database) error {
+func (s ansiSQLChange) apply(session sqlbuilder.SQLBuilder) error {
 	_, err := session.Exec(string(s))
 	return err
 }
+
+// noopChange is a change that does nothing, in place of a change that does not apply to a database
+type noopChange struct{}
+
+func (noopChange) String() string {
+	return "noopChange{}"
+}
+
+func (noopChange) apply(sqlbuilder.SQLBuilder) error {
+	return nil
+}
@@ -2,8 +2,12 @@ package sqldb
 
 import (
 	"database/sql"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/mattn/go-sqlite3"
 	"upper.io/db.v3"
 )
 
@@ -12,19 +16,110 @@ type dbType string
 const (
 	MySQL    dbType = "mysql"
 	Postgres dbType = "postgres"
+	SQLite   dbType = "sqlite"
 )
 
 func dbTypeFor(session db.Database) dbType {
 	switch session.Driver().(*sql.DB).Driver().(type) {
 	case *mysql.MySQLDriver:
 		return MySQL
+	case *sqlite3.SQLiteDriver:
+		return SQLite
 	}
 	return Postgres
 }
 
 func (t dbType) intType() string {
-	if t == MySQL {
+	switch t {
+	case MySQL:
 		return "signed"
+	case SQLite:
+		return "integer"
 	}
 	return "int"
 }
+
+// ago returns an expression for the current time minus the duration
+func (t dbType) ago(d time.Duration) string {
+	if t == SQLite {
+		return fmt.Sprintf("datetime('now', '-%d seconds')", int(d.Seconds()))
+	}
+	return fmt.Sprintf("current_timestamp - interval '%d' second", int(d.Seconds()))
+}
+
+// timestamp returns an expression for the value of the timestamp column, that can be compared with ago
+func (t dbType) timestamp(column string) string {
+	if t == SQLite {
+		// SQLite stores timestamps as text, which may have a time zone
+		return "datetime(" + column + ")"
+	}
+	return column
+}
+
+// duration returns an expression for the number of seconds between the timestamp columns
+func (t dbType) duration(from, to string) string {
+	switch t {
+	case MySQL:
+		return fmt.Sprintf("timestampdiff(second, %s, %s)", from, to)
+	case SQLite:
+		return fmt.Sprintf("((julianday(%s) - julianday(%s)) * 86400)", to, from)
+	}
+	return fmt.Sprintf("extract(epoch from (%s - %s))", to, from)
+}
+
+// epoch returns an expression for the number of seconds since the Unix epoch of the timestamp column
+func (t dbType) epoch(column string) string {
+	switch t {
+	case MySQL:
+		return fmt.Sprintf("unix_timestamp(%s)", column)
+	case SQLite:
+		return fmt.Sprintf("cast(strftime('%%s', %s) as integer)", column)
+	}
+	return fmt.Sprintf("cast(extract(epoch from %s) as bigint)", column)
+}
+
+// bucket returns an expression for the number of seconds since the Unix epoch of the start of the bucket of the
+// timestamp column, buckets are aligned with the epoch
+func (t dbType) bucket(column string, size time.Duration) string {
+	seconds := int64(size.Seconds())
+	if t == MySQL {
+		return fmt.Sprintf("(%s div %d) * %d", t.epoch(column), seconds, seconds)
+	}
+	return fmt.Sprintf("(%s / %d) * %d", t.epoch(column), seconds, seconds)
+}
+
+// jsonString returns an expression for the string at the path in the JSON column
+func (t dbType) jsonString(column string, path ...string) string {
+	switch t {
+	case MySQL:
+		return fmt.Sprintf("json_unquote(json_extract(%s, '$.%s'))", column, strings.Join(path, "."))
+	case SQLite:
+		return fmt.Sprintf("json_extract(%s, '$.%s')", column, strings.Join(path, "."))
+	}
+	expression := column
+	for i, p := range path {
+		if i == len(path)-1 {
+			expression += "->>'" + p + "'"
+		} else {
+			expression += "->'" + p + "'"
+		}
+	}
+	return expression
+}
+
+// Ago returns an expression for the current time minus the duration, in the dialect of the database
+func Ago(session db.Database, d time.Duration) string {
+	return dbTypeFor(session).ago(d)
+}
+
+// Timestamp returns an expression for the value of the timestamp column that can be compared with Ago, in the
+// dialect of the database
+func Timestamp(session db.Database, column string) string {
+	return dbTypeFor(session).timestamp(column)
+}
+
+// SupportsSelectForUpdate returns whether the database can lock the rows that a query selects. SQLite cannot, but
+// only allows one transaction to write at a time.
+func SupportsSelectForUpdate(session db.Database) bool {
+	return dbTypeFor(session) != SQLite
+}
@@ -22,7 +22,7 @@ type migrate struct {
 }
 
 type change interface {
-	apply(session sqlbuilder.Database) error
+	apply(session sqlbuilder.SQLBuilder) error
 }
 
 func ternary(condition bool, left, right change) change {
@@ -258,7 +258,34 @@ func (m migrate) Exec(ctx context.Context) (err error) {
 		// add indexes for list archived workflow performance. #8836
 		ansiSQLChange(`create index argo_archived_workflows_i4 on argo_archived_workflows (startedat)`),
 		ansiSQLChange(`create index argo_archived_workflows_labels_i1 on argo_archived_workflows_labels (name,value)`),
+		// tables for semaphores and mutexes shared between controllers
+		ansiSQLChange(`create table if not exists argo_sync_limit (
+    name varchar(128) not null,
+    sizelimit int not null,
+    primary key (name)
+)`),
+		ansiSQLChange(`create table if not exists argo_sync_state (
+    name varchar(128) not null,
+    controller varchar(64) not null,
+    holderkey varchar(256) not null,
+    held boolean not null,
+    priority int not null,
+    creationtime timestamp default CURRENT_TIMESTAMP,
+    primary key (name, controller, holderkey)
+)`),
+		ansiSQLChange(`create table if not exists argo_sync_controllers (
+    controller varchar(64) not null,
+    heartbeat timestamp default CURRENT_TIMESTAMP,
+    primary key (controller)
+)`),
 	} {
+		if dbType == SQLite && changeSchemaVersion <= sqliteSchemaVersion {
+			if changeSchemaVersion == 0 {
+				change = sqliteSchema{m.tableName}
+			} else {
+				change = noopChange{}
+			}
+		}
 		err := m.applyChange(ctx, changeSchemaVersion, change)
 		if err != nil {
 			return err
@@ -284,7 +311,12 @@ func (m migrate) applyChange(ctx context.Context, changeSchemaVersion int, c cha
 	}
 	if rowsAffected == 1 {
 		log.WithFields(log.Fields{"changeSchemaVersion": changeSchemaVersion, "change": c}).Info("applying database change")
-		err := c.apply(m.session)
+		var session sqlbuilder.SQLBuilder = m.session
+		// SQLite allows only one transaction to write at a time, so the change must be applied in this one
+		if dbTypeFor(m.session) == SQLite {
+			session = tx
+		}
+		err := c.apply(session)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,7 @@
+//go:build !cgo
+// +build !cgo
+
+package sqldb
+
+// cgoEnabled is whether the binary was built with CGO, which the SQLite driver needs
+const cgoEnabled = false
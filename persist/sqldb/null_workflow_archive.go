@@ -4,9 +4,8 @@ import (
 	"fmt"
 	"time"
 
-	"k8s.io/apimachinery/pkg/labels"
-
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
 )
 
 var NullWorkflowArchive WorkflowArchive = &nullWorkflowArchive{}
@@ -21,14 +20,22 @@ func (r *nullWorkflowArchive) ArchiveWorkflow(*wfv1.Workflow) error {
 	return nil
 }
 
-func (r *nullWorkflowArchive) ListWorkflows(string, string, string, time.Time, time.Time, labels.Requirements, int, int) (wfv1.Workflows, error) {
+func (r *nullWorkflowArchive) ListWorkflows(sutils.ListOptions) (wfv1.Workflows, error) {
+	return wfv1.Workflows{}, nil
+}
+
+func (r *nullWorkflowArchive) ListWorkflowsWithStatus(sutils.ListOptions) (wfv1.Workflows, error) {
 	return wfv1.Workflows{}, nil
 }
 
-func (r *nullWorkflowArchive) CountWorkflows(string, string, string, time.Time, time.Time, labels.Requirements) (int64, error) {
+func (r *nullWorkflowArchive) CountWorkflows(sutils.ListOptions) (int64, error) {
 	return 0, nil
 }
 
+func (r *nullWorkflowArchive) GetWorkflowStats(sutils.StatsOptions) (sutils.WorkflowStatsMap, error) {
+	return sutils.WorkflowStatsMap{}, nil
+}
+
 func (r *nullWorkflowArchive) GetWorkflow(string) (*wfv1.Workflow, error) {
 	return nil, fmt.Errorf("getting archived workflows not supported")
 }
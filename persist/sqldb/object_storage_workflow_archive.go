@@ -0,0 +1,702 @@
+package sqldb
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// indexDayFormat is the format of the day of an index object
+const indexDayFormat = "2006-01-02"
+
+// objectStorageIndexEntry is the metadata of an archived workflow in an index object. A deleted entry hides the entries
+// of the workflow in the index objects of other writers, which only they write.
+type objectStorageIndexEntry struct {
+	InstanceID string             `json:"instanceID,omitempty"`
+	UID        string             `json:"uid"`
+	Name       string             `json:"name"`
+	Namespace  string             `json:"namespace"`
+	Phase      wfv1.WorkflowPhase `json:"phase,omitempty"`
+	StartedAt  time.Time          `json:"startedAt"`
+	FinishedAt time.Time          `json:"finishedAt"`
+	Message    string             `json:"message,omitempty"`
+	Labels     map[string]string  `json:"labels,omitempty"`
+	// ResourcesDuration is aggregated by the stats of workflows
+	ResourcesDuration wfv1.ResourcesDuration `json:"resourcesDuration,omitempty"`
+	// UpdatedAt is when the entry was written, the latest entry of a workflow in the index objects of a day is used
+	UpdatedAt time.Time `json:"updatedAt"`
+	Deleted   bool      `json:"deleted,omitempty"`
+}
+
+// objectStorageIndex is an index object, with the entries of the workflows of a namespace that started on a day, that
+// a writer archived or deleted, keyed by UID
+type objectStorageIndex map[string]objectStorageIndexEntry
+
+func deletedIndexEntry(uid string) objectStorageIndexEntry {
+	return objectStorageIndexEntry{UID: uid, Deleted: true}
+}
+
+func (e objectStorageIndexEntry) duration() time.Duration {
+	return e.FinishedAt.Sub(e.StartedAt)
+}
+
+// objectStorageWorkflowArchive stores each workflow as a gzipped JSON object in an artifact repository, using the
+// artifact drivers. The objects are laid out as:
+//
+//	<key prefix>/<cluster name>/workflows/<uid>.json.gz
+//	<key prefix>/<cluster name>/index/<namespace>/<yyyy-mm-dd>/<writer>.json
+//
+// Each index object has the metadata and labels of the workflows of a namespace that started on a day, so that
+// workflows can be listed, counted and expired by reading an object per day rather than the workflows. Each process
+// only writes its own index objects, named after its pod, so processes can archive workflows without locking.
+type objectStorageWorkflowArchive struct {
+	location          *wfv1.ArtifactLocation
+	dir               string
+	clusterName       string
+	managedNamespace  string
+	instanceIDService instanceid.Service
+	resources         resources
+	newDriver         artifact.NewDriverFunc
+	// writer is the name of the index objects of the process
+	writer string
+	// mutex serializes the updates of the index objects of the process
+	mutex sync.Mutex
+}
+
+// NewObjectStorageWorkflowArchive returns a workflow archive that stores workflows in an artifact repository. The
+// secrets of the artifact repository are read from the namespace.
+func NewObjectStorageWorkflowArchive(kubeClient kubernetes.Interface, namespace string, cfg *config.ObjectStorageConfig, clusterName, managedNamespace string, instanceIDService instanceid.Service) (WorkflowArchive, error) {
+	return newObjectStorageWorkflowArchive(kubeClient, namespace, cfg, clusterName, managedNamespace, instanceIDService, artifact.NewDriver)
+}
+
+func newObjectStorageWorkflowArchive(kubeClient kubernetes.Interface, namespace string, cfg *config.ObjectStorageConfig, clusterName, managedNamespace string, instanceIDService instanceid.Service, newDriver artifact.NewDriverFunc) (*objectStorageWorkflowArchive, error) {
+	location := cfg.ArtifactRepository.ToArtifactLocation()
+	if _, err := location.Get(); err != nil {
+		return nil, fmt.Errorf("object storage archive requires an artifact repository: %w", err)
+	}
+	r := &objectStorageWorkflowArchive{
+		location:          location,
+		dir:               path.Join(cfg.GetKeyPrefix(), clusterName),
+		clusterName:       clusterName,
+		managedNamespace:  managedNamespace,
+		instanceIDService: instanceIDService,
+		resources:         resources{kubeClient, namespace},
+		newDriver:         newDriver,
+		writer:            objectStorageWriter(),
+	}
+	if _, err := r.artifact(r.dir); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// objectStorageWriter returns the name of the index objects of the process, which is the name of its pod
+func objectStorageWriter() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		return "unknown"
+	}
+	return hostname
+}
+
+func (r *objectStorageWorkflowArchive) IsEnabled() bool {
+	return true
+}
+
+// artifact returns the artifact at the key in the artifact repository
+func (r *objectStorageWorkflowArchive) artifact(key string) (*wfv1.Artifact, error) {
+	art, err := artifact.NewArtifactAtKey(r.location, path.Base(key), key)
+	if err != nil {
+		return nil, fmt.Errorf("object storage archive is not supported by the artifact repository: %w", err)
+	}
+	return art, nil
+}
+
+func (r *objectStorageWorkflowArchive) driver(art *wfv1.Artifact) (common.ArtifactDriver, error) {
+	return r.newDriver(context.Background(), art, r.resources)
+}
+
+func (r *objectStorageWorkflowArchive) workflowKey(uid string) string {
+	return path.Join(r.dir, "workflows", uid+".json.gz")
+}
+
+func (r *objectStorageWorkflowArchive) indexDir() string {
+	return path.Join(r.dir, "index")
+}
+
+func (r *objectStorageWorkflowArchive) indexKey(namespace string, startedAt time.Time, writer string) string {
+	return path.Join(r.indexDir(), namespace, startedAt.UTC().Format(indexDayFormat), writer+".json")
+}
+
+// read reads the object at the key into v, compressed objects are decompressed, it returns false if the object does
+// not exist
+func (r *objectStorageWorkflowArchive) read(key string, v interface{}) (bool, error) {
+	art, err := r.artifact(key)
+	if err != nil {
+		return false, err
+	}
+	driver, err := r.driver(art)
+	if err != nil {
+		return false, err
+	}
+	stream, err := driver.OpenStream(art)
+	if err != nil {
+		if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	defer func() { _ = stream.Close() }()
+	var reader io.Reader = stream
+	if strings.HasSuffix(key, ".gz") {
+		gzipReader, err := gzip.NewReader(stream)
+		if err != nil {
+			return false, fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		defer func() { _ = gzipReader.Close() }()
+		reader = gzipReader
+	}
+	if err := json.NewDecoder(reader).Decode(v); err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// write writes v to a temporary file as JSON, compressed if the key ends with ".gz", which the driver uploads to the
+// key
+func (r *objectStorageWorkflowArchive) write(key string, v interface{}) error {
+	art, err := r.artifact(key)
+	if err != nil {
+		return err
+	}
+	driver, err := r.driver(art)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp("", "archived-workflow-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	var writer io.WriteCloser = f
+	if strings.HasSuffix(key, ".gz") {
+		writer = gzip.NewWriter(f)
+	}
+	err = json.NewEncoder(writer).Encode(v)
+	if writer != f {
+		if closeErr := writer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if err := driver.Save(f.Name(), art); err != nil {
+		return fmt.Errorf("failed to write %s: %w", key, err)
+	}
+	return nil
+}
+
+func (r *objectStorageWorkflowArchive) delete(key string) error {
+	art, err := r.artifact(key)
+	if err != nil {
+		return err
+	}
+	driver, err := r.driver(art)
+	if err != nil {
+		return err
+	}
+	if err := driver.Delete(art); err != nil && !argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		return fmt.Errorf("failed to delete %s: %w", key, err)
+	}
+	return nil
+}
+
+// indexDay is a namespace and a day of the index, with the index objects of each writer
+type indexDay struct {
+	namespace string
+	day       time.Time
+	keys      []string
+}
+
+// indexDays lists the days of the index of the namespace, or of every namespace if the namespace is empty, on which
+// the workflows may have started in the range, in order of the day and then the namespace
+func (r *objectStorageWorkflowArchive) indexDays(namespace string, minStartedAt, maxStartedAt time.Time) ([]*indexDay, error) {
+	dir := r.indexDir()
+	if namespace != "" {
+		dir = path.Join(dir, namespace)
+	}
+	art, err := r.artifact(dir)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := r.driver(art)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := driver.ListObjects(art)
+	if err != nil {
+		if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list %s: %w", dir, err)
+	}
+	days := make(map[string]*indexDay)
+	for _, object := range objects {
+		// drivers return the full key of each object, so the namespace, day and writer are the last three elements
+		writer := strings.TrimSuffix(path.Base(object), ".json")
+		objectNamespace := path.Base(path.Dir(path.Dir(object)))
+		day, err := time.Parse(indexDayFormat, path.Base(path.Dir(object)))
+		if err != nil || !strings.HasSuffix(object, ".json") {
+			continue
+		}
+		if (r.managedNamespace != "" && objectNamespace != r.managedNamespace) ||
+			(!minStartedAt.IsZero() && !day.Add(24*time.Hour).After(minStartedAt)) ||
+			(!maxStartedAt.IsZero() && day.After(maxStartedAt)) {
+			continue
+		}
+		key := r.indexKey(objectNamespace, day, writer)
+		d, ok := days[path.Dir(key)]
+		if !ok {
+			d = &indexDay{namespace: objectNamespace, day: day}
+			days[path.Dir(key)] = d
+		}
+		d.keys = append(d.keys, key)
+	}
+	sorted := make([]*indexDay, 0, len(days))
+	for _, d := range days {
+		sort.Strings(d.keys)
+		sorted = append(sorted, d)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].day.Equal(sorted[j].day) {
+			return sorted[i].day.Before(sorted[j].day)
+		}
+		return sorted[i].namespace < sorted[j].namespace
+	})
+	return sorted, nil
+}
+
+// readIndexDay reads the index objects of the day, and returns the latest entry of each workflow, including the
+// deleted entries
+func (r *objectStorageWorkflowArchive) readIndexDay(d *indexDay) (objectStorageIndex, error) {
+	entries := make(objectStorageIndex)
+	for _, key := range d.keys {
+		// the object may have been deleted since it was listed
+		index := make(objectStorageIndex)
+		if _, err := r.read(key, &index); err != nil {
+			return nil, err
+		}
+		for uid, entry := range index {
+			if latest, ok := entries[uid]; !ok || entry.UpdatedAt.After(latest.UpdatedAt) {
+				entries[uid] = entry
+			}
+		}
+	}
+	return entries, nil
+}
+
+// isListed returns whether the entry is listed, which it is not if it is deleted or was archived by another instance
+func (r *objectStorageWorkflowArchive) isListed(entry objectStorageIndexEntry) bool {
+	return !entry.Deleted && entry.InstanceID == r.instanceIDService.InstanceID()
+}
+
+// updateIndex writes the entries to the index object of the process for the namespace and the day
+func (r *objectStorageWorkflowArchive) updateIndex(namespace string, startedAt time.Time, entries ...objectStorageIndexEntry) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	key := r.indexKey(namespace, startedAt, r.writer)
+	index := make(objectStorageIndex)
+	if _, err := r.read(key, &index); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, entry := range entries {
+		entry.UpdatedAt = now
+		index[entry.UID] = entry
+	}
+	return r.write(key, index)
+}
+
+func (r *objectStorageWorkflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
+	logCtx := log.WithFields(log.Fields{"uid": wf.UID, "labels": wf.GetLabels()})
+	logCtx.Debug("Archiving workflow")
+	key := r.workflowKey(string(wf.UID))
+	previous := &wfv1.Workflow{}
+	ok, err := r.read(key, previous)
+	if err != nil {
+		return err
+	}
+	if err := r.write(key, wf); err != nil {
+		return err
+	}
+	// if the workflow was archived before it started again, it must be deleted from the index of the day it started
+	if ok && r.indexKey(previous.Namespace, previous.Status.StartedAt.Time, r.writer) != r.indexKey(wf.Namespace, wf.Status.StartedAt.Time, r.writer) {
+		if err := r.updateIndex(previous.Namespace, previous.Status.StartedAt.Time, deletedIndexEntry(string(wf.UID))); err != nil {
+			return err
+		}
+	}
+	return r.updateIndex(wf.Namespace, wf.Status.StartedAt.Time, objectStorageIndexEntry{
+		InstanceID: r.instanceIDService.InstanceID(),
+		UID:        string(wf.UID),
+		Name:       wf.Name,
+		Namespace:  wf.Namespace,
+		Phase:      wf.Status.Phase,
+		StartedAt:  wf.Status.StartedAt.Time,
+		FinishedAt: wf.Status.FinishedAt.Time,
+		Message:    wf.Status.Message,
+		Labels:     wf.GetLabels(),
+		// the stats of workflows aggregate resource durations
+		ResourcesDuration: wf.Status.ResourcesDuration,
+	})
+}
+
+// matching returns the entries that match the options, in the order of the options. If the limit is set and they are
+// ordered by when they started, only the days up to the one with the limit-th entry are read.
+func (r *objectStorageWorkflowArchive) matching(options sutils.ListOptions, limit int) ([]objectStorageIndexEntry, error) {
+	field, descending, err := options.Order()
+	if err != nil {
+		return nil, err
+	}
+	days, err := r.indexDays(options.Namespace, options.MinStartedAt, options.MaxStartedAt)
+	if err != nil {
+		return nil, err
+	}
+	if descending {
+		for i, j := 0, len(days)-1; i < j; i, j = i+1, j-1 {
+			days[i], days[j] = days[j], days[i]
+		}
+	}
+	var matching []objectStorageIndexEntry
+	for i, d := range days {
+		// the workflows of a day all started after those of the earlier days, so once the day with the limit-th entry
+		// has been read, the next days cannot change the first entries
+		if limit > 0 && field == "startedAt" && len(matching) >= limit && !d.day.Equal(days[i-1].day) {
+			break
+		}
+		entries, err := r.readIndexDay(d)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if r.isListed(entry) && matches(entry, options) {
+				matching = append(matching, entry)
+			}
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		a, b := matching[i], matching[j]
+		if descending {
+			a, b = b, a
+		}
+		switch field {
+		case "finishedAt":
+			if !a.FinishedAt.Equal(b.FinishedAt) {
+				return a.FinishedAt.Before(b.FinishedAt)
+			}
+		case "duration":
+			if a.duration() != b.duration() {
+				return a.duration() < b.duration()
+			}
+		default:
+			if !a.StartedAt.Equal(b.StartedAt) {
+				return a.StartedAt.Before(b.StartedAt)
+			}
+		}
+		return a.UID < b.UID
+	})
+	return matching, nil
+}
+
+// matches returns whether the entry matches the filters of the options
+func matches(entry objectStorageIndexEntry, options sutils.ListOptions) bool {
+	return (options.Name == "" || entry.Name == options.Name) &&
+		strings.HasPrefix(entry.Name, options.NamePrefix) &&
+		inTimeRange(entry.StartedAt, options.MinStartedAt, options.MaxStartedAt) &&
+		inTimeRange(entry.FinishedAt, options.MinFinishedAt, options.MaxFinishedAt) &&
+		inPhases(entry.Phase, options.Phases) &&
+		(options.MinDuration == 0 || entry.duration() >= options.MinDuration) &&
+		(options.MaxDuration == 0 || entry.duration() <= options.MaxDuration) &&
+		strings.Contains(entry.Message, options.MessageContains) &&
+		matchesRequirements(entry.Labels, options.LabelRequirements)
+}
+
+// inTimeRange returns whether the time is after the minimum and before the maximum, if they are set
+func inTimeRange(t, min, max time.Time) bool {
+	return (min.IsZero() || t.After(min)) && (max.IsZero() || t.Before(max))
+}
+
+func inPhases(phase wfv1.WorkflowPhase, phases []wfv1.WorkflowPhase) bool {
+	for _, p := range phases {
+		if p == phase {
+			return true
+		}
+	}
+	return len(phases) == 0
+}
+
+func matchesRequirements(l map[string]string, requirements labels.Requirements) bool {
+	for _, requirement := range requirements {
+		if !requirement.Matches(labels.Set(l)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *objectStorageWorkflowArchive) ListWorkflows(options sutils.ListOptions) (wfv1.Workflows, error) {
+	limit, offset := options.Limit, options.Offset
+	var read int
+	if limit > 0 {
+		read = offset + limit
+	}
+	entries, err := r.matching(options, read)
+	if err != nil {
+		return nil, err
+	}
+	if offset > len(entries) {
+		offset = len(entries)
+	}
+	entries = entries[offset:]
+	// If we were passed 0 as the limit, then we should load all available archived workflows
+	// to match the behavior of the `List` operations in the Kubernetes API
+	if limit > 0 && limit < len(entries) {
+		entries = entries[:limit]
+	}
+	wfs := make(wfv1.Workflows, len(entries))
+	for i, entry := range entries {
+		wfs[i] = wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              entry.Name,
+				Namespace:         entry.Namespace,
+				UID:               types.UID(entry.UID),
+				CreationTimestamp: metav1.Time{Time: entry.StartedAt},
+			},
+			Status: wfv1.WorkflowStatus{
+				Phase:      entry.Phase,
+				StartedAt:  metav1.Time{Time: entry.StartedAt},
+				FinishedAt: metav1.Time{Time: entry.FinishedAt},
+			},
+		}
+	}
+	return wfs, nil
+}
+
+// ListWorkflowsWithStatus reads each workflow, as they are stored in separate objects
+func (r *objectStorageWorkflowArchive) ListWorkflowsWithStatus(options sutils.ListOptions) (wfv1.Workflows, error) {
+	list, err := r.ListWorkflows(options)
+	if err != nil {
+		return nil, err
+	}
+	wfs := make(wfv1.Workflows, 0, len(list))
+	for _, md := range list {
+		wf, err := r.GetWorkflow(string(md.UID))
+		if err != nil {
+			return nil, err
+		}
+		if wf != nil {
+			wfs = append(wfs, *wf)
+		}
+	}
+	return wfs, nil
+}
+
+func (r *objectStorageWorkflowArchive) CountWorkflows(options sutils.ListOptions) (int64, error) {
+	entries, err := r.matching(options, 0)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(entries)), nil
+}
+
+// GetWorkflowStats aggregates the entries of the indexes, without reading the workflows
+func (r *objectStorageWorkflowArchive) GetWorkflowStats(options sutils.StatsOptions) (sutils.WorkflowStatsMap, error) {
+	entries, err := r.matching(options.ListOptions, 0)
+	if err != nil {
+		return nil, err
+	}
+	m := sutils.WorkflowStatsMap{}
+	for _, entry := range entries {
+		m.AddWorkflow(options, &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Labels: entry.Labels},
+			Status: wfv1.WorkflowStatus{
+				Phase:             entry.Phase,
+				StartedAt:         metav1.Time{Time: entry.StartedAt},
+				FinishedAt:        metav1.Time{Time: entry.FinishedAt},
+				Message:           entry.Message,
+				ResourcesDuration: entry.ResourcesDuration,
+			},
+		})
+	}
+	return m, nil
+}
+
+func (r *objectStorageWorkflowArchive) GetWorkflow(uid string) (*wfv1.Workflow, error) {
+	var wf *wfv1.Workflow
+	ok, err := r.read(r.workflowKey(uid), &wf)
+	if err != nil || !ok || wf == nil {
+		return nil, err
+	}
+	if (r.managedNamespace != "" && wf.Namespace != r.managedNamespace) || r.instanceIDService.Validate(wf) != nil {
+		return nil, nil
+	}
+	return wf, nil
+}
+
+func (r *objectStorageWorkflowArchive) DeleteWorkflow(uid string) error {
+	wf, err := r.GetWorkflow(uid)
+	if err != nil || wf == nil {
+		return err
+	}
+	// the workflow is deleted from the index first, so that it is never listed after it has been deleted
+	if err := r.updateIndex(wf.Namespace, wf.Status.StartedAt.Time, deletedIndexEntry(uid)); err != nil {
+		return err
+	}
+	if err := r.delete(r.workflowKey(uid)); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{"uid": uid}).Debug("Deleted archived workflow")
+	return nil
+}
+
+func (r *objectStorageWorkflowArchive) DeleteExpiredWorkflows(ttl time.Duration) error {
+	expiry := time.Now().Add(-ttl)
+	// a workflow cannot finish before it starts, so only the days before the expiry are read
+	days, err := r.indexDays("", time.Time{}, expiry)
+	if err != nil {
+		return err
+	}
+	deleted := 0
+	for _, d := range days {
+		entries, err := r.readIndexDay(d)
+		if err != nil {
+			return err
+		}
+		var expired []objectStorageIndexEntry
+		empty := true
+		for _, entry := range entries {
+			if !r.isListed(entry) || !entry.FinishedAt.Before(expiry) {
+				empty = empty && entry.Deleted
+				continue
+			}
+			expired = append(expired, deletedIndexEntry(entry.UID))
+		}
+		if len(expired) == 0 {
+			continue
+		}
+		if err := r.deleteFromIndexDay(d, expired, empty); err != nil {
+			return err
+		}
+		for _, entry := range expired {
+			if err := r.delete(r.workflowKey(entry.UID)); err != nil {
+				return err
+			}
+		}
+		deleted += len(expired)
+	}
+	log.WithFields(log.Fields{"deleted": deleted}).Info("Deleted archived workflows")
+	return nil
+}
+
+// deleteFromIndexDay deletes the entries from the index of the day. If no other workflow is listed on the day, the
+// index objects of every writer are deleted, otherwise the deleted entries are written to the index object of the
+// process, as is the case if the artifact repository does not support deletion.
+func (r *objectStorageWorkflowArchive) deleteFromIndexDay(d *indexDay, deleted []objectStorageIndexEntry, empty bool) error {
+	if empty {
+		var err error
+		for _, key := range d.keys {
+			if err = r.delete(key); err != nil {
+				break
+			}
+		}
+		if !errors.Is(err, common.ErrDeleteNotSupported) {
+			return err
+		}
+	}
+	return r.updateIndex(d.namespace, d.day, deleted...)
+}
+
+func (r *objectStorageWorkflowArchive) ListWorkflowsLabelKeys() (*wfv1.LabelKeys, error) {
+	entries, err := r.matching(sutils.ListOptions{}, 0)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]bool)
+	for _, entry := range entries {
+		for key := range entry.Labels {
+			keys[key] = true
+		}
+	}
+	return &wfv1.LabelKeys{Items: sortedKeys(keys)}, nil
+}
+
+func (r *objectStorageWorkflowArchive) ListWorkflowsLabelValues(key string) (*wfv1.LabelValues, error) {
+	entries, err := r.matching(sutils.ListOptions{}, 0)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]bool)
+	for _, entry := range entries {
+		if value, ok := entry.Labels[key]; ok {
+			values[value] = true
+		}
+	}
+	return &wfv1.LabelValues{Items: sortedKeys(values)}, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// resources gives the artifact drivers access to the secrets of the artifact repository
+type resources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func (r resources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r resources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
@@ -0,0 +1,233 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	artifactsfake "github.com/argoproj/argo-workflows/v3/workflow/artifacts/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+func newTestObjectStorageWorkflowArchive(t *testing.T) (*objectStorageWorkflowArchive, *artifactsfake.MemoryDriver) {
+	return newTestObjectStorageWorkflowArchiveWithDriver(t, artifactsfake.NewMemoryDriver(), "")
+}
+
+func newTestObjectStorageWorkflowArchiveWithDriver(t *testing.T, driver *artifactsfake.MemoryDriver, instanceID string) (*objectStorageWorkflowArchive, *artifactsfake.MemoryDriver) {
+	newDriver := func(context.Context, *wfv1.Artifact, resource.Interface) (common.ArtifactDriver, error) {
+		return driver, nil
+	}
+	cfg := &config.ObjectStorageConfig{ArtifactRepository: wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}}}}
+	archive, err := newObjectStorageWorkflowArchive(fake.NewSimpleClientset(), "argo", cfg, "my-cluster", "", instanceid.NewService(instanceID), newDriver)
+	require.NoError(t, err)
+	archive.writer = "my-controller"
+	return archive, driver
+}
+
+// countingDriver counts the objects that are opened
+type countingDriver struct {
+	*artifactsfake.MemoryDriver
+	opened int
+}
+
+func (d *countingDriver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, error) {
+	d.opened++
+	return d.MemoryDriver.OpenStream(a)
+}
+
+func TestObjectStorageWorkflowArchive(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	newWorkflow := func(name, namespace string, started time.Time) *wfv1.Workflow {
+		return &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID("uid-" + name), Labels: map[string]string{"my-label": name}},
+			Status: wfv1.WorkflowStatus{
+				Phase:      wfv1.WorkflowSucceeded,
+				StartedAt:  metav1.Time{Time: started},
+				FinishedAt: metav1.Time{Time: started.Add(time.Minute)},
+			},
+		}
+	}
+
+	t.Run("Layout", func(t *testing.T) {
+		archive, driver := newTestObjectStorageWorkflowArchive(t)
+		started := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("my-wf", "my-ns", started)))
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("other-wf", "my-ns", started.Add(time.Hour))))
+		assert.Contains(t, driver.Objects, "archived-workflows/my-cluster/workflows/uid-my-wf.json.gz")
+		assert.Contains(t, driver.Objects, "archived-workflows/my-cluster/workflows/uid-other-wf.json.gz")
+		assert.Contains(t, driver.Objects, "archived-workflows/my-cluster/index/my-ns/2023-04-05/my-controller.json")
+		assert.Len(t, driver.Objects, 3)
+
+		// archiving again after the workflow is retried on a later day moves it to the later day
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("my-wf", "my-ns", started.Add(24*time.Hour))))
+		assert.Contains(t, driver.Objects, "archived-workflows/my-cluster/index/my-ns/2023-04-06/my-controller.json")
+		wfs, err := archive.ListWorkflows(sutils.ListOptions{})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 2) {
+			assert.Equal(t, "my-wf", wfs[0].Name)
+			assert.Equal(t, started.Add(24*time.Hour), wfs[0].Status.StartedAt.Time)
+		}
+	})
+	t.Run("ConcurrentArchiving", func(t *testing.T) {
+		// archives in different processes share the repository, but not any lock
+		archive, driver := newTestObjectStorageWorkflowArchive(t)
+		other, _ := newTestObjectStorageWorkflowArchiveWithDriver(t, driver, "")
+		other.writer = "other-controller"
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(a *objectStorageWorkflowArchive, name string) {
+				defer wg.Done()
+				assert.NoError(t, a.ArchiveWorkflow(newWorkflow(name, "my-ns", now)))
+			}([]*objectStorageWorkflowArchive{archive, other}[i%2], "my-wf-"+string(rune('a'+i)))
+		}
+		wg.Wait()
+		count, err := archive.CountWorkflows(sutils.ListOptions{Namespace: "my-ns"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(20), count)
+
+		// a workflow deleted by another process is not listed
+		require.NoError(t, other.DeleteWorkflow("uid-my-wf-a"))
+		count, err = archive.CountWorkflows(sutils.ListOptions{Namespace: "my-ns"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(19), count)
+	})
+	t.Run("ListReadsOnlyTheDaysListed", func(t *testing.T) {
+		archive, driver := newTestObjectStorageWorkflowArchive(t)
+		for i := 0; i < 3; i++ {
+			started := now.Add(-time.Duration(i) * 24 * time.Hour)
+			require.NoError(t, archive.ArchiveWorkflow(newWorkflow(fmt.Sprintf("my-wf-%d", i), "my-ns", started)))
+			require.NoError(t, archive.ArchiveWorkflow(newWorkflow(fmt.Sprintf("other-wf-%d", i), "other-ns", started)))
+		}
+		reads := &countingDriver{MemoryDriver: driver}
+		archive.newDriver = func(context.Context, *wfv1.Artifact, resource.Interface) (common.ArtifactDriver, error) {
+			return reads, nil
+		}
+		wfs, err := archive.ListWorkflows(sutils.ListOptions{Limit: 2, Offset: 1})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 2) {
+			assert.Equal(t, []string{"my-wf-0", "other-wf-1"}, []string{wfs[0].Name, wfs[1].Name})
+		}
+		assert.Equal(t, 4, reads.opened, "the index objects of the two namespaces on the two latest days")
+	})
+	t.Run("ListAndCount", func(t *testing.T) {
+		archive, _ := newTestObjectStorageWorkflowArchive(t)
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("old", "my-ns", now.Add(-48*time.Hour))))
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("new", "my-ns", now.Add(-time.Hour))))
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("other", "other-ns", now.Add(-2*time.Hour))))
+
+		wfs, err := archive.ListWorkflows(sutils.ListOptions{})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 3) {
+			assert.Equal(t, "new", wfs[0].Name)
+			assert.Equal(t, "other", wfs[1].Name)
+			assert.Equal(t, "old", wfs[2].Name)
+			assert.Equal(t, wfv1.WorkflowSucceeded, wfs[0].Status.Phase)
+		}
+		wfs, err = archive.ListWorkflows(sutils.ListOptions{Limit: 1, Offset: 1})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, "other", wfs[0].Name)
+		}
+		wfs, err = archive.ListWorkflows(sutils.ListOptions{Namespace: "my-ns", MinStartedAt: now.Add(-24 * time.Hour)})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, "new", wfs[0].Name)
+		}
+		wfs, err = archive.ListWorkflows(sutils.ListOptions{NamePrefix: "ol"})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, "old", wfs[0].Name)
+		}
+		requirements, err := labels.ParseToRequirements("my-label in (old,other)")
+		require.NoError(t, err)
+		count, err := archive.CountWorkflows(sutils.ListOptions{LabelRequirements: requirements})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		count, err = archive.CountWorkflows(sutils.ListOptions{Name: "new", MaxStartedAt: now.Add(-90 * time.Minute)})
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+
+		keys, err := archive.ListWorkflowsLabelKeys()
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"my-label"}, keys.Items)
+		}
+		values, err := archive.ListWorkflowsLabelValues("my-label")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"new", "old", "other"}, values.Items)
+		}
+	})
+	t.Run("ListOptions", func(t *testing.T) {
+		archive, _ := newTestObjectStorageWorkflowArchive(t)
+		testListOptions(t, archive, "list-options")
+	})
+	t.Run("WorkflowStats", func(t *testing.T) {
+		archive, _ := newTestObjectStorageWorkflowArchive(t)
+		testWorkflowStats(t, archive, "workflow-stats")
+	})
+	t.Run("GetAndDelete", func(t *testing.T) {
+		archive, driver := newTestObjectStorageWorkflowArchive(t)
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("my-wf", "my-ns", now)))
+		wf, err := archive.GetWorkflow("uid-my-wf")
+		if assert.NoError(t, err) && assert.NotNil(t, wf) {
+			assert.Equal(t, "my-wf", wf.Name)
+			assert.Equal(t, map[string]string{"my-label": "my-wf"}, wf.Labels)
+		}
+		wf, err = archive.GetWorkflow("not-found")
+		assert.NoError(t, err)
+		assert.Nil(t, wf)
+
+		require.NoError(t, archive.DeleteWorkflow("uid-my-wf"))
+		assert.NotContains(t, driver.Objects, "archived-workflows/my-cluster/workflows/uid-my-wf.json.gz")
+		count, err := archive.CountWorkflows(sutils.ListOptions{})
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+		assert.NoError(t, archive.DeleteWorkflow("uid-my-wf"))
+	})
+	t.Run("DeleteNotSupported", func(t *testing.T) {
+		archive, driver := newTestObjectStorageWorkflowArchive(t)
+		started := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("my-wf", "my-ns", started)))
+		driver.DeleteNotSupported = true
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("my-wf", "my-ns", started.Add(24*time.Hour))))
+		count, err := archive.CountWorkflows(sutils.ListOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+	t.Run("DeleteExpiredWorkflows", func(t *testing.T) {
+		archive, driver := newTestObjectStorageWorkflowArchive(t)
+		old := newWorkflow("old", "my-ns", now.Add(-48*time.Hour))
+		require.NoError(t, archive.ArchiveWorkflow(old))
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("new", "my-ns", now.Add(-time.Hour))))
+		require.NoError(t, archive.DeleteExpiredWorkflows(24*time.Hour))
+		assert.NotContains(t, driver.Objects, archive.indexKey("my-ns", old.Status.StartedAt.Time, "my-controller"), "the index of a day without workflows is deleted")
+		wf, err := archive.GetWorkflow("uid-old")
+		assert.NoError(t, err)
+		assert.Nil(t, wf)
+		wfs, err := archive.ListWorkflows(sutils.ListOptions{})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, "new", wfs[0].Name)
+		}
+	})
+	t.Run("InstanceID", func(t *testing.T) {
+		archive, driver := newTestObjectStorageWorkflowArchive(t)
+		require.NoError(t, archive.ArchiveWorkflow(newWorkflow("my-wf", "my-ns", now)))
+		other, _ := newTestObjectStorageWorkflowArchiveWithDriver(t, driver, "my-instance")
+		wfs, err := other.ListWorkflows(sutils.ListOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, wfs)
+		wf, err := other.GetWorkflow("uid-my-wf")
+		assert.NoError(t, err)
+		assert.Nil(t, wf)
+		assert.NotEmpty(t, driver.Objects)
+	})
+}
@@ -36,7 +36,7 @@ func NewOffloadNodeStatusRepo(session sqlbuilder.Database, clusterName, tableNam
 	// useful for testing
 	ttl := env.LookupEnvDurationOr("OFFLOAD_NODE_STATUS_TTL", 5*time.Minute)
 	log.WithField("ttl", ttl).Debug("Node status offloading config")
-	return &nodeOffloadRepo{session: session, clusterName: clusterName, tableName: tableName, ttl: ttl}, nil
+	return &nodeOffloadRepo{session: session, clusterName: clusterName, tableName: tableName, ttl: ttl, dbType: dbTypeFor(session)}, nil
 }
 
 type nodesRecord struct {
@@ -51,7 +51,8 @@ type nodeOffloadRepo struct {
 	clusterName string
 	tableName   string
 	// time to live - at what ttl an offload becomes old
-	ttl time.Duration
+	ttl    time.Duration
+	dbType dbType
 }
 
 func (wdc *nodeOffloadRepo) IsEnabled() bool {
@@ -87,7 +88,16 @@ func (wdc *nodeOffloadRepo) Save(uid, namespace string, nodes wfv1.Nodes) (strin
 
 	logCtx := log.WithFields(log.Fields{"uid": uid, "version": version})
 	logCtx.Debug("Offloading nodes")
-	_, err = wdc.session.Collection(wdc.tableName).Insert(record)
+	if wdc.dbType == SQLite {
+		// the SQLite adapter does not roll back a statement that fails, leaving the database locked, so duplicates
+		// are ignored rather than failing
+		_, err = wdc.session.InsertInto(wdc.tableName).
+			Values(record).
+			Amend(func(query string) string { return strings.Replace(query, "INSERT INTO", "INSERT OR IGNORE INTO", 1) }).
+			Exec()
+	} else {
+		_, err = wdc.session.Collection(wdc.tableName).Insert(record)
+	}
 	if err != nil {
 		// if we have a duplicate, then it must have the same clustername+uid+version, which MUST mean that we
 		// have already written this record
@@ -129,6 +139,10 @@ func isDuplicateKeyError(err error) bool {
 	if strings.Contains(err.Error(), "Duplicate entry") {
 		return true
 	}
+	// sqlite
+	if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		return true
+	}
 	return false
 }
 
@@ -225,5 +239,5 @@ func (wdc *nodeOffloadRepo) Delete(uid, version string) error {
 }
 
 func (wdc *nodeOffloadRepo) oldOffload() string {
-	return fmt.Sprintf("updatedat < current_timestamp - interval '%d' second", int(wdc.ttl.Seconds()))
+	return wdc.dbType.timestamp("updatedat") + " < " + wdc.dbType.ago(wdc.ttl)
 }
@@ -9,6 +9,7 @@ import (
 	"upper.io/db.v3/lib/sqlbuilder"
 	"upper.io/db.v3/mysql"
 	"upper.io/db.v3/postgresql"
+	"upper.io/db.v3/sqlite"
 
 	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
@@ -25,6 +26,8 @@ func CreateDBSession(kubectlConfig kubernetes.Interface, namespace string, persi
 		return CreatePostGresDBSession(kubectlConfig, namespace, persistConfig.PostgreSQL, persistConfig.ConnectionPool)
 	} else if persistConfig.MySQL != nil {
 		return CreateMySQLDBSession(kubectlConfig, namespace, persistConfig.MySQL, persistConfig.ConnectionPool)
+	} else if persistConfig.SQLite != nil {
+		return CreateSQLiteDBSession(persistConfig.SQLite, persistConfig.ConnectionPool)
 	}
 	return nil, "", fmt.Errorf("no databases are configured")
 }
@@ -117,3 +120,38 @@ func CreateMySQLDBSession(kubectlConfig kubernetes.Interface, namespace string,
 	}
 	return session, cfg.TableName, nil
 }
+
+// CreateSQLiteDBSession creates SQLite DB session
+func CreateSQLiteDBSession(cfg *config.SQLiteConfig, persistPool *config.ConnectionPool) (sqlbuilder.Database, string, error) {
+	if cfg.TableName == "" {
+		return nil, "", errors.InternalError("tableName is empty")
+	}
+	if cfg.Path == "" {
+		return nil, "", errors.InternalError("path is empty")
+	}
+	if !cgoEnabled {
+		return nil, "", errors.InternalError("SQLite is not supported by this binary, as it was built without CGO (CGO_ENABLED=0)")
+	}
+
+	session, err := sqlite.Open(sqlite.ConnectionURL{
+		Database: cfg.Path,
+		Options: map[string]string{
+			// the labels of archived workflows are deleted by a foreign key
+			"_foreign_keys": "1",
+			// SQLite cannot lock rows, so transactions lock the database when they begin
+			"_txlock": "immediate",
+			// readers do not wait for writers
+			"_journal_mode": "WAL",
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if persistPool != nil {
+		session.SetMaxOpenConns(persistPool.MaxOpenConns)
+		session.SetMaxIdleConns(persistPool.MaxIdleConns)
+		session.SetConnMaxLifetime(time.Duration(persistPool.ConnMaxLifetime))
+	}
+	return session, cfg.TableName, nil
+}
@@ -0,0 +1,65 @@
+package sqldb
+
+import (
+	"fmt"
+
+	"upper.io/db.v3/lib/sqlbuilder"
+)
+
+// sqliteSchemaVersion is the last schema version before SQLite was supported. SQLite cannot alter columns or
+// constraints, so rather than applying the changes up to this version, it creates the schema as it is after them.
+const sqliteSchemaVersion = 59
+
+// sqliteSchema creates the schema as it is at sqliteSchemaVersion
+type sqliteSchema struct {
+	tableName string
+}
+
+func (s sqliteSchema) String() string {
+	return fmt.Sprintf("sqliteSchema{%s}", s.tableName)
+}
+
+func (s sqliteSchema) apply(session sqlbuilder.SQLBuilder) error {
+	for _, change := range []ansiSQLChange{
+		ansiSQLChange(`create table if not exists ` + s.tableName + ` (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    version varchar(64) not null,
+    nodes text not null,
+    updatedat timestamp not null default current_timestamp,
+    primary key (clustername, uid, version)
+)`),
+		ansiSQLChange(`create index if not exists ` + s.tableName + `_i1 on ` + s.tableName + ` (clustername,namespace,updatedat)`),
+		ansiSQLChange(`create table if not exists argo_archived_workflows (
+    clustername varchar(64) not null,
+    instanceid varchar(64) not null,
+    uid varchar(128) not null,
+    name varchar(256) not null,
+    phase varchar(25) not null,
+    namespace varchar(256) not null,
+    workflow text not null,
+    startedat timestamp not null default current_timestamp,
+    finishedat timestamp not null default current_timestamp,
+    primary key (clustername, uid)
+)`),
+		ansiSQLChange(`create index if not exists argo_archived_workflows_i1 on argo_archived_workflows (clustername,instanceid,namespace)`),
+		ansiSQLChange(`create index if not exists argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
+		ansiSQLChange(`create index if not exists argo_archived_workflows_i3 on argo_archived_workflows (clustername,instanceid,name)`),
+		ansiSQLChange(`create index if not exists argo_archived_workflows_i4 on argo_archived_workflows (startedat)`),
+		ansiSQLChange(`create table if not exists argo_archived_workflows_labels (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    name varchar(317) not null,
+    value varchar(63) not null,
+    primary key (clustername, uid, name),
+    foreign key (clustername, uid) references argo_archived_workflows(clustername, uid) on delete cascade
+)`),
+		ansiSQLChange(`create index if not exists argo_archived_workflows_labels_i1 on argo_archived_workflows_labels (name,value)`),
+	} {
+		if err := change.apply(session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
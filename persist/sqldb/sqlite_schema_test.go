@@ -0,0 +1,123 @@
+package sqldb
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+)
+
+func newSQLiteSession(t *testing.T) sqlbuilder.Database {
+	session, tableName, err := CreateSQLiteDBSession(&config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "argo.db"), TableName: "argo_workflows"}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	require.Equal(t, SQLite, dbTypeFor(session))
+	require.NoError(t, NewMigrate(session, "my-cluster", tableName).Exec(context.Background()))
+	return session
+}
+
+func TestSQLite(t *testing.T) {
+	session := newSQLiteSession(t)
+
+	t.Run("Migrate", func(t *testing.T) {
+		// migrating again is a no-op
+		assert.NoError(t, NewMigrate(session, "my-cluster", "argo_workflows").Exec(context.Background()))
+		var version int
+		row, err := session.QueryRow("select schema_version from schema_history")
+		require.NoError(t, err)
+		require.NoError(t, row.Scan(&version))
+		assert.Greater(t, version, sqliteSchemaVersion)
+	})
+	t.Run("WorkflowArchive", func(t *testing.T) {
+		archive := NewWorkflowArchive(session, "my-cluster", "", instanceid.NewService(""))
+		now := time.Now().UTC().Truncate(time.Second)
+		for i, name := range []string{"old", "new"} {
+			wf := &wfv1.Workflow{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", UID: types.UID("uid-" + name), Labels: map[string]string{"my-label": name}},
+				Status: wfv1.WorkflowStatus{
+					Phase:      wfv1.WorkflowSucceeded,
+					StartedAt:  metav1.Time{Time: now.Add(time.Duration(i-2) * time.Hour)},
+					FinishedAt: metav1.Time{Time: now.Add(time.Duration(i-2) * time.Hour)},
+				},
+			}
+			require.NoError(t, archive.ArchiveWorkflow(wf))
+		}
+		// archiving again replaces the workflow
+		require.NoError(t, archive.ArchiveWorkflow(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "my-ns", UID: "uid-new", Labels: map[string]string{"my-label": "new"}}, Status: wfv1.WorkflowStatus{StartedAt: metav1.Time{Time: now.Add(-time.Hour)}, FinishedAt: metav1.Time{Time: now.Add(-time.Hour)}}}))
+
+		wfs, err := archive.ListWorkflows(sutils.ListOptions{Namespace: "my-ns"})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 2) {
+			assert.Equal(t, "new", wfs[0].Name)
+			assert.Equal(t, "old", wfs[1].Name)
+		}
+		requirements, err := labels.ParseToRequirements("my-label=old")
+		require.NoError(t, err)
+		wfs, err = archive.ListWorkflows(sutils.ListOptions{Namespace: "my-ns", LabelRequirements: requirements})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, "old", wfs[0].Name)
+		}
+		count, err := archive.CountWorkflows(sutils.ListOptions{Namespace: "my-ns"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		keys, err := archive.ListWorkflowsLabelKeys()
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"my-label"}, keys.Items)
+		}
+
+		wf, err := archive.GetWorkflow("uid-old")
+		if assert.NoError(t, err) && assert.NotNil(t, wf) {
+			assert.Equal(t, "old", wf.Name)
+		}
+
+		// only the old workflow finished more than 90 minutes ago
+		require.NoError(t, archive.DeleteExpiredWorkflows(90*time.Minute))
+		wf, err = archive.GetWorkflow("uid-old")
+		assert.NoError(t, err)
+		assert.Nil(t, wf)
+		values, err := archive.ListWorkflowsLabelValues("my-label")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"new"}, values.Items, "labels are deleted with the workflow")
+		}
+	})
+	t.Run("ListOptions", func(t *testing.T) {
+		testListOptions(t, NewWorkflowArchive(session, "my-cluster", "", instanceid.NewService("")), "list-options")
+	})
+	t.Run("WorkflowStats", func(t *testing.T) {
+		testWorkflowStats(t, NewWorkflowArchive(session, "my-cluster", "", instanceid.NewService("")), "workflow-stats")
+	})
+	t.Run("OffloadNodeStatusRepo", func(t *testing.T) {
+		repo, err := NewOffloadNodeStatusRepo(session, "my-cluster", "argo_workflows")
+		require.NoError(t, err)
+		nodes := wfv1.Nodes{"my-node": wfv1.NodeStatus{Name: "my-node"}}
+		version, err := repo.Save("my-uid", "my-ns", nodes)
+		require.NoError(t, err)
+		// saving the same nodes again is not an error
+		_, err = repo.Save("my-uid", "my-ns", nodes)
+		require.NoError(t, err)
+		got, err := repo.Get("my-uid", version)
+		if assert.NoError(t, err) {
+			assert.Equal(t, nodes, got)
+		}
+		versions, err := repo.List("my-ns")
+		if assert.NoError(t, err) {
+			assert.Len(t, versions, 1)
+		}
+		assert.NoError(t, repo.Delete("my-uid", version))
+		versions, err = repo.List("my-ns")
+		if assert.NoError(t, err) {
+			assert.Empty(t, versions)
+		}
+	})
+}
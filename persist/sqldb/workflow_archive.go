@@ -3,17 +3,17 @@ package sqldb
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"upper.io/db.v3"
 	"upper.io/db.v3/lib/sqlbuilder"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 )
 
@@ -54,9 +54,13 @@ type archivedWorkflowCount struct {
 
 type WorkflowArchive interface {
 	ArchiveWorkflow(wf *wfv1.Workflow) error
-	// list workflows, with the most recently started workflows at the beginning (i.e. index 0 is the most recent)
-	ListWorkflows(namespace string, name string, namePrefix string, minStartAt, maxStartAt time.Time, labelRequirements labels.Requirements, limit, offset int) (wfv1.Workflows, error)
-	CountWorkflows(namespace string, name string, namePrefix string, minStartAt, maxStartAt time.Time, labelRequirements labels.Requirements) (int64, error)
+	// list workflows, by default with the most recently started workflows at the beginning (i.e. index 0 is the most recent)
+	ListWorkflows(options sutils.ListOptions) (wfv1.Workflows, error)
+	// ListWorkflowsWithStatus lists workflows like ListWorkflows, but with their full status, including their nodes
+	ListWorkflowsWithStatus(options sutils.ListOptions) (wfv1.Workflows, error)
+	CountWorkflows(options sutils.ListOptions) (int64, error)
+	// GetWorkflowStats aggregates the workflows that match the options by the group and the time bucket they are in
+	GetWorkflowStats(options sutils.StatsOptions) (sutils.WorkflowStatsMap, error)
 	GetWorkflow(uid string) (*wfv1.Workflow, error)
 	DeleteWorkflow(uid string) error
 	DeleteExpiredWorkflows(ttl time.Duration) error
@@ -141,15 +145,20 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 	})
 }
 
-func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefix string, minStartedAt, maxStartedAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (wfv1.Workflows, error) {
+func (r *workflowArchive) ListWorkflows(options sutils.ListOptions) (wfv1.Workflows, error) {
 	var archivedWfs []archivedWorkflowMetadata
-	clause, err := labelsClause(r.dbType, labelRequirements)
+	clause, err := r.listOptionsClause(options)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := r.orderBy(options)
 	if err != nil {
 		return nil, err
 	}
 
 	// If we were passed 0 as the limit, then we should load all available archived workflows
 	// to match the behavior of the `List` operations in the Kubernetes API
+	limit, offset := options.Limit, options.Offset
 	if limit == 0 {
 		limit = -1
 		offset = -1
@@ -159,12 +168,8 @@ func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefi
 		Select("name", "namespace", "uid", "phase", "startedat", "finishedat").
 		From(archiveTableName).
 		Where(r.clusterManagedNamespaceAndInstanceID()).
-		And(namespaceEqual(namespace)).
-		And(nameEqual(name)).
-		And(namePrefixClause(namePrefix)).
-		And(startedAtClause(minStartedAt, maxStartedAt)).
 		And(clause).
-		OrderBy("-startedat").
+		OrderBy(orderBy).
 		Limit(limit).
 		Offset(offset).
 		All(&archivedWfs)
@@ -190,9 +195,47 @@ func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefi
 	return wfs, nil
 }
 
-func (r *workflowArchive) CountWorkflows(namespace string, name string, namePrefix string, minStartedAt, maxStartedAt time.Time, labelRequirements labels.Requirements) (int64, error) {
+// ListWorkflowsWithStatus reads the workflows in a single query
+func (r *workflowArchive) ListWorkflowsWithStatus(options sutils.ListOptions) (wfv1.Workflows, error) {
+	clause, err := r.listOptionsClause(options)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := r.orderBy(options)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset := options.Limit, options.Offset
+	if limit == 0 {
+		limit = -1
+		offset = -1
+	}
+
+	var archivedWfs []archivedWorkflowRecord
+	err = r.session.
+		Select("workflow").
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(clause).
+		OrderBy(orderBy).
+		Limit(limit).
+		Offset(offset).
+		All(&archivedWfs)
+	if err != nil {
+		return nil, err
+	}
+	wfs := make(wfv1.Workflows, len(archivedWfs))
+	for i, archivedWf := range archivedWfs {
+		if err := json.Unmarshal([]byte(archivedWf.Workflow), &wfs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return wfs, nil
+}
+
+func (r *workflowArchive) CountWorkflows(options sutils.ListOptions) (int64, error) {
 	total := &archivedWorkflowCount{}
-	clause, err := labelsClause(r.dbType, labelRequirements)
+	clause, err := r.listOptionsClause(options)
 	if err != nil {
 		return 0, err
 	}
@@ -201,10 +244,6 @@ func (r *workflowArchive) CountWorkflows(namespace string, name string, namePref
 		Select(db.Raw("count(*) as total")).
 		From(archiveTableName).
 		Where(r.clusterManagedNamespaceAndInstanceID()).
-		And(namespaceEqual(namespace)).
-		And(nameEqual(name)).
-		And(namePrefixClause(namePrefix)).
-		And(startedAtClause(minStartedAt, maxStartedAt)).
 		And(clause).
 		One(total)
 	if err != nil {
@@ -222,17 +261,80 @@ func (r *workflowArchive) clusterManagedNamespaceAndInstanceID() db.Compound {
 	)
 }
 
-func startedAtClause(from, to time.Time) db.Compound {
+// listOptionsClause returns the clause that matches the workflows the options list
+func (r *workflowArchive) listOptionsClause(options sutils.ListOptions) (db.Compound, error) {
+	clause, err := labelsClause(r.dbType, options.LabelRequirements)
+	if err != nil {
+		return nil, err
+	}
+	return db.And(
+		namespaceEqual(options.Namespace),
+		nameEqual(options.Name),
+		namePrefixClause(options.NamePrefix),
+		timeRangeClause("startedat", options.MinStartedAt, options.MaxStartedAt),
+		timeRangeClause("finishedat", options.MinFinishedAt, options.MaxFinishedAt),
+		phasesClause(options.Phases),
+		r.durationClause(options.MinDuration, options.MaxDuration),
+		r.messageClause(options.MessageContains),
+		clause,
+	), nil
+}
+
+// orderBy returns the order of the workflows the options list
+func (r *workflowArchive) orderBy(options sutils.ListOptions) (interface{}, error) {
+	field, descending, err := options.Order()
+	if err != nil {
+		return nil, err
+	}
+	order := " asc"
+	if descending {
+		order = " desc"
+	}
+	switch field {
+	case "duration":
+		return db.Raw(r.dbType.duration("startedat", "finishedat") + order), nil
+	default:
+		return db.Raw(strings.ToLower(field) + order), nil
+	}
+}
+
+func timeRangeClause(column string, from, to time.Time) db.Compound {
 	var conds []db.Compound
 	if !from.IsZero() {
-		conds = append(conds, db.Cond{"startedat > ": from})
+		conds = append(conds, db.Cond{column + " > ": from})
 	}
 	if !to.IsZero() {
-		conds = append(conds, db.Cond{"startedat < ": to})
+		conds = append(conds, db.Cond{column + " < ": to})
+	}
+	return db.And(conds...)
+}
+
+func phasesClause(phases []wfv1.WorkflowPhase) db.Cond {
+	if len(phases) == 0 {
+		return db.Cond{}
+	}
+	return db.Cond{"phase IN": phases}
+}
+
+func (r *workflowArchive) durationClause(min, max time.Duration) db.Compound {
+	var conds []db.Compound
+	duration := r.dbType.duration("startedat", "finishedat")
+	if min > 0 {
+		conds = append(conds, db.Raw(duration+" >= ?", min.Seconds()))
+	}
+	if max > 0 {
+		conds = append(conds, db.Raw(duration+" <= ?", max.Seconds()))
 	}
 	return db.And(conds...)
 }
 
+func (r *workflowArchive) messageClause(message string) db.Compound {
+	if message == "" {
+		return db.And()
+	}
+	return db.Raw(r.dbType.jsonString("workflow", "status", "message")+" LIKE ? ESCAPE '"+likeEscape+"'", "%"+escapeLike(message)+"%")
+}
+
 func namespaceEqual(namespace string) db.Cond {
 	if namespace == "" {
 		return db.Cond{}
@@ -249,14 +351,22 @@ func nameEqual(name string) db.Cond {
 	}
 }
 
-func namePrefixClause(namePrefix string) db.Cond {
+func namePrefixClause(namePrefix string) db.Compound {
 	if namePrefix == "" {
-		return db.Cond{}
+		return db.And()
 	} else {
-		return db.Cond{"name LIKE ": namePrefix + "%"}
+		return db.Raw("name LIKE ? ESCAPE '"+likeEscape+"'", escapeLike(namePrefix)+"%")
 	}
 }
 
+// likeEscape is the escape character of LIKE patterns. It is not a backslash, which MySQL string literals escape.
+const likeEscape = "!"
+
+// escapeLike escapes the wildcards of LIKE patterns in the string, so that it is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(likeEscape, likeEscape+likeEscape, "%", likeEscape+"%", "_", likeEscape+"_").Replace(s)
+}
+
 func (r *workflowArchive) GetWorkflow(uid string) (*wfv1.Workflow, error) {
 	archivedWf := &archivedWorkflowRecord{}
 	err := r.session.
@@ -300,7 +410,7 @@ func (r *workflowArchive) DeleteExpiredWorkflows(ttl time.Duration) error {
 	rs, err := r.session.
 		DeleteFrom(archiveTableName).
 		Where(r.clusterManagedNamespaceAndInstanceID()).
-		And(fmt.Sprintf("finishedat < current_timestamp - interval '%d' second", int(ttl.Seconds()))).
+		And(r.dbType.timestamp("finishedat") + " < " + r.dbType.ago(ttl)).
 		Exec()
 	if err != nil {
 		return err
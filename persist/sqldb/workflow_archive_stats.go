@@ -0,0 +1,143 @@
+package sqldb
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+)
+
+type archivedWorkflowPhaseStats struct {
+	Group  string             `db:"grp"`
+	Bucket int64              `db:"bucket"`
+	Phase  wfv1.WorkflowPhase `db:"phase"`
+	Total  int64              `db:"total"`
+	CPU    int64              `db:"cpu"`
+	Memory int64              `db:"memory"`
+}
+
+type archivedWorkflowDurationStats struct {
+	Group    string  `db:"grp"`
+	Bucket   int64   `db:"bucket"`
+	Duration float64 `db:"duration"`
+	Total    int64   `db:"total"`
+}
+
+type archivedWorkflowMessageStats struct {
+	Group   string `db:"grp"`
+	Bucket  int64  `db:"bucket"`
+	Message string `db:"message"`
+	Total   int64  `db:"total"`
+}
+
+// GetWorkflowStats counts the workflows by phase, and sums their resource durations, and counts their failure messages
+// in the database. Percentiles cannot be calculated in every database, and must be calculated together with the live
+// workflows, so the workflows are counted by their duration in seconds, which returns a row for each distinct
+// duration rather than for each workflow.
+func (r *workflowArchive) GetWorkflowStats(options sutils.StatsOptions) (sutils.WorkflowStatsMap, error) {
+	stats, err := r.statsSelector(options)
+	if err != nil {
+		return nil, err
+	}
+	key := func(group string, bucket int64) sutils.WorkflowStatsKey {
+		if options.Bucket <= 0 {
+			return sutils.WorkflowStatsKey{Group: group}
+		}
+		return sutils.WorkflowStatsKey{Group: group, BucketStart: time.Unix(bucket, 0).UTC()}
+	}
+	m := sutils.WorkflowStatsMap{}
+
+	var phases []archivedWorkflowPhaseStats
+	err = r.session.
+		Select(db.Raw("grp, bucket, phase, count(*) as total, coalesce(sum(cpu), 0) as cpu, coalesce(sum(memory), 0) as memory")).
+		From(db.Raw("? as stats", stats)).
+		GroupBy(db.Raw("grp, bucket, phase")).
+		All(&phases)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range phases {
+		s := m.Get(key(p.Group, p.Bucket))
+		s.Phases[p.Phase] += p.Total
+		for name, duration := range map[apiv1.ResourceName]int64{apiv1.ResourceCPU: p.CPU, apiv1.ResourceMemory: p.Memory} {
+			if duration > 0 {
+				s.ResourcesDuration[name] += wfv1.ResourceDuration(duration)
+			}
+		}
+	}
+
+	var durations []archivedWorkflowDurationStats
+	err = r.session.
+		// the times of workflows are in seconds, but some databases calculate durations with floating point errors
+		Select(db.Raw("grp, bucket, round(duration) as duration, count(*) as total")).
+		From(db.Raw("? as stats", stats)).
+		GroupBy(db.Raw("grp, bucket, round(duration)")).
+		All(&durations)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range durations {
+		s := m.Get(key(d.Group, d.Bucket))
+		s.Durations[time.Duration(math.Round(d.Duration))*time.Second] += d.Total
+	}
+
+	var messages []archivedWorkflowMessageStats
+	err = r.session.
+		Select(db.Raw("grp, bucket, message, count(*) as total")).
+		From(db.Raw("? as stats", stats)).
+		Where(db.Cond{"phase IN": []wfv1.WorkflowPhase{wfv1.WorkflowFailed, wfv1.WorkflowError}}).
+		GroupBy(db.Raw("grp, bucket, message")).
+		All(&messages)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range messages {
+		s := m.Get(key(msg.Group, msg.Bucket))
+		s.FailureMessages[msg.Message] += msg.Total
+	}
+	return m, nil
+}
+
+// statsSelector returns a selector of the group, time bucket, phase, duration, message and resource durations of each
+// workflow that the options aggregate
+func (r *workflowArchive) statsSelector(options sutils.StatsOptions) (sqlbuilder.Selector, error) {
+	// workflows without the label are not in any group
+	requirement, err := labels.NewRequirement(options.GroupBy, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := options.ListOptions
+	listOptions.LabelRequirements = append(labels.Requirements{*requirement}, listOptions.LabelRequirements...)
+	clause, err := r.listOptionsClause(listOptions)
+	if err != nil {
+		return nil, err
+	}
+	bucket := "0"
+	if options.Bucket > 0 {
+		bucket = r.dbType.bucket("startedat", options.Bucket)
+	}
+	resourceDuration := func(name apiv1.ResourceName) string {
+		return fmt.Sprintf("cast(%s as %s)", r.dbType.jsonString("workflow", "status", "resourcesDuration", string(name)), r.dbType.intType())
+	}
+	return r.session.
+		Select(
+			db.Raw(fmt.Sprintf("(select value from %s where clustername = %s.clustername and uid = %s.uid and name = '%s') as grp", archiveLabelsTableName, archiveTableName, archiveTableName, options.GroupBy)),
+			db.Raw(bucket+" as bucket"),
+			"phase",
+			db.Raw(r.dbType.duration("startedat", "finishedat")+" as duration"),
+			db.Raw("coalesce("+r.dbType.jsonString("workflow", "status", "message")+", '') as message"),
+			db.Raw(resourceDuration(apiv1.ResourceCPU)+" as cpu"),
+			db.Raw(resourceDuration(apiv1.ResourceMemory)+" as memory"),
+		).
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(clause), nil
+}
@@ -0,0 +1,159 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+)
+
+// testListOptions tests that the archive filters and orders workflows by the list options, it archives workflows in
+// the namespace
+func testListOptions(t *testing.T, archive WorkflowArchive, namespace string) {
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, w := range []struct {
+		name     string
+		phase    wfv1.WorkflowPhase
+		started  time.Duration
+		duration time.Duration
+		message  string
+	}{
+		{"quick", wfv1.WorkflowSucceeded, -3 * time.Hour, time.Minute, ""},
+		{"slow", wfv1.WorkflowFailed, -2 * time.Hour, 90 * time.Minute, "pod was OOMKilled"},
+		{"timeout", wfv1.WorkflowError, -time.Hour, 10 * time.Minute, "deadline exceeded"},
+	} {
+		started := now.Add(w.started)
+		require.NoError(t, archive.ArchiveWorkflow(&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: w.name, Namespace: namespace, UID: types.UID(namespace + "-" + w.name)},
+			Status: wfv1.WorkflowStatus{
+				Phase:      w.phase,
+				StartedAt:  metav1.Time{Time: started},
+				FinishedAt: metav1.Time{Time: started.Add(w.duration)},
+				Message:    w.message,
+			},
+		}))
+	}
+	names := func(t *testing.T, options sutils.ListOptions) []string {
+		options.Namespace = namespace
+		wfs, err := archive.ListWorkflows(options)
+		require.NoError(t, err)
+		var names []string
+		for _, wf := range wfs {
+			names = append(names, wf.Name)
+		}
+		if options.Limit == 0 {
+			count, err := archive.CountWorkflows(options)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(names)), count)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"timeout", "slow", "quick"}, names(t, sutils.ListOptions{}))
+	assert.Equal(t, []string{"timeout", "slow"}, names(t, sutils.ListOptions{Phases: []wfv1.WorkflowPhase{wfv1.WorkflowFailed, wfv1.WorkflowError}}))
+	assert.Equal(t, []string{"timeout", "slow"}, names(t, sutils.ListOptions{MinFinishedAt: now.Add(-70 * time.Minute)}))
+	assert.Equal(t, []string{"quick"}, names(t, sutils.ListOptions{MaxFinishedAt: now.Add(-70 * time.Minute)}))
+	assert.Equal(t, []string{"slow"}, names(t, sutils.ListOptions{MinDuration: 30 * time.Minute}))
+	assert.Equal(t, []string{"timeout", "quick"}, names(t, sutils.ListOptions{MaxDuration: 20 * time.Minute}))
+	assert.Equal(t, []string{"slow"}, names(t, sutils.ListOptions{MessageContains: "OOMKilled"}))
+	assert.Equal(t, []string{"quick"}, names(t, sutils.ListOptions{NamePrefix: "qu"}))
+	// wildcards are matched literally
+	assert.Empty(t, names(t, sutils.ListOptions{NamePrefix: "_"}))
+	assert.Empty(t, names(t, sutils.ListOptions{NamePrefix: "%"}))
+	assert.Empty(t, names(t, sutils.ListOptions{MessageContains: "d_adline"}))
+	assert.Empty(t, names(t, sutils.ListOptions{MessageContains: "%!"}))
+	assert.Equal(t, []string{"slow", "timeout", "quick"}, names(t, sutils.ListOptions{OrderBy: "-duration"}))
+	assert.Equal(t, []string{"quick", "timeout", "slow"}, names(t, sutils.ListOptions{OrderBy: "finishedAt"}))
+	assert.Equal(t, []string{"timeout"}, names(t, sutils.ListOptions{OrderBy: "-duration", Limit: 1, Offset: 1}))
+
+	wfs, err := archive.ListWorkflowsWithStatus(sutils.ListOptions{Namespace: namespace, OrderBy: "-duration", Limit: 2})
+	if assert.NoError(t, err) && assert.Len(t, wfs, 2) {
+		assert.Equal(t, "slow", wfs[0].Name)
+		assert.Equal(t, "pod was OOMKilled", wfs[0].Status.Message)
+		assert.Equal(t, "timeout", wfs[1].Name)
+	}
+
+	_, err = archive.ListWorkflows(sutils.ListOptions{OrderBy: "name"})
+	assert.Error(t, err)
+}
+
+// testWorkflowStats tests that the archive aggregates workflows by group and time bucket, it archives workflows in the
+// namespace
+func testWorkflowStats(t *testing.T, archive WorkflowArchive, namespace string) {
+	day := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	for _, w := range []struct {
+		name      string
+		template  string
+		phase     wfv1.WorkflowPhase
+		started   time.Time
+		duration  time.Duration
+		message   string
+		resources wfv1.ResourcesDuration
+	}{
+		{"a-1", "a", wfv1.WorkflowSucceeded, day.Add(10 * time.Hour), 10 * time.Minute, "", wfv1.ResourcesDuration{"cpu": 100, "memory": 200}},
+		{"a-2", "a", wfv1.WorkflowFailed, day.Add(12 * time.Hour), 30 * time.Minute, "OOMKilled", wfv1.ResourcesDuration{"cpu": 50}},
+		{"a-3", "a", wfv1.WorkflowFailed, day.Add(25 * time.Hour), 20 * time.Minute, "OOMKilled", nil},
+		{"b-1", "b", wfv1.WorkflowError, day.Add(26 * time.Hour), 5 * time.Minute, "deadline exceeded", nil},
+		{"none", "", wfv1.WorkflowSucceeded, day.Add(time.Hour), time.Minute, "", nil},
+	} {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: w.name, Namespace: namespace, UID: types.UID(namespace + "-" + w.name)},
+			Status: wfv1.WorkflowStatus{
+				Phase:             w.phase,
+				StartedAt:         metav1.Time{Time: w.started},
+				FinishedAt:        metav1.Time{Time: w.started.Add(w.duration)},
+				Message:           w.message,
+				ResourcesDuration: w.resources,
+			},
+		}
+		if w.template != "" {
+			wf.Labels = map[string]string{"my-template": w.template}
+		}
+		require.NoError(t, archive.ArchiveWorkflow(wf))
+	}
+	options := sutils.StatsOptions{ListOptions: sutils.ListOptions{Namespace: namespace}, GroupBy: "my-template"}
+
+	m, err := archive.GetWorkflowStats(options)
+	require.NoError(t, err)
+	if assert.Len(t, m, 2) && assert.Contains(t, m, sutils.WorkflowStatsKey{Group: "a"}) {
+		s := m[sutils.WorkflowStatsKey{Group: "a"}]
+		assert.Equal(t, map[wfv1.WorkflowPhase]int64{wfv1.WorkflowSucceeded: 1, wfv1.WorkflowFailed: 2}, s.Phases)
+		assert.Equal(t, map[time.Duration]int64{10 * time.Minute: 1, 20 * time.Minute: 1, 30 * time.Minute: 1}, s.Durations)
+		assert.Equal(t, wfv1.ResourcesDuration{"cpu": 150, "memory": 200}, s.ResourcesDuration)
+		assert.Equal(t, map[string]int64{"OOMKilled": 2}, s.FailureMessages)
+	}
+	if assert.Contains(t, m, sutils.WorkflowStatsKey{Group: "b"}) {
+		s := m[sutils.WorkflowStatsKey{Group: "b"}]
+		assert.Equal(t, map[wfv1.WorkflowPhase]int64{wfv1.WorkflowError: 1}, s.Phases)
+		assert.Empty(t, s.ResourcesDuration)
+		assert.Equal(t, map[string]int64{"deadline exceeded": 1}, s.FailureMessages)
+	}
+
+	options.Bucket = 24 * time.Hour
+	m, err = archive.GetWorkflowStats(options)
+	require.NoError(t, err)
+	assert.Len(t, m, 3)
+	for key, phases := range map[sutils.WorkflowStatsKey]map[wfv1.WorkflowPhase]int64{
+		{Group: "a", BucketStart: day}:                     {wfv1.WorkflowSucceeded: 1, wfv1.WorkflowFailed: 1},
+		{Group: "a", BucketStart: day.Add(24 * time.Hour)}: {wfv1.WorkflowFailed: 1},
+		{Group: "b", BucketStart: day.Add(24 * time.Hour)}: {wfv1.WorkflowError: 1},
+	} {
+		if assert.Contains(t, m, key) {
+			assert.Equal(t, phases, m[key].Phases, key)
+		}
+	}
+
+	options.Bucket = 0
+	options.MinStartedAt = day.Add(24 * time.Hour)
+	m, err = archive.GetWorkflowStats(options)
+	require.NoError(t, err)
+	if assert.Contains(t, m, sutils.WorkflowStatsKey{Group: "a"}) {
+		assert.Equal(t, map[wfv1.WorkflowPhase]int64{wfv1.WorkflowFailed: 1}, m[sutils.WorkflowStatsKey{Group: "a"}].Phases)
+	}
+}
@@ -14,13 +14,18 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	workflowstatspkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowstats"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	workflow "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	clusterworkflowtmplserver "github.com/argoproj/argo-workflows/v3/server/clusterworkflowtemplate"
 	cronworkflowserver "github.com/argoproj/argo-workflows/v3/server/cronworkflow"
+	memoizationserver "github.com/argoproj/argo-workflows/v3/server/memoization"
+	syncserver "github.com/argoproj/argo-workflows/v3/server/sync"
 	"github.com/argoproj/argo-workflows/v3/server/types"
 	workflowserver "github.com/argoproj/argo-workflows/v3/server/workflow"
 	workflowtemplateserver "github.com/argoproj/argo-workflows/v3/server/workflowtemplate"
@@ -102,6 +107,20 @@ func (a *argoKubeClient) NewInfoServiceClient() (infopkg.InfoServiceClient, erro
 	return nil, NoArgoServerErr
 }
 
+func (a *argoKubeClient) NewSyncServiceClient() (syncpkg.SyncServiceClient, error) {
+	return &errorTranslatingSyncServiceClient{&argoKubeSyncServiceClient{syncserver.NewSyncServer(a.instanceIDService, argoKubeOffloadNodeStatusRepo, nil, 0)}}, nil
+}
+
+// NewMemoizationServiceClient returns a client that only supports ConfigMap caches, as the artifact repositories are
+// configured in the controller's configuration
+func (a *argoKubeClient) NewMemoizationServiceClient() (memoizationpkg.MemoizationServiceClient, error) {
+	return &errorTranslatingMemoizationServiceClient{&argoKubeMemoizationServiceClient{memoizationserver.NewMemoizationServer(nil)}}, nil
+}
+
+func (a *argoKubeClient) NewWorkflowStatsServiceClient() (workflowstatspkg.WorkflowStatsServiceClient, error) {
+	return nil, NoArgoServerErr
+}
+
 func (a *argoKubeClient) NewClusterWorkflowTemplateServiceClient() (clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient, error) {
 	return &errorTranslatingWorkflowClusterTemplateServiceClient{&argoKubeWorkflowClusterTemplateServiceClient{clusterworkflowtmplserver.NewClusterWorkflowTemplateServer(a.instanceIDService)}}, nil
 }
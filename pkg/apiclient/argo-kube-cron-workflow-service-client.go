@@ -31,6 +31,10 @@ func (c *argoKubeCronWorkflowServiceClient) GetCronWorkflow(ctx context.Context,
 	return c.delegate.GetCronWorkflow(ctx, req)
 }
 
+func (c *argoKubeCronWorkflowServiceClient) GetCronWorkflowBlackoutWindows(ctx context.Context, req *cronworkflowpkg.GetCronWorkflowBlackoutWindowsRequest, _ ...grpc.CallOption) (*cronworkflowpkg.CronWorkflowBlackoutWindowsResponse, error) {
+	return c.delegate.GetCronWorkflowBlackoutWindows(ctx, req)
+}
+
 func (c *argoKubeCronWorkflowServiceClient) UpdateCronWorkflow(ctx context.Context, req *cronworkflowpkg.UpdateCronWorkflowRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
 	return c.delegate.UpdateCronWorkflow(ctx, req)
 }
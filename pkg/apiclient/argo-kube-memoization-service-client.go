@@ -0,0 +1,31 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+)
+
+type argoKubeMemoizationServiceClient struct {
+	delegate memoizationpkg.MemoizationServiceServer
+}
+
+var _ memoizationpkg.MemoizationServiceClient = &argoKubeMemoizationServiceClient{}
+
+func (c *argoKubeMemoizationServiceClient) ListCacheEntries(ctx context.Context, req *memoizationpkg.ListCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationpkg.CacheEntryList, error) {
+	return c.delegate.ListCacheEntries(ctx, req)
+}
+
+func (c *argoKubeMemoizationServiceClient) GetCacheEntry(ctx context.Context, req *memoizationpkg.GetCacheEntryRequest, _ ...grpc.CallOption) (*memoizationpkg.CacheEntry, error) {
+	return c.delegate.GetCacheEntry(ctx, req)
+}
+
+func (c *argoKubeMemoizationServiceClient) DeleteCacheEntry(ctx context.Context, req *memoizationpkg.DeleteCacheEntryRequest, _ ...grpc.CallOption) (*memoizationpkg.CacheEntryDeletedResponse, error) {
+	return c.delegate.DeleteCacheEntry(ctx, req)
+}
+
+func (c *argoKubeMemoizationServiceClient) PruneCache(ctx context.Context, req *memoizationpkg.PruneCacheRequest, _ ...grpc.CallOption) (*memoizationpkg.PruneCacheResponse, error) {
+	return c.delegate.PruneCache(ctx, req)
+}
@@ -0,0 +1,23 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
+)
+
+type argoKubeSyncServiceClient struct {
+	delegate syncpkg.SyncServiceServer
+}
+
+var _ syncpkg.SyncServiceClient = &argoKubeSyncServiceClient{}
+
+func (c *argoKubeSyncServiceClient) ListSyncLocks(ctx context.Context, req *syncpkg.ListSyncLocksRequest, _ ...grpc.CallOption) (*syncpkg.SyncLockList, error) {
+	return c.delegate.ListSyncLocks(ctx, req)
+}
+
+func (c *argoKubeSyncServiceClient) GetSyncLock(ctx context.Context, req *syncpkg.GetSyncLockRequest, _ ...grpc.CallOption) (*syncpkg.SyncLock, error) {
+	return c.delegate.GetSyncLock(ctx, req)
+}
@@ -12,8 +12,11 @@ import (
 	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	workflowstatspkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowstats"
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 )
 
@@ -60,6 +63,18 @@ func (a *argoServerClient) NewInfoServiceClient() (infopkg.InfoServiceClient, er
 	return infopkg.NewInfoServiceClient(a.ClientConn), nil
 }
 
+func (a *argoServerClient) NewSyncServiceClient() (syncpkg.SyncServiceClient, error) {
+	return syncpkg.NewSyncServiceClient(a.ClientConn), nil
+}
+
+func (a *argoServerClient) NewMemoizationServiceClient() (memoizationpkg.MemoizationServiceClient, error) {
+	return memoizationpkg.NewMemoizationServiceClient(a.ClientConn), nil
+}
+
+func (a *argoServerClient) NewWorkflowStatsServiceClient() (workflowstatspkg.WorkflowStatsServiceClient, error) {
+	return workflowstatspkg.NewWorkflowStatsServiceClient(a.ClientConn), nil
+}
+
 func newClientConn(opts ArgoServerOpts) (*grpc.ClientConn, error) {
 	creds := grpc.WithTransportCredentials(insecure.NewCredentials())
 	if opts.Secure {
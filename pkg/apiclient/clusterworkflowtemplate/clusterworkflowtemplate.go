@@ -0,0 +1,3 @@
+package clusterworkflowtemplate
+
+//go:generate mockery --name=ClusterWorkflowTemplateServiceClient
@@ -542,6 +542,109 @@ func (m *CronWorkflowResumeRequest) GetNamespace() string {
 	return ""
 }
 
+type GetCronWorkflowBlackoutWindowsRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCronWorkflowBlackoutWindowsRequest) Reset()         { *m = GetCronWorkflowBlackoutWindowsRequest{} }
+func (m *GetCronWorkflowBlackoutWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCronWorkflowBlackoutWindowsRequest) ProtoMessage()    {}
+func (*GetCronWorkflowBlackoutWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_257f310938c448f8, []int{9}
+}
+func (m *GetCronWorkflowBlackoutWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCronWorkflowBlackoutWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCronWorkflowBlackoutWindowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCronWorkflowBlackoutWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCronWorkflowBlackoutWindowsRequest.Merge(m, src)
+}
+func (m *GetCronWorkflowBlackoutWindowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCronWorkflowBlackoutWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCronWorkflowBlackoutWindowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCronWorkflowBlackoutWindowsRequest proto.InternalMessageInfo
+
+func (m *GetCronWorkflowBlackoutWindowsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetCronWorkflowBlackoutWindowsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type CronWorkflowBlackoutWindowsResponse struct {
+	// Windows are the blackout windows of the cron workflow, followed by the events of its blackout calendars
+	Windows              []*v1alpha1.BlackoutWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *CronWorkflowBlackoutWindowsResponse) Reset()         { *m = CronWorkflowBlackoutWindowsResponse{} }
+func (m *CronWorkflowBlackoutWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*CronWorkflowBlackoutWindowsResponse) ProtoMessage()    {}
+func (*CronWorkflowBlackoutWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_257f310938c448f8, []int{10}
+}
+func (m *CronWorkflowBlackoutWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowBlackoutWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronWorkflowBlackoutWindowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CronWorkflowBlackoutWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowBlackoutWindowsResponse.Merge(m, src)
+}
+func (m *CronWorkflowBlackoutWindowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowBlackoutWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowBlackoutWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowBlackoutWindowsResponse proto.InternalMessageInfo
+
+func (m *CronWorkflowBlackoutWindowsResponse) GetWindows() []*v1alpha1.BlackoutWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LintCronWorkflowRequest)(nil), "cronworkflow.LintCronWorkflowRequest")
 	proto.RegisterType((*CreateCronWorkflowRequest)(nil), "cronworkflow.CreateCronWorkflowRequest")
@@ -552,6 +655,8 @@ func init() {
 	proto.RegisterType((*CronWorkflowDeletedResponse)(nil), "cronworkflow.CronWorkflowDeletedResponse")
 	proto.RegisterType((*CronWorkflowSuspendRequest)(nil), "cronworkflow.CronWorkflowSuspendRequest")
 	proto.RegisterType((*CronWorkflowResumeRequest)(nil), "cronworkflow.CronWorkflowResumeRequest")
+	proto.RegisterType((*GetCronWorkflowBlackoutWindowsRequest)(nil), "cronworkflow.GetCronWorkflowBlackoutWindowsRequest")
+	proto.RegisterType((*CronWorkflowBlackoutWindowsResponse)(nil), "cronworkflow.CronWorkflowBlackoutWindowsResponse")
 }
 
 func init() {
@@ -559,55 +664,61 @@ func init() {
 }
 
 var fileDescriptor_257f310938c448f8 = []byte{
-	// 764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x99, 0xed, 0x8f, 0x1f, 0xf4, 0x69, 0x8b, 0x3a, 0x85, 0xba, 0x8d, 0xb5, 0x94, 0x50,
-	0x6d, 0xbb, 0xda, 0x49, 0xb7, 0xad, 0x22, 0x55, 0x2f, 0x6d, 0xa1, 0x07, 0xdb, 0x2a, 0x29, 0x22,
-	0xf5, 0x22, 0x69, 0x76, 0x4c, 0x63, 0xb3, 0x99, 0x98, 0x99, 0xdd, 0x22, 0xd2, 0x8b, 0x27, 0x2f,
-	0x82, 0xe0, 0x51, 0x5f, 0x80, 0xe0, 0x3b, 0xf0, 0xcf, 0x49, 0x04, 0x11, 0x04, 0xc1, 0x37, 0x20,
-	0xc5, 0x17, 0x22, 0x99, 0xfd, 0x97, 0xc9, 0x6e, 0x34, 0x2d, 0x41, 0xf0, 0x36, 0xd9, 0xcc, 0x3c,
-	0xf3, 0xf9, 0x7e, 0xe7, 0xc9, 0x37, 0x59, 0x20, 0xc1, 0x9e, 0x63, 0x58, 0x81, 0x6b, 0x7b, 0x2e,
-	0xf5, 0x85, 0x61, 0x87, 0xcc, 0xdf, 0x67, 0xe1, 0xde, 0x7d, 0x8f, 0xed, 0xcb, 0x8b, 0xd9, 0xd6,
-	0x15, 0x09, 0x42, 0x26, 0x18, 0x1e, 0x8c, 0xcf, 0xd0, 0xc6, 0x1c, 0xc6, 0x1c, 0x8f, 0x46, 0x05,
-	0x0c, 0xcb, 0xf7, 0x99, 0xb0, 0x84, 0xcb, 0x7c, 0xde, 0x98, 0xab, 0x2d, 0xee, 0x5d, 0xe1, 0xc4,
-	0x65, 0xd1, 0xdd, 0xaa, 0x65, 0xef, 0xba, 0x3e, 0x0d, 0x1f, 0x19, 0xcd, 0xfd, 0xb8, 0x51, 0xa5,
-	0xc2, 0x32, 0xea, 0x65, 0xc3, 0xa1, 0x3e, 0x0d, 0x2d, 0x41, 0x2b, 0xcd, 0x55, 0x1b, 0x8e, 0x2b,
-	0x76, 0x6b, 0x3b, 0xc4, 0x66, 0x55, 0xc3, 0x0a, 0x1d, 0x16, 0x84, 0xec, 0x81, 0x1c, 0xb4, 0x51,
-	0x78, 0xa7, 0x48, 0x9b, 0xb5, 0x5e, 0xb6, 0xbc, 0x60, 0xd7, 0xea, 0x2a, 0xa7, 0xbf, 0x41, 0x70,
-	0x7a, 0xdd, 0xf5, 0xc5, 0x4a, 0xc8, 0xfc, 0x3b, 0xcd, 0xd9, 0x26, 0x7d, 0x58, 0xa3, 0x5c, 0xe0,
-	0x31, 0xe8, 0xf7, 0xad, 0x2a, 0xe5, 0x81, 0x65, 0xd3, 0x22, 0x9a, 0x40, 0xd3, 0xfd, 0x66, 0xe7,
-	0x07, 0x1c, 0x82, 0x14, 0xdb, 0x5a, 0x54, 0x2c, 0x4c, 0xa0, 0xe9, 0x81, 0xf9, 0x4d, 0xd2, 0xe1,
-	0x23, 0x2d, 0x3e, 0x39, 0xb8, 0xd7, 0xe6, 0x23, 0xf5, 0x85, 0xc8, 0x57, 0x12, 0x21, 0x92, 0xb6,
-	0x81, 0x2d, 0x44, 0xa2, 0xa0, 0x28, 0x7b, 0xe8, 0x4f, 0x0b, 0x30, 0xba, 0x12, 0x52, 0x4b, 0xd0,
-	0x7f, 0x82, 0x17, 0x6f, 0xc3, 0x90, 0x2d, 0x71, 0x6f, 0x06, 0xf2, 0xe4, 0x8b, 0x7d, 0x72, 0xd3,
-	0x05, 0xd2, 0x38, 0x7a, 0x12, 0x3f, 0xfa, 0xce, 0x16, 0xd1, 0xd1, 0x93, 0x7a, 0x54, 0x38, 0xb6,
-	0xd4, 0x54, 0x2b, 0xe9, 0xcf, 0x10, 0x14, 0xd7, 0x5d, 0xae, 0x1c, 0x1c, 0xcf, 0xe6, 0xc4, 0x16,
-	0x0c, 0x78, 0x2e, 0x17, 0x2d, 0xa6, 0x86, 0x11, 0xe5, 0x6c, 0x4c, 0xeb, 0x9d, 0x85, 0x66, 0xbc,
-	0x8a, 0xfe, 0x0a, 0xc1, 0xc8, 0x1a, 0xed, 0xd9, 0x47, 0x18, 0xfe, 0x8b, 0x36, 0x6f, 0x82, 0xc8,
-	0xb1, 0x4a, 0x58, 0x48, 0x12, 0xde, 0x02, 0x70, 0xa8, 0x50, 0x4d, 0x9b, 0xcb, 0x06, 0xb8, 0xd6,
-	0x5e, 0x67, 0xc6, 0x6a, 0xe8, 0x9f, 0x10, 0x8c, 0xde, 0x0e, 0x2a, 0x29, 0x9d, 0x33, 0x12, 0x27,
-	0x5c, 0x2e, 0x14, 0x51, 0x26, 0xca, 0x64, 0x47, 0xf5, 0xfd, 0x85, 0x27, 0xe0, 0x35, 0x82, 0xd1,
-	0x55, 0xea, 0xd1, 0xde, 0x3a, 0x8e, 0xee, 0xf4, 0x36, 0x0c, 0x55, 0x64, 0xb9, 0x63, 0x75, 0xe8,
-	0x6a, 0x7c, 0xa9, 0xa9, 0x56, 0xd2, 0xcf, 0xc2, 0x99, 0x38, 0x63, 0x63, 0x6e, 0xc5, 0xa4, 0x3c,
-	0x60, 0x3e, 0xa7, 0xfa, 0x26, 0x68, 0xf1, 0xdb, 0x5b, 0x35, 0x1e, 0x50, 0xbf, 0x72, 0x6c, 0x25,
-	0xfa, 0x46, 0x14, 0x0d, 0x71, 0x4b, 0x78, 0xad, 0x4a, 0x8f, 0x5d, 0x6e, 0xfe, 0xf9, 0x20, 0x0c,
-	0x2b, 0x7c, 0x34, 0xac, 0xbb, 0x36, 0xc5, 0x1f, 0x10, 0x9c, 0x4c, 0x06, 0x26, 0x3e, 0x47, 0xe2,
-	0xb9, 0x4f, 0x52, 0x02, 0x55, 0xcb, 0xb9, 0x35, 0xf4, 0xf9, 0x27, 0xdf, 0x7f, 0xbe, 0x28, 0x5c,
-	0xd4, 0xa7, 0xe4, 0x1b, 0xa6, 0x5e, 0x56, 0x5f, 0x49, 0xdc, 0x78, 0xdc, 0x96, 0x73, 0x60, 0x78,
-	0xae, 0x2f, 0x96, 0x50, 0x09, 0xbf, 0x47, 0x80, 0xbb, 0x23, 0x14, 0x4f, 0xa9, 0x0a, 0x52, 0x43,
-	0x36, 0x77, 0x0d, 0xb3, 0x52, 0xc3, 0x94, 0xae, 0xff, 0x59, 0x43, 0x84, 0xff, 0x0e, 0xc1, 0xa9,
-	0xae, 0xd8, 0xc3, 0xe7, 0x93, 0xfe, 0xf7, 0xce, 0x45, 0xcd, 0xcc, 0x17, 0x3e, 0xda, 0x47, 0x2f,
-	0x49, 0x01, 0x93, 0x38, 0x83, 0x00, 0xfc, 0x16, 0xc1, 0x89, 0x44, 0x48, 0xe2, 0x49, 0x95, 0xbd,
-	0x77, 0x86, 0xe6, 0x6e, 0x7b, 0x59, 0x52, 0x5f, 0xc0, 0x33, 0x19, 0x5a, 0x47, 0x8e, 0x0f, 0xf0,
-	0x47, 0x04, 0xb8, 0x3b, 0x42, 0x93, 0x9d, 0x93, 0x1a, 0xb2, 0xb9, 0x4b, 0x58, 0x94, 0x12, 0x88,
-	0x96, 0x5d, 0x42, 0xd4, 0x40, 0x2f, 0x11, 0xe0, 0xee, 0x00, 0x4d, 0xaa, 0x48, 0x8d, 0x58, 0x6d,
-	0x26, 0xf9, 0xa0, 0xa4, 0x27, 0x5c, 0xd3, 0xe3, 0xd2, 0x11, 0x3c, 0xfe, 0x82, 0x00, 0x37, 0x92,
-	0xeb, 0xf7, 0x4f, 0x67, 0x4a, 0xce, 0xe5, 0xee, 0xf1, 0x55, 0x29, 0xe1, 0x92, 0x36, 0x97, 0x59,
-	0x82, 0x11, 0x4a, 0xa0, 0xc8, 0xea, 0xaf, 0x08, 0x86, 0x9b, 0xb1, 0xae, 0xa8, 0x99, 0x4e, 0x57,
-	0xa3, 0xbe, 0x05, 0x72, 0x97, 0x73, 0x4d, 0xca, 0xb9, 0xac, 0x95, 0xb3, 0xcb, 0xe1, 0x0d, 0xa2,
-	0x25, 0x54, 0x5a, 0xbe, 0xf1, 0xf9, 0x70, 0x1c, 0x7d, 0x3b, 0x1c, 0x47, 0x3f, 0x0e, 0xc7, 0xd1,
-	0xdd, 0xeb, 0xd9, 0x3f, 0xc4, 0x7b, 0xfc, 0x7b, 0xd8, 0xf9, 0x5f, 0x7e, 0x7f, 0x2f, 0xfc, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0xc4, 0x13, 0xb8, 0x3b, 0x62, 0x0c, 0x00, 0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x5f, 0x6b, 0xd3, 0x5c,
+	0x18, 0xe7, 0x74, 0x2f, 0xef, 0xcb, 0x9e, 0xbd, 0x43, 0x3d, 0x83, 0xd9, 0xc5, 0x59, 0x46, 0xdc,
+	0x5c, 0x57, 0xdd, 0xc9, 0xda, 0x4e, 0x91, 0x39, 0x6f, 0xba, 0xc1, 0x2e, 0xdc, 0xe6, 0xc8, 0x90,
+	0x31, 0x6f, 0x24, 0x4b, 0x8f, 0x5d, 0xd6, 0x34, 0x27, 0x26, 0x69, 0x87, 0xc8, 0x6e, 0xbc, 0xf2,
+	0xc6, 0x0b, 0xf1, 0x52, 0x3f, 0x80, 0xe0, 0x37, 0xf0, 0xcf, 0x95, 0x08, 0x22, 0x0a, 0x82, 0x1f,
+	0x40, 0x19, 0x7e, 0x10, 0xc9, 0x49, 0xda, 0x26, 0x69, 0xd3, 0x65, 0xb3, 0x08, 0xde, 0x9d, 0x24,
+	0xe7, 0x3c, 0xcf, 0xef, 0xf7, 0x7b, 0x9e, 0xe7, 0xfc, 0x08, 0x10, 0xb3, 0x5a, 0x91, 0x14, 0x53,
+	0x53, 0x75, 0x8d, 0x1a, 0x8e, 0xa4, 0x5a, 0xcc, 0xd8, 0x67, 0x56, 0xf5, 0x9e, 0xce, 0xf6, 0xf9,
+	0xc3, 0x6c, 0xf3, 0x89, 0x98, 0x16, 0x73, 0x18, 0xfe, 0x3f, 0xb8, 0x43, 0x18, 0xaf, 0x30, 0x56,
+	0xd1, 0xa9, 0x1b, 0x40, 0x52, 0x0c, 0x83, 0x39, 0x8a, 0xa3, 0x31, 0xc3, 0xf6, 0xf6, 0x0a, 0xf3,
+	0xd5, 0x6b, 0x36, 0xd1, 0x98, 0xfb, 0xb5, 0xa6, 0xa8, 0xbb, 0x9a, 0x41, 0xad, 0x07, 0x92, 0x9f,
+	0xcf, 0x96, 0x6a, 0xd4, 0x51, 0xa4, 0x46, 0x5e, 0xaa, 0x50, 0x83, 0x5a, 0x8a, 0x43, 0xcb, 0xfe,
+	0xa9, 0xb5, 0x8a, 0xe6, 0xec, 0xd6, 0x77, 0x88, 0xca, 0x6a, 0x92, 0x62, 0x55, 0x98, 0x69, 0xb1,
+	0x3d, 0xbe, 0x68, 0x41, 0xb1, 0xdb, 0x41, 0x5a, 0x58, 0x1b, 0x79, 0x45, 0x37, 0x77, 0x95, 0x8e,
+	0x70, 0xe2, 0x2b, 0x04, 0x67, 0x57, 0x35, 0xc3, 0x59, 0xb2, 0x98, 0xb1, 0xe5, 0xef, 0x96, 0xe9,
+	0xfd, 0x3a, 0xb5, 0x1d, 0x3c, 0x0e, 0x83, 0x86, 0x52, 0xa3, 0xb6, 0xa9, 0xa8, 0x34, 0x8d, 0x26,
+	0x50, 0x76, 0x50, 0x6e, 0xbf, 0xc0, 0x16, 0x70, 0xb2, 0xcd, 0x43, 0xe9, 0xd4, 0x04, 0xca, 0x0e,
+	0x15, 0xd6, 0x49, 0x1b, 0x1f, 0x69, 0xe2, 0xe3, 0x8b, 0xbb, 0x2d, 0x7c, 0xa4, 0x51, 0x74, 0x75,
+	0x25, 0x2e, 0x44, 0xd2, 0x12, 0xb0, 0x09, 0x91, 0x84, 0xa0, 0x84, 0x72, 0x88, 0x8f, 0x53, 0x30,
+	0xb6, 0x64, 0x51, 0xc5, 0xa1, 0x7f, 0x05, 0x5e, 0xbc, 0x0d, 0xc3, 0x2a, 0x87, 0x7b, 0xcb, 0xe4,
+	0x95, 0x4f, 0x0f, 0xf0, 0xa4, 0x45, 0xe2, 0x95, 0x9e, 0x04, 0x4b, 0xdf, 0x4e, 0xe1, 0x96, 0x9e,
+	0x34, 0xdc, 0xc0, 0x81, 0xa3, 0x72, 0x38, 0x92, 0xf8, 0x04, 0x41, 0x7a, 0x55, 0xb3, 0x43, 0x85,
+	0xb3, 0x93, 0x29, 0xb1, 0x09, 0x43, 0xba, 0x66, 0x3b, 0x4d, 0x4c, 0x9e, 0x10, 0xf9, 0x64, 0x98,
+	0x56, 0xdb, 0x07, 0xe5, 0x60, 0x14, 0xf1, 0x05, 0x82, 0xd1, 0x15, 0xda, 0xb5, 0x8f, 0x30, 0xfc,
+	0xe3, 0x26, 0xf7, 0x81, 0xf0, 0x75, 0x18, 0x61, 0x2a, 0x8a, 0x70, 0x03, 0xa0, 0x42, 0x9d, 0xb0,
+	0x68, 0x73, 0xc9, 0x00, 0xae, 0xb4, 0xce, 0xc9, 0x81, 0x18, 0xe2, 0x07, 0x04, 0x63, 0xb7, 0xcd,
+	0x72, 0x4c, 0xe7, 0x8c, 0x06, 0x11, 0x96, 0x52, 0x69, 0x94, 0x08, 0x65, 0xb4, 0xa3, 0x06, 0xfe,
+	0xc0, 0x04, 0xbc, 0x44, 0x30, 0xb6, 0x4c, 0x75, 0xda, 0x9d, 0xc7, 0xf1, 0x95, 0xde, 0x86, 0xe1,
+	0x32, 0x0f, 0x77, 0xa2, 0x0e, 0x5d, 0x0e, 0x1e, 0x95, 0xc3, 0x91, 0xc4, 0xf3, 0x70, 0x2e, 0x88,
+	0xd1, 0xdb, 0x5b, 0x96, 0xa9, 0x6d, 0x32, 0xc3, 0xa6, 0xe2, 0x3a, 0x08, 0xc1, 0xcf, 0x9b, 0x75,
+	0xdb, 0xa4, 0x46, 0xf9, 0xc4, 0x4c, 0xc4, 0x35, 0xf7, 0x6a, 0x08, 0x4a, 0x62, 0xd7, 0x6b, 0xf4,
+	0xe4, 0xe1, 0xb6, 0x61, 0x2a, 0xd2, 0xce, 0x25, 0x5d, 0x51, 0xab, 0xac, 0xee, 0x6c, 0x69, 0x46,
+	0x39, 0x30, 0x6b, 0xc7, 0x0f, 0xfd, 0x14, 0xc1, 0x85, 0x9e, 0x81, 0x3d, 0x85, 0xf0, 0x1e, 0xfc,
+	0xb7, 0xef, 0xbd, 0x4a, 0xa3, 0x89, 0x81, 0xec, 0x50, 0x61, 0xe3, 0xf7, 0x5b, 0x2b, 0x9c, 0x4b,
+	0x6e, 0x26, 0x28, 0x7c, 0x1f, 0x86, 0x91, 0x50, 0x39, 0xa8, 0xd5, 0xd0, 0x54, 0x8a, 0xdf, 0x21,
+	0x38, 0x1d, 0xf5, 0x07, 0x3c, 0x45, 0x82, 0x36, 0x47, 0x62, 0xfc, 0x43, 0xe8, 0xf3, 0x24, 0x88,
+	0x85, 0x47, 0xdf, 0x7e, 0x3e, 0x4b, 0x5d, 0x16, 0xa7, 0xb9, 0xa1, 0x36, 0xf2, 0x61, 0x07, 0xb6,
+	0xa5, 0x87, 0x2d, 0x89, 0x0f, 0x24, 0x5d, 0x33, 0x9c, 0x05, 0x94, 0xc3, 0x6f, 0x11, 0xe0, 0x4e,
+	0xc7, 0xc0, 0xd3, 0x61, 0x06, 0xb1, 0x9e, 0xd2, 0x77, 0x0e, 0xb3, 0x9c, 0xc3, 0xb4, 0x28, 0x1e,
+	0xcd, 0xc1, 0x85, 0xff, 0x06, 0xc1, 0x99, 0x8e, 0x5b, 0x1e, 0x5f, 0x8c, 0xea, 0xdf, 0xdd, 0x06,
+	0x04, 0xb9, 0xbf, 0xe0, 0xdd, 0x3c, 0x62, 0x8e, 0x13, 0x98, 0xc4, 0x09, 0x08, 0xe0, 0xd7, 0x08,
+	0x4e, 0x45, 0x86, 0x08, 0x4f, 0x86, 0xb1, 0x77, 0xb7, 0x8c, 0xbe, 0xcb, 0x9e, 0xe7, 0xa8, 0x2f,
+	0xe1, 0x99, 0x04, 0xad, 0xc3, 0xd7, 0x07, 0xf8, 0x33, 0x82, 0x4c, 0xef, 0x1b, 0x00, 0x17, 0x7b,
+	0x72, 0xe9, 0x7e, 0x5f, 0x08, 0xf9, 0x68, 0xeb, 0x1d, 0x79, 0x11, 0x88, 0x25, 0x8e, 0x7e, 0x11,
+	0x2f, 0x24, 0x46, 0x2f, 0xed, 0xf8, 0xa1, 0x66, 0xfd, 0x01, 0xc7, 0xef, 0x11, 0xe0, 0x4e, 0x03,
+	0x8c, 0x0e, 0x42, 0xac, 0x45, 0xf6, 0xbd, 0x22, 0xf3, 0x9c, 0x13, 0x11, 0x92, 0x57, 0xc4, 0x9d,
+	0x87, 0xe7, 0x08, 0x70, 0xa7, 0xfd, 0x45, 0x59, 0xc4, 0x1a, 0xa4, 0x30, 0x13, 0x2f, 0x7e, 0xd4,
+	0x9f, 0xfc, 0x96, 0xc9, 0x1d, 0xa3, 0x65, 0x3e, 0x21, 0xc0, 0x9e, 0xef, 0xf4, 0xbe, 0x6c, 0x62,
+	0x5c, 0xaa, 0xef, 0x1a, 0x5f, 0xe7, 0x14, 0xae, 0x08, 0x73, 0xc9, 0xfb, 0xc6, 0xe2, 0x80, 0x5c,
+	0xa9, 0xbf, 0x20, 0x18, 0xf1, 0x4d, 0x39, 0xc4, 0x26, 0x1b, 0xcf, 0x26, 0xec, 0xe1, 0x7d, 0xa7,
+	0xb3, 0xc8, 0xe9, 0x5c, 0x15, 0xf2, 0xc9, 0xe9, 0xd8, 0x1e, 0xa2, 0x05, 0x94, 0x2b, 0xdd, 0xfc,
+	0x78, 0x98, 0x41, 0x5f, 0x0f, 0x33, 0xe8, 0xc7, 0x61, 0x06, 0xdd, 0xb9, 0x91, 0xfc, 0x37, 0xaa,
+	0xcb, 0xbf, 0xdf, 0xce, 0xbf, 0xfc, 0xef, 0xa9, 0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x2f,
+	0xfb, 0xc3, 0x20, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -626,6 +737,7 @@ type CronWorkflowServiceClient interface {
 	CreateCronWorkflow(ctx context.Context, in *CreateCronWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
 	ListCronWorkflows(ctx context.Context, in *ListCronWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflowList, error)
 	GetCronWorkflow(ctx context.Context, in *GetCronWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
+	GetCronWorkflowBlackoutWindows(ctx context.Context, in *GetCronWorkflowBlackoutWindowsRequest, opts ...grpc.CallOption) (*CronWorkflowBlackoutWindowsResponse, error)
 	UpdateCronWorkflow(ctx context.Context, in *UpdateCronWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
 	DeleteCronWorkflow(ctx context.Context, in *DeleteCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflowDeletedResponse, error)
 	ResumeCronWorkflow(ctx context.Context, in *CronWorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
@@ -676,6 +788,15 @@ func (c *cronWorkflowServiceClient) GetCronWorkflow(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *cronWorkflowServiceClient) GetCronWorkflowBlackoutWindows(ctx context.Context, in *GetCronWorkflowBlackoutWindowsRequest, opts ...grpc.CallOption) (*CronWorkflowBlackoutWindowsResponse, error) {
+	out := new(CronWorkflowBlackoutWindowsResponse)
+	err := c.cc.Invoke(ctx, "/cronworkflow.CronWorkflowService/GetCronWorkflowBlackoutWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cronWorkflowServiceClient) UpdateCronWorkflow(ctx context.Context, in *UpdateCronWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
 	out := new(v1alpha1.CronWorkflow)
 	err := c.cc.Invoke(ctx, "/cronworkflow.CronWorkflowService/UpdateCronWorkflow", in, out, opts...)
@@ -718,6 +839,7 @@ type CronWorkflowServiceServer interface {
 	CreateCronWorkflow(context.Context, *CreateCronWorkflowRequest) (*v1alpha1.CronWorkflow, error)
 	ListCronWorkflows(context.Context, *ListCronWorkflowsRequest) (*v1alpha1.CronWorkflowList, error)
 	GetCronWorkflow(context.Context, *GetCronWorkflowRequest) (*v1alpha1.CronWorkflow, error)
+	GetCronWorkflowBlackoutWindows(context.Context, *GetCronWorkflowBlackoutWindowsRequest) (*CronWorkflowBlackoutWindowsResponse, error)
 	UpdateCronWorkflow(context.Context, *UpdateCronWorkflowRequest) (*v1alpha1.CronWorkflow, error)
 	DeleteCronWorkflow(context.Context, *DeleteCronWorkflowRequest) (*CronWorkflowDeletedResponse, error)
 	ResumeCronWorkflow(context.Context, *CronWorkflowResumeRequest) (*v1alpha1.CronWorkflow, error)
@@ -740,6 +862,9 @@ func (*UnimplementedCronWorkflowServiceServer) ListCronWorkflows(ctx context.Con
 func (*UnimplementedCronWorkflowServiceServer) GetCronWorkflow(ctx context.Context, req *GetCronWorkflowRequest) (*v1alpha1.CronWorkflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCronWorkflow not implemented")
 }
+func (*UnimplementedCronWorkflowServiceServer) GetCronWorkflowBlackoutWindows(ctx context.Context, req *GetCronWorkflowBlackoutWindowsRequest) (*CronWorkflowBlackoutWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCronWorkflowBlackoutWindows not implemented")
+}
 func (*UnimplementedCronWorkflowServiceServer) UpdateCronWorkflow(ctx context.Context, req *UpdateCronWorkflowRequest) (*v1alpha1.CronWorkflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCronWorkflow not implemented")
 }
@@ -829,6 +954,24 @@ func _CronWorkflowService_GetCronWorkflow_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CronWorkflowService_GetCronWorkflowBlackoutWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCronWorkflowBlackoutWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).GetCronWorkflowBlackoutWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronworkflow.CronWorkflowService/GetCronWorkflowBlackoutWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).GetCronWorkflowBlackoutWindows(ctx, req.(*GetCronWorkflowBlackoutWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CronWorkflowService_UpdateCronWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCronWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -921,6 +1064,10 @@ var _CronWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCronWorkflow",
 			Handler:    _CronWorkflowService_GetCronWorkflow_Handler,
 		},
+		{
+			MethodName: "GetCronWorkflowBlackoutWindows",
+			Handler:    _CronWorkflowService_GetCronWorkflowBlackoutWindows_Handler,
+		},
 		{
 			MethodName: "UpdateCronWorkflow",
 			Handler:    _CronWorkflowService_UpdateCronWorkflow_Handler,
@@ -1360,6 +1507,88 @@ func (m *CronWorkflowResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *GetCronWorkflowBlackoutWindowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCronWorkflowBlackoutWindowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCronWorkflowBlackoutWindowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronWorkflowBlackoutWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowBlackoutWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowBlackoutWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCronWorkflow(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCronWorkflow(v)
 	base := offset
@@ -1559,6 +1788,44 @@ func (m *CronWorkflowResumeRequest) Size() (n int) {
 	return n
 }
 
+func (m *GetCronWorkflowBlackoutWindowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CronWorkflowBlackoutWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovCronWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCronWorkflow(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2692,6 +2959,206 @@ func (m *CronWorkflowResumeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetCronWorkflowBlackoutWindowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCronWorkflowBlackoutWindowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCronWorkflowBlackoutWindowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowBlackoutWindowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowBlackoutWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowBlackoutWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, &v1alpha1.BlackoutWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCronWorkflow(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,3 @@
+package cronworkflow
+
+//go:generate mockery --name=CronWorkflowServiceClient
@@ -36,6 +36,11 @@ func (c *errorTranslatingCronWorkflowServiceClient) GetCronWorkflow(ctx context.
 	return workflow, grpcutil.TranslateError(err)
 }
 
+func (c *errorTranslatingCronWorkflowServiceClient) GetCronWorkflowBlackoutWindows(ctx context.Context, req *cronworkflowpkg.GetCronWorkflowBlackoutWindowsRequest, _ ...grpc.CallOption) (*cronworkflowpkg.CronWorkflowBlackoutWindowsResponse, error) {
+	windows, err := c.delegate.GetCronWorkflowBlackoutWindows(ctx, req)
+	return windows, grpcutil.TranslateError(err)
+}
+
 func (c *errorTranslatingCronWorkflowServiceClient) UpdateCronWorkflow(ctx context.Context, req *cronworkflowpkg.UpdateCronWorkflowRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
 	workflow, err := c.delegate.UpdateCronWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
@@ -0,0 +1,36 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+)
+
+type errorTranslatingMemoizationServiceClient struct {
+	delegate memoizationpkg.MemoizationServiceClient
+}
+
+var _ memoizationpkg.MemoizationServiceClient = &errorTranslatingMemoizationServiceClient{}
+
+func (c *errorTranslatingMemoizationServiceClient) ListCacheEntries(ctx context.Context, req *memoizationpkg.ListCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationpkg.CacheEntryList, error) {
+	entries, err := c.delegate.ListCacheEntries(ctx, req)
+	return entries, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingMemoizationServiceClient) GetCacheEntry(ctx context.Context, req *memoizationpkg.GetCacheEntryRequest, _ ...grpc.CallOption) (*memoizationpkg.CacheEntry, error) {
+	entry, err := c.delegate.GetCacheEntry(ctx, req)
+	return entry, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingMemoizationServiceClient) DeleteCacheEntry(ctx context.Context, req *memoizationpkg.DeleteCacheEntryRequest, _ ...grpc.CallOption) (*memoizationpkg.CacheEntryDeletedResponse, error) {
+	res, err := c.delegate.DeleteCacheEntry(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingMemoizationServiceClient) PruneCache(ctx context.Context, req *memoizationpkg.PruneCacheRequest, _ ...grpc.CallOption) (*memoizationpkg.PruneCacheResponse, error) {
+	res, err := c.delegate.PruneCache(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
@@ -0,0 +1,26 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+)
+
+type errorTranslatingSyncServiceClient struct {
+	delegate syncpkg.SyncServiceClient
+}
+
+var _ syncpkg.SyncServiceClient = &errorTranslatingSyncServiceClient{}
+
+func (c *errorTranslatingSyncServiceClient) ListSyncLocks(ctx context.Context, req *syncpkg.ListSyncLocksRequest, _ ...grpc.CallOption) (*syncpkg.SyncLockList, error) {
+	locks, err := c.delegate.ListSyncLocks(ctx, req)
+	return locks, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingSyncServiceClient) GetSyncLock(ctx context.Context, req *syncpkg.GetSyncLockRequest, _ ...grpc.CallOption) (*syncpkg.SyncLock, error) {
+	lock, err := c.delegate.GetSyncLock(ctx, req)
+	return lock, grpcutil.TranslateError(err)
+}
@@ -100,17 +100,87 @@ func (m *EventRequest) GetPayload() *v1alpha1.Item {
 	return nil
 }
 
-type EventResponse struct {
+// A workflow submitted for an event by a workflow event binding
+type EventSubmission struct {
+	// The name of the workflow event binding
+	WorkflowEventBinding string `protobuf:"bytes,1,opt,name=workflowEventBinding,proto3" json:"workflowEventBinding,omitempty"`
+	// The name of the workflow
+	WorkflowName string `protobuf:"bytes,2,opt,name=workflowName,proto3" json:"workflowName,omitempty"`
+	// Whether the workflow was submitted for an earlier event with the same idempotency key, and so was reused rather than
+	// submitting another
+	Deduplicated         bool     `protobuf:"varint,3,opt,name=deduplicated,proto3" json:"deduplicated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *EventSubmission) Reset()         { *m = EventSubmission{} }
+func (m *EventSubmission) String() string { return proto.CompactTextString(m) }
+func (*EventSubmission) ProtoMessage()    {}
+func (*EventSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d80a0d2509a47d1c, []int{1}
+}
+func (m *EventSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubmission.Merge(m, src)
+}
+func (m *EventSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubmission proto.InternalMessageInfo
+
+func (m *EventSubmission) GetWorkflowEventBinding() string {
+	if m != nil {
+		return m.WorkflowEventBinding
+	}
+	return ""
+}
+
+func (m *EventSubmission) GetWorkflowName() string {
+	if m != nil {
+		return m.WorkflowName
+	}
+	return ""
+}
+
+func (m *EventSubmission) GetDeduplicated() bool {
+	if m != nil {
+		return m.Deduplicated
+	}
+	return false
+}
+
+type EventResponse struct {
+	// The workflows submitted for the event. This is only set when events are dispatched synchronously.
+	Submissions          []*EventSubmission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
 func (m *EventResponse) Reset()         { *m = EventResponse{} }
 func (m *EventResponse) String() string { return proto.CompactTextString(m) }
 func (*EventResponse) ProtoMessage()    {}
 func (*EventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d80a0d2509a47d1c, []int{1}
+	return fileDescriptor_d80a0d2509a47d1c, []int{2}
 }
 func (m *EventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,6 +209,13 @@ func (m *EventResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventResponse proto.InternalMessageInfo
 
+func (m *EventResponse) GetSubmissions() []*EventSubmission {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
 type ListWorkflowEventBindingsRequest struct {
 	Namespace            string          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	ListOptions          *v1.ListOptions `protobuf:"bytes,2,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
@@ -151,7 +228,7 @@ func (m *ListWorkflowEventBindingsRequest) Reset()         { *m = ListWorkflowEv
 func (m *ListWorkflowEventBindingsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListWorkflowEventBindingsRequest) ProtoMessage()    {}
 func (*ListWorkflowEventBindingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d80a0d2509a47d1c, []int{2}
+	return fileDescriptor_d80a0d2509a47d1c, []int{3}
 }
 func (m *ListWorkflowEventBindingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +273,7 @@ func (m *ListWorkflowEventBindingsRequest) GetListOptions() *v1.ListOptions {
 
 func init() {
 	proto.RegisterType((*EventRequest)(nil), "event.EventRequest")
+	proto.RegisterType((*EventSubmission)(nil), "event.EventSubmission")
 	proto.RegisterType((*EventResponse)(nil), "event.EventResponse")
 	proto.RegisterType((*ListWorkflowEventBindingsRequest)(nil), "event.ListWorkflowEventBindingsRequest")
 }
@@ -203,38 +281,42 @@ func init() {
 func init() { proto.RegisterFile("pkg/apiclient/event/event.proto", fileDescriptor_d80a0d2509a47d1c) }
 
 var fileDescriptor_d80a0d2509a47d1c = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6b, 0x14, 0x31,
-	0x18, 0x25, 0x15, 0x95, 0x66, 0x5b, 0x84, 0xe8, 0x61, 0x5d, 0xca, 0xba, 0x0c, 0x82, 0x45, 0xd9,
-	0x84, 0xd9, 0x55, 0x11, 0xbd, 0x15, 0x15, 0x84, 0x8a, 0x30, 0x3d, 0x08, 0xbd, 0x68, 0x76, 0xf6,
-	0x73, 0x36, 0xee, 0x4c, 0x12, 0x93, 0x34, 0xa5, 0x94, 0x5e, 0xfc, 0x0b, 0xe2, 0x3f, 0xf1, 0x47,
-	0x78, 0x14, 0xc4, 0xab, 0xc8, 0xe2, 0x0f, 0x91, 0xc9, 0xce, 0xec, 0xcc, 0xa2, 0x62, 0xc1, 0xcb,
-	0x90, 0x79, 0xc9, 0xf7, 0xf2, 0xde, 0xf7, 0xf2, 0xe1, 0x1b, 0x7a, 0x9e, 0x31, 0xae, 0x45, 0x9a,
-	0x0b, 0x90, 0x8e, 0x81, 0x5f, 0x7d, 0xa9, 0x36, 0xca, 0x29, 0x72, 0x31, 0xfc, 0xf4, 0x76, 0x32,
-	0xa5, 0xb2, 0x1c, 0xca, 0xa3, 0x8c, 0x4b, 0xa9, 0x1c, 0x77, 0x42, 0x49, 0xbb, 0x3c, 0xd4, 0xbb,
-	0x3b, 0x7f, 0x60, 0xa9, 0x50, 0xe5, 0x6e, 0xc1, 0xd3, 0x99, 0x90, 0x60, 0x4e, 0x58, 0xc5, 0x6c,
-	0x59, 0x01, 0x8e, 0x33, 0x1f, 0xb3, 0x0c, 0x24, 0x18, 0xee, 0x60, 0x5a, 0x55, 0x3d, 0xcf, 0x84,
-	0x9b, 0x1d, 0x4d, 0x68, 0xaa, 0x0a, 0xc6, 0x4d, 0xa6, 0xb4, 0x51, 0x6f, 0xc3, 0x62, 0x78, 0xac,
-	0xcc, 0xfc, 0x4d, 0xae, 0x8e, 0x6d, 0x43, 0x52, 0x43, 0xcc, 0xc7, 0x3c, 0xd7, 0x33, 0xfe, 0x1b,
-	0x5d, 0xf4, 0x09, 0xe1, 0xad, 0x27, 0xa5, 0xd8, 0x04, 0xde, 0x1d, 0x81, 0x75, 0x64, 0x07, 0x6f,
-	0x4a, 0x5e, 0x80, 0xd5, 0x3c, 0x85, 0x2e, 0x1a, 0xa0, 0xdd, 0xcd, 0xa4, 0x01, 0xc8, 0x4d, 0xbc,
-	0x3d, 0x15, 0x36, 0x35, 0xa2, 0x10, 0x92, 0x3b, 0x65, 0xba, 0x1b, 0xe1, 0xc4, 0x3a, 0x48, 0x5e,
-	0xe3, 0xcb, 0x9a, 0x9f, 0xe4, 0x8a, 0x4f, 0xbb, 0x17, 0x06, 0x68, 0xb7, 0x33, 0x7a, 0x4a, 0x1b,
-	0xd5, 0xb4, 0x56, 0x1d, 0x16, 0xaf, 0x56, 0xaa, 0xa9, 0x1f, 0x53, 0x3d, 0xcf, 0x68, 0x29, 0x9c,
-	0xd6, 0x28, 0xad, 0x85, 0xd3, 0x67, 0x0e, 0x8a, 0xa4, 0xa6, 0x8d, 0xae, 0xe0, 0xed, 0x4a, 0xb5,
-	0xd5, 0x4a, 0x5a, 0x88, 0x3e, 0x22, 0x3c, 0xd8, 0x17, 0xd6, 0xbd, 0xac, 0x0a, 0xc3, 0xee, 0x9e,
-	0x90, 0x53, 0x21, 0x33, 0x7b, 0x3e, 0x6f, 0x07, 0xb8, 0x93, 0x0b, 0xeb, 0x5e, 0xe8, 0x10, 0x52,
-	0x70, 0xd6, 0x19, 0xc5, 0x74, 0x99, 0x12, 0x6d, 0xa7, 0xd4, 0xe8, 0x2c, 0x53, 0xa2, 0x3e, 0xa6,
-	0xfb, 0x4d, 0x61, 0xd2, 0x66, 0x19, 0x7d, 0xdf, 0xa8, 0xfa, 0x7b, 0x00, 0xc6, 0x8b, 0x14, 0x88,
-	0xc7, 0x5b, 0x09, 0xa4, 0x20, 0x3c, 0x04, 0x98, 0x5c, 0xa5, 0xcb, 0x87, 0xd3, 0x0e, 0xa1, 0x77,
-	0x6d, 0x1d, 0xac, 0x3c, 0x3e, 0x7a, 0xff, 0xf5, 0xe7, 0x87, 0x8d, 0x7b, 0xd1, 0xed, 0xf0, 0xa0,
-	0x7c, 0xbc, 0x7c, 0x72, 0x96, 0x9d, 0xae, 0x3c, 0x9c, 0xb1, 0xd3, 0xb5, 0x24, 0xce, 0x1e, 0xd6,
-	0x1d, 0x23, 0xdf, 0x10, 0xbe, 0xfe, 0xd7, 0x06, 0x91, 0x5b, 0xd5, 0x85, 0xff, 0x6a, 0x61, 0xef,
-	0xf0, 0xff, 0x93, 0xfc, 0x13, 0x7f, 0x79, 0x6f, 0x34, 0x0e, 0xfe, 0x86, 0xe4, 0x4e, 0xed, 0xaf,
-	0xae, 0x1d, 0x06, 0x71, 0xc3, 0x49, 0xa5, 0xa5, 0x6d, 0x78, 0xef, 0xf1, 0xe7, 0x45, 0x1f, 0x7d,
-	0x59, 0xf4, 0xd1, 0x8f, 0x45, 0x1f, 0x1d, 0xde, 0x3f, 0xff, 0x74, 0xb4, 0x87, 0x77, 0x72, 0x29,
-	0x4c, 0xc3, 0xf8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0x7f, 0xc9, 0x38, 0xda, 0x03, 0x00,
-	0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0x66, 0x5a, 0xfc, 0xe8, 0x24, 0x45, 0x18, 0x8b, 0xc4, 0x50, 0x62, 0x58, 0x04, 0x83, 0x92,
+	0x19, 0x92, 0xa8, 0x14, 0xbd, 0x15, 0x15, 0x0a, 0x55, 0x61, 0x73, 0x10, 0x7a, 0xd1, 0xc9, 0xee,
+	0xeb, 0x66, 0xcc, 0xee, 0xcc, 0xb8, 0x33, 0xd9, 0x52, 0x4a, 0x2f, 0xde, 0x3d, 0x89, 0xff, 0xc4,
+	0x1f, 0xe1, 0x51, 0x10, 0xaf, 0x22, 0xc1, 0x1f, 0x22, 0x3b, 0xd9, 0x4d, 0x36, 0x1a, 0xb1, 0xe0,
+	0x25, 0x4c, 0x9e, 0xf7, 0x63, 0x9e, 0xe7, 0x9d, 0xe7, 0x5d, 0x7c, 0x43, 0x4f, 0x22, 0xc6, 0xb5,
+	0x08, 0x62, 0x01, 0xd2, 0x32, 0xc8, 0x16, 0xbf, 0x54, 0xa7, 0xca, 0x2a, 0x72, 0xc1, 0xfd, 0x69,
+	0xee, 0x46, 0x4a, 0x45, 0x31, 0xe4, 0xa9, 0x8c, 0x4b, 0xa9, 0x2c, 0xb7, 0x42, 0x49, 0x33, 0x4f,
+	0x6a, 0xde, 0x9d, 0xec, 0x19, 0x2a, 0x54, 0x1e, 0x4d, 0x78, 0x30, 0x16, 0x12, 0xd2, 0x13, 0x56,
+	0x74, 0x36, 0x2c, 0x01, 0xcb, 0x59, 0xd6, 0x63, 0x11, 0x48, 0x48, 0xb9, 0x85, 0xb0, 0xa8, 0x7a,
+	0x1a, 0x09, 0x3b, 0x9e, 0x8e, 0x68, 0xa0, 0x12, 0xc6, 0xd3, 0x48, 0xe9, 0x54, 0xbd, 0x71, 0x87,
+	0xee, 0xb1, 0x4a, 0x27, 0xaf, 0x63, 0x75, 0x6c, 0x96, 0x4d, 0x4a, 0x88, 0x65, 0x3d, 0x1e, 0xeb,
+	0x31, 0xff, 0xa3, 0x9d, 0xf7, 0x09, 0xe1, 0xfa, 0xe3, 0x9c, 0xac, 0x0f, 0x6f, 0xa7, 0x60, 0x2c,
+	0xd9, 0xc5, 0x5b, 0x92, 0x27, 0x60, 0x34, 0x0f, 0xa0, 0x81, 0xda, 0xa8, 0xb3, 0xe5, 0x2f, 0x01,
+	0x72, 0x13, 0x6f, 0x87, 0xc2, 0x04, 0xa9, 0x48, 0x84, 0xe4, 0x56, 0xa5, 0x8d, 0x0d, 0x97, 0xb1,
+	0x0a, 0x92, 0x57, 0xf8, 0x92, 0xe6, 0x27, 0xb1, 0xe2, 0x61, 0x63, 0xb3, 0x8d, 0x3a, 0xb5, 0xfe,
+	0x13, 0xba, 0x64, 0x4d, 0x4b, 0xd6, 0xee, 0xf0, 0x72, 0xc1, 0x9a, 0x66, 0x03, 0xaa, 0x27, 0x11,
+	0xcd, 0x89, 0xd3, 0x12, 0xa5, 0x25, 0x71, 0x7a, 0x60, 0x21, 0xf1, 0xcb, 0xb6, 0xde, 0x7b, 0x84,
+	0xaf, 0x38, 0xda, 0xc3, 0xe9, 0x28, 0x11, 0xc6, 0x08, 0x25, 0x49, 0x1f, 0xef, 0x94, 0x65, 0x2e,
+	0xb4, 0x2f, 0x64, 0x28, 0x64, 0x54, 0x88, 0x58, 0x1b, 0x23, 0x1e, 0xae, 0x97, 0xf8, 0x33, 0x9e,
+	0x40, 0x21, 0x67, 0x05, 0xcb, 0x73, 0x42, 0x08, 0xa7, 0x3a, 0x16, 0x41, 0x3e, 0x38, 0x27, 0xe9,
+	0xb2, 0xbf, 0x82, 0x79, 0x07, 0x78, 0xbb, 0x98, 0xa2, 0xd1, 0x4a, 0x1a, 0x20, 0x7b, 0xb8, 0x66,
+	0x16, 0xd4, 0x4c, 0x03, 0xb5, 0x37, 0x3b, 0xb5, 0xfe, 0x35, 0x3a, 0x37, 0xc9, 0x6f, 0xcc, 0xfd,
+	0x6a, 0xaa, 0xf7, 0x11, 0xe1, 0xf6, 0xa1, 0x30, 0xf6, 0xc5, 0x1a, 0xbe, 0xe6, 0x7c, 0xaf, 0x34,
+	0xc4, 0xb5, 0x58, 0x18, 0xfb, 0x5c, 0x3b, 0xbb, 0x39, 0x51, 0xb5, 0x7e, 0x8f, 0xce, 0xfd, 0x46,
+	0xab, 0x7e, 0x5b, 0x4e, 0x3c, 0xf7, 0x1b, 0xcd, 0x7a, 0xf4, 0x70, 0x59, 0xe8, 0x57, 0xbb, 0xf4,
+	0xbf, 0x6f, 0x14, 0x4e, 0x19, 0x42, 0x9a, 0x89, 0x00, 0x48, 0x86, 0xeb, 0x3e, 0x04, 0x20, 0x32,
+	0x70, 0x30, 0xb9, 0x5a, 0x55, 0x57, 0x10, 0x6d, 0xee, 0xac, 0x82, 0xf3, 0xe9, 0x78, 0x0f, 0xdf,
+	0x7d, 0xfd, 0xf9, 0x61, 0xe3, 0x9e, 0x77, 0xdb, 0xad, 0x46, 0xd6, 0x9b, 0x2f, 0x8f, 0x61, 0xa7,
+	0x0b, 0x0d, 0x67, 0xec, 0x74, 0xc5, 0x53, 0x67, 0x0f, 0xca, 0xb7, 0x27, 0xdf, 0x10, 0xbe, 0xfe,
+	0xd7, 0x01, 0x91, 0x5b, 0xc5, 0x85, 0xff, 0x1a, 0x61, 0xf3, 0xe8, 0xff, 0x3d, 0xb9, 0xae, 0x7f,
+	0x7e, 0xaf, 0x37, 0x70, 0xfa, 0xba, 0xe4, 0x4e, 0xa9, 0xaf, 0xac, 0xed, 0x3a, 0x72, 0xdd, 0x51,
+	0xc1, 0xa5, 0x2a, 0x78, 0xff, 0xd1, 0xe7, 0x59, 0x0b, 0x7d, 0x99, 0xb5, 0xd0, 0x8f, 0x59, 0x0b,
+	0x1d, 0xdd, 0x3f, 0xff, 0x9e, 0x57, 0x3f, 0x43, 0xa3, 0x8b, 0x6e, 0xaf, 0x07, 0xbf, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xec, 0x21, 0xe2, 0x63, 0xa4, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -406,6 +488,57 @@ func (m *EventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Deduplicated {
+		i--
+		if m.Deduplicated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WorkflowName) > 0 {
+		i -= len(m.WorkflowName)
+		copy(dAtA[i:], m.WorkflowName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.WorkflowName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkflowEventBinding) > 0 {
+		i -= len(m.WorkflowEventBinding)
+		copy(dAtA[i:], m.WorkflowEventBinding)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.WorkflowEventBinding)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -430,6 +563,20 @@ func (m *EventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -514,12 +661,41 @@ func (m *EventRequest) Size() (n int) {
 	return n
 }
 
+func (m *EventSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowEventBinding)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.WorkflowName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Deduplicated {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *EventResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -703,6 +879,141 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowEventBinding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowEventBinding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deduplicated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deduplicated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -732,6 +1043,40 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, &EventSubmission{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -7,8 +7,11 @@ import (
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/http1"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	workflowstatspkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowstats"
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 )
 
@@ -40,6 +43,18 @@ func (h httpClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error) {
 	return http1.InfoServiceClient(h), nil
 }
 
+func (h httpClient) NewSyncServiceClient() (syncpkg.SyncServiceClient, error) {
+	return http1.SyncServiceClient(h), nil
+}
+
+func (h httpClient) NewMemoizationServiceClient() (memoizationpkg.MemoizationServiceClient, error) {
+	return http1.MemoizationServiceClient(h), nil
+}
+
+func (h httpClient) NewWorkflowStatsServiceClient() (workflowstatspkg.WorkflowStatsServiceClient, error) {
+	return http1.WorkflowStatsServiceClient(h), nil
+}
+
 func newHTTP1Client(baseUrl string, auth string, insecureSkipVerify bool, headers []string) (context.Context, Client, error) {
 	return context.Background(), httpClient(http1.NewFacade(baseUrl, auth, insecureSkipVerify, headers)), nil
 }
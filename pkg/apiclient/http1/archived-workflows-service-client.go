@@ -56,3 +56,19 @@ func (h ArchivedWorkflowsServiceClient) ResubmitArchivedWorkflow(_ context.Conte
 	out := &wfv1.Workflow{}
 	return out, h.Put(in, out, "/api/v1/archived-workflows/{uid}/resubmit")
 }
+
+func (h ArchivedWorkflowsServiceClient) ExportArchivedWorkflows(ctx context.Context, in *workflowarchivepkg.ListArchivedWorkflowsRequest, _ ...grpc.CallOption) (workflowarchivepkg.ArchivedWorkflowService_ExportArchivedWorkflowsClient, error) {
+	reader, err := h.EventStreamReader(in, "/api/v1/archived-workflows-export")
+	if err != nil {
+		return nil, err
+	}
+	return exportArchivedWorkflowsClient{serverSentEventsClient{ctx, reader}}, nil
+}
+
+func (h ArchivedWorkflowsServiceClient) ImportArchivedWorkflows(ctx context.Context, _ ...grpc.CallOption) (workflowarchivepkg.ArchivedWorkflowService_ImportArchivedWorkflowsClient, error) {
+	stream, err := h.RequestStream(ctx, "/api/v1/archived-workflows-import")
+	if err != nil {
+		return nil, err
+	}
+	return importArchivedWorkflowsClient{stream}, nil
+}
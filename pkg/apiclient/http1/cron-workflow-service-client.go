@@ -31,6 +31,11 @@ func (h CronWorkflowServiceClient) GetCronWorkflow(_ context.Context, in *cronwo
 	return out, h.Get(in, out, "/api/v1/cron-workflows/{namespace}/{name}")
 }
 
+func (h CronWorkflowServiceClient) GetCronWorkflowBlackoutWindows(_ context.Context, in *cronworkflowpkg.GetCronWorkflowBlackoutWindowsRequest, _ ...grpc.CallOption) (*cronworkflowpkg.CronWorkflowBlackoutWindowsResponse, error) {
+	out := &cronworkflowpkg.CronWorkflowBlackoutWindowsResponse{}
+	return out, h.Get(in, out, "/api/v1/cron-workflows/{namespace}/{name}/blackout-windows")
+}
+
 func (h CronWorkflowServiceClient) UpdateCronWorkflow(_ context.Context, in *cronworkflowpkg.UpdateCronWorkflowRequest, _ ...grpc.CallOption) (*wfv1.CronWorkflow, error) {
 	out := &wfv1.CronWorkflow{}
 	return out, h.Put(in, out, "/api/v1/cron-workflows/{namespace}/{name}")
@@ -0,0 +1,12 @@
+package http1
+
+import (
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type exportArchivedWorkflowsClient struct{ serverSentEventsClient }
+
+func (f exportArchivedWorkflowsClient) Recv() (*wfv1.Workflow, error) {
+	v := &wfv1.Workflow{}
+	return v, f.RecvEvent(v)
+}
@@ -3,9 +3,11 @@ package http1
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -83,6 +85,43 @@ func (h Facade) EventStreamReader(in interface{}, path string) (*bufio.Reader, e
 	return bufio.NewReader(resp.Body), nil
 }
 
+// RequestStream returns a client that sends each message as JSON in the body of a single POST request, as it is
+// sent, rather than all at once
+func (h Facade) RequestStream(ctx context.Context, path string) (*requestStreamClient, error) {
+	u, err := h.url("POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	reader, writer := io.Pipe()
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	headers, err := parseHeaders(h.headers)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = headers
+	req.Header.Set("Authorization", h.authorization)
+	log.Debugf("curl -X POST -H 'Authorization: ******' -T - '%v'", u)
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: h.insecureSkipVerify,
+			},
+			DisableKeepAlives: true,
+		},
+	}
+	c := &requestStreamClient{ctx: ctx, writer: writer, encoder: json.NewEncoder(writer), done: make(chan struct{})}
+	go func() {
+		defer close(c.done)
+		c.resp, c.err = client.Do(req)
+		// the server has responded, so it will not read any more messages
+		_ = reader.Close()
+	}()
+	return c, nil
+}
+
 func (h Facade) do(in interface{}, out interface{}, method string, path string) error {
 	var data []byte
 	if method != "GET" {
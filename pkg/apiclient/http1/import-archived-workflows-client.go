@@ -0,0 +1,16 @@
+package http1
+
+import (
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+)
+
+type importArchivedWorkflowsClient struct{ *requestStreamClient }
+
+func (f importArchivedWorkflowsClient) Send(req *workflowarchivepkg.ImportArchivedWorkflowRequest) error {
+	return f.SendMsg(req)
+}
+
+func (f importArchivedWorkflowsClient) CloseAndRecv() (*workflowarchivepkg.ArchivedWorkflowsImportedResponse, error) {
+	out := &workflowarchivepkg.ArchivedWorkflowsImportedResponse{}
+	return out, f.CloseAndRecvMsg(out)
+}
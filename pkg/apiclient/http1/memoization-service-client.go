@@ -0,0 +1,31 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+)
+
+type MemoizationServiceClient = Facade
+
+func (h MemoizationServiceClient) ListCacheEntries(_ context.Context, in *memoizationpkg.ListCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationpkg.CacheEntryList, error) {
+	out := &memoizationpkg.CacheEntryList{}
+	return out, h.Get(in, out, "/api/v1/memoization-caches/{namespace}/{name}")
+}
+
+func (h MemoizationServiceClient) GetCacheEntry(_ context.Context, in *memoizationpkg.GetCacheEntryRequest, _ ...grpc.CallOption) (*memoizationpkg.CacheEntry, error) {
+	out := &memoizationpkg.CacheEntry{}
+	return out, h.Get(in, out, "/api/v1/memoization-caches/{namespace}/{name}/{key}")
+}
+
+func (h MemoizationServiceClient) DeleteCacheEntry(_ context.Context, in *memoizationpkg.DeleteCacheEntryRequest, _ ...grpc.CallOption) (*memoizationpkg.CacheEntryDeletedResponse, error) {
+	out := &memoizationpkg.CacheEntryDeletedResponse{}
+	return out, h.Delete(in, out, "/api/v1/memoization-caches/{namespace}/{name}/{key}")
+}
+
+func (h MemoizationServiceClient) PruneCache(_ context.Context, in *memoizationpkg.PruneCacheRequest, _ ...grpc.CallOption) (*memoizationpkg.PruneCacheResponse, error) {
+	out := &memoizationpkg.PruneCacheResponse{}
+	return out, h.Post(in, out, "/api/v1/memoization-caches/{namespace}/{name}/prune")
+}
@@ -0,0 +1,71 @@
+package http1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestStreamClient provides SendMsg and CloseAndRecvMsg funcs to make sending a client stream as the body of a
+// single request simple and consistent
+type requestStreamClient struct {
+	ctx     context.Context
+	writer  *io.PipeWriter
+	encoder *json.Encoder
+	// done is closed once the server has responded
+	done chan struct{}
+	resp *http.Response
+	err  error
+}
+
+func (c *requestStreamClient) Header() (metadata.MD, error) {
+	panic("implement me")
+}
+
+func (c *requestStreamClient) Trailer() metadata.MD {
+	panic("implement me")
+}
+
+func (c *requestStreamClient) CloseSend() error {
+	return c.writer.Close()
+}
+
+func (c *requestStreamClient) Context() context.Context {
+	return c.ctx
+}
+
+// SendMsg returns io.EOF if the server responded before the stream was closed, like a GRPC stream, the error of the
+// response is then returned by CloseAndRecvMsg
+func (c *requestStreamClient) SendMsg(m interface{}) error {
+	err := c.encoder.Encode(m)
+	if errors.Is(err, io.ErrClosedPipe) {
+		return io.EOF
+	}
+	return err
+}
+
+func (c *requestStreamClient) RecvMsg(interface{}) error {
+	panic("implement me")
+}
+
+func (c *requestStreamClient) CloseAndRecvMsg(out interface{}) error {
+	if err := c.CloseSend(); err != nil {
+		return err
+	}
+	<-c.done
+	if c.err != nil {
+		return c.err
+	}
+	defer c.resp.Body.Close()
+	if err := errFromResponse(c.resp); err != nil {
+		return err
+	}
+	return json.NewDecoder(c.resp.Body).Decode(out)
+}
+
+var _ grpc.ClientStream = &requestStreamClient{}
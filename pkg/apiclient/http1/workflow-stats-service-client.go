@@ -0,0 +1,16 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	workflowstatspkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowstats"
+)
+
+type WorkflowStatsServiceClient = Facade
+
+func (h WorkflowStatsServiceClient) GetWorkflowStats(_ context.Context, in *workflowstatspkg.WorkflowStatsRequest, _ ...grpc.CallOption) (*workflowstatspkg.WorkflowStatsResponse, error) {
+	out := &workflowstatspkg.WorkflowStatsResponse{}
+	return out, h.Get(in, out, "/api/v1/workflow-stats/{namespace}")
+}
@@ -7,8 +7,11 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	workflowstatspkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowstats"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/file"
@@ -136,6 +139,18 @@ func (c *offlineClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error
 	return nil, NoArgoServerErr
 }
 
+func (c *offlineClient) NewSyncServiceClient() (syncpkg.SyncServiceClient, error) {
+	return nil, NoArgoServerErr
+}
+
+func (c *offlineClient) NewMemoizationServiceClient() (memoizationpkg.MemoizationServiceClient, error) {
+	return nil, NoArgoServerErr
+}
+
+func (c *offlineClient) NewWorkflowStatsServiceClient() (workflowstatspkg.WorkflowStatsServiceClient, error) {
+	return nil, NoArgoServerErr
+}
+
 type offlineWorkflowTemplateNamespacedGetter struct {
 	namespace         string
 	workflowTemplates map[string]*wfv1.WorkflowTemplate
@@ -38,6 +38,10 @@ func (o OfflineCronWorkflowServiceClient) GetCronWorkflow(ctx context.Context, r
 	return nil, OfflineErr
 }
 
+func (o OfflineCronWorkflowServiceClient) GetCronWorkflowBlackoutWindows(ctx context.Context, req *cronworkflow.GetCronWorkflowBlackoutWindowsRequest, _ ...grpc.CallOption) (*cronworkflow.CronWorkflowBlackoutWindowsResponse, error) {
+	return nil, OfflineErr
+}
+
 func (o OfflineCronWorkflowServiceClient) UpdateCronWorkflow(ctx context.Context, req *cronworkflow.UpdateCronWorkflowRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
 	return nil, OfflineErr
 }
@@ -30,12 +30,33 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ListArchivedWorkflowsRequest struct {
-	ListOptions          *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
-	NamePrefix           string          `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
-	Namespace            string          `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	ListOptions *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	NamePrefix  string          `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	Namespace   string          `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Phases only lists workflows in any of these phases, e.g. "Failed".
+	Phases []string `protobuf:"bytes,4,rep,name=phases,proto3" json:"phases,omitempty"`
+	// MinFinishedAt only lists workflows that finished after this RFC3339 time.
+	MinFinishedAt string `protobuf:"bytes,5,opt,name=minFinishedAt,proto3" json:"minFinishedAt,omitempty"`
+	// MaxFinishedAt only lists workflows that finished before this RFC3339 time.
+	MaxFinishedAt string `protobuf:"bytes,6,opt,name=maxFinishedAt,proto3" json:"maxFinishedAt,omitempty"`
+	// MinDuration only lists workflows that ran for at least this duration, e.g. "1h" or "2d".
+	MinDuration string `protobuf:"bytes,7,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
+	// MaxDuration only lists workflows that ran for at most this duration.
+	MaxDuration string `protobuf:"bytes,8,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`
+	// WorkflowTemplate only lists workflows submitted from this WorkflowTemplate.
+	WorkflowTemplate string `protobuf:"bytes,9,opt,name=workflowTemplate,proto3" json:"workflowTemplate,omitempty"`
+	// ClusterWorkflowTemplate only lists workflows submitted from this ClusterWorkflowTemplate.
+	ClusterWorkflowTemplate string `protobuf:"bytes,10,opt,name=clusterWorkflowTemplate,proto3" json:"clusterWorkflowTemplate,omitempty"`
+	// CronWorkflow only lists workflows created by this CronWorkflow.
+	CronWorkflow string `protobuf:"bytes,11,opt,name=cronWorkflow,proto3" json:"cronWorkflow,omitempty"`
+	// MessageContains only lists workflows whose message, e.g. why they failed, contains this string.
+	MessageContains string `protobuf:"bytes,12,opt,name=messageContains,proto3" json:"messageContains,omitempty"`
+	// OrderBy is "startedAt", "finishedAt" or "duration", prefixed with "-" for descending order.
+	// By default, the most recently started workflows are listed first.
+	OrderBy              string   `protobuf:"bytes,13,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListArchivedWorkflowsRequest) Reset()         { *m = ListArchivedWorkflowsRequest{} }
@@ -92,6 +113,76 @@ func (m *ListArchivedWorkflowsRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *ListArchivedWorkflowsRequest) GetPhases() []string {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *ListArchivedWorkflowsRequest) GetMinFinishedAt() string {
+	if m != nil {
+		return m.MinFinishedAt
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetMaxFinishedAt() string {
+	if m != nil {
+		return m.MaxFinishedAt
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetMinDuration() string {
+	if m != nil {
+		return m.MinDuration
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetMaxDuration() string {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetWorkflowTemplate() string {
+	if m != nil {
+		return m.WorkflowTemplate
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetClusterWorkflowTemplate() string {
+	if m != nil {
+		return m.ClusterWorkflowTemplate
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetCronWorkflow() string {
+	if m != nil {
+		return m.CronWorkflow
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetMessageContains() string {
+	if m != nil {
+		return m.MessageContains
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
 type GetArchivedWorkflowRequest struct {
 	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -511,6 +602,102 @@ func (m *ResubmitArchivedWorkflowRequest) GetParameters() []string {
 	return nil
 }
 
+type ImportArchivedWorkflowRequest struct {
+	// Workflow is archived as is, keeping its UID and labels.
+	Workflow             *v1alpha1.Workflow `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ImportArchivedWorkflowRequest) Reset()         { *m = ImportArchivedWorkflowRequest{} }
+func (m *ImportArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportArchivedWorkflowRequest) ProtoMessage()    {}
+func (*ImportArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{8}
+}
+func (m *ImportArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *ImportArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportArchivedWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *ImportArchivedWorkflowRequest) GetWorkflow() *v1alpha1.Workflow {
+	if m != nil {
+		return m.Workflow
+	}
+	return nil
+}
+
+type ArchivedWorkflowsImportedResponse struct {
+	// Imported is the number of workflows that were archived.
+	Imported             int64    `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchivedWorkflowsImportedResponse) Reset()         { *m = ArchivedWorkflowsImportedResponse{} }
+func (m *ArchivedWorkflowsImportedResponse) String() string { return proto.CompactTextString(m) }
+func (*ArchivedWorkflowsImportedResponse) ProtoMessage()    {}
+func (*ArchivedWorkflowsImportedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{9}
+}
+func (m *ArchivedWorkflowsImportedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedWorkflowsImportedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedWorkflowsImportedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedWorkflowsImportedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedWorkflowsImportedResponse.Merge(m, src)
+}
+func (m *ArchivedWorkflowsImportedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedWorkflowsImportedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedWorkflowsImportedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedWorkflowsImportedResponse proto.InternalMessageInfo
+
+func (m *ArchivedWorkflowsImportedResponse) GetImported() int64 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
 	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
@@ -520,6 +707,8 @@ func init() {
 	proto.RegisterType((*ListArchivedWorkflowLabelValuesRequest)(nil), "workflowarchive.ListArchivedWorkflowLabelValuesRequest")
 	proto.RegisterType((*RetryArchivedWorkflowRequest)(nil), "workflowarchive.RetryArchivedWorkflowRequest")
 	proto.RegisterType((*ResubmitArchivedWorkflowRequest)(nil), "workflowarchive.ResubmitArchivedWorkflowRequest")
+	proto.RegisterType((*ImportArchivedWorkflowRequest)(nil), "workflowarchive.ImportArchivedWorkflowRequest")
+	proto.RegisterType((*ArchivedWorkflowsImportedResponse)(nil), "workflowarchive.ArchivedWorkflowsImportedResponse")
 }
 
 func init() {
@@ -527,57 +716,73 @@ func init() {
 }
 
 var fileDescriptor_95ca9a2d33e8bb19 = []byte{
-	// 786 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0x35, 0x7d, 0x53, 0x3b, 0x3d, 0x00, 0x83, 0x0a, 0x91, 0x95, 0xa6, 0xc1, 0x82, 0x36,
-	0x6d, 0xc9, 0xb8, 0x69, 0x8b, 0x40, 0x3d, 0x01, 0xaa, 0x40, 0xa2, 0x69, 0x8b, 0x1c, 0x09, 0x24,
-	0x2e, 0x68, 0x62, 0x3f, 0x4d, 0x86, 0xd8, 0x1e, 0x33, 0x33, 0x76, 0x29, 0x88, 0x0b, 0x7c, 0x04,
-	0x8e, 0x9c, 0x90, 0xf8, 0x02, 0xdc, 0x10, 0x77, 0x24, 0x4e, 0x68, 0xb5, 0x7b, 0xdb, 0xc3, 0x6a,
-	0x55, 0xed, 0x07, 0x59, 0xd9, 0x79, 0x6b, 0x1d, 0xe7, 0x45, 0xda, 0xf4, 0x36, 0x7e, 0x66, 0xfc,
-	0x9f, 0xdf, 0x7f, 0xfc, 0xf8, 0xaf, 0xc1, 0xc7, 0x61, 0xa7, 0x65, 0xb1, 0x90, 0x3b, 0x1e, 0x87,
-	0x40, 0x5b, 0xd7, 0x42, 0x76, 0xae, 0x3c, 0x71, 0xcd, 0xa4, 0xd3, 0xe6, 0x31, 0x0c, 0x9e, 0xab,
-	0xbd, 0x02, 0x0d, 0xa5, 0xd0, 0x82, 0xbc, 0x96, 0x59, 0x67, 0x14, 0x5b, 0x42, 0xb4, 0x3c, 0x48,
-	0x94, 0x2c, 0x16, 0x04, 0x42, 0x33, 0xcd, 0x45, 0xa0, 0xba, 0xcb, 0x8d, 0xe3, 0xce, 0x47, 0x8a,
-	0x72, 0x91, 0xcc, 0xfa, 0xcc, 0x69, 0xf3, 0x00, 0xe4, 0x8d, 0xd5, 0xdb, 0x58, 0x59, 0x3e, 0x68,
-	0x66, 0xc5, 0x35, 0xab, 0x05, 0x01, 0x48, 0xa6, 0xc1, 0xed, 0xbd, 0x75, 0xde, 0xe2, 0xba, 0x1d,
-	0x35, 0xa9, 0x23, 0x7c, 0x8b, 0xc9, 0x96, 0x08, 0xa5, 0xf8, 0x2e, 0x1d, 0x54, 0xfb, 0xbb, 0xab,
-	0xa1, 0x48, 0xbf, 0x64, 0xc5, 0x35, 0xe6, 0x85, 0x6d, 0x36, 0x22, 0x67, 0xfe, 0x85, 0x70, 0xb1,
-	0xce, 0x95, 0xfe, 0xa4, 0x8b, 0xec, 0x7e, 0xdd, 0x17, 0xb1, 0xe1, 0xfb, 0x08, 0x94, 0x26, 0x0d,
-	0xbc, 0xee, 0x71, 0xa5, 0x2f, 0xc3, 0x14, 0xbd, 0x80, 0xca, 0xa8, 0xb2, 0x7e, 0x58, 0xa3, 0x5d,
-	0x76, 0x7a, 0x97, 0x9d, 0x86, 0x9d, 0x56, 0x52, 0x50, 0x34, 0x61, 0xa7, 0x71, 0x8d, 0xd6, 0x87,
-	0x2f, 0xda, 0x77, 0x55, 0x48, 0x09, 0xe3, 0x80, 0xf9, 0xf0, 0xa5, 0x84, 0x2b, 0xfe, 0x43, 0x61,
-	0xa1, 0x8c, 0x2a, 0x6b, 0xf6, 0x9d, 0x0a, 0x29, 0xe2, 0xb5, 0xe4, 0x49, 0x85, 0xcc, 0x81, 0xc2,
-	0x62, 0x3a, 0x3d, 0x2c, 0x98, 0x75, 0x6c, 0x7c, 0x0e, 0x23, 0xc4, 0x7d, 0xe0, 0xd7, 0xf1, 0x62,
-	0xc4, 0xdd, 0x14, 0x74, 0xcd, 0x4e, 0x86, 0xf7, 0xd5, 0x16, 0xb2, 0x6a, 0x97, 0x78, 0xf3, 0x14,
-	0x3c, 0xd0, 0x30, 0x2f, 0xc1, 0x77, 0xf0, 0x56, 0x56, 0xaa, 0xbb, 0x81, 0x6b, 0x83, 0x0a, 0x45,
-	0xa0, 0xc0, 0x3c, 0xc5, 0xef, 0xe6, 0x1d, 0x7a, 0x9d, 0x35, 0xc1, 0x3b, 0x83, 0x9b, 0xc1, 0xe1,
-	0xdf, 0xdb, 0x08, 0x65, 0x37, 0xfa, 0x1d, 0xe1, 0xed, 0xb1, 0x32, 0x5f, 0x31, 0x2f, 0x82, 0x87,
-	0xfd, 0x8a, 0x93, 0x8f, 0xe1, 0x19, 0xc2, 0x45, 0x1b, 0xb4, 0xbc, 0x99, 0xfd, 0x5c, 0x09, 0x5e,
-	0x4a, 0xde, 0xef, 0x69, 0xa5, 0xe3, 0xc9, 0xad, 0x40, 0xde, 0xc7, 0x6f, 0x48, 0x50, 0x9a, 0x49,
-	0xdd, 0x88, 0x1c, 0x07, 0x94, 0xba, 0x8a, 0xbc, 0xc2, 0x52, 0x19, 0x55, 0x56, 0xed, 0xd1, 0x89,
-	0x64, 0x75, 0x20, 0x5c, 0xf8, 0x8c, 0x83, 0xe7, 0x36, 0xc0, 0x03, 0x47, 0x0b, 0x59, 0x58, 0x4e,
-	0x35, 0x47, 0x27, 0x92, 0x26, 0x0d, 0x99, 0x64, 0x3e, 0x68, 0x90, 0xaa, 0xb0, 0x52, 0x5e, 0x4c,
-	0x9a, 0x74, 0x58, 0x31, 0xff, 0x40, 0x78, 0xcb, 0x06, 0x15, 0x35, 0x7d, 0xae, 0x1f, 0xd2, 0xa3,
-	0x81, 0x57, 0x7d, 0xf0, 0x05, 0xff, 0x11, 0xdc, 0x9e, 0xb5, 0xc1, 0x73, 0x86, 0x71, 0x39, 0xcb,
-	0x78, 0xf8, 0xeb, 0x3a, 0x7e, 0x3b, 0xcb, 0xd6, 0x00, 0x19, 0x73, 0x07, 0xc8, 0x3f, 0x08, 0x6f,
-	0xe4, 0xfe, 0xfa, 0xa4, 0x4a, 0x33, 0x49, 0x46, 0x27, 0x45, 0x84, 0x71, 0x41, 0x87, 0x99, 0x44,
-	0xfb, 0x99, 0x94, 0x0e, 0xbe, 0x1d, 0x64, 0x12, 0x8d, 0x8f, 0x86, 0x9d, 0xd5, 0xaf, 0xd2, 0x7e,
-	0x2c, 0xd1, 0x41, 0xeb, 0x72, 0xa5, 0x4d, 0xf3, 0x97, 0x27, 0x2f, 0x7e, 0x5b, 0x28, 0x12, 0x23,
-	0x0d, 0xce, 0xb8, 0x66, 0xf5, 0x28, 0xdc, 0x61, 0xc4, 0x91, 0xbf, 0x11, 0x7e, 0x33, 0x27, 0x04,
-	0xc8, 0xfe, 0x08, 0xfa, 0xf8, 0xa8, 0x30, 0xbe, 0x98, 0x1f, 0xb8, 0x59, 0x49, 0xa1, 0x4d, 0x52,
-	0x1e, 0x0f, 0x6d, 0xfd, 0x14, 0x71, 0xf7, 0x67, 0xf2, 0x27, 0xc2, 0x6f, 0xe5, 0x27, 0x0e, 0xa1,
-	0x23, 0xf4, 0x13, 0xa3, 0xc9, 0x38, 0x18, 0x59, 0x3f, 0x2d, 0x79, 0x7a, 0x98, 0x7b, 0xd3, 0x31,
-	0x1f, 0x23, 0xbc, 0x39, 0x31, 0xa4, 0xc8, 0x07, 0x33, 0xb5, 0x49, 0x36, 0xd4, 0x8c, 0xb3, 0x57,
-	0x3f, 0xf5, 0x81, 0xa6, 0x59, 0x4d, 0xfd, 0xec, 0x90, 0xf7, 0xc6, 0xfb, 0xa9, 0x7a, 0xc9, 0xea,
-	0x6a, 0x27, 0x41, 0x7e, 0x8a, 0xf0, 0xd6, 0x94, 0xc8, 0x24, 0x1f, 0xce, 0x6e, 0xeb, 0x5e, 0xc8,
-	0x1a, 0xe7, 0x73, 0x32, 0xd6, 0x55, 0x35, 0xad, 0xd4, 0xda, 0x2e, 0xd9, 0x99, 0x6a, 0x2d, 0xee,
-	0x82, 0xff, 0x8b, 0xf0, 0x46, 0x6e, 0xe2, 0xe6, 0xfc, 0xd0, 0x93, 0x92, 0x79, 0xae, 0xff, 0x45,
-	0x2d, 0x75, 0xb1, 0x6f, 0x6c, 0x4f, 0x6b, 0x38, 0x4b, 0x26, 0x48, 0x27, 0x68, 0x8f, 0xfc, 0x8f,
-	0x70, 0x61, 0x5c, 0xb0, 0x92, 0x83, 0x1c, 0x2b, 0x13, 0x33, 0x78, 0xae, 0x6e, 0x8e, 0x53, 0x37,
-	0xd4, 0xd8, 0x9d, 0xc1, 0x4d, 0x97, 0xea, 0x04, 0xed, 0x7d, 0x7a, 0xf1, 0xdf, 0x6d, 0x09, 0x3d,
-	0xba, 0x2d, 0xa1, 0xe7, 0xb7, 0x25, 0xf4, 0xcd, 0xc7, 0xb3, 0xdf, 0xe0, 0xf2, 0xef, 0x9f, 0xcd,
-	0x95, 0xf4, 0xee, 0x76, 0xf4, 0x32, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x6c, 0x67, 0x07, 0xa7, 0x0a,
-	0x00, 0x00,
+	// 1044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xd6, 0x24, 0x69, 0x9a, 0x4c, 0x1a, 0xb5, 0x0c, 0x6a, 0x63, 0x59, 0xf9, 0xb1, 0x31, 0x25,
+	0xdd, 0xa4, 0x5d, 0x3b, 0x9b, 0x06, 0x51, 0xf5, 0x02, 0x2d, 0xa1, 0x08, 0x9a, 0xb6, 0x68, 0x83,
+	0xa8, 0xc4, 0x05, 0x4d, 0xec, 0x97, 0xdd, 0x21, 0xb6, 0xc7, 0xcc, 0x8c, 0xb7, 0x09, 0x88, 0x0b,
+	0x17, 0xfe, 0x00, 0x8e, 0x9c, 0x90, 0x90, 0x38, 0x71, 0x47, 0xdc, 0x38, 0x20, 0x71, 0x42, 0x08,
+	0x6e, 0x1c, 0x10, 0x8a, 0xf8, 0x1f, 0xb8, 0x22, 0xcf, 0xda, 0xbb, 0x1b, 0xdb, 0xfb, 0x03, 0xb1,
+	0xbd, 0xcd, 0xbc, 0xf9, 0xe6, 0xcd, 0xf7, 0x79, 0xde, 0x7c, 0x4f, 0xc6, 0xbb, 0xd1, 0x71, 0xd3,
+	0xa1, 0x11, 0x73, 0x7d, 0x06, 0xa1, 0x72, 0x9e, 0x71, 0x71, 0x7c, 0xe4, 0xf3, 0x67, 0x54, 0xb8,
+	0x2d, 0xd6, 0x86, 0xee, 0xbc, 0x96, 0x06, 0xec, 0x48, 0x70, 0xc5, 0xc9, 0xe5, 0x1c, 0xce, 0x5c,
+	0x6e, 0x72, 0xde, 0xf4, 0x21, 0xc9, 0xe4, 0xd0, 0x30, 0xe4, 0x8a, 0x2a, 0xc6, 0x43, 0xd9, 0x81,
+	0x9b, 0xbb, 0xc7, 0x77, 0xa4, 0xcd, 0x78, 0xb2, 0x1a, 0x50, 0xb7, 0xc5, 0x42, 0x10, 0xa7, 0x4e,
+	0x7a, 0xb0, 0x74, 0x02, 0x50, 0xd4, 0x69, 0xd7, 0x9d, 0x26, 0x84, 0x20, 0xa8, 0x02, 0x2f, 0xdd,
+	0xf5, 0xa8, 0xc9, 0x54, 0x2b, 0x3e, 0xb4, 0x5d, 0x1e, 0x38, 0x54, 0x34, 0x79, 0x24, 0xf8, 0x47,
+	0x7a, 0x50, 0xcb, 0x4e, 0x97, 0xbd, 0x24, 0x59, 0xc8, 0x69, 0xd7, 0xa9, 0x1f, 0xb5, 0x68, 0x21,
+	0x9d, 0xf5, 0xed, 0x0c, 0x5e, 0xde, 0x67, 0x52, 0xdd, 0xeb, 0x50, 0xf6, 0x9e, 0x66, 0x49, 0x1a,
+	0xf0, 0x71, 0x0c, 0x52, 0x91, 0x03, 0xbc, 0xe0, 0x33, 0xa9, 0x9e, 0x44, 0x9a, 0xba, 0x81, 0x2a,
+	0xa8, 0xba, 0xb0, 0x53, 0xb7, 0x3b, 0xdc, 0xed, 0x7e, 0xee, 0x76, 0x74, 0xdc, 0x4c, 0x02, 0xd2,
+	0x4e, 0xb8, 0xdb, 0xed, 0xba, 0xbd, 0xdf, 0xdb, 0xd8, 0xe8, 0xcf, 0x42, 0x56, 0x31, 0x0e, 0x69,
+	0x00, 0xef, 0x0a, 0x38, 0x62, 0x27, 0xc6, 0x54, 0x05, 0x55, 0xe7, 0x1b, 0x7d, 0x11, 0xb2, 0x8c,
+	0xe7, 0x93, 0x99, 0x8c, 0xa8, 0x0b, 0xc6, 0xb4, 0x5e, 0xee, 0x05, 0xc8, 0x35, 0x3c, 0x1b, 0xb5,
+	0xa8, 0x04, 0x69, 0xcc, 0x54, 0xa6, 0xab, 0xf3, 0x8d, 0x74, 0x46, 0xae, 0xe3, 0xc5, 0x80, 0x85,
+	0x0f, 0x58, 0xc8, 0x64, 0x0b, 0xbc, 0x7b, 0xca, 0xb8, 0xa0, 0x77, 0x9e, 0x0f, 0x6a, 0x14, 0x3d,
+	0xe9, 0x43, 0xcd, 0xa6, 0xa8, 0xfe, 0x20, 0xa9, 0xe0, 0x85, 0x80, 0x85, 0x7b, 0xb1, 0xd0, 0x57,
+	0x66, 0x5c, 0xd4, 0x98, 0xfe, 0x90, 0x46, 0xd0, 0x93, 0x2e, 0x62, 0x2e, 0x45, 0xf4, 0x42, 0x64,
+	0x0b, 0x5f, 0xc9, 0x2e, 0xe0, 0x3d, 0x08, 0x22, 0x9f, 0x2a, 0x30, 0xe6, 0x35, 0xac, 0x10, 0x27,
+	0x77, 0xf0, 0x92, 0xeb, 0xc7, 0x52, 0x81, 0x78, 0x9a, 0xdf, 0x82, 0xf5, 0x96, 0x41, 0xcb, 0xc4,
+	0xc2, 0x97, 0x5c, 0xc1, 0xc3, 0x2c, 0x6e, 0x2c, 0x68, 0xf8, 0xb9, 0x18, 0xa9, 0xe2, 0xcb, 0x01,
+	0x48, 0x49, 0x9b, 0xf0, 0x06, 0x0f, 0x15, 0x65, 0xa1, 0x34, 0x2e, 0x69, 0x58, 0x3e, 0x4c, 0x0c,
+	0x7c, 0x91, 0x0b, 0x0f, 0xc4, 0xfd, 0x53, 0x63, 0x51, 0x23, 0xb2, 0xa9, 0xb5, 0x8f, 0xcd, 0xb7,
+	0xa0, 0x50, 0x27, 0x59, 0x99, 0x5c, 0xc1, 0xd3, 0x31, 0xf3, 0x74, 0x79, 0xcc, 0x37, 0x92, 0xe1,
+	0xf9, 0x3b, 0x9c, 0xca, 0xdd, 0xa1, 0xf5, 0x04, 0xaf, 0xec, 0x81, 0x0f, 0x0a, 0x26, 0x95, 0x70,
+	0x1d, 0xaf, 0xe5, 0x53, 0x75, 0x0e, 0xf0, 0x1a, 0x20, 0x23, 0x1e, 0x4a, 0xb0, 0xf6, 0xf0, 0xf5,
+	0xb2, 0x52, 0xdf, 0xa7, 0x87, 0xe0, 0x3f, 0x84, 0xd3, 0x6e, 0xc9, 0x9f, 0x3b, 0x08, 0xe5, 0x0f,
+	0xfa, 0x0a, 0xe1, 0x8d, 0x81, 0x69, 0xde, 0xa7, 0x7e, 0x0c, 0xcf, 0xf7, 0xed, 0x0c, 0xff, 0x0c,
+	0x7f, 0x22, 0xbc, 0xdc, 0x00, 0x25, 0x4e, 0xc7, 0xff, 0xae, 0x04, 0xcf, 0x24, 0xfb, 0xd3, 0x5c,
+	0x7a, 0x3c, 0xe2, 0x01, 0xde, 0xc2, 0x2f, 0x08, 0x90, 0x8a, 0x0a, 0x75, 0x10, 0xbb, 0x2e, 0x48,
+	0x79, 0x14, 0xfb, 0xc6, 0x4c, 0x05, 0x55, 0xe7, 0x1a, 0xc5, 0x85, 0x04, 0x1d, 0x72, 0x0f, 0x1e,
+	0x30, 0xf0, 0xbd, 0x03, 0xf0, 0xc1, 0x55, 0x5c, 0xa4, 0x4f, 0xb3, 0xb8, 0x90, 0x58, 0x43, 0x44,
+	0x05, 0x0d, 0x40, 0x81, 0x90, 0xc6, 0xac, 0x7e, 0xe0, 0x7d, 0x11, 0xeb, 0x6b, 0x84, 0xd7, 0x1a,
+	0x20, 0xe3, 0xc3, 0x80, 0xa9, 0xe7, 0xa9, 0xd1, 0xc4, 0x73, 0x01, 0x04, 0x9c, 0x7d, 0x02, 0x5e,
+	0x2a, 0xad, 0x3b, 0xcf, 0x71, 0xbc, 0x50, 0xe0, 0xf8, 0x05, 0xc2, 0x2b, 0x6f, 0x07, 0x11, 0x17,
+	0x03, 0x19, 0x1e, 0xe1, 0xb9, 0xcc, 0x02, 0xd2, 0xb2, 0x78, 0xc7, 0xee, 0x19, 0xbb, 0x9d, 0x19,
+	0xbb, 0x1e, 0x7c, 0xd8, 0x35, 0x76, 0xbb, 0x7d, 0xbb, 0x57, 0x28, 0x59, 0xd4, 0xce, 0xbc, 0xdd,
+	0xee, 0x1e, 0xd2, 0xcd, 0x6d, 0xbd, 0x86, 0xd7, 0x0b, 0xce, 0xde, 0x61, 0xd6, 0x7b, 0x17, 0x89,
+	0x54, 0x96, 0xc6, 0x34, 0x99, 0xe9, 0x46, 0x77, 0xbe, 0xf3, 0xcf, 0x22, 0x5e, 0xca, 0x67, 0x38,
+	0x00, 0xd1, 0x66, 0x2e, 0x90, 0x1f, 0x10, 0xbe, 0x5a, 0xda, 0x3b, 0x48, 0xcd, 0xce, 0xb5, 0x42,
+	0x7b, 0x58, 0x8f, 0x31, 0x1f, 0x4f, 0x4e, 0x7b, 0x72, 0x8e, 0x65, 0x7d, 0xfe, 0xfb, 0xdf, 0x5f,
+	0x4e, 0x2d, 0x13, 0x53, 0x77, 0xde, 0x76, 0xdd, 0x49, 0x59, 0x78, 0xbd, 0x1e, 0x49, 0xbe, 0x47,
+	0xf8, 0xc5, 0x12, 0x3f, 0x23, 0x37, 0x0b, 0xd4, 0x07, 0xbb, 0x9e, 0x39, 0xc1, 0x4b, 0xb3, 0xaa,
+	0x9a, 0xb4, 0x45, 0x2a, 0x83, 0x49, 0x3b, 0x9f, 0xc6, 0xcc, 0xfb, 0x8c, 0x7c, 0x83, 0xf0, 0xb5,
+	0x72, 0xf3, 0x24, 0x76, 0x81, 0xfd, 0x50, 0x97, 0x35, 0xb7, 0x0b, 0xf8, 0x51, 0x26, 0x9a, 0xd2,
+	0xdc, 0x1a, 0x4d, 0xf3, 0x37, 0x84, 0x57, 0x86, 0xfa, 0x2d, 0x79, 0x65, 0xac, 0x32, 0xc9, 0xfb,
+	0xb3, 0xf9, 0xf0, 0xff, 0x7f, 0xf5, 0x6e, 0x4e, 0xab, 0xa6, 0xf5, 0xdc, 0x20, 0x2f, 0x0f, 0xd6,
+	0x53, 0xf3, 0x13, 0x74, 0xed, 0x38, 0xa1, 0xfc, 0x07, 0xc2, 0x6b, 0x23, 0xdc, 0x9f, 0xbc, 0x3a,
+	0xbe, 0xac, 0x73, 0xfd, 0xc2, 0x7c, 0x34, 0x21, 0x61, 0x9d, 0xac, 0x96, 0xa3, 0xa5, 0x6d, 0x92,
+	0x1b, 0x23, 0xa5, 0xb5, 0x3b, 0xc4, 0x7f, 0x42, 0xf8, 0x6a, 0x69, 0xf3, 0x28, 0x79, 0xd0, 0xc3,
+	0x9a, 0xcc, 0x44, 0xdf, 0x45, 0x5d, 0xab, 0xb8, 0x69, 0x6e, 0x8c, 0x2a, 0x38, 0x47, 0x24, 0x94,
+	0xee, 0xa2, 0x2d, 0xf2, 0x0b, 0xc2, 0xc6, 0xa0, 0x1e, 0x41, 0xb6, 0x4b, 0xa4, 0x0c, 0x6d, 0x27,
+	0x13, 0x55, 0xb3, 0xab, 0xd5, 0xd8, 0xe6, 0xe6, 0x18, 0x6a, 0x3a, 0xac, 0x12, 0x41, 0x3f, 0x22,
+	0xbc, 0xf4, 0xe6, 0x49, 0x59, 0x43, 0xf9, 0xcf, 0x5e, 0x3b, 0x49, 0x31, 0x9b, 0x5a, 0xcc, 0x4b,
+	0x64, 0x7d, 0x48, 0x81, 0x81, 0xa6, 0xbd, 0x8d, 0xc8, 0x77, 0x08, 0x2f, 0x95, 0x37, 0x45, 0x59,
+	0x62, 0x5b, 0x43, 0xdb, 0xa7, 0xb9, 0x33, 0xd2, 0xb6, 0x0a, 0x5d, 0xce, 0xba, 0xa5, 0xc9, 0x6e,
+	0x58, 0xc3, 0xc8, 0x76, 0xda, 0xde, 0x5d, 0xb4, 0x55, 0x45, 0xf7, 0x1f, 0xff, 0x7c, 0xb6, 0x8a,
+	0x7e, 0x3d, 0x5b, 0x45, 0x7f, 0x9d, 0xad, 0xa2, 0x0f, 0x5e, 0x1f, 0xff, 0xb7, 0xab, 0xfc, 0xa7,
+	0xf1, 0x70, 0x56, 0xff, 0x70, 0xdd, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xea, 0x2b, 0x82, 0x5e,
+	0x5c, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -599,6 +804,10 @@ type ArchivedWorkflowServiceClient interface {
 	ListArchivedWorkflowLabelValues(ctx context.Context, in *ListArchivedWorkflowLabelValuesRequest, opts ...grpc.CallOption) (*v1alpha1.LabelValues, error)
 	RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	// ExportArchivedWorkflows streams every archived workflow that matches the filters of the request, in full.
+	ExportArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (ArchivedWorkflowService_ExportArchivedWorkflowsClient, error)
+	// ImportArchivedWorkflows archives a stream of workflows, e.g. ones that were exported from another archive.
+	ImportArchivedWorkflows(ctx context.Context, opts ...grpc.CallOption) (ArchivedWorkflowService_ImportArchivedWorkflowsClient, error)
 }
 
 type archivedWorkflowServiceClient struct {
@@ -672,6 +881,72 @@ func (c *archivedWorkflowServiceClient) ResubmitArchivedWorkflow(ctx context.Con
 	return out, nil
 }
 
+func (c *archivedWorkflowServiceClient) ExportArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (ArchivedWorkflowService_ExportArchivedWorkflowsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArchivedWorkflowService_serviceDesc.Streams[0], "/workflowarchive.ArchivedWorkflowService/ExportArchivedWorkflows", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &archivedWorkflowServiceExportArchivedWorkflowsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArchivedWorkflowService_ExportArchivedWorkflowsClient interface {
+	Recv() (*v1alpha1.Workflow, error)
+	grpc.ClientStream
+}
+
+type archivedWorkflowServiceExportArchivedWorkflowsClient struct {
+	grpc.ClientStream
+}
+
+func (x *archivedWorkflowServiceExportArchivedWorkflowsClient) Recv() (*v1alpha1.Workflow, error) {
+	m := new(v1alpha1.Workflow)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *archivedWorkflowServiceClient) ImportArchivedWorkflows(ctx context.Context, opts ...grpc.CallOption) (ArchivedWorkflowService_ImportArchivedWorkflowsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArchivedWorkflowService_serviceDesc.Streams[1], "/workflowarchive.ArchivedWorkflowService/ImportArchivedWorkflows", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &archivedWorkflowServiceImportArchivedWorkflowsClient{stream}
+	return x, nil
+}
+
+type ArchivedWorkflowService_ImportArchivedWorkflowsClient interface {
+	Send(*ImportArchivedWorkflowRequest) error
+	CloseAndRecv() (*ArchivedWorkflowsImportedResponse, error)
+	grpc.ClientStream
+}
+
+type archivedWorkflowServiceImportArchivedWorkflowsClient struct {
+	grpc.ClientStream
+}
+
+func (x *archivedWorkflowServiceImportArchivedWorkflowsClient) Send(m *ImportArchivedWorkflowRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *archivedWorkflowServiceImportArchivedWorkflowsClient) CloseAndRecv() (*ArchivedWorkflowsImportedResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ArchivedWorkflowsImportedResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ArchivedWorkflowServiceServer is the server API for ArchivedWorkflowService service.
 type ArchivedWorkflowServiceServer interface {
 	ListArchivedWorkflows(context.Context, *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error)
@@ -681,6 +956,10 @@ type ArchivedWorkflowServiceServer interface {
 	ListArchivedWorkflowLabelValues(context.Context, *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error)
 	RetryArchivedWorkflow(context.Context, *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 	ResubmitArchivedWorkflow(context.Context, *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	// ExportArchivedWorkflows streams every archived workflow that matches the filters of the request, in full.
+	ExportArchivedWorkflows(*ListArchivedWorkflowsRequest, ArchivedWorkflowService_ExportArchivedWorkflowsServer) error
+	// ImportArchivedWorkflows archives a stream of workflows, e.g. ones that were exported from another archive.
+	ImportArchivedWorkflows(ArchivedWorkflowService_ImportArchivedWorkflowsServer) error
 }
 
 // UnimplementedArchivedWorkflowServiceServer can be embedded to have forward compatible implementations.
@@ -708,6 +987,12 @@ func (*UnimplementedArchivedWorkflowServiceServer) RetryArchivedWorkflow(ctx con
 func (*UnimplementedArchivedWorkflowServiceServer) ResubmitArchivedWorkflow(ctx context.Context, req *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResubmitArchivedWorkflow not implemented")
 }
+func (*UnimplementedArchivedWorkflowServiceServer) ExportArchivedWorkflows(req *ListArchivedWorkflowsRequest, srv ArchivedWorkflowService_ExportArchivedWorkflowsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportArchivedWorkflows not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) ImportArchivedWorkflows(srv ArchivedWorkflowService_ImportArchivedWorkflowsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportArchivedWorkflows not implemented")
+}
 
 func RegisterArchivedWorkflowServiceServer(s *grpc.Server, srv ArchivedWorkflowServiceServer) {
 	s.RegisterService(&_ArchivedWorkflowService_serviceDesc, srv)
@@ -839,6 +1124,53 @@ func _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchivedWorkflowService_ExportArchivedWorkflows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListArchivedWorkflowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArchivedWorkflowServiceServer).ExportArchivedWorkflows(m, &archivedWorkflowServiceExportArchivedWorkflowsServer{stream})
+}
+
+type ArchivedWorkflowService_ExportArchivedWorkflowsServer interface {
+	Send(*v1alpha1.Workflow) error
+	grpc.ServerStream
+}
+
+type archivedWorkflowServiceExportArchivedWorkflowsServer struct {
+	grpc.ServerStream
+}
+
+func (x *archivedWorkflowServiceExportArchivedWorkflowsServer) Send(m *v1alpha1.Workflow) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArchivedWorkflowService_ImportArchivedWorkflows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ArchivedWorkflowServiceServer).ImportArchivedWorkflows(&archivedWorkflowServiceImportArchivedWorkflowsServer{stream})
+}
+
+type ArchivedWorkflowService_ImportArchivedWorkflowsServer interface {
+	SendAndClose(*ArchivedWorkflowsImportedResponse) error
+	Recv() (*ImportArchivedWorkflowRequest, error)
+	grpc.ServerStream
+}
+
+type archivedWorkflowServiceImportArchivedWorkflowsServer struct {
+	grpc.ServerStream
+}
+
+func (x *archivedWorkflowServiceImportArchivedWorkflowsServer) SendAndClose(m *ArchivedWorkflowsImportedResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *archivedWorkflowServiceImportArchivedWorkflowsServer) Recv() (*ImportArchivedWorkflowRequest, error) {
+	m := new(ImportArchivedWorkflowRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "workflowarchive.ArchivedWorkflowService",
 	HandlerType: (*ArchivedWorkflowServiceServer)(nil),
@@ -872,7 +1204,18 @@ var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportArchivedWorkflows",
+			Handler:       _ArchivedWorkflowService_ExportArchivedWorkflows_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportArchivedWorkflows",
+			Handler:       _ArchivedWorkflowService_ImportArchivedWorkflows_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "pkg/apiclient/workflowarchive/workflow-archive.proto",
 }
 
@@ -900,6 +1243,78 @@ func (m *ListArchivedWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.MessageContains) > 0 {
+		i -= len(m.MessageContains)
+		copy(dAtA[i:], m.MessageContains)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.MessageContains)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CronWorkflow) > 0 {
+		i -= len(m.CronWorkflow)
+		copy(dAtA[i:], m.CronWorkflow)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.CronWorkflow)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ClusterWorkflowTemplate) > 0 {
+		i -= len(m.ClusterWorkflowTemplate)
+		copy(dAtA[i:], m.ClusterWorkflowTemplate)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.ClusterWorkflowTemplate)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.WorkflowTemplate) > 0 {
+		i -= len(m.WorkflowTemplate)
+		copy(dAtA[i:], m.WorkflowTemplate)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.WorkflowTemplate)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MaxDuration) > 0 {
+		i -= len(m.MaxDuration)
+		copy(dAtA[i:], m.MaxDuration)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.MaxDuration)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MinDuration) > 0 {
+		i -= len(m.MinDuration)
+		copy(dAtA[i:], m.MinDuration)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.MinDuration)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MaxFinishedAt) > 0 {
+		i -= len(m.MaxFinishedAt)
+		copy(dAtA[i:], m.MaxFinishedAt)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.MaxFinishedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MinFinishedAt) > 0 {
+		i -= len(m.MinFinishedAt)
+		copy(dAtA[i:], m.MinFinishedAt)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.MinFinishedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Phases[iNdEx])
+			copy(dAtA[i:], m.Phases[iNdEx])
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Phases[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -1259,6 +1674,77 @@ func (m *ResubmitArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *ImportArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedWorkflowsImportedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowsImportedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowsImportedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Imported != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Imported))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWorkflowArchive(v)
 	base := offset
@@ -1288,7 +1774,49 @@ func (m *ListArchivedWorkflowsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflowArchive(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
+	if len(m.Phases) > 0 {
+		for _, s := range m.Phases {
+			l = len(s)
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	l = len(m.MinFinishedAt)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.MaxFinishedAt)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.MinDuration)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.MaxDuration)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.WorkflowTemplate)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.ClusterWorkflowTemplate)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.CronWorkflow)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.MessageContains)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
@@ -1452,6 +1980,37 @@ func (m *ResubmitArchivedWorkflowRequest) Size() (n int) {
 	return n
 }
 
+func (m *ImportArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowsImportedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Imported != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Imported))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkflowArchive(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1587,6 +2146,326 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFinishedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFinishedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFinishedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFinishedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterWorkflowTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterWorkflowTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronWorkflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronWorkflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageContains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageContains = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
@@ -2522,6 +3401,163 @@ func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ImportArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Workflow == nil {
+				m.Workflow = &v1alpha1.Workflow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedWorkflowsImportedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedWorkflowsImportedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedWorkflowsImportedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
+			}
+			m.Imported = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Imported |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflowArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
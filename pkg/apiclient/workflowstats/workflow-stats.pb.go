@@ -0,0 +1,2140 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apiclient/workflowstats/workflow-stats.proto
+
+// Workflow Stats Service
+//
+// Workflow Stats Service API aggregates the runs of live and archived workflows
+
+package workflowstats
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type WorkflowStatsRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// GroupBy is "workflowTemplate" (the default), "clusterWorkflowTemplate", "cronWorkflow", or the key of a label.
+	// Workflows that are not in any group are not aggregated.
+	GroupBy string `protobuf:"bytes,2,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+	// Group only aggregates the workflows in this group, e.g. the name of a WorkflowTemplate.
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// StartedAfter only aggregates workflows that started after this RFC3339 time.
+	StartedAfter string `protobuf:"bytes,4,opt,name=startedAfter,proto3" json:"startedAfter,omitempty"`
+	// StartedBefore only aggregates workflows that started before this RFC3339 time.
+	StartedBefore string `protobuf:"bytes,5,opt,name=startedBefore,proto3" json:"startedBefore,omitempty"`
+	// Bucket is the size of the time buckets that workflows are aggregated by when they started, e.g. "1h" or "1d".
+	// By default, workflows are aggregated in a single bucket.
+	Bucket string `protobuf:"bytes,6,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// LabelSelector only aggregates workflows that match this label selector.
+	LabelSelector string `protobuf:"bytes,7,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	// TopFailureMessages is the number of the most common failure messages to return, by default 5.
+	TopFailureMessages   int32    `protobuf:"varint,8,opt,name=topFailureMessages,proto3" json:"topFailureMessages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowStatsRequest) Reset()         { *m = WorkflowStatsRequest{} }
+func (m *WorkflowStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowStatsRequest) ProtoMessage()    {}
+func (*WorkflowStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81de8a8a0cc7ba54, []int{0}
+}
+func (m *WorkflowStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowStatsRequest.Merge(m, src)
+}
+func (m *WorkflowStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowStatsRequest proto.InternalMessageInfo
+
+func (m *WorkflowStatsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowStatsRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
+}
+
+func (m *WorkflowStatsRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *WorkflowStatsRequest) GetStartedAfter() string {
+	if m != nil {
+		return m.StartedAfter
+	}
+	return ""
+}
+
+func (m *WorkflowStatsRequest) GetStartedBefore() string {
+	if m != nil {
+		return m.StartedBefore
+	}
+	return ""
+}
+
+func (m *WorkflowStatsRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *WorkflowStatsRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *WorkflowStatsRequest) GetTopFailureMessages() int32 {
+	if m != nil {
+		return m.TopFailureMessages
+	}
+	return 0
+}
+
+type DurationPercentiles struct {
+	// The percentiles of the durations of the completed workflows, in seconds.
+	P50                  int64    `protobuf:"varint,1,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90                  int64    `protobuf:"varint,2,opt,name=p90,proto3" json:"p90,omitempty"`
+	P95                  int64    `protobuf:"varint,3,opt,name=p95,proto3" json:"p95,omitempty"`
+	P99                  int64    `protobuf:"varint,4,opt,name=p99,proto3" json:"p99,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DurationPercentiles) Reset()         { *m = DurationPercentiles{} }
+func (m *DurationPercentiles) String() string { return proto.CompactTextString(m) }
+func (*DurationPercentiles) ProtoMessage()    {}
+func (*DurationPercentiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81de8a8a0cc7ba54, []int{1}
+}
+func (m *DurationPercentiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DurationPercentiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DurationPercentiles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DurationPercentiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationPercentiles.Merge(m, src)
+}
+func (m *DurationPercentiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *DurationPercentiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationPercentiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationPercentiles proto.InternalMessageInfo
+
+func (m *DurationPercentiles) GetP50() int64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
+}
+
+func (m *DurationPercentiles) GetP90() int64 {
+	if m != nil {
+		return m.P90
+	}
+	return 0
+}
+
+func (m *DurationPercentiles) GetP95() int64 {
+	if m != nil {
+		return m.P95
+	}
+	return 0
+}
+
+func (m *DurationPercentiles) GetP99() int64 {
+	if m != nil {
+		return m.P99
+	}
+	return 0
+}
+
+type FailureMessage struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// The number of failed and errored workflows with this message.
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FailureMessage) Reset()         { *m = FailureMessage{} }
+func (m *FailureMessage) String() string { return proto.CompactTextString(m) }
+func (*FailureMessage) ProtoMessage()    {}
+func (*FailureMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81de8a8a0cc7ba54, []int{2}
+}
+func (m *FailureMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailureMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailureMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailureMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailureMessage.Merge(m, src)
+}
+func (m *FailureMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailureMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailureMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailureMessage proto.InternalMessageInfo
+
+func (m *FailureMessage) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *FailureMessage) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type WorkflowStats struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// BucketStart is the start of the time bucket, it is not set if workflows are aggregated in a single bucket.
+	BucketStart *v1.Time `protobuf:"bytes,2,opt,name=bucketStart,proto3" json:"bucketStart,omitempty"`
+	// Phases is the number of workflows by their phase.
+	Phases    map[string]int64     `protobuf:"bytes,3,rep,name=phases,proto3" json:"phases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Durations *DurationPercentiles `protobuf:"bytes,4,opt,name=durations,proto3" json:"durations,omitempty"`
+	// ResourcesDuration is the sum of the resource durations of the workflows, in seconds.
+	ResourcesDuration    map[string]int64  `protobuf:"bytes,5,rep,name=resourcesDuration,proto3" json:"resourcesDuration,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TopFailureMessages   []*FailureMessage `protobuf:"bytes,6,rep,name=topFailureMessages,proto3" json:"topFailureMessages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WorkflowStats) Reset()         { *m = WorkflowStats{} }
+func (m *WorkflowStats) String() string { return proto.CompactTextString(m) }
+func (*WorkflowStats) ProtoMessage()    {}
+func (*WorkflowStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81de8a8a0cc7ba54, []int{3}
+}
+func (m *WorkflowStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowStats.Merge(m, src)
+}
+func (m *WorkflowStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowStats proto.InternalMessageInfo
+
+func (m *WorkflowStats) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *WorkflowStats) GetBucketStart() *v1.Time {
+	if m != nil {
+		return m.BucketStart
+	}
+	return nil
+}
+
+func (m *WorkflowStats) GetPhases() map[string]int64 {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *WorkflowStats) GetDurations() *DurationPercentiles {
+	if m != nil {
+		return m.Durations
+	}
+	return nil
+}
+
+func (m *WorkflowStats) GetResourcesDuration() map[string]int64 {
+	if m != nil {
+		return m.ResourcesDuration
+	}
+	return nil
+}
+
+func (m *WorkflowStats) GetTopFailureMessages() []*FailureMessage {
+	if m != nil {
+		return m.TopFailureMessages
+	}
+	return nil
+}
+
+type WorkflowStatsResponse struct {
+	Items                []*WorkflowStats `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WorkflowStatsResponse) Reset()         { *m = WorkflowStatsResponse{} }
+func (m *WorkflowStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowStatsResponse) ProtoMessage()    {}
+func (*WorkflowStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81de8a8a0cc7ba54, []int{4}
+}
+func (m *WorkflowStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowStatsResponse.Merge(m, src)
+}
+func (m *WorkflowStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowStatsResponse proto.InternalMessageInfo
+
+func (m *WorkflowStatsResponse) GetItems() []*WorkflowStats {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*WorkflowStatsRequest)(nil), "workflowstats.WorkflowStatsRequest")
+	proto.RegisterType((*DurationPercentiles)(nil), "workflowstats.DurationPercentiles")
+	proto.RegisterType((*FailureMessage)(nil), "workflowstats.FailureMessage")
+	proto.RegisterType((*WorkflowStats)(nil), "workflowstats.WorkflowStats")
+	proto.RegisterMapType((map[string]int64)(nil), "workflowstats.WorkflowStats.PhasesEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "workflowstats.WorkflowStats.ResourcesDurationEntry")
+	proto.RegisterType((*WorkflowStatsResponse)(nil), "workflowstats.WorkflowStatsResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apiclient/workflowstats/workflow-stats.proto", fileDescriptor_81de8a8a0cc7ba54)
+}
+
+var fileDescriptor_81de8a8a0cc7ba54 = []byte{
+	// 677 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0x67, 0x93, 0x26, 0xb5, 0x13, 0x2b, 0x75, 0xac, 0x65, 0x09, 0xb5, 0x94, 0xb5, 0x87, 0x50,
+	0xe8, 0x6c, 0x9b, 0x5a, 0x68, 0x3c, 0x48, 0x2d, 0x55, 0x0f, 0xb6, 0x50, 0x36, 0x82, 0x20, 0x78,
+	0x98, 0x6c, 0x5f, 0xb7, 0x6b, 0x76, 0x77, 0xd6, 0x99, 0xd9, 0x94, 0x20, 0x5e, 0xbc, 0x79, 0xf6,
+	0xea, 0xc9, 0xa3, 0x9f, 0xc4, 0xa3, 0xe0, 0x17, 0x90, 0xe2, 0x07, 0x91, 0x79, 0xbb, 0x69, 0xb3,
+	0x31, 0x04, 0xbc, 0xcd, 0xef, 0xf7, 0xfe, 0xee, 0xef, 0xbd, 0x7d, 0x64, 0x3b, 0xed, 0x07, 0x2e,
+	0x4f, 0x43, 0x3f, 0x0a, 0x21, 0xd1, 0xee, 0xa5, 0x90, 0xfd, 0xf3, 0x48, 0x5c, 0x2a, 0xcd, 0xb5,
+	0xba, 0x46, 0x5b, 0x08, 0x59, 0x2a, 0x85, 0x16, 0x74, 0xb1, 0xe4, 0xd3, 0x5c, 0x0d, 0x84, 0x08,
+	0x22, 0x30, 0x39, 0x5c, 0x9e, 0x24, 0x42, 0x73, 0x1d, 0x8a, 0xa4, 0x70, 0x6e, 0x3e, 0xea, 0xef,
+	0x2b, 0x16, 0x0a, 0x63, 0x8d, 0xb9, 0x7f, 0x11, 0x26, 0x20, 0x87, 0x6e, 0x51, 0x52, 0xb9, 0x31,
+	0x68, 0xee, 0x0e, 0x76, 0xdc, 0x00, 0x12, 0x90, 0x5c, 0xc3, 0x59, 0x1e, 0xe5, 0x7c, 0xad, 0x90,
+	0xe5, 0xd7, 0x45, 0x95, 0xae, 0xa9, 0xe2, 0xc1, 0xfb, 0x0c, 0x94, 0xa6, 0xab, 0x64, 0x21, 0xe1,
+	0x31, 0xa8, 0x94, 0xfb, 0x60, 0x5b, 0xeb, 0x56, 0x6b, 0xc1, 0xbb, 0x21, 0xa8, 0x4d, 0xe6, 0x03,
+	0x29, 0xb2, 0xf4, 0x70, 0x68, 0x57, 0xd0, 0x36, 0x82, 0x74, 0x99, 0xd4, 0xf0, 0x69, 0x57, 0x91,
+	0xcf, 0x01, 0x75, 0xc8, 0x6d, 0xa5, 0xb9, 0xd4, 0x70, 0xf6, 0xf4, 0x5c, 0x83, 0xb4, 0xe7, 0xd0,
+	0x58, 0xe2, 0xe8, 0x06, 0x59, 0x2c, 0xf0, 0x21, 0x9c, 0x0b, 0x09, 0x76, 0x0d, 0x9d, 0xca, 0x24,
+	0x5d, 0x21, 0xf5, 0x5e, 0xe6, 0xf7, 0x41, 0xdb, 0x75, 0x34, 0x17, 0xc8, 0x44, 0x47, 0xbc, 0x07,
+	0x51, 0x17, 0x22, 0xf0, 0xb5, 0x90, 0xf6, 0x7c, 0x1e, 0x5d, 0x22, 0x29, 0x23, 0x54, 0x8b, 0xf4,
+	0x39, 0x0f, 0xa3, 0x4c, 0xc2, 0x09, 0x28, 0xc5, 0x03, 0x50, 0xf6, 0xad, 0x75, 0xab, 0x55, 0xf3,
+	0xa6, 0x58, 0x9c, 0xb7, 0xe4, 0xde, 0x51, 0x26, 0x51, 0xe7, 0x53, 0x90, 0x3e, 0x24, 0x3a, 0x8c,
+	0x40, 0xd1, 0x25, 0x52, 0x4d, 0xf7, 0xb6, 0x51, 0x96, 0xaa, 0x67, 0x9e, 0xc8, 0x74, 0xb6, 0x51,
+	0x0c, 0xc3, 0x74, 0x0a, 0x66, 0x0f, 0x65, 0x40, 0x66, 0x2f, 0x67, 0x3a, 0xf8, 0xed, 0xc8, 0x74,
+	0x9c, 0x03, 0x72, 0xa7, 0x5c, 0xd1, 0x08, 0x1b, 0xe7, 0xcf, 0x42, 0xf4, 0x11, 0x34, 0xc2, 0xfa,
+	0x22, 0x4b, 0x74, 0x51, 0x23, 0x07, 0xce, 0xf7, 0x39, 0xb2, 0x58, 0x9a, 0xdf, 0xcd, 0x00, 0xac,
+	0xf1, 0x01, 0x1c, 0x93, 0x46, 0x2e, 0x54, 0xd7, 0xa8, 0x89, 0x39, 0x1a, 0xed, 0x4d, 0x96, 0xef,
+	0x0c, 0x1b, 0xdf, 0x19, 0x96, 0xf6, 0x03, 0x43, 0x28, 0x66, 0x76, 0x86, 0x0d, 0x76, 0xd8, 0xab,
+	0x30, 0x06, 0x6f, 0x3c, 0x9c, 0x1e, 0x90, 0x7a, 0x7a, 0xc1, 0x15, 0x28, 0xbb, 0xba, 0x5e, 0x6d,
+	0x35, 0xda, 0x2d, 0x56, 0xda, 0x54, 0x56, 0xea, 0x88, 0x9d, 0xa2, 0xeb, 0xb3, 0x44, 0xcb, 0xa1,
+	0x57, 0xc4, 0xd1, 0x03, 0xb2, 0x70, 0x56, 0x08, 0xab, 0x50, 0x91, 0x46, 0xdb, 0x99, 0x48, 0x32,
+	0x45, 0x78, 0xef, 0x26, 0x88, 0x72, 0x72, 0x57, 0x82, 0x12, 0x99, 0xf4, 0x41, 0x8d, 0x5c, 0xed,
+	0x1a, 0xb6, 0xb3, 0x3b, 0xb3, 0x1d, 0x6f, 0x32, 0x2a, 0xef, 0xec, 0xdf, 0x6c, 0xf4, 0x64, 0xea,
+	0xb6, 0xd4, 0xb1, 0xc6, 0x83, 0x89, 0x1a, 0x65, 0xaf, 0x69, 0xcb, 0xd4, 0xec, 0x90, 0xc6, 0x98,
+	0x14, 0x66, 0x1d, 0xfa, 0x30, 0x2c, 0xc6, 0x64, 0x9e, 0x66, 0x74, 0x03, 0x1e, 0x65, 0x30, 0x1a,
+	0x31, 0x82, 0xc7, 0x95, 0x7d, 0xab, 0x79, 0x44, 0x56, 0xa6, 0xb7, 0xfd, 0x3f, 0x59, 0x9c, 0x97,
+	0xe4, 0xfe, 0xc4, 0xbf, 0xae, 0x52, 0x91, 0x28, 0xa0, 0x6d, 0x52, 0x0b, 0x35, 0xc4, 0xca, 0xb6,
+	0xf0, 0xdb, 0x56, 0x67, 0xe9, 0xe7, 0xe5, 0xae, 0xed, 0x6f, 0xd6, 0xc4, 0xe5, 0xe8, 0x82, 0x1c,
+	0x84, 0x3e, 0xd0, 0xcf, 0x16, 0x59, 0x7a, 0x01, 0xba, 0xbc, 0x95, 0x0f, 0x67, 0xa6, 0xcc, 0x6f,
+	0x4e, 0x73, 0x63, 0xb6, 0x53, 0xde, 0xac, 0xb3, 0xf9, 0xe9, 0xd7, 0x9f, 0x2f, 0x95, 0x0d, 0xea,
+	0xe0, 0x21, 0x1c, 0xec, 0x4c, 0xdc, 0x4e, 0xf7, 0xc3, 0xf5, 0x99, 0xfa, 0x78, 0x78, 0xfc, 0xe3,
+	0x6a, 0xcd, 0xfa, 0x79, 0xb5, 0x66, 0xfd, 0xbe, 0x5a, 0xb3, 0xde, 0x3c, 0x09, 0x42, 0x7d, 0x91,
+	0xf5, 0x98, 0x2f, 0x62, 0x97, 0xcb, 0x40, 0xa4, 0x52, 0xbc, 0xc3, 0xc7, 0xd6, 0x75, 0x5d, 0x77,
+	0xc6, 0x91, 0xee, 0xd5, 0xf1, 0x66, 0xee, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x00, 0xbb, 0x01,
+	0xf3, 0xca, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WorkflowStatsServiceClient is the client API for WorkflowStatsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkflowStatsServiceClient interface {
+	GetWorkflowStats(ctx context.Context, in *WorkflowStatsRequest, opts ...grpc.CallOption) (*WorkflowStatsResponse, error)
+}
+
+type workflowStatsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkflowStatsServiceClient(cc *grpc.ClientConn) WorkflowStatsServiceClient {
+	return &workflowStatsServiceClient{cc}
+}
+
+func (c *workflowStatsServiceClient) GetWorkflowStats(ctx context.Context, in *WorkflowStatsRequest, opts ...grpc.CallOption) (*WorkflowStatsResponse, error) {
+	out := new(WorkflowStatsResponse)
+	err := c.cc.Invoke(ctx, "/workflowstats.WorkflowStatsService/GetWorkflowStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkflowStatsServiceServer is the server API for WorkflowStatsService service.
+type WorkflowStatsServiceServer interface {
+	GetWorkflowStats(context.Context, *WorkflowStatsRequest) (*WorkflowStatsResponse, error)
+}
+
+// UnimplementedWorkflowStatsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkflowStatsServiceServer struct {
+}
+
+func (*UnimplementedWorkflowStatsServiceServer) GetWorkflowStats(ctx context.Context, req *WorkflowStatsRequest) (*WorkflowStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowStats not implemented")
+}
+
+func RegisterWorkflowStatsServiceServer(s *grpc.Server, srv WorkflowStatsServiceServer) {
+	s.RegisterService(&_WorkflowStatsService_serviceDesc, srv)
+}
+
+func _WorkflowStatsService_GetWorkflowStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowStatsServiceServer).GetWorkflowStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowstats.WorkflowStatsService/GetWorkflowStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowStatsServiceServer).GetWorkflowStats(ctx, req.(*WorkflowStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WorkflowStatsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "workflowstats.WorkflowStatsService",
+	HandlerType: (*WorkflowStatsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWorkflowStats",
+			Handler:    _WorkflowStatsService_GetWorkflowStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/workflowstats/workflow-stats.proto",
+}
+
+func (m *WorkflowStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TopFailureMessages != 0 {
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(m.TopFailureMessages))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.LabelSelector) > 0 {
+		i -= len(m.LabelSelector)
+		copy(dAtA[i:], m.LabelSelector)
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(len(m.LabelSelector)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Bucket) > 0 {
+		i -= len(m.Bucket)
+		copy(dAtA[i:], m.Bucket)
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(len(m.Bucket)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StartedBefore) > 0 {
+		i -= len(m.StartedBefore)
+		copy(dAtA[i:], m.StartedBefore)
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(len(m.StartedBefore)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StartedAfter) > 0 {
+		i -= len(m.StartedAfter)
+		copy(dAtA[i:], m.StartedAfter)
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(len(m.StartedAfter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(len(m.GroupBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DurationPercentiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DurationPercentiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DurationPercentiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.P99 != 0 {
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(m.P99))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.P95 != 0 {
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(m.P95))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.P90 != 0 {
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(m.P90))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.P50 != 0 {
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(m.P50))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailureMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailureMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailureMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TopFailureMessages) > 0 {
+		for iNdEx := len(m.TopFailureMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopFailureMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ResourcesDuration) > 0 {
+		for k := range m.ResourcesDuration {
+			v := m.ResourcesDuration[k]
+			baseI := i
+			i = encodeVarintWorkflowStats(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintWorkflowStats(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintWorkflowStats(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Durations != nil {
+		{
+			size, err := m.Durations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowStats(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Phases) > 0 {
+		for k := range m.Phases {
+			v := m.Phases[k]
+			baseI := i
+			i = encodeVarintWorkflowStats(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintWorkflowStats(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintWorkflowStats(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BucketStart != nil {
+		{
+			size, err := m.BucketStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowStats(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintWorkflowStats(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflowStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflowStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WorkflowStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	l = len(m.StartedAfter)
+	if l > 0 {
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	l = len(m.StartedBefore)
+	if l > 0 {
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	l = len(m.LabelSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	if m.TopFailureMessages != 0 {
+		n += 1 + sovWorkflowStats(uint64(m.TopFailureMessages))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DurationPercentiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.P50 != 0 {
+		n += 1 + sovWorkflowStats(uint64(m.P50))
+	}
+	if m.P90 != 0 {
+		n += 1 + sovWorkflowStats(uint64(m.P90))
+	}
+	if m.P95 != 0 {
+		n += 1 + sovWorkflowStats(uint64(m.P95))
+	}
+	if m.P99 != 0 {
+		n += 1 + sovWorkflowStats(uint64(m.P99))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FailureMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovWorkflowStats(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	if m.BucketStart != nil {
+		l = m.BucketStart.Size()
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	if len(m.Phases) > 0 {
+		for k, v := range m.Phases {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovWorkflowStats(uint64(len(k))) + 1 + sovWorkflowStats(uint64(v))
+			n += mapEntrySize + 1 + sovWorkflowStats(uint64(mapEntrySize))
+		}
+	}
+	if m.Durations != nil {
+		l = m.Durations.Size()
+		n += 1 + l + sovWorkflowStats(uint64(l))
+	}
+	if len(m.ResourcesDuration) > 0 {
+		for k, v := range m.ResourcesDuration {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovWorkflowStats(uint64(len(k))) + 1 + sovWorkflowStats(uint64(v))
+			n += mapEntrySize + 1 + sovWorkflowStats(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TopFailureMessages) > 0 {
+		for _, e := range m.TopFailureMessages {
+			l = e.Size()
+			n += 1 + l + sovWorkflowStats(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovWorkflowStats(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWorkflowStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWorkflowStats(x uint64) (n int) {
+	return sovWorkflowStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WorkflowStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedBefore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopFailureMessages", wireType)
+			}
+			m.TopFailureMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopFailureMessages |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DurationPercentiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DurationPercentiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DurationPercentiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			m.P50 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P50 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			m.P90 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P90 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P95", wireType)
+			}
+			m.P95 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P95 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
+			}
+			m.P99 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P99 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailureMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailureMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailureMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BucketStart == nil {
+				m.BucketStart = &v1.Time{}
+			}
+			if err := m.BucketStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Phases == nil {
+				m.Phases = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflowStats
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowStats
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWorkflowStats
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthWorkflowStats
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowStats
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWorkflowStats(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthWorkflowStats
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Phases[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Durations == nil {
+				m.Durations = &DurationPercentiles{}
+			}
+			if err := m.Durations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourcesDuration == nil {
+				m.ResourcesDuration = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflowStats
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowStats
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWorkflowStats
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthWorkflowStats
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowStats
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWorkflowStats(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthWorkflowStats
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesDuration[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopFailureMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopFailureMessages = append(m.TopFailureMessages, &FailureMessage{})
+			if err := m.TopFailureMessages[len(m.TopFailureMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &WorkflowStats{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWorkflowStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWorkflowStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWorkflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWorkflowStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWorkflowStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWorkflowStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWorkflowStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWorkflowStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWorkflowStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,9 @@
 package v1alpha1
 
 import (
+	"strings"
+	"time"
+
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -42,8 +45,8 @@ const annotationKeyLatestSchedule = workflow.CronWorkflowFullName + "/last-used-
 type CronWorkflowSpec struct {
 	// WorkflowSpec is the spec of the workflow to be run
 	WorkflowSpec WorkflowSpec `json:"workflowSpec" protobuf:"bytes,1,opt,name=workflowSpec,casttype=WorkflowSpec"`
-	// Schedule is a schedule to run the Workflow in Cron format
-	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Schedule is a schedule to run the Workflow in Cron format. Either schedule or schedules must be set.
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
 	// ConcurrencyPolicy is the K8s-style concurrency policy that will be used
 	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" protobuf:"bytes,3,opt,name=concurrencyPolicy,casttype=ConcurrencyPolicy"`
 	// Suspend is a flag that will stop new CronWorkflows from running if set to true
@@ -59,6 +62,56 @@ type CronWorkflowSpec struct {
 	Timezone string `json:"timezone,omitempty" protobuf:"bytes,8,opt,name=timezone"`
 	// WorkflowMetadata contains some metadata of the workflow to be run
 	WorkflowMetadata *metav1.ObjectMeta `json:"workflowMetadata,omitempty" protobuf:"bytes,9,opt,name=workflowMeta"`
+	// Schedules are more schedules to run the Workflow on, in addition to Schedule
+	Schedules []CronWorkflowSchedule `json:"schedules,omitempty" protobuf:"bytes,10,rep,name=schedules"`
+	// BlackoutWindows are periods of time during which the scheduled runs are skipped, e.g. change freezes
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows,omitempty" protobuf:"bytes,11,rep,name=blackoutWindows"`
+	// BlackoutCalendars are keys of ConfigMaps that contain iCalendar events, e.g. holidays, during which the scheduled
+	// runs are skipped
+	BlackoutCalendars []v1.ConfigMapKeySelector `json:"blackoutCalendars,omitempty" protobuf:"bytes,12,rep,name=blackoutCalendars"`
+	// StopStrategy suspends the CronWorkflow when its Workflows keep failing
+	StopStrategy *StopStrategy `json:"stopStrategy,omitempty" protobuf:"bytes,13,opt,name=stopStrategy"`
+	// Jitter delays every scheduled run by the same amount of time, less than the jitter, derived from the namespace and
+	// name of the CronWorkflow. It spreads the runs of CronWorkflows with the same schedule, e.g. "10m".
+	Jitter *metav1.Duration `json:"jitter,omitempty" protobuf:"bytes,14,opt,name=jitter"`
+}
+
+// StopStrategy suspends a CronWorkflow when either of its conditions is met by a completed Workflow
+type StopStrategy struct {
+	// ConsecutiveFailures suspends the CronWorkflow after this many of its Workflows fail or error in a row
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty" protobuf:"varint,1,opt,name=consecutiveFailures"`
+	// Expression suspends the CronWorkflow once it is true. It can use the counters of the status of the CronWorkflow
+	// as `cronworkflow.succeeded`, `cronworkflow.failed` and `cronworkflow.consecutiveFailures`, e.g.
+	// "cronworkflow.failed >= 10"
+	Expression string `json:"expression,omitempty" protobuf:"bytes,2,opt,name=expression"`
+}
+
+// BlackoutWindow is a period of time during which the scheduled runs of a CronWorkflow are skipped
+type BlackoutWindow struct {
+	// Name describes the window, e.g. "year-end freeze"
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Start is the time the window starts, inclusive
+	Start metav1.Time `json:"start" protobuf:"bytes,2,opt,name=start"`
+	// End is the time the window ends, exclusive
+	End metav1.Time `json:"end" protobuf:"bytes,3,opt,name=end"`
+}
+
+// Contains returns whether the time is within the window
+func (w BlackoutWindow) Contains(t time.Time) bool {
+	return !t.Before(w.Start.Time) && t.Before(w.End.Time)
+}
+
+// CronWorkflowSchedule is one of the schedules of a CronWorkflow
+type CronWorkflowSchedule struct {
+	// Name is the name of the schedule, which must be unique. Defaults to the schedule and its timezone.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Schedule is a schedule to run the Workflow in Cron format
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Timezone is the timezone against which the cron schedule will be calculated, e.g. "Asia/Tokyo". Defaults to the
+	// timezone of the CronWorkflow.
+	Timezone string `json:"timezone,omitempty" protobuf:"bytes,3,opt,name=timezone"`
+	// Parameters override the arguments of the Workflows that are run on this schedule
+	Parameters []Parameter `json:"parameters,omitempty" protobuf:"bytes,4,rep,name=parameters"`
 }
 
 // CronWorkflowStatus is the status of a CronWorkflow
@@ -69,13 +122,37 @@ type CronWorkflowStatus struct {
 	LastScheduledTime *metav1.Time `json:"lastScheduledTime" protobuf:"bytes,2,opt,name=lastScheduledTime"`
 	// Conditions is a list of conditions the CronWorkflow may have
 	Conditions Conditions `json:"conditions" protobuf:"bytes,3,rep,name=conditions"`
+	// LastScheduledTimes are the last times that the CronWorkflow was scheduled on each of its schedules, by name
+	LastScheduledTimes map[string]metav1.Time `json:"lastScheduledTimes,omitempty" protobuf:"bytes,4,rep,name=lastScheduledTimes"`
+	// RecentRuns are the most recently completed Workflows of the CronWorkflow, latest first
+	RecentRuns []CronWorkflowRun `json:"recentRuns,omitempty" protobuf:"bytes,5,rep,name=recentRuns"`
+	// Succeeded is the number of Workflows of the CronWorkflow that have succeeded
+	Succeeded int64 `json:"succeeded,omitempty" protobuf:"varint,6,opt,name=succeeded"`
+	// Failed is the number of Workflows of the CronWorkflow that have failed or errored
+	Failed int64 `json:"failed,omitempty" protobuf:"varint,7,opt,name=failed"`
+	// ConsecutiveFailures is the number of the latest Workflows of the CronWorkflow that have failed or errored in a row
+	ConsecutiveFailures int64 `json:"consecutiveFailures,omitempty" protobuf:"varint,8,opt,name=consecutiveFailures"`
+}
+
+// CronWorkflowRun is a completed Workflow of a CronWorkflow
+type CronWorkflowRun struct {
+	// Name is the name of the Workflow
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ScheduledTime is the time the Workflow was scheduled to run at
+	ScheduledTime *metav1.Time `json:"scheduledTime,omitempty" protobuf:"bytes,2,opt,name=scheduledTime"`
+	// Phase is the phase the Workflow completed in
+	Phase WorkflowPhase `json:"phase" protobuf:"bytes,3,opt,name=phase,casttype=WorkflowPhase"`
+	// FinishedAt is the time the Workflow completed
+	FinishedAt metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,4,opt,name=finishedAt"`
+	// Duration is how long the Workflow ran for
+	Duration metav1.Duration `json:"duration,omitempty" protobuf:"bytes,5,opt,name=duration"`
 }
 
 func (c *CronWorkflow) IsUsingNewSchedule() bool {
 	lastUsedSchedule, exists := c.Annotations[annotationKeyLatestSchedule]
 	// If last-used-schedule does not exist, or if it does not match the current schedule then the CronWorkflow schedule
 	// was just updated
-	return !exists || lastUsedSchedule != c.Spec.GetScheduleString()
+	return !exists || lastUsedSchedule != c.Spec.GetScheduleFingerprint()
 }
 
 func (c *CronWorkflow) SetSchedule(schedule string) {
@@ -89,14 +166,61 @@ func (c *CronWorkflow) GetLatestSchedule() string {
 	return c.Annotations[annotationKeyLatestSchedule]
 }
 
+// GetScheduleString returns the schedules of the CronWorkflow with their timezones, separated by commas
 func (c *CronWorkflowSpec) GetScheduleString() string {
-	scheduleString := c.Schedule
-	if c.Timezone != "" {
-		scheduleString = "CRON_TZ=" + c.Timezone + " " + scheduleString
+	var scheduleStrings []string
+	for _, schedule := range c.GetSchedules() {
+		scheduleStrings = append(scheduleStrings, schedule.GetScheduleString())
+	}
+	return strings.Join(scheduleStrings, ",")
+}
+
+// GetScheduleFingerprint returns the schedule string followed by the jitter, if any, as they both determine when the
+// CronWorkflow is run
+func (c *CronWorkflowSpec) GetScheduleFingerprint() string {
+	if c.Jitter == nil || c.Jitter.Duration <= 0 {
+		return c.GetScheduleString()
+	}
+	return c.GetScheduleString() + ";jitter=" + c.Jitter.Duration.String()
+}
+
+// GetSchedules returns Schedule followed by Schedules, with their timezones and names defaulted
+func (c *CronWorkflowSpec) GetSchedules() []CronWorkflowSchedule {
+	var schedules []CronWorkflowSchedule
+	if c.Schedule != "" {
+		schedules = append(schedules, CronWorkflowSchedule{Schedule: c.Schedule})
+	}
+	for _, schedule := range c.Schedules {
+		schedules = append(schedules, *schedule.DeepCopy())
+	}
+	for i := range schedules {
+		if schedules[i].Timezone == "" {
+			schedules[i].Timezone = c.Timezone
+		}
+		if schedules[i].Name == "" {
+			schedules[i].Name = schedules[i].GetScheduleString()
+		}
+	}
+	return schedules
+}
+
+func (s *CronWorkflowSchedule) GetScheduleString() string {
+	scheduleString := s.Schedule
+	if s.Timezone != "" {
+		scheduleString = "CRON_TZ=" + s.Timezone + " " + scheduleString
 	}
 	return scheduleString
 }
 
+// GetLastScheduledTime returns the last time that the CronWorkflow was scheduled on a schedule, falling back to the
+// last time it was scheduled on any schedule
+func (c *CronWorkflowStatus) GetLastScheduledTime(scheduleName string) *metav1.Time {
+	if t, ok := c.LastScheduledTimes[scheduleName]; ok {
+		return &t
+	}
+	return c.LastScheduledTime
+}
+
 func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
 	for _, ref := range c.Active {
 		if uid == ref.UID {
@@ -109,4 +233,9 @@ func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
 const (
 	// ConditionTypeSubmissionError signifies that there was an error when submitting the CronWorkflow as a Workflow
 	ConditionTypeSubmissionError ConditionType = "SubmissionError"
+	// ConditionTypeSkipped signifies that the last scheduled run of the CronWorkflow was skipped, as it was in a
+	// blackout window
+	ConditionTypeSkipped ConditionType = "Skipped"
+	// ConditionTypeStopped signifies that the CronWorkflow was suspended by its stop strategy
+	ConditionTypeStopped ConditionType = "Stopped"
 )
@@ -2,9 +2,11 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestCronWorkflowStatus_HasActiveUID(t *testing.T) {
@@ -26,4 +28,49 @@ func TestCronWorkflowSpec_GetScheduleString(t *testing.T) {
 
 	cwfSpec.Timezone = "America/Los_Angeles"
 	assert.Equal(t, "CRON_TZ=America/Los_Angeles * * * * *", cwfSpec.GetScheduleString())
+
+	cwfSpec.Schedules = []CronWorkflowSchedule{{Schedule: "0 12 * * 6", Timezone: "Asia/Tokyo"}}
+	assert.Equal(t, "CRON_TZ=America/Los_Angeles * * * * *,CRON_TZ=Asia/Tokyo 0 12 * * 6", cwfSpec.GetScheduleString())
+}
+
+func TestCronWorkflowSpec_GetScheduleFingerprint(t *testing.T) {
+	cwfSpec := CronWorkflowSpec{Schedule: "0 * * * *"}
+	assert.Equal(t, "0 * * * *", cwfSpec.GetScheduleFingerprint())
+
+	cwfSpec.Jitter = &metav1.Duration{Duration: 10 * time.Minute}
+	assert.Equal(t, "0 * * * *;jitter=10m0s", cwfSpec.GetScheduleFingerprint())
+
+	cwf := CronWorkflow{Spec: cwfSpec}
+	cwf.SetSchedule(cwfSpec.GetScheduleFingerprint())
+	assert.False(t, cwf.IsUsingNewSchedule())
+	cwf.Spec.Jitter.Duration = 20 * time.Minute
+	assert.True(t, cwf.IsUsingNewSchedule(), "changing the jitter is a new schedule")
+}
+
+func TestCronWorkflowSpec_GetSchedules(t *testing.T) {
+	cwfSpec := CronWorkflowSpec{
+		Timezone: "America/Los_Angeles",
+		Schedules: []CronWorkflowSchedule{
+			{Name: "weekdays", Schedule: "0 9 * * 1-5"},
+			{Schedule: "0 12 * * 6", Timezone: "Asia/Tokyo"},
+		},
+	}
+	assert.Equal(t, []CronWorkflowSchedule{
+		{Name: "weekdays", Schedule: "0 9 * * 1-5", Timezone: "America/Los_Angeles"},
+		{Name: "CRON_TZ=Asia/Tokyo 0 12 * * 6", Schedule: "0 12 * * 6", Timezone: "Asia/Tokyo"},
+	}, cwfSpec.GetSchedules())
+	assert.Empty(t, cwfSpec.Schedules[1].Name, "the spec is not modified")
+
+	cwfSpec.Schedule = "* * * * *"
+	if schedules := cwfSpec.GetSchedules(); assert.Len(t, schedules, 3) {
+		assert.Equal(t, CronWorkflowSchedule{Name: "CRON_TZ=America/Los_Angeles * * * * *", Schedule: "* * * * *", Timezone: "America/Los_Angeles"}, schedules[0])
+	}
+}
+
+func TestCronWorkflowStatus_GetLastScheduledTime(t *testing.T) {
+	last := metav1.Now()
+	weekdays := metav1.NewTime(last.Add(-time.Hour))
+	cwfStatus := CronWorkflowStatus{LastScheduledTime: &last, LastScheduledTimes: map[string]metav1.Time{"weekdays": weekdays}}
+	assert.Equal(t, &weekdays, cwfStatus.GetLastScheduledTime("weekdays"))
+	assert.Equal(t, &last, cwfStatus.GetLastScheduledTime("saturdays"))
 }
@@ -45,4 +45,13 @@ type Submit struct {
 
 	// Arguments extracted from the event and then set as arguments to the workflow created.
 	Arguments *Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
+
+	// IdempotencyKey is an expression that is evaluated over the event to a string that identifies it, e.g.
+	// `metadata["x-github-delivery"][0]`. If a workflow was submitted with the same key within the idempotency window,
+	// then it is reused rather than submitting another, so that a redelivered event does not run the workflow twice.
+	// An empty key disables deduplication for the event.
+	IdempotencyKey string `json:"idempotencyKey,omitempty" protobuf:"bytes,4,opt,name=idempotencyKey"`
+
+	// IdempotencyWindow is how long a workflow is reused for events with the same idempotency key. Defaults to 1h.
+	IdempotencyWindow *metav1.Duration `json:"idempotencyWindow,omitempty" protobuf:"bytes,5,opt,name=idempotencyWindow"`
 }
@@ -372,10 +372,38 @@ func (m *ArtifactRepository) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactRepository proto.InternalMessageInfo
 
+func (m *ArtifactRepositoryCache) Reset()      { *m = ArtifactRepositoryCache{} }
+func (*ArtifactRepositoryCache) ProtoMessage() {}
+func (*ArtifactRepositoryCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{12}
+}
+func (m *ArtifactRepositoryCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactRepositoryCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactRepositoryCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactRepositoryCache.Merge(m, src)
+}
+func (m *ArtifactRepositoryCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactRepositoryCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactRepositoryCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactRepositoryCache proto.InternalMessageInfo
+
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{12}
+	return fileDescriptor_724696e352c3df5f, []int{13}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +431,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{13}
+	return fileDescriptor_724696e352c3df5f, []int{14}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +459,7 @@ var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 func (m *ArtifactResult) Reset()      { *m = ArtifactResult{} }
 func (*ArtifactResult) ProtoMessage() {}
 func (*ArtifactResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{14}
+	return fileDescriptor_724696e352c3df5f, []int{15}
 }
 func (m *ArtifactResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +487,7 @@ var xxx_messageInfo_ArtifactResult proto.InternalMessageInfo
 func (m *ArtifactResultNodeStatus) Reset()      { *m = ArtifactResultNodeStatus{} }
 func (*ArtifactResultNodeStatus) ProtoMessage() {}
 func (*ArtifactResultNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{15}
+	return fileDescriptor_724696e352c3df5f, []int{16}
 }
 func (m *ArtifactResultNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +515,7 @@ var xxx_messageInfo_ArtifactResultNodeStatus proto.InternalMessageInfo
 func (m *ArtifactSearchQuery) Reset()      { *m = ArtifactSearchQuery{} }
 func (*ArtifactSearchQuery) ProtoMessage() {}
 func (*ArtifactSearchQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{16}
+	return fileDescriptor_724696e352c3df5f, []int{17}
 }
 func (m *ArtifactSearchQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +543,7 @@ var xxx_messageInfo_ArtifactSearchQuery proto.InternalMessageInfo
 func (m *ArtifactSearchResult) Reset()      { *m = ArtifactSearchResult{} }
 func (*ArtifactSearchResult) ProtoMessage() {}
 func (*ArtifactSearchResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{17}
+	return fileDescriptor_724696e352c3df5f, []int{18}
 }
 func (m *ArtifactSearchResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +571,7 @@ var xxx_messageInfo_ArtifactSearchResult proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{18}
+	return fileDescriptor_724696e352c3df5f, []int{19}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +599,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryArtifactRepository) Reset()      { *m = ArtifactoryArtifactRepository{} }
 func (*ArtifactoryArtifactRepository) ProtoMessage() {}
 func (*ArtifactoryArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{19}
+	return fileDescriptor_724696e352c3df5f, []int{20}
 }
 func (m *ArtifactoryArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +627,7 @@ var xxx_messageInfo_ArtifactoryArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{20}
+	return fileDescriptor_724696e352c3df5f, []int{21}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +655,7 @@ var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
 func (*AzureArtifact) ProtoMessage() {}
 func (*AzureArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{21}
+	return fileDescriptor_724696e352c3df5f, []int{22}
 }
 func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +683,7 @@ var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
 func (m *AzureArtifactRepository) Reset()      { *m = AzureArtifactRepository{} }
 func (*AzureArtifactRepository) ProtoMessage() {}
 func (*AzureArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{22}
+	return fileDescriptor_724696e352c3df5f, []int{23}
 }
 func (m *AzureArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +711,7 @@ var xxx_messageInfo_AzureArtifactRepository proto.InternalMessageInfo
 func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
 func (*AzureBlobContainer) ProtoMessage() {}
 func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{23}
+	return fileDescriptor_724696e352c3df5f, []int{24}
 }
 func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +739,7 @@ var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{24}
+	return fileDescriptor_724696e352c3df5f, []int{25}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +767,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuth) Reset()      { *m = BasicAuth{} }
 func (*BasicAuth) ProtoMessage() {}
 func (*BasicAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{25}
+	return fileDescriptor_724696e352c3df5f, []int{26}
 }
 func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -764,10 +792,38 @@ func (m *BasicAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
 
+func (m *BlackoutWindow) Reset()      { *m = BlackoutWindow{} }
+func (*BlackoutWindow) ProtoMessage() {}
+func (*BlackoutWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{27}
+}
+func (m *BlackoutWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlackoutWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlackoutWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlackoutWindow.Merge(m, src)
+}
+func (m *BlackoutWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlackoutWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlackoutWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlackoutWindow proto.InternalMessageInfo
+
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{26}
+	return fileDescriptor_724696e352c3df5f, []int{28}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +851,7 @@ var xxx_messageInfo_Cache proto.InternalMessageInfo
 func (m *ClientCertAuth) Reset()      { *m = ClientCertAuth{} }
 func (*ClientCertAuth) ProtoMessage() {}
 func (*ClientCertAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{29}
 }
 func (m *ClientCertAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +879,7 @@ var xxx_messageInfo_ClientCertAuth proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{30}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +907,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +935,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +963,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +991,7 @@ var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 func (m *ContainerSetRetryStrategy) Reset()      { *m = ContainerSetRetryStrategy{} }
 func (*ContainerSetRetryStrategy) ProtoMessage() {}
 func (*ContainerSetRetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{34}
 }
 func (m *ContainerSetRetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1019,7 @@ var xxx_messageInfo_ContainerSetRetryStrategy proto.InternalMessageInfo
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{35}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1047,7 @@ var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{36}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1075,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1103,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{38}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1131,7 @@ var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1159,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1128,10 +1184,66 @@ func (m *CronWorkflowList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 
+func (m *CronWorkflowRun) Reset()      { *m = CronWorkflowRun{} }
+func (*CronWorkflowRun) ProtoMessage() {}
+func (*CronWorkflowRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{41}
+}
+func (m *CronWorkflowRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowRun.Merge(m, src)
+}
+func (m *CronWorkflowRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowRun proto.InternalMessageInfo
+
+func (m *CronWorkflowSchedule) Reset()      { *m = CronWorkflowSchedule{} }
+func (*CronWorkflowSchedule) ProtoMessage() {}
+func (*CronWorkflowSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{42}
+}
+func (m *CronWorkflowSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowSchedule.Merge(m, src)
+}
+func (m *CronWorkflowSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowSchedule proto.InternalMessageInfo
+
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{43}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1271,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{44}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1299,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{45}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1327,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{46}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1355,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1383,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{48}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1411,7 @@ var xxx_messageInfo_DataSource proto.InternalMessageInfo
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{49}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1439,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1467,7 @@ var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{51}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1495,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSArtifactRepository) Reset()      { *m = GCSArtifactRepository{} }
 func (*GCSArtifactRepository) ProtoMessage() {}
 func (*GCSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{52}
 }
 func (m *GCSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1523,7 @@ var xxx_messageInfo_GCSArtifactRepository proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{53}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1551,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{54}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1579,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{55}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1607,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1635,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSArtifactRepository) Reset()      { *m = HDFSArtifactRepository{} }
 func (*HDFSArtifactRepository) ProtoMessage() {}
 func (*HDFSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{57}
 }
 func (m *HDFSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1663,7 @@ var xxx_messageInfo_HDFSArtifactRepository proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{58}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1691,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{59}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1719,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{60}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1747,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1775,7 @@ var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 func (m *HTTPAuth) Reset()      { *m = HTTPAuth{} }
 func (*HTTPAuth) ProtoMessage() {}
 func (*HTTPAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *HTTPAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +1803,7 @@ var xxx_messageInfo_HTTPAuth proto.InternalMessageInfo
 func (m *HTTPBodySource) Reset()      { *m = HTTPBodySource{} }
 func (*HTTPBodySource) ProtoMessage() {}
 func (*HTTPBodySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *HTTPBodySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1831,7 @@ var xxx_messageInfo_HTTPBodySource proto.InternalMessageInfo
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1859,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +1887,7 @@ var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{66}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +1915,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +1943,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{68}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +1971,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{69}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +1999,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *LabelKeys) Reset()      { *m = LabelKeys{} }
 func (*LabelKeys) ProtoMessage() {}
 func (*LabelKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{70}
 }
 func (m *LabelKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2027,7 @@ var xxx_messageInfo_LabelKeys proto.InternalMessageInfo
 func (m *LabelValueFrom) Reset()      { *m = LabelValueFrom{} }
 func (*LabelValueFrom) ProtoMessage() {}
 func (*LabelValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{71}
 }
 func (m *LabelValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2055,7 @@ var xxx_messageInfo_LabelValueFrom proto.InternalMessageInfo
 func (m *LabelValues) Reset()      { *m = LabelValues{} }
 func (*LabelValues) ProtoMessage() {}
 func (*LabelValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{72}
 }
 func (m *LabelValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2083,7 @@ var xxx_messageInfo_LabelValues proto.InternalMessageInfo
 func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
 func (*LifecycleHook) ProtoMessage() {}
 func (*LifecycleHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2111,7 @@ var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{74}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2139,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *ManifestFrom) Reset()      { *m = ManifestFrom{} }
 func (*ManifestFrom) ProtoMessage() {}
 func (*ManifestFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{75}
 }
 func (m *ManifestFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2167,7 @@ var xxx_messageInfo_ManifestFrom proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{76}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2195,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{77}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2223,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2251,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2279,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2307,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2335,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{82}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2363,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{83}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2391,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeResult) Reset()      { *m = NodeResult{} }
 func (*NodeResult) ProtoMessage() {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{84}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2419,7 @@ var xxx_messageInfo_NodeResult proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2447,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2475,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2503,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OAuth2Auth) Reset()      { *m = OAuth2Auth{} }
 func (*OAuth2Auth) ProtoMessage() {}
 func (*OAuth2Auth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *OAuth2Auth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2531,7 @@ var xxx_messageInfo_OAuth2Auth proto.InternalMessageInfo
 func (m *OAuth2EndpointParam) Reset()      { *m = OAuth2EndpointParam{} }
 func (*OAuth2EndpointParam) ProtoMessage() {}
 func (*OAuth2EndpointParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{89}
 }
 func (m *OAuth2EndpointParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2559,7 @@ var xxx_messageInfo_OAuth2EndpointParam proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2587,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSArtifactRepository) Reset()      { *m = OSSArtifactRepository{} }
 func (*OSSArtifactRepository) ProtoMessage() {}
 func (*OSSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *OSSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +2615,7 @@ var xxx_messageInfo_OSSArtifactRepository proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{92}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +2643,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *OSSLifecycleRule) Reset()      { *m = OSSLifecycleRule{} }
 func (*OSSLifecycleRule) ProtoMessage() {}
 func (*OSSLifecycleRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{93}
 }
 func (m *OSSLifecycleRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2671,7 @@ var xxx_messageInfo_OSSLifecycleRule proto.InternalMessageInfo
 func (m *Object) Reset()      { *m = Object{} }
 func (*Object) ProtoMessage() {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{94}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +2699,7 @@ var xxx_messageInfo_Object proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{95}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +2727,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{96}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +2755,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +2783,7 @@ var xxx_messageInfo_Parameter proto.InternalMessageInfo
 func (m *Plugin) Reset()      { *m = Plugin{} }
 func (*Plugin) ProtoMessage() {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{98}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +2811,7 @@ var xxx_messageInfo_Plugin proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{99}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +2839,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{100}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2752,10 +2864,38 @@ func (m *Prometheus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 
+func (m *RateLimit) Reset()      { *m = RateLimit{} }
+func (*RateLimit) ProtoMessage() {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{101}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{102}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2783,7 +2923,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{103}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2811,7 +2951,7 @@ var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{104}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2839,7 +2979,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{105}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2867,7 +3007,7 @@ var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{101}
+	return fileDescriptor_724696e352c3df5f, []int{106}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3035,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{102}
+	return fileDescriptor_724696e352c3df5f, []int{107}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2923,7 +3063,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3ArtifactRepository) Reset()      { *m = S3ArtifactRepository{} }
 func (*S3ArtifactRepository) ProtoMessage() {}
 func (*S3ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{103}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *S3ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2951,7 +3091,7 @@ var xxx_messageInfo_S3ArtifactRepository proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{104}
+	return fileDescriptor_724696e352c3df5f, []int{109}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2979,7 +3119,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *S3EncryptionOptions) Reset()      { *m = S3EncryptionOptions{} }
 func (*S3EncryptionOptions) ProtoMessage() {}
 func (*S3EncryptionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{105}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *S3EncryptionOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3007,7 +3147,7 @@ var xxx_messageInfo_S3EncryptionOptions proto.InternalMessageInfo
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{106}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3035,7 +3175,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{107}
+	return fileDescriptor_724696e352c3df5f, []int{112}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3063,7 +3203,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3231,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{114}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3119,7 +3259,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{115}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3144,10 +3284,38 @@ func (m *Sequence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sequence proto.InternalMessageInfo
 
+func (m *StopStrategy) Reset()      { *m = StopStrategy{} }
+func (*StopStrategy) ProtoMessage() {}
+func (*StopStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{116}
+}
+func (m *StopStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StopStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopStrategy.Merge(m, src)
+}
+func (m *StopStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopStrategy proto.InternalMessageInfo
+
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{117}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3343,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{118}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3203,7 +3371,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3399,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3256,10 +3424,38 @@ func (m *SuspendTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 
+func (m *SyncDatabaseRef) Reset()      { *m = SyncDatabaseRef{} }
+func (*SyncDatabaseRef) ProtoMessage() {}
+func (*SyncDatabaseRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{121}
+}
+func (m *SyncDatabaseRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncDatabaseRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncDatabaseRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncDatabaseRef.Merge(m, src)
+}
+func (m *SyncDatabaseRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncDatabaseRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncDatabaseRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncDatabaseRef proto.InternalMessageInfo
+
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{122}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +3483,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{123}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +3511,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3539,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{118}
+	return fileDescriptor_724696e352c3df5f, []int{125}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3371,7 +3567,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{119}
+	return fileDescriptor_724696e352c3df5f, []int{126}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +3595,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{120}
+	return fileDescriptor_724696e352c3df5f, []int{127}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3427,7 +3623,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{121}
+	return fileDescriptor_724696e352c3df5f, []int{128}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3455,7 +3651,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{122}
+	return fileDescriptor_724696e352c3df5f, []int{129}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3483,7 +3679,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{123}
+	return fileDescriptor_724696e352c3df5f, []int{130}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3511,7 +3707,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{124}
+	return fileDescriptor_724696e352c3df5f, []int{131}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3539,7 +3735,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{125}
+	return fileDescriptor_724696e352c3df5f, []int{132}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3567,7 +3763,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{126}
+	return fileDescriptor_724696e352c3df5f, []int{133}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3595,7 +3791,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTask) Reset()      { *m = WorkflowArtifactGCTask{} }
 func (*WorkflowArtifactGCTask) ProtoMessage() {}
 func (*WorkflowArtifactGCTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{127}
+	return fileDescriptor_724696e352c3df5f, []int{134}
 }
 func (m *WorkflowArtifactGCTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3623,7 +3819,7 @@ var xxx_messageInfo_WorkflowArtifactGCTask proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTaskList) Reset()      { *m = WorkflowArtifactGCTaskList{} }
 func (*WorkflowArtifactGCTaskList) ProtoMessage() {}
 func (*WorkflowArtifactGCTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{128}
+	return fileDescriptor_724696e352c3df5f, []int{135}
 }
 func (m *WorkflowArtifactGCTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3651,7 +3847,7 @@ var xxx_messageInfo_WorkflowArtifactGCTaskList proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{129}
+	return fileDescriptor_724696e352c3df5f, []int{136}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3679,7 +3875,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{130}
+	return fileDescriptor_724696e352c3df5f, []int{137}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3707,7 +3903,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{131}
+	return fileDescriptor_724696e352c3df5f, []int{138}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3735,7 +3931,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{132}
+	return fileDescriptor_724696e352c3df5f, []int{139}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3763,7 +3959,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowMetadata) Reset()      { *m = WorkflowMetadata{} }
 func (*WorkflowMetadata) ProtoMessage() {}
 func (*WorkflowMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{133}
+	return fileDescriptor_724696e352c3df5f, []int{140}
 }
 func (m *WorkflowMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3791,7 +3987,7 @@ var xxx_messageInfo_WorkflowMetadata proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{134}
+	return fileDescriptor_724696e352c3df5f, []int{141}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3819,7 +4015,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{135}
+	return fileDescriptor_724696e352c3df5f, []int{142}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3847,7 +4043,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{136}
+	return fileDescriptor_724696e352c3df5f, []int{143}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3875,7 +4071,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskResult) Reset()      { *m = WorkflowTaskResult{} }
 func (*WorkflowTaskResult) ProtoMessage() {}
 func (*WorkflowTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{137}
+	return fileDescriptor_724696e352c3df5f, []int{144}
 }
 func (m *WorkflowTaskResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3903,7 +4099,7 @@ var xxx_messageInfo_WorkflowTaskResult proto.InternalMessageInfo
 func (m *WorkflowTaskResultList) Reset()      { *m = WorkflowTaskResultList{} }
 func (*WorkflowTaskResultList) ProtoMessage() {}
 func (*WorkflowTaskResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{138}
+	return fileDescriptor_724696e352c3df5f, []int{145}
 }
 func (m *WorkflowTaskResultList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3931,7 +4127,7 @@ var xxx_messageInfo_WorkflowTaskResultList proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{139}
+	return fileDescriptor_724696e352c3df5f, []int{146}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3959,7 +4155,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{140}
+	return fileDescriptor_724696e352c3df5f, []int{147}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3987,7 +4183,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{141}
+	return fileDescriptor_724696e352c3df5f, []int{148}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4015,7 +4211,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{142}
+	return fileDescriptor_724696e352c3df5f, []int{149}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4043,7 +4239,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{143}
+	return fileDescriptor_724696e352c3df5f, []int{150}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4071,7 +4267,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{144}
+	return fileDescriptor_724696e352c3df5f, []int{151}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4099,7 +4295,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{145}
+	return fileDescriptor_724696e352c3df5f, []int{152}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4127,7 +4323,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{146}
+	return fileDescriptor_724696e352c3df5f, []int{153}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4170,6 +4366,7 @@ func init() {
 	proto.RegisterMapType((map[string]Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactNodeSpec.ArtifactsEntry")
 	proto.RegisterType((*ArtifactPaths)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactPaths")
 	proto.RegisterType((*ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepository")
+	proto.RegisterType((*ArtifactRepositoryCache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepositoryCache")
 	proto.RegisterType((*ArtifactRepositoryRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRef")
 	proto.RegisterType((*ArtifactRepositoryRefStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRefStatus")
 	proto.RegisterType((*ArtifactResult)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactResult")
@@ -4187,6 +4384,7 @@ func init() {
 	proto.RegisterType((*AzureBlobContainer)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureBlobContainer")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.BasicAuth")
+	proto.RegisterType((*BlackoutWindow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.BlackoutWindow")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Cache")
 	proto.RegisterType((*ClientCertAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClientCertAuth")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
@@ -4200,8 +4398,11 @@ func init() {
 	proto.RegisterType((*CreateS3BucketOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CreateS3BucketOptions")
 	proto.RegisterType((*CronWorkflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflow")
 	proto.RegisterType((*CronWorkflowList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowList")
+	proto.RegisterType((*CronWorkflowRun)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowRun")
+	proto.RegisterType((*CronWorkflowSchedule)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSchedule")
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
+	proto.RegisterMapType((map[string]v11.Time)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowStatus.LastScheduledTimesEntry")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask")
 	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask.HooksEntry")
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTemplate")
@@ -4262,6 +4463,7 @@ func init() {
 	proto.RegisterType((*Plugin)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Plugin")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
+	proto.RegisterType((*RateLimit)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RateLimit")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceTemplate")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryAffinity")
@@ -4273,13 +4475,16 @@ func init() {
 	proto.RegisterType((*S3EncryptionOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3EncryptionOptions")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
+	proto.RegisterMapType((map[string]int32)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding.HolderWeightsEntry")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreRef")
 	proto.RegisterType((*SemaphoreStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreStatus")
 	proto.RegisterType((*Sequence)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Sequence")
+	proto.RegisterType((*StopStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.StopStrategy")
 	proto.RegisterType((*Submit)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Submit")
 	proto.RegisterType((*SubmitOpts)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SubmitOpts")
 	proto.RegisterType((*SuppliedValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SuppliedValueFrom")
 	proto.RegisterType((*SuspendTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SuspendTemplate")
+	proto.RegisterType((*SyncDatabaseRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SyncDatabaseRef")
 	proto.RegisterType((*Synchronization)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Synchronization")
 	proto.RegisterType((*SynchronizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SynchronizationStatus")
 	proto.RegisterType((*TTLStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TTLStrategy")
@@ -4331,671 +4536,727 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 10618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x7b, 0x70, 0x24, 0xc7,
-	0x79, 0x18, 0xce, 0x59, 0x60, 0xf1, 0xf8, 0xf0, 0x38, 0x5c, 0xdf, 0x6b, 0x09, 0x92, 0x07, 0x7a,
-	0x28, 0xf2, 0xc7, 0xb3, 0x29, 0xc0, 0x3c, 0x4a, 0xbf, 0x30, 0x52, 0x22, 0x09, 0x8f, 0x03, 0x0e,
-	0x04, 0x70, 0x00, 0x7b, 0x71, 0x77, 0x26, 0xc5, 0x48, 0x1a, 0xec, 0x36, 0x76, 0x87, 0xd8, 0x9d,
-	0x59, 0xcd, 0xcc, 0x02, 0x07, 0xf2, 0x28, 0x29, 0xb2, 0x9e, 0xb1, 0x62, 0x25, 0xb6, 0x24, 0x4b,
-	0x4a, 0x52, 0xa5, 0x28, 0x52, 0xa2, 0x52, 0x5c, 0x49, 0xc9, 0x95, 0x3f, 0x52, 0xf6, 0x7f, 0xa9,
-	0x94, 0x4b, 0x29, 0xa7, 0x2a, 0x72, 0x99, 0x89, 0xf4, 0x47, 0x0c, 0x46, 0x70, 0xa2, 0xaa, 0x24,
-	0xa5, 0xaa, 0x44, 0x15, 0x3b, 0xf6, 0xe5, 0x51, 0xa9, 0x7e, 0x4e, 0xf7, 0xec, 0x2c, 0x0e, 0xb8,
-	0x6b, 0xe0, 0x58, 0xf6, 0x5f, 0xc0, 0x7e, 0xfd, 0xf5, 0xf7, 0x75, 0xf7, 0xf4, 0x7c, 0xfd, 0xbd,
-	0xfa, 0x1b, 0x58, 0xab, 0xf9, 0x49, 0xbd, 0xbd, 0x31, 0x59, 0x09, 0x9b, 0x53, 0x5e, 0x54, 0x0b,
-	0x5b, 0x51, 0xf8, 0x2a, 0xfb, 0xe7, 0x9d, 0x3b, 0x61, 0xb4, 0xb5, 0xd9, 0x08, 0x77, 0xe2, 0xa9,
-	0xed, 0xe7, 0xa6, 0x5a, 0x5b, 0xb5, 0x29, 0xaf, 0xe5, 0xc7, 0x53, 0x12, 0x3a, 0xb5, 0xfd, 0xac,
-	0xd7, 0x68, 0xd5, 0xbd, 0x67, 0xa7, 0x6a, 0x24, 0x20, 0x91, 0x97, 0x90, 0xea, 0x64, 0x2b, 0x0a,
-	0x93, 0x10, 0x7d, 0x20, 0xa5, 0x38, 0x29, 0x29, 0xb2, 0x7f, 0x3e, 0xac, 0x28, 0x4e, 0x6e, 0x3f,
-	0x37, 0xd9, 0xda, 0xaa, 0x4d, 0x52, 0x8a, 0x93, 0x12, 0x3a, 0x29, 0x29, 0x8e, 0xbf, 0x53, 0x1b,
-	0x53, 0x2d, 0xac, 0x85, 0x53, 0x8c, 0xf0, 0x46, 0x7b, 0x93, 0xfd, 0x62, 0x3f, 0xd8, 0x7f, 0x9c,
-	0xe1, 0xb8, 0xbb, 0xf5, 0x7c, 0x3c, 0xe9, 0x87, 0x74, 0x7c, 0x53, 0x95, 0x30, 0x22, 0x53, 0xdb,
-	0x1d, 0x83, 0x1a, 0xbf, 0xa4, 0xe1, 0xb4, 0xc2, 0x86, 0x5f, 0xd9, 0x9d, 0xda, 0x7e, 0x76, 0x83,
-	0x24, 0x9d, 0xe3, 0x1f, 0x7f, 0x57, 0x8a, 0xda, 0xf4, 0x2a, 0x75, 0x3f, 0x20, 0xd1, 0x6e, 0x3a,
-	0xff, 0x26, 0x49, 0xbc, 0x3c, 0x06, 0x53, 0xdd, 0x7a, 0x45, 0xed, 0x20, 0xf1, 0x9b, 0xa4, 0xa3,
-	0xc3, 0xff, 0x7f, 0xb7, 0x0e, 0x71, 0xa5, 0x4e, 0x9a, 0x5e, 0x47, 0xbf, 0xe7, 0xba, 0xf5, 0x6b,
-	0x27, 0x7e, 0x63, 0xca, 0x0f, 0x92, 0x38, 0x89, 0xb2, 0x9d, 0xdc, 0x2b, 0xd0, 0x37, 0xdd, 0x0c,
-	0xdb, 0x41, 0x82, 0xde, 0x0b, 0xc5, 0x6d, 0xaf, 0xd1, 0x26, 0x25, 0xe7, 0x71, 0xe7, 0xe9, 0xc1,
-	0x99, 0x27, 0xbf, 0xbf, 0x37, 0xf1, 0xd0, 0xfe, 0xde, 0x44, 0xf1, 0x06, 0x05, 0xde, 0xd9, 0x9b,
-	0x38, 0x4b, 0x82, 0x4a, 0x58, 0xf5, 0x83, 0xda, 0xd4, 0xab, 0x71, 0x18, 0x4c, 0x5e, 0x6b, 0x37,
-	0x37, 0x48, 0x84, 0x79, 0x1f, 0xf7, 0x0f, 0x0a, 0x70, 0x6a, 0x3a, 0xaa, 0xd4, 0xfd, 0x6d, 0x52,
-	0x4e, 0x28, 0xfd, 0xda, 0x2e, 0xaa, 0x43, 0x4f, 0xe2, 0x45, 0x8c, 0xdc, 0xd0, 0xe5, 0x95, 0xc9,
-	0xfb, 0x7d, 0xf8, 0x93, 0xeb, 0x5e, 0x24, 0x69, 0xcf, 0xf4, 0xef, 0xef, 0x4d, 0xf4, 0xac, 0x7b,
-	0x11, 0xa6, 0x2c, 0x50, 0x03, 0x7a, 0x83, 0x30, 0x20, 0xa5, 0x02, 0x63, 0x75, 0xed, 0xfe, 0x59,
-	0x5d, 0x0b, 0x03, 0x35, 0x8f, 0x99, 0x81, 0xfd, 0xbd, 0x89, 0x5e, 0x0a, 0xc1, 0x8c, 0x0b, 0x9d,
-	0xd7, 0x6b, 0x7e, 0xab, 0xd4, 0x63, 0x6b, 0x5e, 0x2f, 0xfb, 0x2d, 0x73, 0x5e, 0x2f, 0xfb, 0x2d,
-	0x4c, 0x59, 0xb8, 0x9f, 0x2f, 0xc0, 0xe0, 0x74, 0x54, 0x6b, 0x37, 0x49, 0x90, 0xc4, 0xe8, 0xe3,
-	0x00, 0x2d, 0x2f, 0xf2, 0x9a, 0x24, 0x21, 0x51, 0x5c, 0x72, 0x1e, 0xef, 0x79, 0x7a, 0xe8, 0xf2,
-	0xd2, 0xfd, 0xb3, 0x5f, 0x93, 0x34, 0x67, 0x90, 0x78, 0xe4, 0xa0, 0x40, 0x31, 0xd6, 0x58, 0xa2,
-	0xd7, 0x61, 0xd0, 0x8b, 0x12, 0x7f, 0xd3, 0xab, 0x24, 0x71, 0xa9, 0xc0, 0xf8, 0xbf, 0x70, 0xff,
-	0xfc, 0xa7, 0x05, 0xc9, 0x99, 0xd3, 0x82, 0xfd, 0xa0, 0x84, 0xc4, 0x38, 0xe5, 0xe7, 0xfe, 0x76,
-	0x2f, 0x0c, 0x4d, 0x47, 0xc9, 0xc2, 0x6c, 0x39, 0xf1, 0x92, 0x76, 0x8c, 0x7e, 0xcf, 0x81, 0x33,
-	0x31, 0x5f, 0x36, 0x9f, 0xc4, 0x6b, 0x51, 0x58, 0x21, 0x71, 0x4c, 0xaa, 0x62, 0x5d, 0x36, 0xad,
-	0x8c, 0x4b, 0x32, 0x9b, 0x2c, 0x77, 0x32, 0xba, 0x12, 0x24, 0xd1, 0xee, 0xcc, 0xb3, 0x62, 0xcc,
-	0x67, 0x72, 0x30, 0x3e, 0xf9, 0xd6, 0x04, 0x92, 0x53, 0xa1, 0x94, 0xf8, 0x23, 0xc6, 0x79, 0xa3,
-	0x46, 0x5f, 0x73, 0x60, 0xb8, 0x15, 0x56, 0x63, 0x4c, 0x2a, 0x61, 0xbb, 0x45, 0xaa, 0x62, 0x79,
-	0x3f, 0x6c, 0x77, 0x1a, 0x6b, 0x1a, 0x07, 0x3e, 0xfe, 0xb3, 0x62, 0xfc, 0xc3, 0x7a, 0x13, 0x36,
-	0x86, 0x82, 0x9e, 0x87, 0xe1, 0x20, 0x4c, 0xca, 0x2d, 0x52, 0xf1, 0x37, 0x7d, 0x52, 0x65, 0x1b,
-	0x7f, 0x20, 0xed, 0x79, 0x4d, 0x6b, 0xc3, 0x06, 0xe6, 0xf8, 0x3c, 0x94, 0xba, 0xad, 0x1c, 0x1a,
-	0x83, 0x9e, 0x2d, 0xb2, 0xcb, 0x85, 0x0d, 0xa6, 0xff, 0xa2, 0xb3, 0x52, 0x00, 0xd1, 0xd7, 0x78,
-	0x40, 0x48, 0x96, 0xf7, 0x14, 0x9e, 0x77, 0xc6, 0xdf, 0x0f, 0xa7, 0x3b, 0x86, 0x7e, 0x14, 0x02,
-	0xee, 0x0f, 0xfa, 0x60, 0x40, 0x3e, 0x0a, 0xf4, 0x38, 0xf4, 0x06, 0x5e, 0x53, 0xca, 0xb9, 0x61,
-	0x31, 0x8f, 0xde, 0x6b, 0x5e, 0x93, 0xbe, 0xe1, 0x5e, 0x93, 0x50, 0x8c, 0x96, 0x97, 0xd4, 0x19,
-	0x1d, 0x0d, 0x63, 0xcd, 0x4b, 0xea, 0x98, 0xb5, 0xa0, 0x47, 0xa1, 0xb7, 0x19, 0x56, 0x09, 0x5b,
-	0x8b, 0x22, 0x97, 0x10, 0x2b, 0x61, 0x95, 0x60, 0x06, 0xa5, 0xfd, 0x37, 0xa3, 0xb0, 0x59, 0xea,
-	0x35, 0xfb, 0xcf, 0x47, 0x61, 0x13, 0xb3, 0x16, 0xf4, 0x55, 0x07, 0xc6, 0xe4, 0xde, 0x5e, 0x0e,
-	0x2b, 0x5e, 0xe2, 0x87, 0x41, 0xa9, 0xc8, 0x24, 0x0a, 0xb6, 0xf7, 0x4a, 0x49, 0xca, 0x33, 0x25,
-	0x31, 0x84, 0xb1, 0x6c, 0x0b, 0xee, 0x18, 0x05, 0xba, 0x0c, 0x50, 0x6b, 0x84, 0x1b, 0x5e, 0x83,
-	0x2e, 0x48, 0xa9, 0x8f, 0x4d, 0x41, 0x49, 0x86, 0x05, 0xd5, 0x82, 0x35, 0x2c, 0x74, 0x0b, 0xfa,
-	0x3d, 0x2e, 0xfd, 0x4b, 0xfd, 0x6c, 0x12, 0x2f, 0xda, 0x98, 0x84, 0x71, 0x9c, 0xcc, 0x0c, 0xed,
-	0xef, 0x4d, 0xf4, 0x0b, 0x20, 0x96, 0xec, 0xd0, 0x33, 0x30, 0x10, 0xb6, 0xe8, 0xb8, 0xbd, 0x46,
-	0x69, 0x80, 0x6d, 0xcc, 0x31, 0x31, 0xd6, 0x81, 0x55, 0x01, 0xc7, 0x0a, 0x03, 0x5d, 0x82, 0xfe,
-	0xb8, 0xbd, 0x41, 0x9f, 0x63, 0x69, 0x90, 0x4d, 0xec, 0x94, 0x40, 0xee, 0x2f, 0x73, 0x30, 0x96,
-	0xed, 0xe8, 0xdd, 0x30, 0x14, 0x91, 0x4a, 0x3b, 0x8a, 0x09, 0x7d, 0xb0, 0x25, 0x60, 0xb4, 0xcf,
-	0x08, 0xf4, 0x21, 0x9c, 0x36, 0x61, 0x1d, 0x0f, 0xbd, 0x0f, 0x46, 0xe9, 0x03, 0xbe, 0x72, 0xab,
-	0x15, 0x91, 0x38, 0xa6, 0x4f, 0x75, 0x88, 0x31, 0x3a, 0x2f, 0x7a, 0x8e, 0xce, 0x1b, 0xad, 0x38,
-	0x83, 0x8d, 0x6e, 0x03, 0x78, 0x4a, 0x66, 0x94, 0x86, 0xd9, 0x62, 0x2e, 0xdb, 0xdb, 0x11, 0x0b,
-	0xb3, 0x33, 0xa3, 0xf4, 0x39, 0xa6, 0xbf, 0xb1, 0xc6, 0x8f, 0xae, 0x4f, 0x95, 0x34, 0x48, 0x42,
-	0xaa, 0xa5, 0x11, 0x36, 0x61, 0xb5, 0x3e, 0x73, 0x1c, 0x8c, 0x65, 0xbb, 0xfb, 0x77, 0x0a, 0xa0,
-	0x51, 0x41, 0x33, 0x30, 0x20, 0xe4, 0x9a, 0x78, 0x25, 0x67, 0x9e, 0x92, 0xcf, 0x41, 0x3e, 0xc1,
-	0x3b, 0x7b, 0xb9, 0xf2, 0x50, 0xf5, 0x43, 0x6f, 0xc0, 0x50, 0x2b, 0xac, 0xae, 0x90, 0xc4, 0xab,
-	0x7a, 0x89, 0x27, 0x4e, 0x73, 0x0b, 0x27, 0x8c, 0xa4, 0x38, 0x73, 0x8a, 0x3e, 0xba, 0xb5, 0x94,
-	0x05, 0xd6, 0xf9, 0xa1, 0x17, 0x00, 0xc5, 0x24, 0xda, 0xf6, 0x2b, 0x64, 0xba, 0x52, 0xa1, 0x2a,
-	0x11, 0x7b, 0x01, 0x7a, 0xd8, 0x64, 0xc6, 0xc5, 0x64, 0x50, 0xb9, 0x03, 0x03, 0xe7, 0xf4, 0x72,
-	0xdf, 0x2c, 0xc0, 0xa8, 0x36, 0xd7, 0x16, 0xa9, 0xa0, 0xef, 0x38, 0x70, 0x4a, 0x1d, 0x67, 0x33,
-	0xbb, 0xd7, 0xe8, 0xae, 0xe2, 0x87, 0x15, 0xb1, 0xf9, 0x7c, 0x29, 0x2f, 0xf5, 0x53, 0xf0, 0xe1,
-	0xb2, 0xfe, 0x82, 0x98, 0xc3, 0xa9, 0x4c, 0x2b, 0xce, 0x0e, 0x6b, 0xfc, 0x2b, 0x0e, 0x9c, 0xcd,
-	0x23, 0x91, 0x23, 0x73, 0xeb, 0xba, 0xcc, 0xb5, 0x2a, 0xbc, 0x28, 0x57, 0x3a, 0x19, 0x5d, 0x8e,
-	0xff, 0xdf, 0x02, 0x8c, 0xe9, 0x5b, 0x88, 0x69, 0x02, 0xff, 0xc2, 0x81, 0x73, 0x72, 0x06, 0x98,
-	0xc4, 0xed, 0x46, 0x66, 0x79, 0x9b, 0x56, 0x97, 0x97, 0x9f, 0xa4, 0xd3, 0x79, 0xfc, 0xf8, 0x32,
-	0x3f, 0x26, 0x96, 0xf9, 0x5c, 0x2e, 0x0e, 0xce, 0x1f, 0xea, 0xf8, 0xb7, 0x1c, 0x18, 0xef, 0x4e,
-	0x34, 0x67, 0xe1, 0x5b, 0xe6, 0xc2, 0xbf, 0x6c, 0x6f, 0x92, 0x9c, 0x3d, 0x5b, 0x7e, 0x36, 0x59,
-	0xfd, 0x01, 0xfc, 0xe6, 0x00, 0x74, 0x9c, 0x21, 0xe8, 0x59, 0x18, 0x12, 0xe2, 0x78, 0x39, 0xac,
-	0xc5, 0x6c, 0x90, 0x03, 0xfc, 0x5d, 0x9b, 0x4e, 0xc1, 0x58, 0xc7, 0x41, 0x55, 0x28, 0xc4, 0xcf,
-	0x89, 0xa1, 0x5b, 0x10, 0x6f, 0xe5, 0xe7, 0x94, 0x16, 0xd9, 0xb7, 0xbf, 0x37, 0x51, 0x28, 0x3f,
-	0x87, 0x0b, 0xf1, 0x73, 0x54, 0x53, 0xaf, 0xf9, 0x89, 0x3d, 0x4d, 0x7d, 0xc1, 0x4f, 0x14, 0x1f,
-	0xa6, 0xa9, 0x2f, 0xf8, 0x09, 0xa6, 0x2c, 0xa8, 0x05, 0x52, 0x4f, 0x92, 0x16, 0x3b, 0xf1, 0xad,
-	0x58, 0x20, 0x57, 0xd7, 0xd7, 0xd7, 0x14, 0x2f, 0xa6, 0x5f, 0x50, 0x08, 0x66, 0x5c, 0xd0, 0xe7,
-	0x1c, 0xba, 0xe2, 0xbc, 0x31, 0x8c, 0x76, 0x85, 0xe2, 0x70, 0xdd, 0xde, 0x16, 0x08, 0xa3, 0x5d,
-	0xc5, 0x5c, 0x3c, 0x48, 0xd5, 0x80, 0x75, 0xd6, 0x6c, 0xe2, 0xd5, 0xcd, 0x98, 0xe9, 0x09, 0x76,
-	0x26, 0x3e, 0x37, 0x5f, 0xce, 0x4c, 0x7c, 0x6e, 0xbe, 0x8c, 0x19, 0x17, 0xfa, 0x40, 0x23, 0x6f,
-	0x47, 0xe8, 0x18, 0x16, 0x1e, 0x28, 0xf6, 0x76, 0xcc, 0x07, 0x8a, 0xbd, 0x1d, 0x4c, 0x59, 0x50,
-	0x4e, 0x61, 0x1c, 0x33, 0x95, 0xc2, 0x0a, 0xa7, 0xd5, 0x72, 0xd9, 0xe4, 0xb4, 0x5a, 0x2e, 0x63,
-	0xca, 0x82, 0x6d, 0xd2, 0x4a, 0xcc, 0xf4, 0x11, 0x3b, 0x9b, 0x74, 0x36, 0xc3, 0x69, 0x61, 0xb6,
-	0x8c, 0x29, 0x0b, 0x2a, 0x32, 0xbc, 0xd7, 0xda, 0x11, 0x57, 0x66, 0x86, 0x2e, 0xaf, 0x5a, 0xd8,
-	0x2f, 0x94, 0x9c, 0xe2, 0x36, 0xb8, 0xbf, 0x37, 0x51, 0x64, 0x20, 0xcc, 0x19, 0xb9, 0xbf, 0xdb,
-	0x93, 0x8a, 0x0b, 0x29, 0xcf, 0xd1, 0xdf, 0x66, 0x07, 0xa1, 0x90, 0x05, 0x42, 0xf5, 0x75, 0x8e,
-	0x4d, 0xf5, 0x3d, 0xc3, 0x4f, 0x3c, 0x83, 0x1d, 0xce, 0xf2, 0x47, 0xbf, 0xe6, 0x74, 0xda, 0xb6,
-	0x9e, 0xfd, 0xb3, 0x2c, 0x3d, 0x98, 0xf9, 0x59, 0x71, 0xa0, 0xc9, 0x3b, 0xfe, 0x39, 0x27, 0x55,
-	0x22, 0xe2, 0x6e, 0xe7, 0xc0, 0x47, 0xcc, 0x73, 0xc0, 0xa2, 0x41, 0xae, 0xcb, 0xfd, 0xcf, 0x3b,
-	0x30, 0x22, 0xe1, 0x54, 0x3d, 0x8e, 0xd1, 0x2d, 0x18, 0x90, 0x23, 0x15, 0x4f, 0xcf, 0xa6, 0x2f,
-	0x40, 0x29, 0xf1, 0x6a, 0x30, 0x8a, 0x9b, 0xfb, 0x9d, 0x3e, 0x40, 0xe9, 0x59, 0xd5, 0x0a, 0x63,
-	0x9f, 0x49, 0xa2, 0x7b, 0x38, 0x85, 0x02, 0xed, 0x14, 0xba, 0x61, 0xf3, 0x14, 0x4a, 0x87, 0x65,
-	0x9c, 0x47, 0xbf, 0x96, 0x91, 0xdb, 0xfc, 0x60, 0xfa, 0xf0, 0xb1, 0xc8, 0x6d, 0x6d, 0x08, 0x07,
-	0x4b, 0xf0, 0x6d, 0x21, 0xc1, 0xf9, 0xd1, 0xf5, 0x4b, 0x76, 0x25, 0xb8, 0x36, 0x8a, 0xac, 0x2c,
-	0x8f, 0xb8, 0x84, 0xe5, 0x67, 0xd7, 0x4d, 0xab, 0x12, 0x56, 0xe3, 0x6a, 0xca, 0xda, 0x88, 0xcb,
-	0xda, 0x3e, 0x5b, 0x3c, 0x35, 0x59, 0x9b, 0xe5, 0xa9, 0xa4, 0xee, 0x6b, 0x52, 0xea, 0xf2, 0x53,
-	0xeb, 0x25, 0xcb, 0x52, 0x57, 0xe3, 0xdb, 0x29, 0x7f, 0x3f, 0x0a, 0xe7, 0x3a, 0xf1, 0x30, 0xd9,
-	0x44, 0x53, 0x30, 0x58, 0x09, 0x83, 0x4d, 0xbf, 0xb6, 0xe2, 0xb5, 0x84, 0xbd, 0xa6, 0x64, 0xd1,
-	0xac, 0x6c, 0xc0, 0x29, 0x0e, 0x7a, 0x8c, 0x0b, 0x1e, 0xee, 0x11, 0x19, 0x12, 0xa8, 0x3d, 0x4b,
-	0x64, 0x97, 0x49, 0xa1, 0xf7, 0x0c, 0x7c, 0xf5, 0x1b, 0x13, 0x0f, 0x7d, 0xe2, 0xdf, 0x3f, 0xfe,
-	0x90, 0xfb, 0xfb, 0x3d, 0xf0, 0x48, 0x2e, 0x4f, 0xa1, 0xad, 0xff, 0xa6, 0xa1, 0xad, 0x6b, 0xed,
-	0x42, 0x8a, 0xdc, 0xb4, 0xa9, 0xc8, 0x6a, 0xe4, 0xf3, 0xf4, 0x72, 0xad, 0x19, 0xe7, 0x0f, 0x8a,
-	0x2e, 0x54, 0xe0, 0x35, 0x49, 0xdc, 0xf2, 0x2a, 0x44, 0xcc, 0x5e, 0x2d, 0xd4, 0x35, 0xd9, 0x80,
-	0x53, 0x1c, 0x6e, 0x42, 0x6f, 0x7a, 0xed, 0x46, 0x22, 0x1c, 0x65, 0x9a, 0x09, 0xcd, 0xc0, 0x58,
-	0xb6, 0xa3, 0xbf, 0xeb, 0x00, 0xea, 0xe4, 0x2a, 0x5e, 0xc4, 0xf5, 0xe3, 0x58, 0x87, 0x99, 0xf3,
-	0xfb, 0x9a, 0x11, 0xae, 0xcd, 0x34, 0x67, 0x1c, 0xda, 0x33, 0xfd, 0x58, 0x7a, 0x0e, 0x71, 0xe3,
-	0xe0, 0x10, 0x3e, 0x34, 0xe6, 0x6a, 0xa9, 0x54, 0x48, 0x1c, 0x73, 0x77, 0x9c, 0xee, 0x6a, 0x61,
-	0x60, 0x2c, 0xdb, 0xd1, 0x04, 0x14, 0x49, 0x14, 0x85, 0x91, 0xb0, 0xb5, 0xd9, 0x36, 0xbe, 0x42,
-	0x01, 0x98, 0xc3, 0xdd, 0x9f, 0x14, 0xa0, 0xd4, 0xcd, 0x3a, 0x41, 0xbf, 0xa5, 0xd9, 0xd5, 0xc2,
-	0x72, 0x12, 0x86, 0x5f, 0x78, 0x7c, 0x36, 0x51, 0xd6, 0x00, 0xec, 0x62, 0x61, 0x8b, 0x56, 0x9c,
-	0x1d, 0xe0, 0xf8, 0x97, 0x34, 0x0b, 0x5b, 0x27, 0x91, 0x73, 0xc0, 0x6f, 0x9a, 0x07, 0xfc, 0x9a,
-	0xed, 0x49, 0xe9, 0xc7, 0xfc, 0x1f, 0x16, 0xe1, 0x8c, 0x6c, 0x2d, 0x13, 0x7a, 0x54, 0xbe, 0xd8,
-	0x26, 0xd1, 0x2e, 0xfa, 0xa1, 0x03, 0x67, 0xbd, 0xac, 0xeb, 0xc6, 0x27, 0xc7, 0xb0, 0xd0, 0x1a,
-	0xd7, 0xc9, 0xe9, 0x1c, 0x8e, 0x7c, 0xa1, 0x2f, 0x8b, 0x85, 0x3e, 0x9b, 0x87, 0xd2, 0xc5, 0xef,
-	0x9e, 0x3b, 0x01, 0xf4, 0x3c, 0x0c, 0x4b, 0x38, 0x73, 0xf7, 0xf0, 0x57, 0x5c, 0x39, 0xb7, 0xa7,
-	0xb5, 0x36, 0x6c, 0x60, 0xd2, 0x9e, 0x09, 0x69, 0xb6, 0x1a, 0x5e, 0x42, 0x34, 0x47, 0x91, 0xea,
-	0xb9, 0xae, 0xb5, 0x61, 0x03, 0x13, 0x3d, 0x05, 0x7d, 0x41, 0x58, 0x25, 0x8b, 0x55, 0xe1, 0x20,
-	0x1e, 0x15, 0x7d, 0xfa, 0xae, 0x31, 0x28, 0x16, 0xad, 0xe8, 0xc9, 0xd4, 0x1b, 0x57, 0x64, 0xaf,
-	0xd0, 0x50, 0x9e, 0x27, 0x0e, 0xfd, 0x7d, 0x07, 0x06, 0x69, 0x8f, 0xf5, 0xdd, 0x16, 0xa1, 0x67,
-	0x1b, 0x7d, 0x22, 0xd5, 0xe3, 0x79, 0x22, 0xd7, 0x24, 0x1b, 0xd3, 0xd5, 0x31, 0xa8, 0xe0, 0x9f,
-	0x7c, 0x6b, 0x62, 0x40, 0xfe, 0xc0, 0xe9, 0xa8, 0xc6, 0x17, 0xe0, 0xe1, 0xae, 0x4f, 0xf3, 0x48,
-	0xa1, 0x80, 0xbf, 0x02, 0xa3, 0xe6, 0x20, 0x8e, 0x14, 0x07, 0xf8, 0xe7, 0xda, 0x6b, 0xc7, 0xe7,
-	0x25, 0xe4, 0xd9, 0x03, 0xd3, 0x66, 0xd5, 0x66, 0x98, 0x13, 0x5b, 0xcf, 0xdc, 0x0c, 0x73, 0x62,
-	0x33, 0xcc, 0xb9, 0xbf, 0xe7, 0xa4, 0xaf, 0xa6, 0xa6, 0xe6, 0xd1, 0x83, 0xb9, 0x1d, 0x35, 0x84,
-	0x20, 0x56, 0x07, 0xf3, 0x75, 0xbc, 0x8c, 0x29, 0x1c, 0x7d, 0x49, 0x93, 0x8e, 0xb4, 0x5b, 0x5b,
-	0x84, 0x35, 0x2c, 0xb9, 0xe8, 0x0d, 0xc2, 0x9d, 0xf2, 0x4f, 0x34, 0xe0, 0xec, 0x10, 0xdc, 0x1f,
-	0x3b, 0xf0, 0xd8, 0x81, 0x4a, 0x6b, 0xee, 0xc0, 0x9d, 0x07, 0x3e, 0x70, 0x7a, 0xac, 0x45, 0xa4,
-	0x15, 0x5e, 0xc7, 0xcb, 0xe2, 0x79, 0xa9, 0x63, 0x0d, 0x73, 0x30, 0x96, 0xed, 0xee, 0x0f, 0x1d,
-	0xc8, 0xd2, 0x43, 0x1e, 0x8c, 0xb6, 0x63, 0x12, 0xd1, 0x13, 0xb2, 0x4c, 0x2a, 0x11, 0x91, 0xbb,
-	0xed, 0xc9, 0x49, 0x1e, 0xbc, 0xa7, 0x03, 0x9e, 0xac, 0x84, 0x11, 0x99, 0xdc, 0x7e, 0x76, 0x92,
-	0x63, 0x2c, 0x91, 0xdd, 0x32, 0x69, 0x10, 0x4a, 0x63, 0x06, 0xed, 0xef, 0x4d, 0x8c, 0x5e, 0x37,
-	0x08, 0xe0, 0x0c, 0x41, 0xca, 0xa2, 0xe5, 0xc5, 0xf1, 0x4e, 0x18, 0x55, 0x05, 0x8b, 0xc2, 0x91,
-	0x59, 0xac, 0x19, 0x04, 0x70, 0x86, 0xa0, 0xfb, 0x26, 0xb5, 0x06, 0x75, 0x25, 0x14, 0x7d, 0x83,
-	0xaa, 0x32, 0x14, 0x32, 0xd3, 0x08, 0x37, 0x66, 0xc3, 0x20, 0xf1, 0xfc, 0x80, 0xc8, 0xd8, 0xff,
-	0xba, 0x25, 0x95, 0xd7, 0xa0, 0x9d, 0xba, 0xe4, 0x3b, 0xdb, 0x70, 0xce, 0x58, 0xa8, 0xca, 0xb2,
-	0xd1, 0x08, 0x37, 0xb2, 0x41, 0x3d, 0x8a, 0x84, 0x59, 0x8b, 0xfb, 0x33, 0x07, 0x2e, 0x74, 0xd1,
-	0xad, 0xd1, 0x57, 0x1c, 0x18, 0xd9, 0x78, 0x5b, 0xcc, 0xcd, 0x1c, 0x06, 0x7a, 0x1f, 0x8c, 0x52,
-	0x00, 0x3d, 0x58, 0xe6, 0xc3, 0xa8, 0xe9, 0x25, 0x62, 0x82, 0x2a, 0xe0, 0x34, 0x63, 0xb4, 0xe2,
-	0x0c, 0xb6, 0xfb, 0xeb, 0x05, 0xc8, 0xe1, 0x82, 0x9e, 0x81, 0x01, 0x12, 0x54, 0x5b, 0xa1, 0x1f,
-	0x24, 0x42, 0xb6, 0x28, 0x21, 0x76, 0x45, 0xc0, 0xb1, 0xc2, 0x10, 0xe6, 0x84, 0x58, 0x98, 0x42,
-	0x87, 0x39, 0x21, 0x46, 0x9e, 0xe2, 0xa0, 0x1a, 0x8c, 0x79, 0x3c, 0x5c, 0xc2, 0xf6, 0x1e, 0xdb,
-	0xa6, 0x3d, 0x47, 0xd9, 0xa6, 0x67, 0x59, 0x34, 0x33, 0x43, 0x02, 0x77, 0x10, 0x45, 0xef, 0x86,
-	0xa1, 0x76, 0x4c, 0xca, 0x73, 0x4b, 0xb3, 0x11, 0xa9, 0x72, 0x23, 0x57, 0x0b, 0xe3, 0x5d, 0x4f,
-	0x9b, 0xb0, 0x8e, 0xe7, 0xfe, 0x4b, 0x07, 0xfa, 0x67, 0xbc, 0xca, 0x56, 0xb8, 0xb9, 0x49, 0x97,
-	0xa2, 0xda, 0x8e, 0x52, 0x3f, 0x95, 0xb6, 0x14, 0x73, 0x02, 0x8e, 0x15, 0x06, 0x5a, 0x87, 0x3e,
-	0xfe, 0xc2, 0x8b, 0xd7, 0xee, 0x17, 0xb5, 0xf9, 0xa8, 0xb4, 0x1c, 0xb6, 0x1d, 0xda, 0x89, 0xdf,
-	0x98, 0xe4, 0x69, 0x39, 0x93, 0x8b, 0x41, 0xb2, 0x1a, 0x95, 0x93, 0xc8, 0x0f, 0x6a, 0x33, 0x40,
-	0xa5, 0xff, 0x3c, 0xa3, 0x81, 0x05, 0x2d, 0x3a, 0x8d, 0xa6, 0x77, 0x4b, 0xb2, 0x13, 0xba, 0x86,
-	0x9a, 0xc6, 0x4a, 0xda, 0x84, 0x75, 0x3c, 0xf7, 0xf7, 0x1d, 0x18, 0x9c, 0xf1, 0x62, 0xbf, 0xf2,
-	0xe7, 0x48, 0xf8, 0x7c, 0x08, 0x8a, 0xb3, 0x5e, 0xa5, 0x4e, 0xd0, 0xf5, 0xac, 0x0d, 0x3b, 0x74,
-	0xf9, 0xe9, 0x3c, 0x36, 0xca, 0x9e, 0xd5, 0x39, 0x8d, 0x74, 0xb3, 0x74, 0xdd, 0xb7, 0x1c, 0x18,
-	0x9d, 0x6d, 0xf8, 0x24, 0x48, 0x66, 0x49, 0x94, 0xb0, 0x85, 0xab, 0xc1, 0x58, 0x45, 0x41, 0xee,
-	0x65, 0xe9, 0xd8, 0x6e, 0x9d, 0xcd, 0x90, 0xc0, 0x1d, 0x44, 0x51, 0x15, 0x4e, 0x71, 0x58, 0xfa,
-	0x56, 0x1c, 0x69, 0xfd, 0x98, 0xb3, 0x73, 0xd6, 0xa4, 0x80, 0xb3, 0x24, 0xdd, 0x9f, 0x3a, 0x70,
-	0x61, 0xb6, 0xd1, 0x8e, 0x13, 0x12, 0xdd, 0x14, 0xd2, 0x48, 0x6a, 0xab, 0xe8, 0x23, 0x30, 0xd0,
-	0x94, 0x01, 0x58, 0xe7, 0x2e, 0x1b, 0x98, 0xc9, 0x33, 0x8a, 0x4d, 0x07, 0xb3, 0xba, 0xf1, 0x2a,
-	0xa9, 0x24, 0x2b, 0x24, 0xf1, 0xd2, 0x6c, 0x81, 0x14, 0x86, 0x15, 0x55, 0xd4, 0x82, 0xde, 0xb8,
-	0x45, 0x2a, 0xf6, 0x92, 0xb5, 0xe4, 0x1c, 0xca, 0x2d, 0x52, 0x49, 0xe5, 0x3a, 0x0b, 0x1d, 0x32,
-	0x4e, 0xee, 0xff, 0x72, 0xe0, 0x91, 0x2e, 0xf3, 0x5d, 0xf6, 0xe3, 0x04, 0xbd, 0xd2, 0x31, 0xe7,
-	0xc9, 0xc3, 0xcd, 0x99, 0xf6, 0x66, 0x33, 0x56, 0x02, 0x41, 0x42, 0xb4, 0xf9, 0x7e, 0x0c, 0x8a,
-	0x7e, 0x42, 0x9a, 0xd2, 0xab, 0x6c, 0xc1, 0xff, 0xd3, 0x65, 0x2e, 0x33, 0x23, 0x32, 0x65, 0x6f,
-	0x91, 0xf2, 0xc3, 0x9c, 0xad, 0xfb, 0xaf, 0x1c, 0xa0, 0x1b, 0xbd, 0xea, 0x8b, 0x58, 0x5d, 0x6f,
-	0xb2, 0xdb, 0x92, 0x86, 0xbb, 0x54, 0xe0, 0x7b, 0xa9, 0x3e, 0x7d, 0x67, 0x6f, 0x62, 0x44, 0x21,
-	0x32, 0x05, 0x9e, 0xa1, 0xa2, 0x0f, 0x41, 0x5f, 0xcc, 0x8c, 0x5e, 0x21, 0xd9, 0xe7, 0xa5, 0x86,
-	0xca, 0x4d, 0xe1, 0x3b, 0x7b, 0x13, 0x87, 0x4a, 0x8c, 0x9c, 0x54, 0xb4, 0x45, 0x58, 0x51, 0x50,
-	0xa5, 0x2a, 0x55, 0x93, 0xc4, 0xb1, 0x57, 0x93, 0x36, 0x94, 0x52, 0xa9, 0x56, 0x38, 0x18, 0xcb,
-	0x76, 0xf7, 0xcb, 0x0e, 0x8c, 0xa8, 0xf3, 0x84, 0x2a, 0xc8, 0xe8, 0x9a, 0x7e, 0xf2, 0xf0, 0x87,
-	0xf7, 0x58, 0x17, 0x21, 0x20, 0xce, 0xd6, 0x83, 0x0f, 0xa6, 0x77, 0xc1, 0x70, 0x95, 0xb4, 0x48,
-	0x50, 0x25, 0x41, 0x85, 0x1a, 0xb8, 0xf4, 0xa1, 0x0d, 0xce, 0x8c, 0x51, 0x8b, 0x6e, 0x4e, 0x83,
-	0x63, 0x03, 0xcb, 0xfd, 0xa6, 0x03, 0x0f, 0x2b, 0x72, 0x65, 0x92, 0x60, 0x92, 0x44, 0xbb, 0x2a,
-	0x11, 0xf2, 0x68, 0x07, 0xc8, 0x4d, 0xaa, 0x61, 0x26, 0x11, 0x67, 0x7e, 0x6f, 0x27, 0xc8, 0x10,
-	0xd7, 0x47, 0x19, 0x11, 0x2c, 0xa9, 0xb9, 0xbf, 0xda, 0x03, 0x67, 0xf5, 0x41, 0xaa, 0x77, 0xfe,
-	0x97, 0x1d, 0x00, 0xb5, 0x02, 0xf4, 0x8c, 0xec, 0xb1, 0x13, 0x1d, 0x32, 0x9e, 0x54, 0x2a, 0x15,
-	0x14, 0x38, 0xc6, 0x1a, 0x5b, 0xf4, 0x12, 0x0c, 0x6f, 0x87, 0x8d, 0x76, 0x93, 0xac, 0xd0, 0x13,
-	0x3c, 0x2e, 0xf5, 0xb0, 0x61, 0x4c, 0xe4, 0x3d, 0xcc, 0x1b, 0x29, 0x5e, 0x6a, 0x70, 0x6b, 0xc0,
-	0x18, 0x1b, 0xa4, 0xa8, 0x2d, 0x31, 0x12, 0xe9, 0x8f, 0x44, 0x78, 0x9d, 0x3f, 0x68, 0x71, 0x8e,
-	0xd9, 0xa7, 0x3e, 0x73, 0x7a, 0x7f, 0x6f, 0x62, 0xc4, 0x00, 0x61, 0x73, 0x10, 0xee, 0x4b, 0xc0,
-	0xd6, 0xc2, 0x0f, 0xda, 0x64, 0x35, 0x40, 0x4f, 0x48, 0x2f, 0x18, 0x8f, 0x5c, 0xa8, 0x97, 0x59,
-	0xf7, 0x84, 0x51, 0x6b, 0x71, 0xd3, 0xf3, 0x1b, 0x2c, 0x41, 0x90, 0x62, 0x29, 0x6b, 0x71, 0x9e,
-	0x41, 0xb1, 0x68, 0x75, 0x27, 0xa1, 0x7f, 0x96, 0xce, 0x9d, 0x44, 0x94, 0xae, 0x9e, 0xd7, 0x3b,
-	0x62, 0xe4, 0xf5, 0xca, 0xfc, 0xdd, 0x75, 0x38, 0x37, 0x1b, 0x11, 0x2f, 0x21, 0xe5, 0xe7, 0x66,
-	0xda, 0x95, 0x2d, 0x92, 0xf0, 0xe4, 0xa9, 0x18, 0xbd, 0x17, 0x46, 0x42, 0x26, 0xc5, 0x97, 0xc3,
-	0xca, 0x96, 0x1f, 0xd4, 0x84, 0x53, 0xf3, 0x9c, 0xa0, 0x32, 0xb2, 0xaa, 0x37, 0x62, 0x13, 0xd7,
-	0xfd, 0x8f, 0x05, 0x18, 0x9e, 0x8d, 0xc2, 0x40, 0x4a, 0xaa, 0x13, 0x38, 0x5d, 0x12, 0xe3, 0x74,
-	0xb1, 0x10, 0x50, 0xd4, 0xc7, 0xdf, 0xed, 0x84, 0x41, 0xb7, 0x95, 0x88, 0xec, 0xb1, 0x65, 0x15,
-	0x18, 0x7c, 0x19, 0xed, 0xf4, 0x61, 0x9b, 0x02, 0xd4, 0xfd, 0x4f, 0x0e, 0x8c, 0xe9, 0xe8, 0x27,
-	0x70, 0xa8, 0xc5, 0xe6, 0xa1, 0x76, 0xcd, 0xee, 0x7c, 0xbb, 0x9c, 0x64, 0x9f, 0xef, 0x33, 0xe7,
-	0xc9, 0xa2, 0xc9, 0x5f, 0x75, 0x60, 0x78, 0x47, 0x03, 0x88, 0xc9, 0xda, 0xd6, 0x2b, 0xde, 0x21,
-	0xc5, 0x8c, 0x0e, 0xbd, 0x93, 0xf9, 0x8d, 0x8d, 0x91, 0x50, 0xb9, 0x1f, 0x57, 0xea, 0xa4, 0xda,
-	0x6e, 0x48, 0xbf, 0xa2, 0x5a, 0xd2, 0xb2, 0x80, 0x63, 0x85, 0x81, 0x5e, 0x81, 0xd3, 0x95, 0x30,
-	0xa8, 0xb4, 0xa3, 0x88, 0x04, 0x95, 0xdd, 0x35, 0x76, 0x15, 0x41, 0x1c, 0x88, 0x93, 0xa2, 0xdb,
-	0xe9, 0xd9, 0x2c, 0xc2, 0x9d, 0x3c, 0x20, 0xee, 0x24, 0xc4, 0xdd, 0xf1, 0x31, 0x3d, 0xb2, 0x84,
-	0x0d, 0xa4, 0xb9, 0xe3, 0x19, 0x18, 0xcb, 0x76, 0x74, 0x1d, 0x2e, 0xc4, 0x89, 0x17, 0x25, 0x7e,
-	0x50, 0x9b, 0x23, 0x5e, 0xb5, 0xe1, 0x07, 0x54, 0xbb, 0x0f, 0x83, 0x2a, 0x0f, 0xd6, 0xf5, 0xcc,
-	0x3c, 0xb2, 0xbf, 0x37, 0x71, 0xa1, 0x9c, 0x8f, 0x82, 0xbb, 0xf5, 0x45, 0x1f, 0x82, 0x71, 0xe1,
-	0xf0, 0xdf, 0x6c, 0x37, 0x5e, 0x08, 0x37, 0xe2, 0xab, 0x7e, 0x4c, 0x4d, 0xeb, 0x65, 0xbf, 0xe9,
-	0x27, 0x2c, 0x24, 0x57, 0x9c, 0xb9, 0xb8, 0xbf, 0x37, 0x31, 0x5e, 0xee, 0x8a, 0x85, 0x0f, 0xa0,
-	0x80, 0x30, 0x9c, 0xe7, 0xc2, 0xaf, 0x83, 0x76, 0x3f, 0xa3, 0x3d, 0xbe, 0xbf, 0x37, 0x71, 0x7e,
-	0x3e, 0x17, 0x03, 0x77, 0xe9, 0x49, 0x9f, 0x60, 0xe2, 0x37, 0xc9, 0x6b, 0x61, 0x40, 0x58, 0x2a,
-	0x88, 0xf6, 0x04, 0xd7, 0x05, 0x1c, 0x2b, 0x0c, 0xf4, 0x6a, 0xba, 0x13, 0xe9, 0xeb, 0x22, 0x52,
-	0x3a, 0x8e, 0x2e, 0xe1, 0x98, 0xb5, 0x70, 0x53, 0xa3, 0xc4, 0x72, 0x15, 0x0d, 0xda, 0xee, 0x1f,
-	0x14, 0x00, 0x75, 0x8a, 0x08, 0xb4, 0x04, 0x7d, 0x5e, 0x25, 0xf1, 0xb7, 0x65, 0xee, 0xdb, 0x13,
-	0x79, 0xc7, 0x27, 0x67, 0x85, 0xc9, 0x26, 0xa1, 0x3b, 0x84, 0xa4, 0x72, 0x65, 0x9a, 0x75, 0xc5,
-	0x82, 0x04, 0x0a, 0xe1, 0x74, 0xc3, 0x8b, 0x13, 0xb9, 0x57, 0xab, 0x74, 0xca, 0x42, 0xb0, 0xfe,
-	0xfc, 0xe1, 0x26, 0x45, 0x7b, 0xcc, 0x9c, 0xa3, 0x3b, 0x77, 0x39, 0x4b, 0x08, 0x77, 0xd2, 0x46,
-	0x1f, 0x67, 0x7a, 0x08, 0x57, 0x12, 0xa5, 0x02, 0xb0, 0x64, 0xe5, 0x8c, 0xe6, 0x34, 0x0d, 0x1d,
-	0x44, 0xb0, 0xc1, 0x1a, 0x4b, 0xf7, 0x5f, 0x03, 0xf4, 0xcf, 0x4d, 0x2f, 0xac, 0x7b, 0xf1, 0xd6,
-	0x21, 0x42, 0x5c, 0x74, 0x77, 0x08, 0x1d, 0x2a, 0xfb, 0x7e, 0x4b, 0xdd, 0x0a, 0x2b, 0x0c, 0x14,
-	0x40, 0x9f, 0x1f, 0xd0, 0x17, 0xa2, 0x34, 0x6a, 0xcb, 0xc1, 0xac, 0x34, 0x7f, 0xe6, 0x32, 0x58,
-	0x64, 0xd4, 0xb1, 0xe0, 0x82, 0x6e, 0xc3, 0xa0, 0x27, 0xef, 0x8e, 0x88, 0x63, 0x69, 0xc9, 0x86,
-	0xe7, 0x54, 0x90, 0xd4, 0x73, 0x57, 0x04, 0x08, 0xa7, 0x0c, 0xd1, 0x27, 0x1c, 0x18, 0x92, 0x53,
-	0xc7, 0x64, 0x53, 0x04, 0x35, 0x57, 0xec, 0xcd, 0x19, 0x93, 0x4d, 0x9e, 0xd8, 0xa0, 0x01, 0xb0,
-	0xce, 0xb2, 0x43, 0x95, 0x2f, 0x1e, 0x46, 0x95, 0x47, 0x3b, 0x30, 0xb8, 0xe3, 0x27, 0x75, 0x76,
-	0xf0, 0x88, 0x60, 0xca, 0xfc, 0xfd, 0x8f, 0x9a, 0x92, 0x4b, 0x57, 0xec, 0xa6, 0x64, 0x80, 0x53,
-	0x5e, 0x68, 0x8a, 0x33, 0x66, 0x77, 0x6f, 0x98, 0xc8, 0x1a, 0x34, 0x3b, 0xb0, 0x06, 0x9c, 0xe2,
-	0xd0, 0x25, 0x1e, 0xa6, 0xbf, 0xca, 0xe4, 0xa3, 0x6d, 0xfa, 0x1e, 0x8b, 0x64, 0x35, 0x0b, 0xfb,
-	0x4a, 0x52, 0xe4, 0x8b, 0x75, 0x53, 0xe3, 0x81, 0x0d, 0x8e, 0xf4, 0x1d, 0xd9, 0xa9, 0x93, 0x40,
-	0x24, 0xd3, 0xab, 0x77, 0xe4, 0x66, 0x9d, 0x04, 0x98, 0xb5, 0xa0, 0xdb, 0xdc, 0xb4, 0xe0, 0x3a,
-	0xae, 0x48, 0x3c, 0x5b, 0xb6, 0xa3, 0x76, 0x73, 0x9a, 0x3c, 0x9f, 0x3d, 0xfd, 0x8d, 0x35, 0x7e,
-	0x54, 0x5d, 0x0e, 0x83, 0x2b, 0xb7, 0xfc, 0x44, 0x64, 0xe1, 0x2b, 0x49, 0xb7, 0xca, 0xa0, 0x58,
-	0xb4, 0xf2, 0xa0, 0x3d, 0xdd, 0x04, 0x31, 0x4b, 0xb9, 0x1f, 0xd4, 0x83, 0xf6, 0x0c, 0x8c, 0x65,
-	0x3b, 0xfa, 0x7b, 0x0e, 0x14, 0xeb, 0x61, 0xb8, 0x15, 0x97, 0x46, 0xd8, 0xe6, 0xb0, 0xa0, 0xea,
-	0x09, 0x89, 0x33, 0x79, 0x95, 0x92, 0x35, 0xef, 0x15, 0x15, 0x19, 0xec, 0xce, 0xde, 0xc4, 0xe8,
-	0xb2, 0xbf, 0x49, 0x2a, 0xbb, 0x95, 0x06, 0x61, 0x90, 0x4f, 0xbe, 0xa5, 0x41, 0xae, 0x6c, 0x93,
-	0x20, 0xc1, 0x7c, 0x54, 0xe3, 0x9f, 0x77, 0x00, 0x52, 0x42, 0x39, 0xd1, 0x31, 0x62, 0xc6, 0x93,
-	0x2d, 0xd8, 0x79, 0xc6, 0xd0, 0xf4, 0x70, 0xdb, 0xbf, 0x71, 0x60, 0x88, 0x4e, 0x4e, 0x8a, 0xc0,
-	0xa7, 0xa0, 0x2f, 0xf1, 0xa2, 0x1a, 0x91, 0x2e, 0x65, 0xf5, 0x38, 0xd6, 0x19, 0x14, 0x8b, 0x56,
-	0x14, 0x40, 0x31, 0xf1, 0xe2, 0x2d, 0xa9, 0x5d, 0x2e, 0x5a, 0x5b, 0xe2, 0x54, 0xb1, 0xa4, 0xbf,
-	0x62, 0xcc, 0xd9, 0xa0, 0xa7, 0x61, 0x80, 0x2a, 0x00, 0xf3, 0x5e, 0x2c, 0x93, 0x36, 0x86, 0xa9,
-	0x10, 0x9f, 0x17, 0x30, 0xac, 0x5a, 0xdd, 0x5f, 0x2f, 0x40, 0xef, 0x1c, 0xb7, 0x33, 0xfa, 0xe2,
-	0xb0, 0x1d, 0x55, 0x88, 0xd0, 0x37, 0x2d, 0xec, 0x69, 0x4a, 0xb7, 0xcc, 0x68, 0x6a, 0x9a, 0x3e,
-	0xfb, 0x8d, 0x05, 0x2f, 0x6a, 0xc8, 0x8e, 0x26, 0x91, 0x17, 0xc4, 0x9b, 0xcc, 0x79, 0xef, 0x87,
-	0x81, 0x58, 0x22, 0x0b, 0xbb, 0x70, 0xdd, 0xa0, 0x5b, 0x4e, 0x48, 0x2b, 0x8d, 0x21, 0x98, 0x6d,
-	0x38, 0x33, 0x06, 0xf7, 0x37, 0x1c, 0x80, 0x74, 0xf4, 0xe8, 0x73, 0x0e, 0x8c, 0x78, 0x7a, 0xb2,
-	0xa0, 0x58, 0xa3, 0x55, 0x7b, 0x81, 0x3b, 0x46, 0x96, 0x9b, 0xd8, 0x06, 0x08, 0x9b, 0x8c, 0xdd,
-	0x77, 0x43, 0x91, 0xbd, 0x1d, 0x4c, 0x17, 0x17, 0x5e, 0xd2, 0xac, 0x0f, 0x46, 0x7a, 0x4f, 0xb1,
-	0xc2, 0x70, 0x5f, 0x81, 0xd1, 0x2b, 0xb7, 0x48, 0xa5, 0x9d, 0x84, 0x11, 0xf7, 0x11, 0x77, 0xb9,
-	0x1c, 0xe2, 0xdc, 0xd3, 0xe5, 0x90, 0xef, 0x3a, 0x30, 0xa4, 0x65, 0x8e, 0xd1, 0x93, 0xba, 0x36,
-	0x5b, 0xe6, 0x76, 0xb7, 0x58, 0xaa, 0x25, 0x2b, 0xb9, 0x69, 0x9c, 0x64, 0x7a, 0x8c, 0x28, 0x10,
-	0x4e, 0x19, 0xde, 0x25, 0xb3, 0xcb, 0xfd, 0x5d, 0x07, 0xce, 0xe5, 0xa6, 0xb9, 0x3d, 0xe0, 0x61,
-	0x4f, 0xc1, 0xe0, 0x16, 0xd9, 0x35, 0x42, 0x5e, 0xaa, 0xc3, 0x92, 0x6c, 0xc0, 0x29, 0x8e, 0xfb,
-	0x3d, 0x07, 0x52, 0x4a, 0x54, 0x14, 0x6d, 0xa4, 0x23, 0xd7, 0x44, 0x91, 0xe0, 0x24, 0x5a, 0xd1,
-	0x6d, 0xb8, 0x60, 0x3e, 0xc1, 0x7b, 0xf4, 0xcc, 0x73, 0x9b, 0x29, 0x9f, 0x12, 0xee, 0xc6, 0xc2,
-	0xbd, 0x01, 0xc5, 0x05, 0xaf, 0x5d, 0x23, 0x87, 0x72, 0xe2, 0x50, 0x31, 0x16, 0x11, 0xaf, 0x91,
-	0x48, 0x35, 0x5d, 0x88, 0x31, 0x2c, 0x60, 0x58, 0xb5, 0xba, 0x3f, 0x2c, 0xc2, 0x90, 0x76, 0x99,
-	0x81, 0x9e, 0xe3, 0x11, 0x69, 0x85, 0x59, 0x5d, 0x97, 0x3e, 0x6c, 0xcc, 0x5a, 0xe8, 0xfb, 0x13,
-	0x91, 0x6d, 0x3f, 0xe6, 0x22, 0xc7, 0x78, 0x7f, 0xb0, 0x80, 0x63, 0x85, 0x81, 0x26, 0xa0, 0x58,
-	0x25, 0xad, 0xa4, 0xce, 0xa4, 0x69, 0x2f, 0xcf, 0xe8, 0x9a, 0xa3, 0x00, 0xcc, 0xe1, 0x14, 0x61,
-	0x93, 0x24, 0x95, 0x3a, 0x73, 0x36, 0x8a, 0x94, 0xaf, 0x79, 0x0a, 0xc0, 0x1c, 0x9e, 0x13, 0xab,
-	0x2a, 0x1e, 0x7f, 0xac, 0xaa, 0xcf, 0x72, 0xac, 0x0a, 0xb5, 0xe0, 0x4c, 0x1c, 0xd7, 0xd7, 0x22,
-	0x7f, 0xdb, 0x4b, 0x48, 0xba, 0x73, 0xfa, 0x8f, 0xc2, 0xe7, 0x02, 0xbb, 0x5e, 0x5c, 0xbe, 0x9a,
-	0xa5, 0x82, 0xf3, 0x48, 0xa3, 0x32, 0x9c, 0xf3, 0x83, 0x98, 0x54, 0xda, 0x11, 0x59, 0xac, 0x05,
-	0x61, 0x44, 0xae, 0x86, 0x31, 0x25, 0x27, 0x2e, 0x47, 0xaa, 0x24, 0xc8, 0xc5, 0x3c, 0x24, 0x9c,
-	0xdf, 0x17, 0x2d, 0xc0, 0xe9, 0xaa, 0x1f, 0x7b, 0x1b, 0x0d, 0x52, 0x6e, 0x6f, 0x34, 0x43, 0x6a,
-	0xb0, 0xf1, 0x0b, 0x0b, 0x03, 0x33, 0x0f, 0x4b, 0xd7, 0xc4, 0x5c, 0x16, 0x01, 0x77, 0xf6, 0x41,
-	0xcf, 0xc3, 0x70, 0xec, 0x07, 0xb5, 0x06, 0x99, 0x89, 0xbc, 0xa0, 0x52, 0x17, 0xb7, 0x2a, 0x95,
-	0x0b, 0xb7, 0xac, 0xb5, 0x61, 0x03, 0x93, 0xbd, 0xaf, 0xbc, 0x4f, 0x46, 0x93, 0x13, 0xd8, 0xa2,
-	0xd5, 0xfd, 0x91, 0x03, 0xc3, 0x7a, 0x02, 0x32, 0xd5, 0x92, 0xa1, 0x3e, 0x37, 0x5f, 0xe6, 0x72,
-	0xdc, 0xde, 0x69, 0x7d, 0x55, 0xd1, 0x4c, 0xad, 0xca, 0x14, 0x86, 0x35, 0x9e, 0x87, 0xb8, 0x4e,
-	0xfc, 0x04, 0x14, 0x37, 0x43, 0xaa, 0x4c, 0xf4, 0x98, 0xbe, 0xdf, 0x79, 0x0a, 0xc4, 0xbc, 0xcd,
-	0xfd, 0x1f, 0x0e, 0x9c, 0xcf, 0xcf, 0xad, 0x7e, 0x3b, 0x4c, 0xf2, 0x32, 0x00, 0x9d, 0x8a, 0x21,
-	0x90, 0xb5, 0x82, 0x02, 0xb2, 0x05, 0x6b, 0x58, 0x87, 0x9b, 0xf6, 0x9f, 0x50, 0x85, 0x36, 0xe5,
-	0xf3, 0x05, 0x07, 0x46, 0x28, 0xdb, 0xa5, 0x68, 0xc3, 0x98, 0xed, 0xaa, 0x9d, 0xd9, 0x2a, 0xb2,
-	0xa9, 0x8b, 0xdb, 0x00, 0x63, 0x93, 0x39, 0xfa, 0x05, 0x18, 0xf4, 0xaa, 0xd5, 0x88, 0xc4, 0xb1,
-	0x0a, 0x16, 0xb1, 0xd0, 0xf2, 0xb4, 0x04, 0xe2, 0xb4, 0x9d, 0x0a, 0xd1, 0x7a, 0x75, 0x33, 0xa6,
-	0x72, 0x49, 0x78, 0xf6, 0x94, 0x10, 0xa5, 0x4c, 0x28, 0x1c, 0x2b, 0x0c, 0xf7, 0x6f, 0xf6, 0x82,
-	0xc9, 0x1b, 0x55, 0xe1, 0xd4, 0x56, 0xb4, 0x31, 0xcb, 0xc2, 0xdf, 0xf7, 0x12, 0x86, 0x66, 0xe1,
-	0xe1, 0x25, 0x93, 0x02, 0xce, 0x92, 0x14, 0x5c, 0x96, 0xc8, 0x6e, 0xe2, 0x6d, 0xdc, 0x73, 0x10,
-	0x7a, 0xc9, 0xa4, 0x80, 0xb3, 0x24, 0xd1, 0xbb, 0x61, 0x68, 0x2b, 0xda, 0x90, 0x22, 0x3a, 0x9b,
-	0xd1, 0xb0, 0x94, 0x36, 0x61, 0x1d, 0x8f, 0x2e, 0xe1, 0x56, 0xb4, 0x41, 0x8f, 0x34, 0x79, 0xbd,
-	0x5e, 0x2d, 0xe1, 0x92, 0x80, 0x63, 0x85, 0x81, 0x5a, 0x80, 0xb6, 0xe4, 0xea, 0xa9, 0x60, 0xbf,
-	0x38, 0x49, 0x0e, 0x9f, 0x2b, 0xc0, 0x92, 0xa6, 0x97, 0x3a, 0xe8, 0xe0, 0x1c, 0xda, 0xe8, 0x25,
-	0xb8, 0xb0, 0x15, 0x6d, 0x88, 0x83, 0x7e, 0x2d, 0xf2, 0x83, 0x8a, 0xdf, 0x32, 0xae, 0xd2, 0x4f,
-	0x88, 0xe1, 0x5e, 0x58, 0xca, 0x47, 0xc3, 0xdd, 0xfa, 0xbb, 0xbf, 0xd5, 0x0b, 0xec, 0x12, 0x20,
-	0x95, 0x85, 0x4d, 0x92, 0xd4, 0xc3, 0x6a, 0x56, 0x77, 0x59, 0x61, 0x50, 0x2c, 0x5a, 0x65, 0x6a,
-	0x60, 0xa1, 0x4b, 0x6a, 0xe0, 0x0e, 0xf4, 0xd7, 0x89, 0x57, 0x25, 0x91, 0x74, 0xb5, 0x2d, 0xdb,
-	0xb9, 0xb6, 0x78, 0x95, 0x11, 0x4d, 0x4d, 0x68, 0xfe, 0x3b, 0xc6, 0x92, 0x1b, 0x7a, 0x0f, 0x8c,
-	0x52, 0x2d, 0x24, 0x6c, 0x27, 0xd2, 0xaf, 0xdc, 0xcb, 0xfc, 0xca, 0xec, 0x44, 0x5d, 0x37, 0x5a,
-	0x70, 0x06, 0x13, 0xcd, 0xc1, 0x98, 0xf0, 0x01, 0x2b, 0x17, 0x9e, 0x58, 0x58, 0x55, 0xe3, 0xa0,
-	0x9c, 0x69, 0xc7, 0x1d, 0x3d, 0x58, 0x2e, 0x58, 0x58, 0xe5, 0x61, 0x40, 0x3d, 0x17, 0x2c, 0xac,
-	0xee, 0x62, 0xd6, 0x82, 0x5e, 0x83, 0x01, 0xfa, 0x77, 0x3e, 0x0a, 0x9b, 0xc2, 0xaf, 0xb2, 0x66,
-	0x67, 0x75, 0x28, 0x0f, 0x61, 0xe5, 0x31, 0xed, 0x6c, 0x46, 0x70, 0xc1, 0x8a, 0x1f, 0xb5, 0x35,
-	0xe4, 0x39, 0x5c, 0xde, 0xf2, 0x5b, 0x37, 0x48, 0xe4, 0x6f, 0xee, 0x32, 0xa5, 0x61, 0x20, 0xb5,
-	0x35, 0x16, 0x3b, 0x30, 0x70, 0x4e, 0x2f, 0xf7, 0x0b, 0x05, 0x18, 0xd6, 0xef, 0x92, 0xde, 0x2d,
-	0x5f, 0x34, 0x4e, 0x37, 0x05, 0xb7, 0x2c, 0xaf, 0x5a, 0x98, 0xf6, 0xdd, 0x36, 0x44, 0x1d, 0x7a,
-	0xbd, 0xb6, 0xd0, 0x16, 0xad, 0x38, 0xb0, 0xd8, 0x8c, 0xdb, 0x49, 0x9d, 0x5f, 0x3a, 0x62, 0x99,
-	0x9c, 0x8c, 0x83, 0xfb, 0xe9, 0x1e, 0x18, 0x90, 0x8d, 0xe8, 0x53, 0x0e, 0x40, 0x9a, 0x82, 0x23,
-	0x44, 0xe9, 0x9a, 0x8d, 0xfc, 0x0c, 0x3d, 0x7b, 0x48, 0x73, 0x3a, 0x2b, 0x38, 0xd6, 0xf8, 0xa2,
-	0x04, 0xfa, 0x42, 0x3a, 0xb8, 0xcb, 0xf6, 0xee, 0x43, 0xaf, 0x52, 0xc6, 0x97, 0x19, 0xf7, 0xd4,
-	0xe5, 0xc5, 0x60, 0x58, 0xf0, 0xa2, 0xd6, 0xdb, 0x86, 0xcc, 0x0c, 0xb3, 0xe7, 0x1e, 0x56, 0xc9,
-	0x66, 0xa9, 0x31, 0xa6, 0x40, 0x38, 0x65, 0xe8, 0x3e, 0x0b, 0xa3, 0xe6, 0xcb, 0x40, 0x2d, 0x82,
-	0x8d, 0xdd, 0x84, 0x70, 0x5f, 0xc1, 0x30, 0xb7, 0x08, 0x66, 0x28, 0x00, 0x73, 0xb8, 0xfb, 0x26,
-	0xd5, 0x03, 0x94, 0x78, 0x39, 0x84, 0x7b, 0xfe, 0x09, 0xdd, 0xd1, 0xd5, 0xcd, 0x66, 0xfa, 0x38,
-	0x0c, 0xb2, 0x7f, 0xd8, 0x8b, 0xde, 0x63, 0x2b, 0x68, 0x9c, 0x8e, 0x53, 0xbc, 0xea, 0x4c, 0x27,
-	0xb8, 0x21, 0x19, 0xe1, 0x94, 0xa7, 0x1b, 0xc2, 0x58, 0x16, 0x1b, 0x7d, 0x10, 0x86, 0x63, 0x79,
-	0xac, 0xa6, 0x37, 0xa3, 0x0e, 0x79, 0xfc, 0x32, 0x9f, 0x6d, 0x59, 0xeb, 0x8e, 0x0d, 0x62, 0xee,
-	0x2a, 0xf4, 0x59, 0x5d, 0x42, 0xf7, 0xdb, 0x0e, 0x0c, 0xb2, 0xa8, 0x59, 0x2d, 0xf2, 0x9a, 0x69,
-	0x97, 0x9e, 0x03, 0x56, 0x3d, 0x86, 0x7e, 0x6e, 0x5f, 0xcb, 0x6c, 0x13, 0x0b, 0x52, 0x86, 0x97,
-	0x31, 0x4b, 0xa5, 0x0c, 0x37, 0xe4, 0x63, 0x2c, 0x39, 0xb9, 0x9f, 0x29, 0x40, 0xdf, 0x62, 0xd0,
-	0x6a, 0xff, 0x85, 0x2f, 0xa5, 0xb5, 0x02, 0xbd, 0x8b, 0x09, 0x69, 0x9a, 0x15, 0xdf, 0x86, 0x67,
-	0x9e, 0xd4, 0xab, 0xbd, 0x95, 0xcc, 0x6a, 0x6f, 0xd8, 0xdb, 0x91, 0xc9, 0x58, 0xc2, 0xbf, 0x9b,
-	0xde, 0x0e, 0x7b, 0x06, 0x06, 0x97, 0xbd, 0x0d, 0xd2, 0x58, 0x22, 0xbb, 0xec, 0x2e, 0x17, 0x4f,
-	0x0c, 0x70, 0x52, 0xc3, 0xde, 0x08, 0xe2, 0xcf, 0xc1, 0x28, 0xc3, 0x56, 0x2f, 0x03, 0xb5, 0x1c,
-	0x48, 0x5a, 0x2e, 0xc7, 0x31, 0x2d, 0x07, 0xad, 0x54, 0x8e, 0x86, 0xe5, 0x4e, 0xc2, 0x50, 0x4a,
-	0xe5, 0x10, 0x5c, 0x7f, 0x56, 0x80, 0x11, 0xc3, 0x4d, 0x6d, 0x04, 0xef, 0x9c, 0xbb, 0x06, 0xef,
-	0x8c, 0x60, 0x5a, 0xe1, 0x41, 0x07, 0xd3, 0x7a, 0x4e, 0x3e, 0x98, 0x66, 0x3e, 0xa4, 0xde, 0x43,
-	0x3d, 0xa4, 0x06, 0xf4, 0x2e, 0xfb, 0xc1, 0xd6, 0xe1, 0xe4, 0x4c, 0x5c, 0x09, 0x5b, 0x1d, 0x72,
-	0xa6, 0x4c, 0x81, 0x98, 0xb7, 0x49, 0xcd, 0xa5, 0x27, 0x5f, 0x73, 0x71, 0x3f, 0xe5, 0xc0, 0xf0,
-	0x8a, 0x17, 0xf8, 0x9b, 0x24, 0x4e, 0xd8, 0xbe, 0x4a, 0x8e, 0xf5, 0x4e, 0xcf, 0x70, 0x97, 0xdb,
-	0xe9, 0x9f, 0x74, 0xe0, 0xf4, 0x0a, 0x69, 0x86, 0xfe, 0x6b, 0x5e, 0x9a, 0xeb, 0x48, 0xc7, 0x5e,
-	0xf7, 0x13, 0x91, 0xda, 0xa5, 0xc6, 0x7e, 0xd5, 0x4f, 0x30, 0x85, 0xdf, 0xc5, 0x07, 0xcb, 0xd2,
-	0xeb, 0xa9, 0x81, 0xa6, 0xdd, 0x33, 0x4b, 0xb3, 0x18, 0x65, 0x03, 0x4e, 0x71, 0xdc, 0xdf, 0x76,
-	0xa0, 0x9f, 0x0f, 0x82, 0x48, 0xda, 0x4e, 0x17, 0xda, 0x75, 0x28, 0xb2, 0x7e, 0x62, 0x57, 0x2f,
-	0x58, 0x50, 0x7f, 0x28, 0x39, 0xfe, 0x0e, 0xb2, 0x7f, 0x31, 0x67, 0xc0, 0xcc, 0x16, 0xef, 0xd6,
-	0xb4, 0x4a, 0xf3, 0x4c, 0xcd, 0x16, 0x06, 0xc5, 0xa2, 0xd5, 0xfd, 0x7a, 0x0f, 0x0c, 0xa8, 0xa2,
-	0x4c, 0xec, 0xca, 0x7c, 0x10, 0x84, 0x89, 0xc7, 0x93, 0x02, 0xb8, 0xac, 0xfe, 0xa0, 0xbd, 0xa2,
-	0x50, 0x93, 0xd3, 0x29, 0x75, 0x1e, 0x7b, 0x53, 0x46, 0xa8, 0xd6, 0x82, 0xf5, 0x41, 0xa0, 0x8f,
-	0x41, 0x5f, 0x83, 0x4a, 0x1f, 0x29, 0xba, 0x6f, 0x58, 0x1c, 0x0e, 0x13, 0x6b, 0x62, 0x24, 0x6a,
-	0x85, 0x38, 0x10, 0x0b, 0xae, 0xe3, 0xef, 0x83, 0xb1, 0xec, 0xa8, 0xef, 0x76, 0x0d, 0x6e, 0x50,
-	0xbf, 0x44, 0xf7, 0x97, 0x85, 0xf4, 0x3c, 0x7a, 0x57, 0xf7, 0x45, 0x18, 0x5a, 0x21, 0x49, 0xe4,
-	0x57, 0x18, 0x81, 0xbb, 0x6d, 0xae, 0x43, 0xe9, 0x0f, 0x9f, 0x65, 0x9b, 0x95, 0xd2, 0x8c, 0xd1,
-	0x6d, 0x80, 0x56, 0x14, 0x52, 0xfb, 0x95, 0xb4, 0xe5, 0xc3, 0xb6, 0xa0, 0x0f, 0xaf, 0x29, 0x9a,
-	0x3c, 0x5c, 0x9c, 0xfe, 0xc6, 0x1a, 0x3f, 0xf7, 0x12, 0x14, 0x57, 0xda, 0x09, 0xb9, 0x75, 0x77,
-	0x89, 0xe5, 0x7e, 0x10, 0x86, 0x19, 0xea, 0xd5, 0xb0, 0x41, 0x4f, 0x49, 0x3a, 0xd3, 0x26, 0xfd,
-	0x9d, 0x75, 0xd0, 0x33, 0x24, 0xcc, 0xdb, 0xe8, 0x1b, 0x50, 0x0f, 0x1b, 0x55, 0x75, 0x47, 0x46,
-	0x3d, 0xdf, 0xab, 0x0c, 0x8a, 0x45, 0xab, 0xfb, 0xcb, 0x05, 0x18, 0x62, 0x1d, 0x85, 0xf4, 0xd8,
-	0x85, 0xfe, 0x3a, 0xe7, 0x23, 0x96, 0xc4, 0x42, 0x76, 0x9b, 0x3e, 0x7a, 0xcd, 0x34, 0xe3, 0x00,
-	0x2c, 0xf9, 0x51, 0xd6, 0x3b, 0x9e, 0x9f, 0x50, 0xd6, 0x85, 0xe3, 0x65, 0x7d, 0x93, 0xb3, 0xc1,
-	0x92, 0x9f, 0xfb, 0xe5, 0x02, 0x00, 0x2b, 0x99, 0xc5, 0xaf, 0x68, 0xfe, 0x22, 0x14, 0x5b, 0x75,
-	0x2f, 0xce, 0x06, 0xdd, 0x8a, 0x6b, 0x14, 0x78, 0x47, 0x5c, 0x42, 0x65, 0x3f, 0x30, 0x47, 0xd4,
-	0x13, 0xcb, 0x0b, 0x07, 0x27, 0x96, 0xa3, 0x16, 0xf4, 0x87, 0xed, 0x84, 0xea, 0x86, 0xe2, 0x70,
-	0xb5, 0x10, 0x73, 0x5e, 0xe5, 0x04, 0x79, 0x36, 0xb6, 0xf8, 0x81, 0x25, 0x1b, 0xf4, 0x3c, 0x0c,
-	0xb4, 0xa2, 0xb0, 0x46, 0xcf, 0x4a, 0x71, 0x9c, 0x3e, 0x2a, 0xf5, 0x8f, 0x35, 0x01, 0xbf, 0xa3,
-	0xfd, 0x8f, 0x15, 0xb6, 0xfb, 0x93, 0x53, 0x7c, 0x5d, 0xc4, 0xe6, 0x18, 0x87, 0x82, 0x2f, 0x3d,
-	0x41, 0x20, 0x48, 0x14, 0x16, 0xe7, 0x70, 0xc1, 0xaf, 0xaa, 0x7d, 0x5c, 0xe8, 0x7a, 0xf2, 0xbe,
-	0x1b, 0x86, 0xaa, 0x7e, 0xdc, 0x6a, 0x78, 0xbb, 0xd7, 0x72, 0xdc, 0x70, 0x73, 0x69, 0x13, 0xd6,
-	0xf1, 0xd0, 0x33, 0xe2, 0x1a, 0x41, 0xaf, 0xe1, 0x7a, 0x91, 0xd7, 0x08, 0xd2, 0x2b, 0xc0, 0xfc,
-	0x06, 0x41, 0xf6, 0xaa, 0x74, 0xf1, 0xd0, 0x57, 0xa5, 0xb3, 0x9a, 0x4f, 0xdf, 0xc9, 0x6b, 0x3e,
-	0xef, 0x85, 0x11, 0xf9, 0x93, 0xa9, 0x23, 0xa5, 0xb3, 0x6c, 0xf4, 0xca, 0x3d, 0xbc, 0xae, 0x37,
-	0x62, 0x13, 0x37, 0xdd, 0xb4, 0xfd, 0x87, 0xdd, 0xb4, 0x97, 0x01, 0x36, 0xc2, 0x76, 0x50, 0xf5,
-	0xa2, 0xdd, 0xc5, 0x39, 0x91, 0x74, 0xa8, 0x14, 0xad, 0x19, 0xd5, 0x82, 0x35, 0x2c, 0x7d, 0xa3,
-	0x0f, 0xde, 0x65, 0xa3, 0x7f, 0x10, 0x06, 0x59, 0x82, 0x26, 0xa9, 0x4e, 0x27, 0x22, 0x1d, 0xe7,
-	0x28, 0xb9, 0x7c, 0x4a, 0xed, 0x28, 0x4b, 0x22, 0x38, 0xa5, 0x87, 0x3e, 0x04, 0xb0, 0xe9, 0x07,
-	0x7e, 0x5c, 0x67, 0xd4, 0x87, 0x8e, 0x4c, 0x5d, 0xcd, 0x73, 0x5e, 0x51, 0xc1, 0x1a, 0x45, 0xf4,
-	0x0a, 0x9c, 0x26, 0x71, 0xe2, 0x37, 0xbd, 0x84, 0x54, 0xd5, 0x5d, 0xb8, 0x12, 0xf3, 0x1d, 0xaa,
-	0x14, 0xd9, 0x2b, 0x59, 0x84, 0x3b, 0x79, 0x40, 0xdc, 0x49, 0xc8, 0x78, 0x23, 0xc7, 0x8f, 0xf2,
-	0x46, 0xa2, 0x3f, 0x75, 0xe0, 0x74, 0x44, 0x78, 0x8e, 0x46, 0xac, 0x06, 0x76, 0x8e, 0xc9, 0xcb,
-	0x8a, 0x8d, 0x6a, 0xd4, 0xaa, 0xec, 0x04, 0xce, 0x72, 0xe1, 0x8a, 0x02, 0x91, 0xb3, 0xef, 0x68,
-	0xbf, 0x93, 0x07, 0xfc, 0xe4, 0x5b, 0x13, 0x13, 0x9d, 0xa5, 0xd1, 0x15, 0x71, 0xfa, 0xe6, 0xfd,
-	0x8d, 0xb7, 0x26, 0xc6, 0xe4, 0xef, 0x74, 0xd1, 0x3a, 0x26, 0x49, 0xcf, 0xbd, 0x56, 0x58, 0x5d,
-	0x5c, 0x13, 0x79, 0x53, 0xea, 0xdc, 0x5b, 0xa3, 0x40, 0xcc, 0xdb, 0xd0, 0xd3, 0x30, 0x50, 0xf5,
-	0x48, 0x33, 0x0c, 0x54, 0x5d, 0x51, 0xa6, 0x3d, 0xcf, 0x09, 0x18, 0x56, 0xad, 0xa8, 0x01, 0x7d,
-	0x3e, 0x33, 0xd1, 0x45, 0x92, 0xa4, 0x05, 0xbf, 0x00, 0x37, 0xf9, 0x65, 0x8a, 0x24, 0x13, 0xc2,
-	0x82, 0x87, 0x2e, 0xf5, 0x4f, 0x9d, 0x8c, 0xd4, 0x7f, 0x1a, 0x06, 0x2a, 0x75, 0xbf, 0x51, 0x8d,
-	0x48, 0x50, 0x1a, 0x63, 0xb6, 0x2a, 0x5b, 0x89, 0x59, 0x01, 0xc3, 0xaa, 0x15, 0xfd, 0x25, 0x18,
-	0x09, 0xdb, 0x09, 0x7b, 0xc9, 0xe9, 0xf3, 0x8f, 0x4b, 0xa7, 0x19, 0x3a, 0x4b, 0x79, 0x59, 0xd5,
-	0x1b, 0xb0, 0x89, 0x47, 0x85, 0x6d, 0x3d, 0x8c, 0x59, 0xad, 0x12, 0x26, 0x6c, 0xcf, 0x9b, 0xc2,
-	0xf6, 0xaa, 0xd6, 0x86, 0x0d, 0x4c, 0xf4, 0x55, 0x07, 0x4e, 0x37, 0xb3, 0xa6, 0x4b, 0xe9, 0x02,
-	0x5b, 0x99, 0xb2, 0x0d, 0x15, 0x37, 0x43, 0x9a, 0x67, 0x06, 0x77, 0x80, 0x71, 0xe7, 0x20, 0x58,
-	0xd5, 0xa0, 0x78, 0x37, 0xa8, 0xd4, 0xa3, 0x30, 0x30, 0x87, 0xf7, 0xb0, 0xad, 0x9b, 0x3c, 0xec,
-	0x2d, 0xcb, 0x63, 0x31, 0xf3, 0xf0, 0xfe, 0xde, 0xc4, 0xb9, 0xdc, 0x26, 0x9c, 0x3f, 0xa8, 0xf1,
-	0x39, 0x38, 0x9f, 0xff, 0xa6, 0xde, 0x4d, 0xd7, 0xee, 0xd1, 0x75, 0xed, 0x79, 0x78, 0xb8, 0xeb,
-	0xa0, 0xa8, 0xcc, 0x97, 0x8a, 0x99, 0x63, 0xca, 0xfc, 0x0e, 0x45, 0x6a, 0x14, 0x86, 0xf5, 0x82,
-	0xf6, 0xee, 0xff, 0xe9, 0x01, 0x48, 0x3d, 0xc4, 0xc8, 0x83, 0x51, 0xee, 0x8d, 0x5e, 0x9c, 0xbb,
-	0xe7, 0x6b, 0xc1, 0xb3, 0x06, 0x01, 0x9c, 0x21, 0x88, 0x9a, 0x80, 0x38, 0x84, 0xff, 0xbe, 0x97,
-	0xa8, 0x22, 0x0b, 0xc2, 0xcd, 0x76, 0x10, 0xc1, 0x39, 0x84, 0xe9, 0x8c, 0x92, 0x70, 0x8b, 0x04,
-	0xd7, 0xf1, 0xf2, 0xbd, 0xdc, 0x2d, 0xe7, 0x71, 0x28, 0x83, 0x00, 0xce, 0x10, 0x44, 0x2e, 0xf4,
-	0x31, 0xaf, 0x84, 0x4c, 0x2b, 0x66, 0xe2, 0x85, 0x9d, 0xf9, 0x31, 0x16, 0x2d, 0xe8, 0xcb, 0x0e,
-	0x8c, 0xca, 0x2b, 0xf2, 0xcc, 0x0f, 0x28, 0x13, 0x8a, 0xaf, 0xdb, 0xf2, 0xf0, 0x5f, 0xd1, 0xa9,
-	0xa7, 0xe9, 0x7a, 0x06, 0x38, 0xc6, 0x99, 0x41, 0xb8, 0x2f, 0xc1, 0x99, 0x9c, 0xee, 0x56, 0x6c,
-	0xb9, 0xef, 0x3a, 0x30, 0xa4, 0x15, 0x62, 0x43, 0xb7, 0x61, 0x30, 0x2c, 0x5b, 0xcf, 0x11, 0x5b,
-	0x2d, 0x77, 0xe4, 0x88, 0x29, 0x10, 0x4e, 0x19, 0x1e, 0x26, 0xb5, 0x2d, 0xb7, 0x6a, 0xdc, 0x03,
-	0x1e, 0xf6, 0x91, 0x53, 0xdb, 0xfe, 0x5d, 0x2f, 0xa4, 0x94, 0x8e, 0x58, 0xba, 0x21, 0x4d, 0x84,
-	0x2b, 0x1c, 0x98, 0x08, 0x57, 0x85, 0x53, 0x1e, 0x8b, 0xa2, 0xde, 0x63, 0xc1, 0x06, 0x5e, 0x87,
-	0xd3, 0xa4, 0x80, 0xb3, 0x24, 0x29, 0x97, 0x38, 0xed, 0xca, 0xb8, 0xf4, 0x1e, 0x99, 0x4b, 0xd9,
-	0xa4, 0x80, 0xb3, 0x24, 0xd1, 0x2b, 0x50, 0xaa, 0xb0, 0xdb, 0x8e, 0x7c, 0x8e, 0x8b, 0x9b, 0xd7,
-	0xc2, 0x64, 0x2d, 0x22, 0x31, 0x09, 0x12, 0x51, 0x69, 0xe9, 0x71, 0xb1, 0x0a, 0xa5, 0xd9, 0x2e,
-	0x78, 0xb8, 0x2b, 0x05, 0x6a, 0x30, 0xb0, 0x30, 0xac, 0x9f, 0xec, 0x32, 0x21, 0x22, 0xe2, 0xd3,
-	0xca, 0x60, 0x28, 0xeb, 0x8d, 0xd8, 0xc4, 0x45, 0xbf, 0xe2, 0xc0, 0x48, 0x43, 0x3a, 0xaa, 0x71,
-	0xbb, 0x21, 0xcb, 0x06, 0x62, 0x2b, 0xdb, 0x6f, 0x59, 0xa7, 0xcc, 0x75, 0x09, 0x03, 0x84, 0x4d,
-	0xde, 0xee, 0x9b, 0x0e, 0x8c, 0x65, 0xbb, 0xa1, 0x2d, 0x78, 0xac, 0xe9, 0x45, 0x5b, 0x8b, 0xc1,
-	0x66, 0xc4, 0xee, 0x01, 0x24, 0xfc, 0xa9, 0x4e, 0x6f, 0x26, 0x24, 0x9a, 0xf3, 0x76, 0x79, 0x04,
-	0xaf, 0xa8, 0x3e, 0x20, 0xf3, 0xd8, 0xca, 0x41, 0xc8, 0xf8, 0x60, 0x5a, 0xa8, 0x0c, 0xe7, 0x28,
-	0x02, 0x2b, 0x7a, 0xe5, 0x87, 0x41, 0xca, 0xa4, 0xc0, 0x98, 0xa8, 0x7c, 0xb6, 0x95, 0x3c, 0x24,
-	0x9c, 0xdf, 0xd7, 0x1d, 0x80, 0x3e, 0x7e, 0x07, 0xca, 0xfd, 0xb7, 0x05, 0x90, 0x4a, 0xda, 0x5f,
-	0xec, 0xa0, 0x10, 0x3d, 0xd0, 0x22, 0xe6, 0x68, 0x11, 0x3e, 0x00, 0x76, 0xa0, 0x89, 0x0a, 0x71,
-	0xa2, 0x85, 0x6a, 0xaf, 0xe4, 0x96, 0x9f, 0xcc, 0x86, 0x55, 0x69, 0xf9, 0x33, 0xed, 0xf5, 0x8a,
-	0x80, 0x61, 0xd5, 0xea, 0x7e, 0xca, 0x81, 0x11, 0x3a, 0xcb, 0x46, 0x83, 0x34, 0xca, 0x09, 0x69,
-	0xc5, 0x28, 0x86, 0x62, 0x4c, 0xff, 0xb1, 0xe7, 0xc1, 0x4a, 0xaf, 0xbe, 0x91, 0x96, 0x16, 0x32,
-	0xa0, 0x4c, 0x30, 0xe7, 0xe5, 0x7e, 0xa7, 0x07, 0x06, 0xd5, 0x62, 0x1f, 0x22, 0x0e, 0x71, 0x39,
-	0x2d, 0xde, 0xc8, 0xa5, 0x61, 0x49, 0x2b, 0xdc, 0x48, 0xcd, 0xf5, 0xe9, 0x60, 0x97, 0xdf, 0xb1,
-	0x4f, 0xab, 0x38, 0x3e, 0x63, 0x06, 0x3c, 0xcf, 0xeb, 0x51, 0x34, 0x0d, 0x5f, 0x44, 0x3e, 0x6f,
-	0xe9, 0xf1, 0xe6, 0x5e, 0x5b, 0x27, 0x8b, 0x0a, 0xa6, 0x75, 0x0f, 0x34, 0x67, 0xbe, 0xeb, 0x51,
-	0x3c, 0xd4, 0x77, 0x3d, 0x2e, 0x41, 0x2f, 0x09, 0xda, 0x4d, 0xa6, 0xb6, 0x0c, 0x32, 0x75, 0xbd,
-	0xf7, 0x4a, 0xd0, 0x6e, 0x9a, 0x33, 0x63, 0x28, 0xe8, 0x7d, 0x30, 0x54, 0x25, 0x71, 0x25, 0xf2,
-	0xd9, 0xc5, 0x71, 0xe1, 0xef, 0x78, 0x94, 0x39, 0x91, 0x52, 0xb0, 0xd9, 0x51, 0xef, 0xe0, 0xbe,
-	0x06, 0x7d, 0x6b, 0x8d, 0x76, 0xcd, 0x0f, 0x50, 0x0b, 0xfa, 0xf8, 0x35, 0x72, 0x71, 0xf2, 0x5a,
-	0xb0, 0x01, 0xf9, 0xdb, 0xae, 0xe5, 0x42, 0xf0, 0x1b, 0x90, 0x82, 0x8f, 0xfb, 0xcf, 0x1c, 0xa0,
-	0x06, 0xeb, 0xc2, 0x2c, 0xfa, 0xab, 0x1d, 0x9f, 0xb1, 0xf8, 0xb9, 0x9c, 0xcf, 0x58, 0x8c, 0x30,
-	0xe4, 0x9c, 0x2f, 0x58, 0x34, 0x60, 0x84, 0xb9, 0xe8, 0xe5, 0x79, 0x24, 0x54, 0xdc, 0xe7, 0x0e,
-	0x79, 0xf3, 0x5a, 0xef, 0x2a, 0xa4, 0xb3, 0x0e, 0xc2, 0x26, 0x71, 0xf7, 0x77, 0x7a, 0x41, 0xf3,
-	0x64, 0x1f, 0x62, 0x7b, 0x7f, 0x34, 0x13, 0xb7, 0x58, 0xb1, 0x12, 0xb7, 0x90, 0xc1, 0x00, 0x2e,
-	0x32, 0xcc, 0x50, 0x05, 0x1d, 0x54, 0x9d, 0x34, 0x5a, 0xe2, 0xe5, 0x50, 0x83, 0xba, 0x4a, 0x1a,
-	0x2d, 0xcc, 0x5a, 0xd4, 0x1d, 0xb2, 0xde, 0xae, 0x77, 0xc8, 0xea, 0x50, 0xac, 0x79, 0xed, 0x1a,
-	0x11, 0x89, 0x7b, 0x16, 0x42, 0x54, 0x2c, 0xa9, 0x9e, 0x87, 0xa8, 0xd8, 0xbf, 0x98, 0x33, 0xa0,
-	0x6f, 0x67, 0x5d, 0x66, 0x32, 0x08, 0x5f, 0xa3, 0x85, 0xb7, 0x53, 0x25, 0x47, 0xf0, 0xb7, 0x53,
-	0xfd, 0xc4, 0x29, 0x33, 0xd4, 0x82, 0xfe, 0x0a, 0x2f, 0xd8, 0x20, 0x0e, 0xfc, 0x45, 0x1b, 0x97,
-	0xe4, 0x18, 0x41, 0xee, 0x8a, 0x10, 0x3f, 0xb0, 0x64, 0xe3, 0x4e, 0xc1, 0x90, 0x56, 0xfe, 0x9e,
-	0x3e, 0x06, 0x55, 0x2b, 0x40, 0x7b, 0x0c, 0x73, 0x5e, 0xe2, 0x61, 0xd6, 0xe2, 0x7e, 0xb3, 0x17,
-	0x94, 0x4b, 0x48, 0xbf, 0xd2, 0xe5, 0x55, 0xb4, 0xca, 0x26, 0xc6, 0x5d, 0xe2, 0x30, 0xc0, 0xa2,
-	0x95, 0x2a, 0x45, 0x4d, 0x12, 0xd5, 0x94, 0x11, 0x2a, 0xe4, 0xab, 0x52, 0x8a, 0x56, 0xf4, 0x46,
-	0x6c, 0xe2, 0x52, 0x8d, 0xb6, 0x29, 0x22, 0xbb, 0xd9, 0xbc, 0x59, 0x19, 0xf1, 0xc5, 0x0a, 0x03,
-	0x7d, 0xca, 0x81, 0xe1, 0xa6, 0x16, 0x08, 0x16, 0xf9, 0x7b, 0x36, 0x02, 0x17, 0x1a, 0x55, 0x9e,
-	0x67, 0xa3, 0x43, 0xb0, 0xc1, 0x15, 0x2d, 0xc0, 0xe9, 0x98, 0x24, 0xab, 0x3b, 0x01, 0x89, 0xd4,
-	0x55, 0x6b, 0x71, 0xf7, 0x5e, 0x25, 0xcd, 0x97, 0xb3, 0x08, 0xb8, 0xb3, 0x4f, 0x6e, 0xca, 0x63,
-	0xf1, 0xc8, 0x29, 0x8f, 0x73, 0x30, 0xb6, 0xe9, 0xf9, 0x8d, 0x76, 0x44, 0xba, 0x26, 0x4e, 0xce,
-	0x67, 0xda, 0x71, 0x47, 0x0f, 0x76, 0x6f, 0xa3, 0xe1, 0xd5, 0xe2, 0x52, 0xbf, 0x76, 0x6f, 0x83,
-	0x02, 0x30, 0x87, 0xbb, 0xff, 0xd8, 0x01, 0x5e, 0xf4, 0x64, 0x7a, 0x73, 0xd3, 0x0f, 0xfc, 0x64,
-	0x17, 0x7d, 0xcd, 0x81, 0xb1, 0x20, 0xac, 0x92, 0xe9, 0x20, 0xf1, 0x25, 0xd0, 0x5e, 0xad, 0x67,
-	0xc6, 0xeb, 0x5a, 0x86, 0x3c, 0xbf, 0x41, 0x9f, 0x85, 0xe2, 0x8e, 0x61, 0xb8, 0x17, 0xe0, 0x5c,
-	0x2e, 0x01, 0xf7, 0xcd, 0x1e, 0x30, 0x6b, 0xb7, 0xa0, 0x17, 0xa1, 0xd8, 0x60, 0xd5, 0x04, 0x9c,
-	0x7b, 0x2c, 0xca, 0xc3, 0xd6, 0x8a, 0x97, 0x1b, 0xe0, 0x94, 0xd0, 0x1c, 0x0c, 0xb1, 0x82, 0x30,
-	0xa2, 0xd6, 0x03, 0x7f, 0x23, 0xdc, 0xf4, 0x13, 0x53, 0xaa, 0xe9, 0x8e, 0xf9, 0x13, 0xeb, 0xdd,
-	0xd0, 0xeb, 0xd0, 0xbf, 0xc1, 0x2b, 0xd5, 0xd9, 0x0b, 0x5d, 0x89, 0xd2, 0x77, 0x4c, 0x99, 0x91,
-	0x75, 0xf0, 0xee, 0xa4, 0xff, 0x62, 0xc9, 0x11, 0xed, 0xc2, 0x80, 0x27, 0x9f, 0x69, 0xaf, 0xad,
-	0x3c, 0x7c, 0x63, 0xff, 0x88, 0x44, 0x0b, 0xf9, 0x0c, 0x15, 0xbb, 0x4c, 0x46, 0x4a, 0xf1, 0x50,
-	0x19, 0x29, 0xdf, 0x76, 0x00, 0xd2, 0x2a, 0xfd, 0xe8, 0x16, 0x0c, 0xc4, 0xcf, 0x19, 0x56, 0xbe,
-	0x8d, 0xcb, 0xd3, 0x82, 0xa2, 0x76, 0xc1, 0x50, 0x40, 0xb0, 0xe2, 0x76, 0x37, 0xcf, 0xc4, 0xcf,
-	0x1c, 0x38, 0x9b, 0xf7, 0x35, 0x81, 0x07, 0x38, 0xe2, 0xa3, 0x3a, 0x25, 0x44, 0x87, 0xb5, 0x88,
-	0x6c, 0xfa, 0xb7, 0xb2, 0x49, 0x2b, 0x4b, 0xb2, 0x01, 0xa7, 0x38, 0xee, 0xf7, 0xfa, 0x40, 0x31,
-	0x3e, 0x26, 0x27, 0xc6, 0x53, 0xd4, 0xc8, 0xa9, 0xa5, 0x15, 0x14, 0x15, 0x1e, 0x66, 0x50, 0x2c,
-	0x5a, 0xa9, 0xa1, 0x23, 0x73, 0xa9, 0x85, 0xc8, 0x66, 0xbb, 0x50, 0xe6, 0x5c, 0x63, 0xd5, 0x9a,
-	0xe7, 0x16, 0x29, 0x9e, 0x88, 0x5b, 0xa4, 0xcf, 0xbe, 0x5b, 0xe4, 0x12, 0xf4, 0x47, 0x61, 0x83,
-	0x4c, 0xe3, 0x6b, 0x42, 0x7d, 0x4f, 0x6b, 0xdb, 0x72, 0x30, 0x96, 0xed, 0xd9, 0xb2, 0x9a, 0x03,
-	0x87, 0x2b, 0xab, 0x89, 0xbe, 0xe7, 0x1c, 0xe0, 0x79, 0x19, 0xb4, 0x75, 0x26, 0xe4, 0x56, 0xb2,
-	0x62, 0xb6, 0xc8, 0xbd, 0xb8, 0x73, 0xbe, 0xee, 0xc0, 0x69, 0x12, 0x54, 0xa2, 0x5d, 0x46, 0x47,
-	0x50, 0x13, 0xa1, 0xd3, 0xeb, 0x36, 0x5e, 0xbe, 0x2b, 0x59, 0xe2, 0x3c, 0x2e, 0xd2, 0x01, 0xc6,
-	0x9d, 0xc3, 0x70, 0x7f, 0x52, 0x80, 0x33, 0x39, 0x14, 0xd8, 0x35, 0x99, 0x26, 0xdd, 0x40, 0x8b,
-	0xd5, 0xec, 0xeb, 0xb3, 0x24, 0xe0, 0x58, 0x61, 0xa0, 0x35, 0x38, 0xbb, 0xd5, 0x8c, 0x53, 0x2a,
-	0xb3, 0x61, 0x90, 0x90, 0x5b, 0xf2, 0x65, 0x92, 0x51, 0xd0, 0xb3, 0x4b, 0x39, 0x38, 0x38, 0xb7,
-	0x27, 0xd5, 0x36, 0x48, 0xe0, 0x6d, 0x34, 0x48, 0xda, 0x24, 0x2e, 0x79, 0x29, 0x6d, 0xe3, 0x4a,
-	0xa6, 0x1d, 0x77, 0xf4, 0x40, 0x9f, 0x73, 0xe0, 0x91, 0x98, 0x44, 0xdb, 0x24, 0x2a, 0xfb, 0x55,
-	0x32, 0xdb, 0x8e, 0x93, 0xb0, 0x49, 0xa2, 0x7b, 0x74, 0x0d, 0x4e, 0xec, 0xef, 0x4d, 0x3c, 0x52,
-	0xee, 0x4e, 0x0d, 0x1f, 0xc4, 0xca, 0xfd, 0x9c, 0x03, 0xa3, 0x65, 0x66, 0xac, 0x2a, 0xd5, 0xd7,
-	0x76, 0xe9, 0xc1, 0xa7, 0x54, 0x49, 0x81, 0x8c, 0x10, 0x33, 0x8b, 0x00, 0xb8, 0xaf, 0xc2, 0x58,
-	0x99, 0x34, 0xbd, 0x56, 0x9d, 0xdd, 0xd0, 0xe4, 0x69, 0x3a, 0x53, 0x30, 0x18, 0x4b, 0x58, 0xf6,
-	0x7b, 0x1e, 0x0a, 0x19, 0xa7, 0x38, 0xe8, 0x49, 0x9e, 0x52, 0x24, 0xef, 0x79, 0x0c, 0x72, 0x23,
-	0x81, 0xe7, 0x21, 0xc5, 0x58, 0xb6, 0xb9, 0x3b, 0x30, 0x9c, 0x76, 0x27, 0x9b, 0xa8, 0x06, 0xa7,
-	0x2a, 0xda, 0x15, 0xa9, 0x34, 0x39, 0xfd, 0xf0, 0xb7, 0xa9, 0x78, 0x8d, 0x52, 0x93, 0x08, 0xce,
-	0x52, 0x75, 0xbf, 0x58, 0x80, 0x53, 0x8a, 0xb3, 0x08, 0x79, 0xbd, 0x91, 0x4d, 0x83, 0xc2, 0x36,
-	0x4a, 0x9d, 0x98, 0x2b, 0x79, 0x40, 0x2a, 0xd4, 0x1b, 0xd9, 0x54, 0xa8, 0x63, 0x65, 0xdf, 0x11,
-	0xc5, 0xfb, 0x76, 0x01, 0x06, 0x54, 0xe1, 0x95, 0x17, 0xa1, 0xc8, 0xec, 0xb8, 0xfb, 0xd3, 0x46,
-	0x99, 0x4d, 0x88, 0x39, 0x25, 0x4a, 0x92, 0x65, 0x72, 0xdc, 0x73, 0xd5, 0xc9, 0x41, 0xee, 0x7e,
-	0xf3, 0xa2, 0x04, 0x73, 0x4a, 0x68, 0x09, 0x7a, 0x48, 0x50, 0x15, 0x6a, 0xe9, 0xd1, 0x09, 0xb2,
-	0xef, 0xe8, 0x5c, 0x09, 0xaa, 0x98, 0x52, 0x61, 0xa5, 0x0f, 0xb9, 0xf6, 0x91, 0xf9, 0x6a, 0x82,
-	0x50, 0x3d, 0x44, 0xab, 0xfb, 0x2b, 0x3d, 0xd0, 0x57, 0x6e, 0x6f, 0x50, 0x05, 0xfb, 0x5b, 0x0e,
-	0x9c, 0xd9, 0xc9, 0x54, 0x49, 0x4d, 0xb7, 0xec, 0x75, 0x7b, 0x2e, 0x48, 0x3d, 0x9b, 0xe8, 0x11,
-	0xf9, 0x49, 0xe8, 0x9c, 0x46, 0x9c, 0x37, 0x1c, 0xa3, 0x2a, 0x62, 0xcf, 0xb1, 0x54, 0x45, 0xbc,
-	0x75, 0xcc, 0xe9, 0xeb, 0x23, 0xdd, 0x52, 0xd7, 0xdd, 0xdf, 0x29, 0x02, 0xf0, 0xa7, 0xb1, 0xda,
-	0x4a, 0x0e, 0xe3, 0xa3, 0x7a, 0x1e, 0x86, 0xe5, 0x37, 0xea, 0xf3, 0x3e, 0xc8, 0xb1, 0xa0, 0xb5,
-	0x61, 0x03, 0x93, 0x19, 0x04, 0x41, 0x12, 0xed, 0x72, 0xa5, 0x31, 0x9b, 0xa2, 0xae, 0x5a, 0xb0,
-	0x86, 0x85, 0x26, 0x0d, 0x9f, 0x3f, 0x0f, 0xe5, 0x8e, 0x1e, 0xe0, 0xa2, 0x7f, 0x1f, 0x8c, 0x9a,
-	0xb5, 0x1a, 0x84, 0xa6, 0xa4, 0x42, 0xaf, 0x66, 0x89, 0x07, 0x9c, 0xc1, 0xa6, 0x9b, 0xb8, 0x1a,
-	0xed, 0xe2, 0x76, 0x20, 0x54, 0x26, 0xb5, 0x89, 0xe7, 0x18, 0x14, 0x8b, 0x56, 0x76, 0x51, 0x9e,
-	0x9d, 0x46, 0x1c, 0x2e, 0x2e, 0xdb, 0xa7, 0x17, 0xe5, 0xb5, 0x36, 0x6c, 0x60, 0x52, 0x0e, 0xc2,
-	0xc7, 0x07, 0xe6, 0x6b, 0x92, 0x71, 0xcc, 0xb5, 0x60, 0x34, 0x34, 0x7d, 0x13, 0x3c, 0x5f, 0xeb,
-	0x5d, 0x87, 0xdc, 0x7a, 0x46, 0x5f, 0x1e, 0x32, 0xcf, 0xb8, 0x32, 0x32, 0xf4, 0xa9, 0xce, 0xa8,
-	0x67, 0x72, 0x0f, 0x9b, 0xa9, 0x86, 0x5d, 0x93, 0xad, 0xd7, 0xe0, 0x6c, 0x2b, 0xac, 0xae, 0x45,
-	0x7e, 0x18, 0xf9, 0xc9, 0xee, 0x6c, 0xc3, 0x8b, 0x63, 0xb6, 0x31, 0x46, 0x4c, 0xe5, 0x64, 0x2d,
-	0x07, 0x07, 0xe7, 0xf6, 0xa4, 0xda, 0x7d, 0x4b, 0x00, 0x59, 0x9a, 0x51, 0x91, 0x6b, 0xf7, 0x12,
-	0x11, 0xab, 0x56, 0xf7, 0x0c, 0x9c, 0x2e, 0xb7, 0x5b, 0xad, 0x86, 0x4f, 0xaa, 0xca, 0xa7, 0xee,
-	0xbe, 0x1f, 0x4e, 0x89, 0x9a, 0x89, 0x4a, 0x15, 0x38, 0x52, 0x85, 0x5f, 0xf7, 0x4f, 0x1d, 0x38,
-	0x95, 0x49, 0xea, 0x40, 0xaf, 0x67, 0x0f, 0x70, 0x2b, 0x0e, 0x2b, 0xfd, 0xec, 0xe6, 0x2f, 0x69,
-	0xae, 0x32, 0x50, 0x97, 0xc9, 0xcb, 0xd6, 0xee, 0x00, 0xb0, 0x14, 0x5f, 0x7e, 0x22, 0xe8, 0x19,
-	0xd0, 0xee, 0x67, 0x0b, 0x90, 0x9f, 0x49, 0x83, 0x3e, 0xd6, 0xb9, 0x00, 0x2f, 0x5a, 0x5c, 0x00,
-	0x91, 0xca, 0xd3, 0x7d, 0x0d, 0x02, 0x73, 0x0d, 0x56, 0x2c, 0xad, 0x81, 0xe0, 0xdb, 0xb9, 0x12,
-	0xff, 0xd3, 0x81, 0xa1, 0xf5, 0xf5, 0x65, 0xe5, 0x5f, 0xc2, 0x70, 0x3e, 0xe6, 0x77, 0x9c, 0x59,
-	0x9c, 0x72, 0x36, 0x6c, 0xb6, 0x78, 0xd8, 0x52, 0x84, 0x53, 0x59, 0xf9, 0xca, 0x72, 0x2e, 0x06,
-	0xee, 0xd2, 0x13, 0x2d, 0xc2, 0x19, 0xbd, 0xa5, 0xac, 0x7d, 0x8f, 0xab, 0x28, 0xea, 0x8a, 0x74,
-	0x36, 0xe3, 0xbc, 0x3e, 0x59, 0x52, 0xc2, 0x55, 0x28, 0xbe, 0x7f, 0xdf, 0x41, 0x4a, 0x34, 0xe3,
-	0xbc, 0x3e, 0xee, 0x2a, 0x0c, 0xad, 0x7b, 0x91, 0x9a, 0xf8, 0x07, 0x60, 0xac, 0x12, 0x36, 0xa5,
-	0x8b, 0x66, 0x99, 0x6c, 0x93, 0x86, 0x98, 0x32, 0xaf, 0x9a, 0x9f, 0x69, 0xc3, 0x1d, 0xd8, 0xee,
-	0x7f, 0xbb, 0x08, 0xea, 0xce, 0xd6, 0x21, 0x4e, 0x98, 0x96, 0xca, 0x31, 0x2c, 0x5a, 0xce, 0x31,
-	0x54, 0xb2, 0x36, 0x93, 0x67, 0x98, 0xa4, 0x79, 0x86, 0x7d, 0xb6, 0xf3, 0x0c, 0x95, 0xc2, 0xd8,
-	0x91, 0x6b, 0xf8, 0x15, 0x07, 0x86, 0x83, 0xb0, 0x4a, 0x54, 0x30, 0xaa, 0x9f, 0x69, 0xad, 0xaf,
-	0xd8, 0x4b, 0x9e, 0xe6, 0x39, 0x73, 0x82, 0x3c, 0xcf, 0x44, 0x55, 0x47, 0x94, 0xde, 0x84, 0x8d,
-	0x71, 0xa0, 0x79, 0xcd, 0x69, 0xc8, 0x7d, 0xf3, 0x8f, 0xe6, 0x59, 0x0f, 0x77, 0xf5, 0x00, 0xde,
-	0xd2, 0xf4, 0xa6, 0x41, 0xeb, 0x1f, 0x8b, 0x4f, 0x43, 0x0c, 0xb2, 0x02, 0x6b, 0xaa, 0x4f, 0xb9,
-	0xd0, 0xc7, 0x53, 0x56, 0x45, 0x05, 0x1b, 0x16, 0xf9, 0xe2, 0xe9, 0xac, 0x58, 0xb4, 0xa0, 0x44,
-	0x06, 0xbc, 0x87, 0x6c, 0xd5, 0x53, 0x37, 0x02, 0xea, 0xf9, 0x11, 0x6f, 0xf4, 0x82, 0x6e, 0x94,
-	0x0e, 0x1f, 0xc6, 0x28, 0x1d, 0xe9, 0x6a, 0x90, 0x7e, 0xc1, 0x81, 0xe1, 0x8a, 0x56, 0xdf, 0xbc,
-	0xf4, 0xb4, 0xad, 0x2f, 0xa5, 0xe6, 0x95, 0xa1, 0xe7, 0x01, 0x15, 0xa3, 0x9e, 0xba, 0xc1, 0x9d,
-	0x95, 0xdc, 0x63, 0x16, 0x38, 0x3b, 0xfa, 0xad, 0xdc, 0xd4, 0x37, 0x2d, 0x7a, 0x99, 0xc4, 0x47,
-	0x61, 0x58, 0xf0, 0x42, 0xb7, 0x61, 0x40, 0x66, 0x3d, 0x8b, 0x9c, 0x64, 0x6c, 0xc3, 0xc3, 0x6d,
-	0x86, 0xd1, 0x64, 0xa1, 0x2e, 0x0e, 0xc5, 0x8a, 0x23, 0xaa, 0x43, 0x4f, 0xd5, 0xab, 0x89, 0xec,
-	0xe4, 0x15, 0x3b, 0x75, 0x10, 0x25, 0x4f, 0x66, 0x5e, 0xcd, 0x4d, 0x2f, 0x60, 0xca, 0x02, 0xdd,
-	0x4a, 0x0b, 0x44, 0x8f, 0x59, 0x3b, 0x7d, 0x4d, 0x35, 0x89, 0xfb, 0x18, 0x3a, 0xea, 0x4d, 0x57,
-	0x45, 0xe4, 0xf1, 0xff, 0x63, 0x6c, 0xe7, 0xed, 0x14, 0x52, 0xe4, 0x95, 0x1f, 0xd2, 0xe8, 0x25,
-	0xe5, 0xc2, 0xbe, 0xd0, 0xfe, 0xf3, 0xb6, 0xb8, 0xb0, 0xfa, 0x05, 0xd9, 0x2f, 0xb3, 0x37, 0xa0,
-	0xaf, 0xc5, 0xb2, 0x18, 0x4a, 0xbf, 0x60, 0xeb, 0x6c, 0xe1, 0x59, 0x11, 0x7c, 0x6f, 0xf2, 0xff,
-	0xb1, 0xe0, 0x81, 0xae, 0x40, 0x3f, 0xff, 0xce, 0x01, 0xcf, 0x0e, 0x1f, 0xba, 0x3c, 0xde, 0xfd,
-	0x6b, 0x09, 0xe9, 0x41, 0xc1, 0x7f, 0xc7, 0x58, 0xf6, 0x45, 0x5f, 0x74, 0x60, 0x94, 0x4a, 0xd4,
-	0xf4, 0xc3, 0x0c, 0x25, 0x64, 0x4b, 0x66, 0x5d, 0x8f, 0xa9, 0x46, 0x22, 0x65, 0x8d, 0x32, 0x93,
-	0x16, 0x0d, 0x76, 0x38, 0xc3, 0x1e, 0xbd, 0x01, 0x03, 0xb1, 0x5f, 0x25, 0x15, 0x2f, 0x8a, 0x4b,
-	0x67, 0x8e, 0x67, 0x28, 0x69, 0xac, 0x43, 0x30, 0xc2, 0x8a, 0x65, 0xee, 0x17, 0xca, 0xcf, 0x3e,
-	0xe0, 0x2f, 0x94, 0xff, 0x75, 0x07, 0xce, 0xf1, 0xba, 0xdc, 0xd9, 0xa2, 0xec, 0xe7, 0xee, 0xd1,
-	0xbd, 0xc2, 0xd2, 0xda, 0xa7, 0xf3, 0x48, 0xe2, 0x7c, 0x4e, 0xac, 0xb0, 0xa7, 0xf9, 0x1d, 0x8d,
-	0xf3, 0x56, 0x63, 0x7e, 0x87, 0xff, 0x76, 0x06, 0x7a, 0x16, 0x86, 0x5a, 0xe2, 0x38, 0xf4, 0xe3,
-	0x26, 0xbb, 0xa4, 0xd0, 0xc3, 0x2f, 0x72, 0xad, 0xa5, 0x60, 0xac, 0xe3, 0x18, 0x55, 0x5e, 0x2f,
-	0x1d, 0x54, 0xe5, 0x15, 0x5d, 0x87, 0xa1, 0x24, 0x6c, 0x90, 0x48, 0x58, 0xaa, 0x25, 0xb6, 0x03,
-	0x2f, 0xe6, 0xbd, 0x5b, 0xeb, 0x0a, 0x2d, 0xb5, 0x64, 0x53, 0x58, 0x8c, 0x75, 0x3a, 0x2c, 0x31,
-	0x54, 0xd4, 0x3b, 0x8f, 0x98, 0x09, 0xfb, 0x70, 0x26, 0x31, 0x54, 0x6f, 0xc4, 0x26, 0x2e, 0x5a,
-	0x80, 0xd3, 0xad, 0x0e, 0x1b, 0x98, 0x5f, 0x53, 0x52, 0xe9, 0x04, 0x9d, 0x06, 0x70, 0x67, 0x1f,
-	0xc3, 0xfa, 0x7d, 0xe4, 0x20, 0xeb, 0xb7, 0x4b, 0xcd, 0xd3, 0x47, 0xef, 0xa5, 0xe6, 0x29, 0xaa,
-	0xc2, 0xa3, 0x5e, 0x3b, 0x09, 0x59, 0x8d, 0x0e, 0xb3, 0x0b, 0xcf, 0x91, 0x7d, 0x9c, 0xa7, 0xdd,
-	0xee, 0xef, 0x4d, 0x3c, 0x3a, 0x7d, 0x00, 0x1e, 0x3e, 0x90, 0x0a, 0x7a, 0x0d, 0x06, 0x88, 0xa8,
-	0xdb, 0x5a, 0xfa, 0x39, 0x5b, 0x4a, 0x82, 0x59, 0x09, 0x56, 0xa6, 0x3c, 0x72, 0x18, 0x56, 0xfc,
-	0xd0, 0x3a, 0x0c, 0xd5, 0xc3, 0x38, 0x99, 0x6e, 0xf8, 0x5e, 0x4c, 0xe2, 0xd2, 0x63, 0x6c, 0xd3,
-	0xe4, 0xea, 0x5e, 0x57, 0x25, 0x5a, 0xba, 0x67, 0xae, 0xa6, 0x3d, 0xb1, 0x4e, 0x06, 0x11, 0x16,
-	0xf9, 0x63, 0x09, 0xc2, 0x32, 0x2a, 0x73, 0x91, 0x4d, 0xec, 0xa9, 0x3c, 0xca, 0x6b, 0x61, 0xb5,
-	0x6c, 0x62, 0xab, 0xd0, 0x9f, 0x0e, 0xc4, 0x59, 0x9a, 0xe8, 0x79, 0x18, 0x6e, 0x85, 0xd5, 0x72,
-	0x8b, 0x54, 0xd6, 0xbc, 0xa4, 0x52, 0x2f, 0x4d, 0x98, 0x5e, 0xb7, 0x35, 0xad, 0x0d, 0x1b, 0x98,
-	0xa8, 0x05, 0xfd, 0x4d, 0x7e, 0x79, 0xbb, 0xf4, 0x84, 0x2d, 0xdb, 0x46, 0xdc, 0x06, 0xe7, 0xfa,
-	0x82, 0xf8, 0x81, 0x25, 0x1b, 0xf4, 0x0f, 0x1c, 0x38, 0x95, 0xb9, 0x76, 0x53, 0x7a, 0x87, 0x35,
-	0x95, 0xc5, 0x24, 0x3c, 0xf3, 0x14, 0x5b, 0x3e, 0x13, 0x78, 0xa7, 0x13, 0x84, 0xb3, 0x23, 0xe2,
-	0xeb, 0xc2, 0x2a, 0x30, 0x94, 0x9e, 0xb4, 0xb7, 0x2e, 0x8c, 0xa0, 0x5c, 0x17, 0xf6, 0x03, 0x4b,
-	0x36, 0xe8, 0x12, 0xf4, 0x8b, 0x62, 0x69, 0xa5, 0xa7, 0xcc, 0xf0, 0xad, 0xa8, 0xa9, 0x86, 0x65,
-	0xfb, 0xf8, 0xfb, 0xe1, 0x74, 0x87, 0xe9, 0x76, 0xa4, 0x32, 0x00, 0xbf, 0xe1, 0x80, 0x7e, 0x63,
-	0xd6, 0xfa, 0xc7, 0x12, 0x9e, 0x87, 0xe1, 0x0a, 0xff, 0xca, 0x19, 0xbf, 0x73, 0xdb, 0x6b, 0xfa,
-	0x3f, 0x67, 0xb5, 0x36, 0x6c, 0x60, 0xba, 0x57, 0x01, 0x75, 0x56, 0xb2, 0xbe, 0xa7, 0x1a, 0x33,
-	0xff, 0xc8, 0x81, 0x11, 0x43, 0x67, 0xb0, 0x1e, 0xf1, 0x9b, 0x07, 0xd4, 0xf4, 0xa3, 0x28, 0x8c,
-	0xf4, 0x6f, 0x57, 0x89, 0xd2, 0xbd, 0xec, 0xbe, 0xd3, 0x4a, 0x47, 0x2b, 0xce, 0xe9, 0xe1, 0xfe,
-	0xd3, 0x5e, 0x48, 0x73, 0x7e, 0x55, 0xb9, 0x51, 0xa7, 0x6b, 0xb9, 0xd1, 0x67, 0x60, 0xe0, 0xd5,
-	0x38, 0x0c, 0xd6, 0xd2, 0xa2, 0xa4, 0xea, 0x59, 0xbc, 0x50, 0x5e, 0xbd, 0xc6, 0x30, 0x15, 0x06,
-	0xc3, 0xfe, 0xe8, 0xbc, 0xdf, 0x48, 0x3a, 0xab, 0x56, 0xbe, 0xf0, 0x22, 0x87, 0x63, 0x85, 0xc1,
-	0x3e, 0x63, 0xb5, 0x4d, 0x94, 0x63, 0x3c, 0xfd, 0x8c, 0x15, 0x2f, 0x52, 0xcf, 0xda, 0xd0, 0x14,
-	0x0c, 0x2a, 0xa7, 0xba, 0xf0, 0xd4, 0xab, 0x95, 0x52, 0x9e, 0x77, 0x9c, 0xe2, 0x30, 0x85, 0x50,
-	0x38, 0x62, 0x85, 0x0b, 0xa5, 0x6c, 0xc3, 0x3c, 0xc9, 0xb8, 0x76, 0xb9, 0x6c, 0x97, 0x60, 0xac,
-	0x58, 0xe6, 0x85, 0x3d, 0x07, 0x8f, 0x23, 0xec, 0xa9, 0x27, 0xa0, 0x17, 0x0f, 0x9b, 0x80, 0x6e,
-	0xee, 0xed, 0x81, 0x43, 0xed, 0xed, 0x4f, 0xf7, 0x40, 0xff, 0x0d, 0x12, 0xb1, 0x62, 0xcd, 0x97,
-	0xa0, 0x7f, 0x9b, 0xff, 0x9b, 0xbd, 0x49, 0x28, 0x30, 0xb0, 0x6c, 0xa7, 0xcf, 0x6d, 0xa3, 0xed,
-	0x37, 0xaa, 0x73, 0xe9, 0x5b, 0x9c, 0xd6, 0x79, 0x93, 0x0d, 0x38, 0xc5, 0xa1, 0x1d, 0x6a, 0x54,
-	0xb3, 0x6f, 0x36, 0xfd, 0x24, 0x9b, 0x04, 0xb4, 0x20, 0x1b, 0x70, 0x8a, 0x83, 0x9e, 0x82, 0xbe,
-	0x9a, 0x9f, 0xac, 0x7b, 0xb5, 0x6c, 0x94, 0x6f, 0x81, 0x41, 0xb1, 0x68, 0x65, 0x61, 0x22, 0x3f,
-	0x59, 0x8f, 0x08, 0xf3, 0xec, 0x76, 0x94, 0x14, 0x58, 0xd0, 0xda, 0xb0, 0x81, 0xc9, 0x86, 0x14,
-	0x8a, 0x99, 0x89, 0x0c, 0xc8, 0x74, 0x48, 0xb2, 0x01, 0xa7, 0x38, 0x74, 0xff, 0x57, 0xc2, 0x66,
-	0xcb, 0x6f, 0x88, 0xdc, 0x5c, 0x6d, 0xff, 0xcf, 0x0a, 0x38, 0x56, 0x18, 0x14, 0x9b, 0x8a, 0x30,
-	0x2a, 0x7e, 0xb2, 0x9f, 0x0c, 0x5a, 0x13, 0x70, 0xac, 0x30, 0xdc, 0x1b, 0x30, 0xc2, 0xdf, 0xe4,
-	0xd9, 0x86, 0xe7, 0x37, 0x17, 0x66, 0xd1, 0x95, 0x8e, 0x04, 0xf4, 0x4b, 0x39, 0x09, 0xe8, 0xe7,
-	0x8c, 0x4e, 0x9d, 0x89, 0xe8, 0xee, 0x8f, 0x0a, 0x30, 0x70, 0x82, 0x5f, 0x5d, 0x3b, 0xf1, 0x6f,
-	0x7a, 0xa2, 0x5b, 0x99, 0x2f, 0xae, 0xad, 0xd9, 0xbc, 0x4f, 0x72, 0xe0, 0xd7, 0xd6, 0xfe, 0x4b,
-	0x01, 0xce, 0x4b, 0xd4, 0xf4, 0x9b, 0xf6, 0xec, 0x93, 0x41, 0xc7, 0xbf, 0xd0, 0x91, 0xb1, 0xd0,
-	0x6b, 0xf6, 0xac, 0xd1, 0x85, 0xd9, 0xae, 0x4b, 0xfd, 0x5a, 0x66, 0xa9, 0xb1, 0x55, 0xae, 0x07,
-	0x2f, 0xf6, 0x9f, 0x39, 0x30, 0x9e, 0xbf, 0xd8, 0x27, 0xf0, 0x91, 0xbb, 0x37, 0xcc, 0x8f, 0xdc,
-	0xfd, 0x92, 0xbd, 0x2d, 0x66, 0x4e, 0xa5, 0xcb, 0xe7, 0xee, 0xfe, 0xc4, 0x81, 0xb3, 0xb2, 0x03,
-	0x3b, 0x3d, 0x67, 0xfc, 0x80, 0x25, 0xa2, 0x1c, 0xff, 0x36, 0xbb, 0x6d, 0x6c, 0xb3, 0x97, 0xed,
-	0x4d, 0x5c, 0x9f, 0x47, 0xd7, 0xef, 0xf5, 0xfe, 0xb1, 0x03, 0xa5, 0xbc, 0x0e, 0x27, 0xf0, 0xc8,
-	0x5f, 0x37, 0x1f, 0xf9, 0x8d, 0xe3, 0x99, 0x79, 0xf7, 0x07, 0x5e, 0xea, 0xb6, 0x50, 0xa8, 0x21,
-	0xf5, 0x2a, 0xc7, 0x56, 0x8c, 0x96, 0xb3, 0xc8, 0x57, 0xd0, 0x1a, 0xd0, 0x17, 0xb3, 0xac, 0x0d,
-	0xb1, 0x05, 0xae, 0xda, 0xd0, 0xb6, 0x28, 0x3d, 0xe1, 0x63, 0x67, 0xff, 0x63, 0xc1, 0xc3, 0xfd,
-	0x43, 0x07, 0x86, 0x4f, 0xf0, 0xe3, 0x95, 0xa1, 0xf9, 0x90, 0x5f, 0xb0, 0xf7, 0x90, 0xbb, 0x3c,
-	0xd8, 0xbd, 0x22, 0x74, 0x7c, 0xcf, 0x0f, 0x7d, 0xc6, 0x51, 0x99, 0x1a, 0x3c, 0x9b, 0xed, 0x43,
-	0xf6, 0xc6, 0x71, 0x94, 0x6a, 0x72, 0xe8, 0xeb, 0x99, 0x12, 0x7b, 0x05, 0x5b, 0x75, 0x6b, 0x3a,
-	0x46, 0x73, 0x0f, 0xa5, 0xf6, 0xbe, 0xe2, 0x00, 0xf0, 0x71, 0x8a, 0x0a, 0xbd, 0x74, 0x6c, 0x1b,
-	0xc7, 0xb6, 0x52, 0x94, 0x09, 0x1f, 0x9a, 0x12, 0x90, 0x69, 0x03, 0xd6, 0x46, 0x72, 0x1f, 0x35,
-	0xf4, 0xee, 0xbb, 0x7c, 0xdf, 0x17, 0x1d, 0x38, 0x95, 0x19, 0x6e, 0x4e, 0xff, 0x4d, 0xf3, 0x3b,
-	0x5f, 0x16, 0x74, 0x05, 0xb3, 0x6e, 0xab, 0xee, 0x0e, 0xf8, 0x23, 0x17, 0x8c, 0x0f, 0xa1, 0xa2,
-	0xd7, 0x61, 0x50, 0xda, 0xf2, 0x72, 0x7b, 0xdb, 0xfc, 0xde, 0xa1, 0x52, 0xd8, 0x25, 0x24, 0xc6,
-	0x29, 0xbf, 0x4c, 0x22, 0x58, 0xe1, 0x50, 0x89, 0x60, 0x0f, 0xf6, 0x6b, 0x89, 0xf9, 0x9e, 0xd6,
-	0xde, 0x63, 0xf1, 0xb4, 0x3e, 0x6a, 0xdd, 0xd3, 0xfa, 0xd8, 0x09, 0x7b, 0x5a, 0xb5, 0xb0, 0x57,
-	0xf1, 0x3e, 0xc2, 0x5e, 0xaf, 0xc3, 0xd9, 0xed, 0xd4, 0x8c, 0x52, 0x3b, 0x49, 0xd4, 0x68, 0xb9,
-	0x94, 0xeb, 0x5f, 0xa5, 0x26, 0x61, 0x9c, 0x90, 0x20, 0xd1, 0x0c, 0xb0, 0x34, 0x07, 0xed, 0x46,
-	0x0e, 0x39, 0x9c, 0xcb, 0x24, 0x1b, 0xbf, 0xe8, 0x3f, 0x44, 0xfc, 0xe2, 0x3b, 0x0e, 0x9c, 0xf3,
-	0x3a, 0xae, 0x04, 0x61, 0xb2, 0x29, 0x92, 0x28, 0x6e, 0xda, 0xd3, 0xcb, 0x0d, 0xf2, 0x22, 0x50,
-	0x94, 0xd7, 0x84, 0xf3, 0x07, 0x84, 0x9e, 0x4c, 0x83, 0xc9, 0x3c, 0x73, 0x31, 0x3f, 0xf2, 0xfb,
-	0xf5, 0x6c, 0x86, 0x0a, 0xb0, 0xa5, 0xff, 0x88, 0x5d, 0xfb, 0xd1, 0x42, 0x96, 0xca, 0xd0, 0x7d,
-	0x64, 0xa9, 0x64, 0x82, 0x49, 0xc3, 0x96, 0x82, 0x49, 0x01, 0x8c, 0xf9, 0x4d, 0xaf, 0x46, 0xd6,
-	0xda, 0x8d, 0x06, 0xbf, 0xa3, 0x20, 0xbf, 0x48, 0x99, 0xeb, 0x93, 0x5a, 0x0e, 0x2b, 0x5e, 0x23,
-	0xfb, 0xe1, 0x5f, 0x75, 0x17, 0x63, 0x31, 0x43, 0x09, 0x77, 0xd0, 0xa6, 0x1b, 0x96, 0x15, 0x0b,
-	0x23, 0x09, 0x5d, 0x6d, 0x96, 0x0a, 0x31, 0xc0, 0x37, 0xec, 0xd5, 0x14, 0x8c, 0x75, 0x1c, 0xb4,
-	0x04, 0x83, 0xd5, 0x20, 0x16, 0xb7, 0x1b, 0x4f, 0x31, 0x61, 0xf6, 0x4e, 0x2a, 0x02, 0xe7, 0xae,
-	0x95, 0xd5, 0xbd, 0xc6, 0x47, 0x73, 0xea, 0xd0, 0xa9, 0x76, 0x9c, 0xf6, 0x47, 0x2b, 0x8c, 0x98,
-	0xf8, 0xe4, 0x0f, 0xcf, 0x50, 0x78, 0xbc, 0x4b, 0x08, 0x64, 0xee, 0x9a, 0xfc, 0x68, 0xd1, 0x88,
-	0x60, 0x27, 0xbe, 0xdd, 0x93, 0x52, 0xd0, 0xbe, 0x0c, 0x7a, 0xfa, 0xc0, 0x2f, 0x83, 0xb2, 0x02,
-	0x94, 0x49, 0x43, 0x05, 0x3c, 0x2f, 0x5a, 0x2b, 0x40, 0x99, 0xe6, 0xfe, 0x89, 0x02, 0x94, 0x29,
-	0x00, 0xeb, 0x2c, 0xd1, 0x6a, 0xb7, 0xc0, 0xef, 0x19, 0x26, 0x34, 0x8e, 0x1e, 0xc6, 0xd5, 0x23,
-	0x80, 0x67, 0x0f, 0x8c, 0x00, 0x76, 0x44, 0x2c, 0xcf, 0x1d, 0x21, 0x62, 0x59, 0x67, 0xa5, 0x01,
-	0x17, 0x66, 0x45, 0x90, 0xd8, 0x82, 0xc5, 0xc2, 0xca, 0x2e, 0xf0, 0x5c, 0x4a, 0xf6, 0x2f, 0xe6,
-	0x0c, 0xba, 0xa6, 0x08, 0x5f, 0xb8, 0xe7, 0x14, 0x61, 0x2a, 0x9e, 0x53, 0x38, 0xab, 0x31, 0x59,
-	0x14, 0xe2, 0x39, 0x05, 0x63, 0x1d, 0x27, 0x1b, 0xff, 0x7b, 0xf8, 0xd8, 0xe2, 0x7f, 0xe3, 0x27,
-	0x10, 0xff, 0x7b, 0xe4, 0xd0, 0xf1, 0xbf, 0x37, 0xe0, 0x4c, 0x2b, 0xac, 0xce, 0xf9, 0x71, 0xd4,
-	0x66, 0x97, 0xb6, 0x66, 0xda, 0xd5, 0x1a, 0x49, 0x58, 0x00, 0x71, 0xe8, 0xf2, 0x65, 0x7d, 0x90,
-	0x2d, 0xf6, 0x22, 0x4f, 0x6e, 0x3f, 0xbb, 0x41, 0x12, 0xfe, 0x30, 0xb3, 0xbd, 0x98, 0x47, 0x80,
-	0x25, 0x93, 0xe6, 0x34, 0xe2, 0x3c, 0x3e, 0x7a, 0xf8, 0xf1, 0xf1, 0x93, 0x09, 0x3f, 0x7e, 0x00,
-	0x06, 0xe2, 0x7a, 0x3b, 0xa9, 0x86, 0x3b, 0x01, 0x8b, 0x31, 0x0f, 0xce, 0xbc, 0x43, 0x79, 0x68,
-	0x05, 0xfc, 0xce, 0xde, 0xc4, 0x98, 0xfc, 0x5f, 0x73, 0xce, 0x0a, 0x08, 0xfa, 0x46, 0x97, 0x6b,
-	0x29, 0xee, 0x71, 0x5e, 0x4b, 0xb9, 0x70, 0xa4, 0x2b, 0x29, 0x79, 0x31, 0xd6, 0x27, 0xde, 0x76,
-	0x31, 0xd6, 0xaf, 0x39, 0x30, 0xb2, 0xad, 0x7b, 0xc2, 0x45, 0x1c, 0xd8, 0x42, 0x3e, 0x8a, 0xe1,
-	0x60, 0x9f, 0x71, 0xa9, 0xb0, 0x33, 0x40, 0x77, 0xb2, 0x00, 0x6c, 0x8e, 0x24, 0x27, 0x57, 0xe6,
-	0xc9, 0x07, 0x95, 0x2b, 0xf3, 0x06, 0x13, 0x66, 0xd2, 0xd2, 0x65, 0xc1, 0x61, 0xbb, 0xa9, 0xb2,
-	0x52, 0x30, 0xaa, 0x4c, 0x59, 0x9d, 0x1f, 0xfa, 0x82, 0x03, 0x63, 0xd2, 0x38, 0x13, 0x91, 0xac,
-	0x58, 0x24, 0xfb, 0xd9, 0xb4, 0x09, 0x59, 0xb6, 0xf8, 0x7a, 0x86, 0x0f, 0xee, 0xe0, 0x4c, 0x45,
-	0xbb, 0xca, 0xad, 0xaa, 0xc5, 0x2c, 0xa7, 0x55, 0x28, 0x32, 0xd3, 0x29, 0x18, 0xeb, 0x38, 0xe8,
-	0x9b, 0xea, 0x9b, 0xdf, 0x97, 0x98, 0x54, 0x7f, 0xc9, 0xb2, 0x82, 0x6a, 0xe3, 0xc3, 0xdf, 0xe8,
-	0x4b, 0x0e, 0x8c, 0xed, 0x64, 0xbc, 0x1a, 0x22, 0xdb, 0x11, 0xdb, 0xf7, 0x97, 0xf0, 0xe5, 0xce,
-	0x42, 0x71, 0xc7, 0x08, 0xd0, 0x6d, 0x00, 0x4f, 0x79, 0xbb, 0x45, 0x56, 0xe4, 0xb2, 0xcd, 0x08,
-	0x02, 0xbf, 0xaf, 0x95, 0xfe, 0xc6, 0x1a, 0xbf, 0xfb, 0x4e, 0x74, 0x78, 0x5b, 0x7d, 0x4e, 0xfd,
-	0x3f, 0x9f, 0x81, 0x51, 0x33, 0x48, 0x85, 0xde, 0x65, 0x16, 0xc5, 0xbf, 0x98, 0xad, 0x2f, 0x3e,
-	0x22, 0xf1, 0x8d, 0x1a, 0xe3, 0x46, 0x11, 0xf0, 0xc2, 0xb1, 0x16, 0x01, 0xef, 0x39, 0x99, 0x22,
-	0xe0, 0x63, 0xc7, 0x51, 0x04, 0xfc, 0xf4, 0x91, 0x8a, 0x80, 0x6b, 0x45, 0xd8, 0x7b, 0xef, 0x52,
-	0x84, 0x7d, 0x1a, 0x4e, 0xc9, 0x4b, 0x2c, 0x44, 0x54, 0x77, 0xe6, 0xf1, 0xeb, 0x0b, 0xa2, 0xcb,
-	0xa9, 0x59, 0xb3, 0x19, 0x67, 0xf1, 0xd1, 0xe7, 0x1d, 0x28, 0x06, 0xac, 0x67, 0x9f, 0xad, 0x2f,
-	0xa2, 0x98, 0x5b, 0x8b, 0x59, 0xcd, 0x42, 0x28, 0xc9, 0xb4, 0xdd, 0x22, 0x83, 0xdd, 0x91, 0xff,
-	0x60, 0x3e, 0x02, 0xf4, 0x0a, 0x94, 0xc2, 0xcd, 0xcd, 0x46, 0xe8, 0x55, 0xd3, 0x4a, 0xe5, 0x32,
-	0xc0, 0xce, 0x2f, 0x21, 0xaa, 0x72, 0x9a, 0xab, 0x5d, 0xf0, 0x70, 0x57, 0x0a, 0xe8, 0x3b, 0x54,
-	0x15, 0x49, 0xc2, 0x88, 0x54, 0x53, 0x17, 0xcd, 0x20, 0x9b, 0x33, 0xb1, 0x3e, 0xe7, 0xb2, 0xc9,
-	0x87, 0xcf, 0x5e, 0x3d, 0x94, 0x4c, 0x2b, 0xce, 0x0e, 0x0b, 0x45, 0x70, 0xbe, 0x95, 0xe7, 0x21,
-	0x8a, 0xc5, 0xd5, 0x9b, 0x83, 0xfc, 0x54, 0xf2, 0xd5, 0x3d, 0x9f, 0xeb, 0x63, 0x8a, 0x71, 0x17,
-	0xca, 0x7a, 0x0d, 0xf3, 0x81, 0x93, 0xa9, 0x61, 0xfe, 0x71, 0x80, 0x8a, 0x2c, 0x08, 0x25, 0x7d,
-	0x0e, 0x4b, 0x56, 0xee, 0x84, 0x70, 0x9a, 0xda, 0x07, 0x13, 0x15, 0x1b, 0xac, 0xb1, 0x44, 0xff,
-	0x3b, 0xb7, 0xdc, 0x3e, 0x77, 0xac, 0xd4, 0xac, 0xef, 0x89, 0xb7, 0x5d, 0xc9, 0xfd, 0x7f, 0xe8,
-	0xc0, 0x38, 0xdf, 0x79, 0x59, 0x75, 0x9e, 0x2a, 0x13, 0xe2, 0x92, 0x8a, 0xed, 0x1c, 0x0c, 0x96,
-	0x8e, 0x56, 0x36, 0xb8, 0xb2, 0x88, 0xed, 0x01, 0x23, 0x41, 0x5f, 0xc9, 0x31, 0x22, 0x4e, 0xd9,
-	0x72, 0x55, 0xe6, 0x97, 0x6a, 0x3f, 0xb3, 0x7f, 0x18, 0xbb, 0xe1, 0x9f, 0x74, 0xf5, 0xa4, 0x22,
-	0x36, 0xbc, 0xbf, 0x76, 0x4c, 0x9e, 0x54, 0xbd, 0x9e, 0xfc, 0x91, 0xfc, 0xa9, 0x5f, 0x74, 0x60,
-	0xcc, 0xcb, 0xe4, 0x4c, 0x30, 0xf7, 0x8f, 0x15, 0x57, 0xd4, 0x74, 0x94, 0x26, 0x62, 0x30, 0xb5,
-	0x2e, 0x9b, 0x9e, 0x81, 0x3b, 0x98, 0x8f, 0x7f, 0xc6, 0xe1, 0x1f, 0xa1, 0xe9, 0xaa, 0x17, 0x6d,
-	0x98, 0x7a, 0xd1, 0xb2, 0xcd, 0xcf, 0x60, 0xe8, 0x0a, 0xda, 0xaf, 0x3a, 0x70, 0x36, 0x4f, 0x6c,
-	0xe7, 0x0c, 0xe9, 0x23, 0xe6, 0x90, 0x2c, 0x1a, 0x1f, 0xfa, 0x80, 0xec, 0xd4, 0xfe, 0xff, 0xe3,
-	0x41, 0x2d, 0xa2, 0x96, 0x90, 0x96, 0xf5, 0x0c, 0xdb, 0x00, 0xfa, 0xfc, 0xa0, 0xe1, 0x07, 0x44,
-	0xdc, 0xa6, 0xb3, 0x69, 0x8a, 0x89, 0x6f, 0x6d, 0x50, 0xea, 0x58, 0x70, 0x79, 0xc0, 0x01, 0xb6,
-	0xec, 0x77, 0x84, 0x7a, 0x4f, 0xfe, 0x3b, 0x42, 0x3b, 0x30, 0xb8, 0xe3, 0x27, 0x75, 0x96, 0x18,
-	0x20, 0xe2, 0x56, 0x16, 0x6e, 0xa1, 0x51, 0x72, 0xe9, 0xdc, 0x6f, 0x4a, 0x06, 0x38, 0xe5, 0x85,
-	0xa6, 0x38, 0x63, 0x96, 0x57, 0x9b, 0x4d, 0x78, 0xbc, 0x29, 0x1b, 0x70, 0x8a, 0x43, 0x17, 0x6b,
-	0x98, 0xfe, 0x92, 0xd5, 0x66, 0x44, 0x45, 0x52, 0x1b, 0x95, 0xe6, 0x04, 0x45, 0x7e, 0xd7, 0xf3,
-	0xa6, 0xc6, 0x03, 0x1b, 0x1c, 0x55, 0x51, 0xd8, 0x81, 0xae, 0x45, 0x61, 0x6f, 0x33, 0x2d, 0x24,
-	0xf1, 0x83, 0x36, 0x59, 0x0d, 0x44, 0x36, 0xee, 0xb2, 0x9d, 0x9b, 0xa9, 0x9c, 0x26, 0xb7, 0x2b,
-	0xd3, 0xdf, 0x58, 0xe3, 0xa7, 0x85, 0x0f, 0x86, 0x0e, 0x0c, 0x1f, 0xa4, 0x9e, 0x83, 0x61, 0xeb,
-	0x9e, 0x83, 0x84, 0xb4, 0xac, 0x78, 0x0e, 0xde, 0x56, 0x36, 0xee, 0x9f, 0x39, 0x80, 0x94, 0x32,
-	0xe1, 0xc5, 0x5b, 0xe2, 0xe3, 0x6f, 0xc7, 0x9f, 0xf2, 0xf6, 0x09, 0x07, 0x20, 0x50, 0x5f, 0x9b,
-	0xb3, 0x7b, 0x6a, 0x71, 0x9a, 0xe9, 0x00, 0x52, 0x18, 0xd6, 0x78, 0xba, 0xff, 0xdd, 0x49, 0x33,
-	0x4b, 0xd3, 0xb9, 0x9f, 0x40, 0x42, 0xd4, 0xae, 0x99, 0x10, 0xb5, 0x6e, 0xd1, 0x03, 0xad, 0xa6,
-	0xd1, 0x25, 0x35, 0xea, 0xa7, 0x05, 0x38, 0xa5, 0x23, 0x97, 0xc9, 0x49, 0x3c, 0xec, 0x1d, 0x23,
-	0xbf, 0xf1, 0xba, 0xdd, 0xf9, 0x96, 0x45, 0x20, 0x23, 0x2f, 0x97, 0xf6, 0xe3, 0x99, 0x5c, 0xda,
-	0x9b, 0xf6, 0x59, 0x1f, 0x9c, 0x50, 0xfb, 0x5f, 0x1d, 0x38, 0x93, 0xe9, 0x71, 0x02, 0x1b, 0x6c,
-	0xdb, 0xdc, 0x60, 0x2f, 0x5a, 0x9f, 0x75, 0x97, 0xdd, 0xf5, 0xad, 0x42, 0xc7, 0x6c, 0x99, 0x65,
-	0xf2, 0x69, 0x07, 0x8a, 0x89, 0x17, 0x6f, 0xc9, 0xdc, 0xa4, 0x8f, 0x1c, 0xcb, 0x0e, 0x98, 0xa4,
-	0xff, 0x0b, 0xe9, 0xac, 0xc6, 0xc7, 0x60, 0x98, 0x73, 0x1f, 0xff, 0x94, 0x03, 0x90, 0x22, 0x3d,
-	0x28, 0x95, 0xd5, 0xfd, 0x6e, 0x01, 0xce, 0xe5, 0x6e, 0x23, 0xf4, 0x59, 0xe5, 0x66, 0x72, 0x6c,
-	0x67, 0xde, 0x19, 0x8c, 0x74, 0x6f, 0xd3, 0x88, 0xe1, 0x6d, 0x12, 0x4e, 0xa6, 0x07, 0x65, 0x70,
-	0x08, 0x31, 0xad, 0x2d, 0xd6, 0x4f, 0x9c, 0x34, 0x99, 0x53, 0x55, 0x9d, 0xf9, 0x73, 0x78, 0xc5,
-	0xc2, 0xfd, 0xa9, 0x96, 0x7f, 0x2e, 0x27, 0x7a, 0x02, 0xb2, 0x62, 0xc7, 0x94, 0x15, 0xd8, 0x7e,
-	0x38, 0xb4, 0x8b, 0xb0, 0xf8, 0x28, 0xe4, 0xc5, 0x47, 0x0f, 0x57, 0xb2, 0xce, 0xb8, 0xac, 0x58,
-	0x38, 0xf4, 0x65, 0xc5, 0x11, 0x18, 0x7a, 0xd9, 0x6f, 0xa9, 0x50, 0xde, 0xe4, 0xf7, 0x7f, 0x7c,
-	0xf1, 0xa1, 0x1f, 0xfc, 0xf8, 0xe2, 0x43, 0x3f, 0xfa, 0xf1, 0xc5, 0x87, 0x3e, 0xb1, 0x7f, 0xd1,
-	0xf9, 0xfe, 0xfe, 0x45, 0xe7, 0x07, 0xfb, 0x17, 0x9d, 0x1f, 0xed, 0x5f, 0x74, 0xfe, 0xc3, 0xfe,
-	0x45, 0xe7, 0x6f, 0xfd, 0xd1, 0xc5, 0x87, 0x5e, 0x1e, 0x90, 0x13, 0xfb, 0x7f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xd2, 0xea, 0x98, 0x1a, 0xa3, 0xcf, 0x00, 0x00,
+	// 11506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6b, 0x70, 0x64, 0xc7,
+	0x75, 0x18, 0xcc, 0x3b, 0xc0, 0xe0, 0x71, 0xf0, 0x58, 0x6c, 0xef, 0x6b, 0x08, 0x92, 0x0b, 0xfa,
+	0xd2, 0xe4, 0x47, 0xda, 0x14, 0x56, 0x5c, 0x4a, 0x9f, 0x69, 0x29, 0xa1, 0x84, 0xc7, 0x02, 0x0b,
+	0x02, 0x58, 0x80, 0x3d, 0xd8, 0x5d, 0x93, 0x62, 0x24, 0x5d, 0xcc, 0x34, 0x66, 0x2e, 0x31, 0x73,
+	0xef, 0xf0, 0xde, 0x3b, 0xc0, 0x82, 0x0f, 0x49, 0xa1, 0xf5, 0x4c, 0x18, 0x2b, 0xb2, 0x1e, 0x96,
+	0x94, 0xa4, 0x4a, 0x52, 0x24, 0x47, 0xa5, 0xb8, 0x9c, 0x92, 0x93, 0x1f, 0x2a, 0xfb, 0x4f, 0x2a,
+	0x95, 0x72, 0x29, 0xe5, 0x3c, 0xac, 0x8a, 0x12, 0xe9, 0x47, 0x0c, 0x46, 0xeb, 0x44, 0x3f, 0x92,
+	0x52, 0x55, 0xa2, 0x8a, 0xed, 0x78, 0xf3, 0xa8, 0x54, 0x3f, 0x6f, 0xf7, 0x9d, 0x3b, 0xd8, 0x01,
+	0xb6, 0xb1, 0x54, 0xd9, 0xbf, 0x80, 0x39, 0x7d, 0xfa, 0x9c, 0x7e, 0xdd, 0xd3, 0xa7, 0xcf, 0x39,
+	0x7d, 0x1a, 0xd6, 0x6b, 0x7e, 0x52, 0x6f, 0x6f, 0x4e, 0x57, 0xc2, 0xe6, 0x05, 0x2f, 0xaa, 0x85,
+	0xad, 0x28, 0x7c, 0x91, 0xfd, 0xf3, 0xb6, 0xdd, 0x30, 0xda, 0xde, 0x6a, 0x84, 0xbb, 0xf1, 0x85,
+	0x9d, 0x27, 0x2f, 0xb4, 0xb6, 0x6b, 0x17, 0xbc, 0x96, 0x1f, 0x5f, 0x90, 0xd0, 0x0b, 0x3b, 0x4f,
+	0x78, 0x8d, 0x56, 0xdd, 0x7b, 0xe2, 0x42, 0x8d, 0x04, 0x24, 0xf2, 0x12, 0x52, 0x9d, 0x6e, 0x45,
+	0x61, 0x12, 0xa2, 0xf7, 0xa6, 0x14, 0xa7, 0x25, 0x45, 0xf6, 0xcf, 0x07, 0x14, 0xc5, 0xe9, 0x9d,
+	0x27, 0xa7, 0x5b, 0xdb, 0xb5, 0x69, 0x4a, 0x71, 0x5a, 0x42, 0xa7, 0x25, 0xc5, 0xc9, 0xb7, 0x69,
+	0x6d, 0xaa, 0x85, 0xb5, 0xf0, 0x02, 0x23, 0xbc, 0xd9, 0xde, 0x62, 0xbf, 0xd8, 0x0f, 0xf6, 0x1f,
+	0x67, 0x38, 0xe9, 0x6e, 0x3f, 0x15, 0x4f, 0xfb, 0x21, 0x6d, 0xdf, 0x85, 0x4a, 0x18, 0x91, 0x0b,
+	0x3b, 0x1d, 0x8d, 0x9a, 0x7c, 0x4c, 0xc3, 0x69, 0x85, 0x0d, 0xbf, 0xb2, 0x77, 0x61, 0xe7, 0x89,
+	0x4d, 0x92, 0x74, 0xb6, 0x7f, 0xf2, 0x1d, 0x29, 0x6a, 0xd3, 0xab, 0xd4, 0xfd, 0x80, 0x44, 0x7b,
+	0x69, 0xff, 0x9b, 0x24, 0xf1, 0xf2, 0x18, 0x5c, 0xe8, 0x56, 0x2b, 0x6a, 0x07, 0x89, 0xdf, 0x24,
+	0x1d, 0x15, 0xfe, 0xff, 0xdb, 0x55, 0x88, 0x2b, 0x75, 0xd2, 0xf4, 0x3a, 0xea, 0x3d, 0xd9, 0xad,
+	0x5e, 0x3b, 0xf1, 0x1b, 0x17, 0xfc, 0x20, 0x89, 0x93, 0x28, 0x5b, 0xc9, 0xbd, 0x04, 0x03, 0x33,
+	0xcd, 0xb0, 0x1d, 0x24, 0xe8, 0xdd, 0x50, 0xdc, 0xf1, 0x1a, 0x6d, 0x52, 0x72, 0x1e, 0x74, 0x1e,
+	0x1d, 0x9e, 0x7d, 0xf8, 0xbb, 0xfb, 0x53, 0xf7, 0xdc, 0xdc, 0x9f, 0x2a, 0x5e, 0xa3, 0xc0, 0x5b,
+	0xfb, 0x53, 0xa7, 0x49, 0x50, 0x09, 0xab, 0x7e, 0x50, 0xbb, 0xf0, 0x62, 0x1c, 0x06, 0xd3, 0x57,
+	0xda, 0xcd, 0x4d, 0x12, 0x61, 0x5e, 0xc7, 0xfd, 0xb7, 0x05, 0x38, 0x31, 0x13, 0x55, 0xea, 0xfe,
+	0x0e, 0x29, 0x27, 0x94, 0x7e, 0x6d, 0x0f, 0xd5, 0xa1, 0x2f, 0xf1, 0x22, 0x46, 0x6e, 0xe4, 0xe2,
+	0xea, 0xf4, 0x9d, 0x4e, 0xfe, 0xf4, 0x86, 0x17, 0x49, 0xda, 0xb3, 0x83, 0x37, 0xf7, 0xa7, 0xfa,
+	0x36, 0xbc, 0x08, 0x53, 0x16, 0xa8, 0x01, 0xfd, 0x41, 0x18, 0x90, 0x52, 0x81, 0xb1, 0xba, 0x72,
+	0xe7, 0xac, 0xae, 0x84, 0x81, 0xea, 0xc7, 0xec, 0xd0, 0xcd, 0xfd, 0xa9, 0x7e, 0x0a, 0xc1, 0x8c,
+	0x0b, 0xed, 0xd7, 0xcb, 0x7e, 0xab, 0xd4, 0x67, 0xab, 0x5f, 0xcf, 0xfb, 0x2d, 0xb3, 0x5f, 0xcf,
+	0xfb, 0x2d, 0x4c, 0x59, 0xb8, 0x9f, 0x2a, 0xc0, 0xf0, 0x4c, 0x54, 0x6b, 0x37, 0x49, 0x90, 0xc4,
+	0xe8, 0xc3, 0x00, 0x2d, 0x2f, 0xf2, 0x9a, 0x24, 0x21, 0x51, 0x5c, 0x72, 0x1e, 0xec, 0x7b, 0x74,
+	0xe4, 0xe2, 0xf2, 0x9d, 0xb3, 0x5f, 0x97, 0x34, 0x67, 0x91, 0x98, 0x72, 0x50, 0xa0, 0x18, 0x6b,
+	0x2c, 0xd1, 0x2b, 0x30, 0xec, 0x45, 0x89, 0xbf, 0xe5, 0x55, 0x92, 0xb8, 0x54, 0x60, 0xfc, 0x9f,
+	0xb9, 0x73, 0xfe, 0x33, 0x82, 0xe4, 0xec, 0x49, 0xc1, 0x7e, 0x58, 0x42, 0x62, 0x9c, 0xf2, 0x73,
+	0x7f, 0xb7, 0x1f, 0x46, 0x66, 0xa2, 0x64, 0x71, 0xae, 0x9c, 0x78, 0x49, 0x3b, 0x46, 0x7f, 0xe0,
+	0xc0, 0xa9, 0x98, 0x0f, 0x9b, 0x4f, 0xe2, 0xf5, 0x28, 0xac, 0x90, 0x38, 0x26, 0x55, 0x31, 0x2e,
+	0x5b, 0x56, 0xda, 0x25, 0x99, 0x4d, 0x97, 0x3b, 0x19, 0x5d, 0x0a, 0x92, 0x68, 0x6f, 0xf6, 0x09,
+	0xd1, 0xe6, 0x53, 0x39, 0x18, 0xaf, 0xbf, 0x39, 0x85, 0x64, 0x57, 0x28, 0x25, 0x3e, 0xc5, 0x38,
+	0xaf, 0xd5, 0xe8, 0x4b, 0x0e, 0x8c, 0xb6, 0xc2, 0x6a, 0x8c, 0x49, 0x25, 0x6c, 0xb7, 0x48, 0x55,
+	0x0c, 0xef, 0x07, 0xec, 0x76, 0x63, 0x5d, 0xe3, 0xc0, 0xdb, 0x7f, 0x5a, 0xb4, 0x7f, 0x54, 0x2f,
+	0xc2, 0x46, 0x53, 0xd0, 0x53, 0x30, 0x1a, 0x84, 0x49, 0xb9, 0x45, 0x2a, 0xfe, 0x96, 0x4f, 0xaa,
+	0x6c, 0xe1, 0x0f, 0xa5, 0x35, 0xaf, 0x68, 0x65, 0xd8, 0xc0, 0x9c, 0x5c, 0x80, 0x52, 0xb7, 0x91,
+	0x43, 0x13, 0xd0, 0xb7, 0x4d, 0xf6, 0xb8, 0xb0, 0xc1, 0xf4, 0x5f, 0x74, 0x5a, 0x0a, 0x20, 0xfa,
+	0x19, 0x0f, 0x09, 0xc9, 0xf2, 0xae, 0xc2, 0x53, 0xce, 0xe4, 0x7b, 0xe0, 0x64, 0x47, 0xd3, 0x0f,
+	0x43, 0xc0, 0xfd, 0xbf, 0x03, 0x30, 0x24, 0xa7, 0x02, 0x3d, 0x08, 0xfd, 0x81, 0xd7, 0x94, 0x72,
+	0x6e, 0x54, 0xf4, 0xa3, 0xff, 0x8a, 0xd7, 0xa4, 0x5f, 0xb8, 0xd7, 0x24, 0x14, 0xa3, 0xe5, 0x25,
+	0x75, 0x46, 0x47, 0xc3, 0x58, 0xf7, 0x92, 0x3a, 0x66, 0x25, 0xe8, 0x7e, 0xe8, 0x6f, 0x86, 0x55,
+	0xc2, 0xc6, 0xa2, 0xc8, 0x25, 0xc4, 0x6a, 0x58, 0x25, 0x98, 0x41, 0x69, 0xfd, 0xad, 0x28, 0x6c,
+	0x96, 0xfa, 0xcd, 0xfa, 0x0b, 0x51, 0xd8, 0xc4, 0xac, 0x04, 0x7d, 0xd1, 0x81, 0x09, 0xb9, 0xb6,
+	0x57, 0xc2, 0x8a, 0x97, 0xf8, 0x61, 0x50, 0x2a, 0x32, 0x89, 0x82, 0xed, 0x7d, 0x52, 0x92, 0xf2,
+	0x6c, 0x49, 0x34, 0x61, 0x22, 0x5b, 0x82, 0x3b, 0x5a, 0x81, 0x2e, 0x02, 0xd4, 0x1a, 0xe1, 0xa6,
+	0xd7, 0xa0, 0x03, 0x52, 0x1a, 0x60, 0x5d, 0x50, 0x92, 0x61, 0x51, 0x95, 0x60, 0x0d, 0x0b, 0xdd,
+	0x80, 0x41, 0x8f, 0x4b, 0xff, 0xd2, 0x20, 0xeb, 0xc4, 0xb3, 0x36, 0x3a, 0x61, 0x6c, 0x27, 0xb3,
+	0x23, 0x37, 0xf7, 0xa7, 0x06, 0x05, 0x10, 0x4b, 0x76, 0xe8, 0x71, 0x18, 0x0a, 0x5b, 0xb4, 0xdd,
+	0x5e, 0xa3, 0x34, 0xc4, 0x16, 0xe6, 0x84, 0x68, 0xeb, 0xd0, 0x9a, 0x80, 0x63, 0x85, 0x81, 0x1e,
+	0x83, 0xc1, 0xb8, 0xbd, 0x49, 0xe7, 0xb1, 0x34, 0xcc, 0x3a, 0x76, 0x42, 0x20, 0x0f, 0x96, 0x39,
+	0x18, 0xcb, 0x72, 0xf4, 0x4e, 0x18, 0x89, 0x48, 0xa5, 0x1d, 0xc5, 0x84, 0x4e, 0x6c, 0x09, 0x18,
+	0xed, 0x53, 0x02, 0x7d, 0x04, 0xa7, 0x45, 0x58, 0xc7, 0x43, 0x4f, 0xc3, 0x38, 0x9d, 0xe0, 0x4b,
+	0x37, 0x5a, 0x11, 0x89, 0x63, 0x3a, 0xab, 0x23, 0x8c, 0xd1, 0x59, 0x51, 0x73, 0x7c, 0xc1, 0x28,
+	0xc5, 0x19, 0x6c, 0xf4, 0x2a, 0x80, 0xa7, 0x64, 0x46, 0x69, 0x94, 0x0d, 0xe6, 0x8a, 0xbd, 0x15,
+	0xb1, 0x38, 0x37, 0x3b, 0x4e, 0xe7, 0x31, 0xfd, 0x8d, 0x35, 0x7e, 0x74, 0x7c, 0xaa, 0xa4, 0x41,
+	0x12, 0x52, 0x2d, 0x8d, 0xb1, 0x0e, 0xab, 0xf1, 0x99, 0xe7, 0x60, 0x2c, 0xcb, 0xe9, 0xc0, 0x57,
+	0xea, 0xa4, 0xb2, 0x1d, 0xb7, 0x9b, 0xa5, 0x71, 0xd6, 0x45, 0x35, 0xf0, 0x73, 0x02, 0x8e, 0x15,
+	0x86, 0xfb, 0x77, 0x0a, 0xa0, 0xf1, 0x44, 0xb3, 0x30, 0x24, 0xa4, 0xa0, 0xf8, 0x80, 0x67, 0x1f,
+	0x91, 0x95, 0xe5, 0x7c, 0xdf, 0xda, 0xcf, 0x95, 0x9e, 0xaa, 0x1e, 0x7a, 0x0d, 0x46, 0x5a, 0x61,
+	0x75, 0x95, 0x24, 0x5e, 0xd5, 0x4b, 0x3c, 0xb1, 0xf7, 0x5b, 0xd8, 0x8f, 0x24, 0xc5, 0xd9, 0x13,
+	0x74, 0xa2, 0xd7, 0x53, 0x16, 0x58, 0xe7, 0x87, 0x9e, 0x01, 0x14, 0x93, 0x68, 0xc7, 0xaf, 0x90,
+	0x99, 0x4a, 0x85, 0x2a, 0x50, 0xec, 0x73, 0xe9, 0x63, 0x9d, 0x99, 0x14, 0x9d, 0x41, 0xe5, 0x0e,
+	0x0c, 0x9c, 0x53, 0xcb, 0xfd, 0x7e, 0x01, 0xc6, 0xb5, 0xbe, 0xb6, 0x48, 0x05, 0x7d, 0xd3, 0x81,
+	0x13, 0x6a, 0xf3, 0x9b, 0xdd, 0xbb, 0x42, 0xd7, 0x20, 0xdf, 0xda, 0x88, 0xcd, 0xd5, 0x40, 0x79,
+	0xa9, 0x9f, 0x82, 0x0f, 0xdf, 0x19, 0xce, 0x89, 0x3e, 0x9c, 0xc8, 0x94, 0xe2, 0x6c, 0xb3, 0x26,
+	0xbf, 0xe0, 0xc0, 0xe9, 0x3c, 0x12, 0x39, 0x12, 0xba, 0xae, 0x4b, 0x68, 0xab, 0xa2, 0x8e, 0x72,
+	0xa5, 0x9d, 0x31, 0xa4, 0x7e, 0x01, 0x26, 0xf4, 0x25, 0xc4, 0xf4, 0x86, 0x7f, 0xe6, 0xc0, 0x19,
+	0xd9, 0x03, 0x4c, 0xe2, 0x76, 0x23, 0x33, 0xbc, 0x4d, 0xab, 0xc3, 0xcb, 0xf7, 0xdd, 0x99, 0x3c,
+	0x7e, 0x7c, 0x98, 0x1f, 0x10, 0xc3, 0x7c, 0x26, 0x17, 0x07, 0xe7, 0x37, 0x75, 0xf2, 0xeb, 0x0e,
+	0x4c, 0x76, 0x27, 0x9a, 0x33, 0xf0, 0x2d, 0x73, 0xe0, 0x9f, 0xb7, 0xd7, 0x49, 0xce, 0x9e, 0x0d,
+	0x3f, 0xeb, 0xac, 0x3e, 0x01, 0xbf, 0x35, 0x04, 0x1d, 0x3b, 0x0e, 0x7a, 0x02, 0x46, 0x84, 0xf0,
+	0x5e, 0x09, 0x6b, 0x31, 0x6b, 0xe4, 0x10, 0xff, 0xd6, 0x66, 0x52, 0x30, 0xd6, 0x71, 0x50, 0x15,
+	0x0a, 0xf1, 0x93, 0xa2, 0xe9, 0x16, 0x84, 0x61, 0xf9, 0x49, 0xa5, 0x73, 0x0e, 0xdc, 0xdc, 0x9f,
+	0x2a, 0x94, 0x9f, 0xc4, 0x85, 0xf8, 0x49, 0xaa, 0xd7, 0xd7, 0xfc, 0xc4, 0x9e, 0x5e, 0xbf, 0xe8,
+	0x27, 0x8a, 0x0f, 0xd3, 0xeb, 0x17, 0xfd, 0x04, 0x53, 0x16, 0xf4, 0xbc, 0x52, 0x4f, 0x92, 0x16,
+	0xd3, 0x0f, 0xac, 0x9c, 0x57, 0x2e, 0x6f, 0x6c, 0xac, 0x2b, 0x5e, 0x4c, 0x1b, 0xa1, 0x10, 0xcc,
+	0xb8, 0xa0, 0x4f, 0x3a, 0x74, 0xc4, 0x79, 0x61, 0x18, 0xed, 0x09, 0x35, 0xe3, 0xaa, 0xbd, 0x25,
+	0x10, 0x46, 0x7b, 0x8a, 0xb9, 0x98, 0x48, 0x55, 0x80, 0x75, 0xd6, 0xac, 0xe3, 0xd5, 0xad, 0x98,
+	0x69, 0x15, 0x76, 0x3a, 0x3e, 0xbf, 0x50, 0xce, 0x74, 0x7c, 0x7e, 0xa1, 0x8c, 0x19, 0x17, 0x3a,
+	0xa1, 0x91, 0xb7, 0x2b, 0x34, 0x12, 0x0b, 0x13, 0x8a, 0xbd, 0x5d, 0x73, 0x42, 0xb1, 0xb7, 0x8b,
+	0x29, 0x0b, 0xca, 0x29, 0x8c, 0x63, 0xa6, 0x80, 0x58, 0xe1, 0xb4, 0x56, 0x2e, 0x9b, 0x9c, 0xd6,
+	0xca, 0x65, 0x4c, 0x59, 0xb0, 0x45, 0x5a, 0x89, 0x99, 0xf6, 0x62, 0x67, 0x91, 0xce, 0x65, 0x38,
+	0x2d, 0xce, 0x95, 0x31, 0x65, 0x41, 0x45, 0x86, 0xf7, 0x72, 0x3b, 0xe2, 0xaa, 0xcf, 0xc8, 0xc5,
+	0x35, 0x0b, 0xeb, 0x85, 0x92, 0x53, 0xdc, 0x86, 0x6f, 0xee, 0x4f, 0x15, 0x19, 0x08, 0x73, 0x46,
+	0xee, 0xef, 0xf7, 0xa5, 0xe2, 0x42, 0xca, 0x73, 0xf4, 0x19, 0xb6, 0x11, 0x0a, 0x59, 0x20, 0x14,
+	0x65, 0xe7, 0xd8, 0x14, 0xe5, 0x53, 0x7c, 0xc7, 0x33, 0xd8, 0xe1, 0x2c, 0x7f, 0xf4, 0xeb, 0x4e,
+	0xe7, 0x49, 0xd8, 0xb3, 0xbf, 0x97, 0xa5, 0x1b, 0x33, 0xdf, 0x2b, 0x0e, 0x3c, 0x20, 0x4f, 0x7e,
+	0xd2, 0x49, 0x95, 0x88, 0xb8, 0xdb, 0x3e, 0xf0, 0x41, 0x73, 0x1f, 0xb0, 0x78, 0x7c, 0xd7, 0xe5,
+	0xfe, 0xa7, 0x1c, 0x18, 0x93, 0x70, 0xaa, 0x4c, 0xc7, 0xe8, 0x06, 0x0c, 0xc9, 0x96, 0x8a, 0xd9,
+	0xb3, 0x69, 0x39, 0x50, 0x9a, 0xa7, 0x6a, 0x8c, 0xe2, 0xe6, 0x7e, 0x73, 0x00, 0x50, 0xba, 0x57,
+	0xb5, 0xc2, 0xd8, 0x67, 0x92, 0xe8, 0x08, 0xbb, 0x50, 0xa0, 0xed, 0x42, 0xd7, 0x6c, 0xee, 0x42,
+	0x69, 0xb3, 0x8c, 0xfd, 0xe8, 0xd7, 0x33, 0x72, 0x9b, 0x6f, 0x4c, 0x1f, 0x38, 0x16, 0xb9, 0xad,
+	0x35, 0xe1, 0x60, 0x09, 0xbe, 0x23, 0x24, 0x38, 0xdf, 0xba, 0x7e, 0xc5, 0xae, 0x04, 0xd7, 0x5a,
+	0x91, 0x95, 0xe5, 0x11, 0x97, 0xb0, 0x7c, 0xef, 0xba, 0x6e, 0x55, 0xc2, 0x6a, 0x5c, 0x4d, 0x59,
+	0x1b, 0x71, 0x59, 0x3b, 0x60, 0x8b, 0xa7, 0x26, 0x6b, 0xb3, 0x3c, 0x95, 0xd4, 0x7d, 0x59, 0x4a,
+	0x5d, 0xbe, 0x6b, 0x3d, 0x67, 0x59, 0xea, 0x6a, 0x7c, 0x3b, 0xe5, 0xef, 0xbb, 0xe1, 0x5c, 0x27,
+	0xde, 0x9c, 0x57, 0xa9, 0x93, 0xdb, 0xdb, 0x4c, 0xdc, 0x97, 0xe0, 0x4c, 0x67, 0x65, 0x4c, 0xb6,
+	0xd0, 0x05, 0x18, 0xae, 0x84, 0xc1, 0x96, 0x5f, 0x5b, 0xf5, 0x5a, 0xa2, 0xbe, 0x12, 0x64, 0x73,
+	0xb2, 0x00, 0xa7, 0x38, 0xe8, 0x01, 0x2e, 0xb5, 0xb8, 0xf1, 0x65, 0x44, 0xa0, 0xf6, 0x2d, 0x93,
+	0x3d, 0x26, 0xc2, 0xde, 0x35, 0xf4, 0xc5, 0xaf, 0x4c, 0xdd, 0xf3, 0x91, 0xff, 0xf0, 0xe0, 0x3d,
+	0xee, 0xf7, 0xfa, 0xe0, 0xbe, 0x5c, 0x9e, 0x42, 0xd5, 0xff, 0x2d, 0x43, 0xd5, 0xd7, 0xca, 0x85,
+	0x08, 0xba, 0x6e, 0x53, 0x0b, 0xd6, 0xc8, 0xe7, 0x29, 0xf5, 0x5a, 0x31, 0xce, 0x6f, 0x14, 0x1d,
+	0x28, 0x3a, 0x92, 0x71, 0xcb, 0xab, 0x10, 0xd1, 0x7b, 0x35, 0x50, 0x57, 0x64, 0x01, 0x4e, 0x71,
+	0xf8, 0x69, 0x7d, 0xcb, 0x6b, 0x37, 0x12, 0x61, 0x93, 0xd3, 0x4e, 0xeb, 0x0c, 0x8c, 0x65, 0x39,
+	0xfa, 0xbb, 0x0e, 0xa0, 0x4e, 0xae, 0xe2, 0x2b, 0xde, 0x38, 0x8e, 0x71, 0x98, 0x3d, 0x7b, 0x53,
+	0x3b, 0xc1, 0x6b, 0x3d, 0xcd, 0x69, 0x87, 0x36, 0xa7, 0x1f, 0x4a, 0x37, 0x31, 0x7e, 0xb2, 0xe8,
+	0xc1, 0x5c, 0xc7, 0xac, 0x3a, 0x95, 0x0a, 0x89, 0x63, 0x6e, 0xf9, 0xd3, 0xad, 0x3a, 0x0c, 0x8c,
+	0x65, 0x39, 0x9a, 0x82, 0x22, 0x89, 0xa2, 0x30, 0x12, 0x07, 0x75, 0xf6, 0x0d, 0x5c, 0xa2, 0x00,
+	0xcc, 0xe1, 0xee, 0x8f, 0x0b, 0x50, 0xea, 0x76, 0xb4, 0x41, 0xbf, 0xa3, 0x1d, 0xca, 0xc5, 0xb1,
+	0x4b, 0x9c, 0x1a, 0xc3, 0xe3, 0x3b, 0x50, 0x65, 0x4f, 0x8f, 0x5d, 0x8e, 0xe7, 0xa2, 0x14, 0x67,
+	0x1b, 0x38, 0xf9, 0x39, 0xed, 0x78, 0xae, 0x93, 0xc8, 0xd1, 0x0e, 0xb6, 0x4c, 0xed, 0x60, 0xdd,
+	0x76, 0xa7, 0x74, 0x1d, 0xe1, 0x8f, 0x8a, 0x70, 0x4a, 0x96, 0x96, 0x09, 0xdd, 0x67, 0x9f, 0x6d,
+	0x93, 0x68, 0x0f, 0xfd, 0xc0, 0x81, 0xd3, 0x5e, 0xd6, 0xee, 0xe3, 0x93, 0x63, 0x18, 0x68, 0x8d,
+	0xeb, 0xf4, 0x4c, 0x0e, 0x47, 0x3e, 0xd0, 0x17, 0xc5, 0x40, 0x9f, 0xce, 0x43, 0xe9, 0x62, 0xe2,
+	0xcf, 0xed, 0x00, 0x7a, 0x0a, 0x46, 0x25, 0x9c, 0xd9, 0x8a, 0xf8, 0x27, 0xae, 0xec, 0xe8, 0x33,
+	0x5a, 0x19, 0x36, 0x30, 0x69, 0xcd, 0x84, 0x34, 0x5b, 0x0d, 0x2f, 0x21, 0x9a, 0x95, 0x49, 0xd5,
+	0xdc, 0xd0, 0xca, 0xb0, 0x81, 0x89, 0x1e, 0x81, 0x81, 0x20, 0xac, 0x92, 0xa5, 0xaa, 0xb0, 0x45,
+	0x8f, 0x8b, 0x3a, 0x03, 0x57, 0x18, 0x14, 0x8b, 0x52, 0xf4, 0x70, 0x6a, 0xf8, 0x2b, 0xb2, 0x4f,
+	0x68, 0x24, 0xd7, 0xe8, 0xf7, 0x55, 0x07, 0x86, 0x69, 0x8d, 0x8d, 0xbd, 0x16, 0xa1, 0x1b, 0x23,
+	0x9d, 0x91, 0xea, 0xf1, 0xcc, 0xc8, 0x15, 0xc9, 0xc6, 0xb4, 0x93, 0x0c, 0x2b, 0xf8, 0xeb, 0x6f,
+	0x4e, 0x0d, 0xc9, 0x1f, 0x38, 0x6d, 0xd5, 0xe4, 0x22, 0xdc, 0xdb, 0x75, 0x36, 0x0f, 0xe5, 0x75,
+	0xf8, 0x2b, 0x30, 0x6e, 0x36, 0xe2, 0x50, 0x2e, 0x87, 0xef, 0x68, 0x9f, 0x1d, 0xef, 0x97, 0x90,
+	0x67, 0x6f, 0x99, 0x2a, 0xac, 0x16, 0xc3, 0xbc, 0x58, 0x7a, 0xe6, 0x62, 0x98, 0x17, 0x8b, 0x61,
+	0xde, 0xfd, 0x03, 0x27, 0xfd, 0x34, 0x35, 0x1d, 0x91, 0x6e, 0xcc, 0xed, 0xa8, 0x21, 0x04, 0xb1,
+	0xda, 0x98, 0xaf, 0xe2, 0x15, 0x4c, 0xe1, 0xe8, 0x73, 0x9a, 0x74, 0xa4, 0xd5, 0xda, 0xc2, 0x83,
+	0x62, 0xc9, 0x1b, 0x60, 0x10, 0xee, 0x94, 0x7f, 0xa2, 0x00, 0x67, 0x9b, 0xe0, 0xfe, 0xc8, 0x81,
+	0x07, 0x0e, 0xd4, 0x78, 0x73, 0x1b, 0xee, 0xbc, 0xe5, 0x0d, 0xa7, 0xdb, 0x5a, 0x44, 0x5a, 0xe1,
+	0x55, 0xbc, 0x22, 0xe6, 0x4b, 0x6d, 0x6b, 0x98, 0x83, 0xb1, 0x2c, 0x77, 0x7f, 0xe0, 0x40, 0x96,
+	0x1e, 0xf2, 0x60, 0xbc, 0x1d, 0x93, 0x88, 0xee, 0x90, 0x65, 0x52, 0x89, 0x88, 0x5c, 0x6d, 0x0f,
+	0x4f, 0xf3, 0x38, 0x01, 0xda, 0xe0, 0xe9, 0x4a, 0x18, 0x91, 0xe9, 0x9d, 0x27, 0xa6, 0x39, 0xc6,
+	0x32, 0xd9, 0x2b, 0x93, 0x06, 0xa1, 0x34, 0x66, 0xd1, 0xcd, 0xfd, 0xa9, 0xf1, 0xab, 0x06, 0x01,
+	0x9c, 0x21, 0x48, 0x59, 0xb4, 0xbc, 0x38, 0xde, 0x0d, 0xa3, 0xaa, 0x60, 0x51, 0x38, 0x34, 0x8b,
+	0x75, 0x83, 0x00, 0xce, 0x10, 0x74, 0xbf, 0x4f, 0x8f, 0x92, 0xba, 0x06, 0x8b, 0xbe, 0x42, 0x55,
+	0x19, 0x0a, 0x99, 0x6d, 0x84, 0x9b, 0x73, 0x61, 0x90, 0x78, 0x7e, 0x40, 0x64, 0x98, 0xc1, 0x86,
+	0x25, 0x7d, 0xd9, 0xa0, 0x9d, 0xda, 0xf3, 0x3b, 0xcb, 0x70, 0x4e, 0x5b, 0xa8, 0xca, 0xb2, 0xd9,
+	0x08, 0x37, 0xb3, 0xfe, 0x43, 0x8a, 0x84, 0x59, 0x89, 0xfb, 0x53, 0x07, 0xce, 0x75, 0x51, 0xcc,
+	0xd1, 0x17, 0x1c, 0x18, 0xdb, 0xfc, 0x99, 0xe8, 0x9b, 0xd9, 0x0c, 0xf4, 0x34, 0x8c, 0x53, 0x00,
+	0xdd, 0x58, 0x16, 0xc2, 0xa8, 0xe9, 0x25, 0xa2, 0x83, 0xca, 0xb7, 0x35, 0x6b, 0x94, 0xe2, 0x0c,
+	0xb6, 0xfb, 0xd9, 0x02, 0xe4, 0x70, 0x41, 0x8f, 0xc3, 0x10, 0x09, 0xaa, 0xad, 0xd0, 0x0f, 0x12,
+	0x21, 0x5b, 0x94, 0x10, 0xbb, 0x24, 0xe0, 0x58, 0x61, 0x88, 0xe3, 0x84, 0x18, 0x98, 0x42, 0xc7,
+	0x71, 0x42, 0xb4, 0x3c, 0xc5, 0x41, 0x35, 0x98, 0xf0, 0xb8, 0xaf, 0x85, 0xad, 0x3d, 0xb6, 0x4c,
+	0xfb, 0x0e, 0xb3, 0x4c, 0x4f, 0x33, 0xc7, 0x69, 0x86, 0x04, 0xee, 0x20, 0x8a, 0xde, 0x09, 0x23,
+	0xed, 0x98, 0x94, 0xe7, 0x97, 0xe7, 0x22, 0x52, 0xe5, 0x27, 0x64, 0xcd, 0x63, 0x78, 0x35, 0x2d,
+	0xc2, 0x3a, 0x9e, 0xfb, 0xcf, 0x1d, 0x18, 0x9c, 0xf5, 0x2a, 0xdb, 0xe1, 0xd6, 0x16, 0x1d, 0x8a,
+	0x6a, 0x3b, 0x4a, 0x8d, 0x5c, 0xda, 0x50, 0xcc, 0x0b, 0x38, 0x56, 0x18, 0x68, 0x03, 0x06, 0xf8,
+	0x07, 0x2f, 0x3e, 0xbb, 0xb7, 0x6b, 0xfd, 0x51, 0x11, 0x40, 0x6c, 0x39, 0xb4, 0x13, 0xbf, 0x31,
+	0xcd, 0x23, 0x80, 0xa6, 0x97, 0x82, 0x64, 0x2d, 0x2a, 0x27, 0x91, 0x1f, 0xd4, 0x66, 0x81, 0x4a,
+	0xff, 0x05, 0x46, 0x03, 0x0b, 0x5a, 0xb4, 0x1b, 0x4d, 0xef, 0x86, 0x64, 0x27, 0x74, 0x0d, 0xd5,
+	0x8d, 0xd5, 0xb4, 0x08, 0xeb, 0x78, 0xee, 0xf7, 0x1c, 0x18, 0x9e, 0xf5, 0x62, 0xbf, 0xf2, 0x17,
+	0x48, 0xf8, 0xfc, 0x6b, 0x07, 0xc6, 0x67, 0x1b, 0x74, 0x6e, 0xda, 0xc9, 0x75, 0x3f, 0xa8, 0x86,
+	0xbb, 0x3d, 0x9c, 0x46, 0xd6, 0xa0, 0x18, 0x27, 0x5e, 0x24, 0x9b, 0xf3, 0x0b, 0x5d, 0x27, 0x85,
+	0x7d, 0xa3, 0x4d, 0x92, 0x78, 0xb4, 0x81, 0x1b, 0x7e, 0x93, 0xcc, 0x8e, 0xc9, 0x98, 0xab, 0x32,
+	0x25, 0x80, 0x39, 0x1d, 0xb4, 0x04, 0x7d, 0x24, 0xa8, 0x8a, 0x35, 0x7b, 0x18, 0x72, 0x6a, 0x8b,
+	0xbe, 0x14, 0x54, 0x31, 0xa5, 0xe1, 0xbe, 0x5e, 0x80, 0x22, 0x3f, 0xd0, 0x5f, 0xcd, 0x9e, 0xca,
+	0x47, 0x2e, 0x3e, 0x9a, 0x37, 0x70, 0xea, 0x84, 0xae, 0x8f, 0xdd, 0x58, 0xd7, 0xb3, 0xfb, 0x57,
+	0xf3, 0xcf, 0x99, 0x05, 0x6b, 0xc6, 0x8c, 0x7c, 0xfb, 0xc4, 0x61, 0x0e, 0x9b, 0xee, 0x9b, 0x0e,
+	0x8c, 0xcf, 0x35, 0x7c, 0x12, 0x24, 0x73, 0x24, 0x4a, 0xd8, 0x72, 0xad, 0xc1, 0x44, 0x45, 0x41,
+	0x8e, 0xb2, 0x60, 0x99, 0x8c, 0x98, 0xcb, 0x90, 0xc0, 0x1d, 0x44, 0x51, 0x15, 0x4e, 0x70, 0x58,
+	0x2a, 0x8b, 0x0e, 0xb5, 0x6a, 0x99, 0x7d, 0x7a, 0xce, 0xa4, 0x80, 0xb3, 0x24, 0xdd, 0x9f, 0x38,
+	0x70, 0x6e, 0xae, 0xd1, 0x8e, 0x13, 0x12, 0x5d, 0x17, 0x43, 0x28, 0xcf, 0x08, 0xe8, 0x83, 0x30,
+	0xd4, 0x94, 0x3e, 0x73, 0xe7, 0x36, 0x62, 0xc3, 0x58, 0x52, 0x6b, 0x9b, 0x2f, 0x92, 0x4a, 0xb2,
+	0x4a, 0x12, 0x2f, 0x0d, 0x07, 0x49, 0x61, 0x58, 0x51, 0x45, 0x2d, 0xe8, 0x8f, 0x5b, 0xa4, 0x62,
+	0x2f, 0x1a, 0x4f, 0xf6, 0xa1, 0xdc, 0x22, 0x95, 0xf4, 0x93, 0x63, 0xde, 0x5e, 0xc6, 0xc9, 0xfd,
+	0x5f, 0x0e, 0xdc, 0xd7, 0xa5, 0xbf, 0x2b, 0x7e, 0x9c, 0xa0, 0x17, 0x3a, 0xfa, 0x3c, 0xdd, 0x5b,
+	0x9f, 0x69, 0x6d, 0xd6, 0x63, 0x25, 0x86, 0x25, 0x44, 0xeb, 0xef, 0x87, 0xa0, 0xe8, 0x27, 0xa4,
+	0x29, 0x1d, 0x01, 0x16, 0x56, 0x79, 0x97, 0xbe, 0xa4, 0xf2, 0x61, 0x89, 0xf2, 0xc3, 0x9c, 0xad,
+	0xfb, 0x2f, 0x1c, 0xa0, 0x1f, 0x63, 0xd5, 0x17, 0xee, 0xd5, 0xfe, 0x64, 0xaf, 0x25, 0x05, 0x94,
+	0x3c, 0x36, 0xf5, 0xd3, 0x53, 0xcc, 0xad, 0xfd, 0xa9, 0x31, 0x85, 0xc8, 0x8e, 0x4d, 0x0c, 0x15,
+	0xbd, 0x1f, 0x06, 0x62, 0x66, 0x6a, 0x10, 0xfb, 0xe9, 0x82, 0x3c, 0x17, 0x70, 0x03, 0xc4, 0xad,
+	0xfd, 0xa9, 0x9e, 0x22, 0x5f, 0xa7, 0x15, 0x6d, 0xe1, 0x09, 0x16, 0x54, 0xa9, 0x22, 0xdb, 0x24,
+	0x71, 0xec, 0xd5, 0xe4, 0xc9, 0x55, 0x29, 0xb2, 0xab, 0x1c, 0x8c, 0x65, 0xb9, 0xfb, 0x79, 0x07,
+	0xc6, 0xd4, 0x2e, 0x4e, 0x8f, 0x25, 0xe8, 0x8a, 0xbe, 0xdf, 0xf3, 0xc9, 0x7b, 0xa0, 0x8b, 0xa0,
+	0x12, 0x1a, 0xcd, 0xc1, 0xea, 0xc0, 0x3b, 0x60, 0xb4, 0x4a, 0x5a, 0x24, 0xa8, 0x92, 0xa0, 0xe2,
+	0x13, 0x3e, 0x69, 0xc3, 0xb3, 0x13, 0xf4, 0x1c, 0x3d, 0xaf, 0xc1, 0xb1, 0x81, 0xe5, 0x7e, 0xcd,
+	0x81, 0x7b, 0x15, 0xb9, 0x32, 0x49, 0x30, 0x49, 0xa2, 0x3d, 0x15, 0xe9, 0x7a, 0xb8, 0x6d, 0xfb,
+	0x3a, 0xd5, 0xeb, 0x93, 0x88, 0x33, 0x3f, 0xda, 0xbe, 0x3d, 0xc2, 0x4f, 0x01, 0x8c, 0x08, 0x96,
+	0xd4, 0xdc, 0x5f, 0xeb, 0x83, 0xd3, 0x7a, 0x23, 0xd5, 0x37, 0xff, 0xab, 0x0e, 0x80, 0x1a, 0x01,
+	0xaa, 0x99, 0xf4, 0xd9, 0x71, 0xe8, 0x19, 0x33, 0x95, 0x4a, 0x05, 0x05, 0x8e, 0xb1, 0xc6, 0x16,
+	0x3d, 0x07, 0xa3, 0x3b, 0x61, 0xa3, 0xdd, 0x24, 0xab, 0x54, 0x6f, 0x8a, 0x4b, 0x7d, 0xac, 0x19,
+	0x53, 0x79, 0x93, 0x79, 0x2d, 0xc5, 0x4b, 0xcd, 0x1c, 0x1a, 0x30, 0xc6, 0x06, 0x29, 0x7a, 0x82,
+	0x1b, 0x8b, 0xf4, 0x29, 0x11, 0x8e, 0x82, 0xf7, 0x59, 0xec, 0x63, 0x76, 0xd6, 0x67, 0x4f, 0xde,
+	0xdc, 0x9f, 0x1a, 0x33, 0x40, 0xd8, 0x6c, 0x84, 0xfb, 0x1c, 0xb0, 0xb1, 0xf0, 0x83, 0x36, 0x59,
+	0x0b, 0xd0, 0x43, 0xd2, 0xf6, 0xc8, 0x9d, 0x4d, 0xea, 0x63, 0xd6, 0xed, 0x8f, 0xf4, 0x8c, 0xbe,
+	0xe5, 0xf9, 0x0d, 0x16, 0x01, 0x4a, 0xb1, 0xd4, 0x19, 0x7d, 0x81, 0x41, 0xb1, 0x28, 0x75, 0xa7,
+	0x61, 0x70, 0x8e, 0xf6, 0x9d, 0x44, 0x94, 0xae, 0x1e, 0xb8, 0x3d, 0x66, 0x04, 0x6e, 0xcb, 0x00,
+	0xed, 0x0d, 0x38, 0x33, 0x17, 0x11, 0x2f, 0x21, 0xe5, 0x27, 0x67, 0xdb, 0x95, 0x6d, 0x92, 0xf0,
+	0xe8, 0xb8, 0x18, 0xbd, 0x1b, 0xc6, 0x42, 0x26, 0xc5, 0x57, 0xc2, 0xca, 0xb6, 0x1f, 0xd4, 0x84,
+	0x29, 0xf9, 0x8c, 0xa0, 0x32, 0xb6, 0xa6, 0x17, 0x62, 0x13, 0xd7, 0xfd, 0x4f, 0x05, 0x18, 0x9d,
+	0x8b, 0xc2, 0x40, 0x4a, 0xaa, 0xbb, 0xb0, 0xbb, 0x24, 0xc6, 0xee, 0x62, 0xc1, 0x07, 0xac, 0xb7,
+	0xbf, 0xdb, 0x0e, 0x83, 0x5e, 0x55, 0x22, 0xb2, 0xcf, 0xd6, 0x59, 0xcc, 0xe0, 0xcb, 0x68, 0xa7,
+	0x93, 0x6d, 0x0a, 0x50, 0xf7, 0x3f, 0x3b, 0x30, 0xa1, 0xa3, 0xdf, 0x85, 0x4d, 0x2d, 0x36, 0x37,
+	0xb5, 0x2b, 0x76, 0xfb, 0xdb, 0x65, 0x27, 0x7b, 0xa3, 0x0f, 0x4e, 0xe8, 0x68, 0xb8, 0x1d, 0xf4,
+	0xa0, 0x70, 0x57, 0x60, 0x2c, 0xae, 0xd4, 0x49, 0xb5, 0xdd, 0x20, 0x55, 0xaa, 0xf7, 0x1e, 0x41,
+	0xf1, 0x66, 0x9f, 0x72, 0x59, 0x27, 0x82, 0x4d, 0x9a, 0xe8, 0x1d, 0x50, 0x6c, 0xd5, 0xbd, 0x58,
+	0xee, 0x60, 0xe7, 0x65, 0xfb, 0xd7, 0x29, 0x90, 0x6e, 0xac, 0xb2, 0xcd, 0x0c, 0x80, 0x39, 0x32,
+	0x7a, 0x3f, 0xc0, 0x96, 0x1f, 0xf8, 0x71, 0x9d, 0x54, 0x67, 0x12, 0xe1, 0x6d, 0x39, 0x4c, 0xbb,
+	0xd4, 0xa7, 0xb0, 0xa0, 0xa8, 0x60, 0x8d, 0x22, 0x5d, 0x03, 0x6a, 0xe3, 0x29, 0x1e, 0x66, 0x0d,
+	0xc8, 0x6d, 0xe9, 0xa0, 0x8d, 0xca, 0xfd, 0x7c, 0x01, 0x4e, 0x1b, 0xab, 0x54, 0x8c, 0x48, 0x0f,
+	0x73, 0xf2, 0x38, 0x0c, 0xc9, 0xf1, 0x13, 0x4a, 0x85, 0x62, 0x24, 0xa9, 0x60, 0x85, 0x41, 0xb1,
+	0x13, 0xbf, 0x49, 0x5e, 0x0e, 0x03, 0x39, 0xbe, 0x0a, 0x7b, 0x43, 0xc0, 0xb1, 0xc2, 0xc8, 0xdc,
+	0x83, 0xe8, 0xbf, 0xeb, 0xf7, 0x20, 0xdc, 0x3f, 0x03, 0xf3, 0x73, 0x64, 0x71, 0x2a, 0x5f, 0x74,
+	0x60, 0x74, 0x57, 0x03, 0x88, 0x6f, 0xd2, 0xb6, 0xfa, 0xfb, 0xf3, 0x72, 0x37, 0xd4, 0xa1, 0xb7,
+	0x32, 0xbf, 0xb1, 0xd1, 0x92, 0x43, 0x4e, 0xc6, 0x0b, 0x70, 0xb2, 0x12, 0x06, 0x95, 0x76, 0x14,
+	0x91, 0xa0, 0xb2, 0xb7, 0xce, 0xae, 0x44, 0x89, 0x59, 0x99, 0x16, 0xd5, 0x4e, 0xce, 0x65, 0x11,
+	0x6e, 0xe5, 0x01, 0x71, 0x27, 0x21, 0xee, 0xab, 0x8b, 0xa9, 0x66, 0x25, 0x0c, 0x24, 0x9a, 0xaf,
+	0x8e, 0x81, 0xb1, 0x2c, 0x47, 0x57, 0xe1, 0x1c, 0x3b, 0x00, 0xfb, 0x41, 0x6d, 0x9e, 0x78, 0xd5,
+	0x86, 0x1f, 0xd0, 0xa3, 0x7f, 0x18, 0x54, 0x79, 0x18, 0x40, 0xdf, 0xec, 0x7d, 0x37, 0xf7, 0xa7,
+	0xce, 0x95, 0xf3, 0x51, 0x70, 0xb7, 0xba, 0xe8, 0xfd, 0x30, 0x29, 0xbc, 0x81, 0x5b, 0xed, 0xc6,
+	0x33, 0xe1, 0x66, 0x7c, 0xd9, 0x8f, 0xe9, 0xb9, 0x70, 0xc5, 0x6f, 0xfa, 0x09, 0x73, 0xf6, 0x17,
+	0x67, 0xcf, 0xdf, 0xdc, 0x9f, 0x9a, 0x2c, 0x77, 0xc5, 0xc2, 0x07, 0x50, 0x40, 0x18, 0xce, 0xf2,
+	0x3d, 0xba, 0x83, 0xf6, 0x20, 0xa3, 0x3d, 0x79, 0x73, 0x7f, 0xea, 0xec, 0x42, 0x2e, 0x06, 0xee,
+	0x52, 0xd3, 0xf8, 0x40, 0x86, 0x6e, 0xfb, 0x81, 0xbc, 0x98, 0xae, 0x44, 0x2a, 0xd5, 0x45, 0xb0,
+	0xd8, 0xe1, 0x37, 0x62, 0x76, 0xa8, 0xbd, 0xae, 0x51, 0x62, 0x51, 0xd0, 0x06, 0x6d, 0xf4, 0x09,
+	0x07, 0x86, 0xe5, 0xd2, 0x89, 0x4b, 0xc0, 0x3e, 0xc6, 0x6b, 0x96, 0x37, 0x47, 0x41, 0x3e, 0x55,
+	0xec, 0x25, 0x24, 0xc6, 0x29, 0x6f, 0xf4, 0x59, 0x07, 0x4e, 0x6c, 0x1a, 0xc6, 0x9a, 0xb8, 0x34,
+	0xc2, 0xda, 0x63, 0xc1, 0x8f, 0x69, 0x5a, 0x81, 0x52, 0x23, 0xbe, 0x09, 0x8f, 0x71, 0xb6, 0x05,
+	0xe8, 0x25, 0x38, 0x29, 0x41, 0x73, 0x5e, 0x83, 0x04, 0x55, 0x2f, 0x8a, 0x4b, 0xa3, 0xac, 0x59,
+	0xbd, 0xdb, 0x5b, 0xee, 0x95, 0xdf, 0xdd, 0x6c, 0x96, 0x14, 0xee, 0xa4, 0x8e, 0x3e, 0xea, 0xc0,
+	0x68, 0x9c, 0x84, 0xea, 0x52, 0x19, 0x0b, 0xe5, 0xb7, 0x22, 0x89, 0xca, 0x1a, 0x55, 0x7e, 0x64,
+	0xd2, 0x21, 0xd8, 0xe0, 0x8a, 0x30, 0x0c, 0xbc, 0xe8, 0x27, 0x09, 0x89, 0xd8, 0xf5, 0x80, 0xc3,
+	0xef, 0x4c, 0xcc, 0x36, 0xf9, 0x0c, 0xa3, 0x80, 0x05, 0x25, 0xf7, 0xdb, 0x83, 0x80, 0x3a, 0xf5,
+	0x26, 0xb4, 0x0c, 0x03, 0x5e, 0x25, 0xf1, 0x77, 0x64, 0x0c, 0xf7, 0x43, 0x79, 0x23, 0xcb, 0x17,
+	0x36, 0x26, 0x5b, 0x84, 0xca, 0x23, 0x92, 0x2a, 0x5b, 0x33, 0xac, 0x2a, 0x16, 0x24, 0x50, 0x08,
+	0x27, 0x1b, 0x5e, 0x9c, 0x94, 0xef, 0x50, 0xa5, 0x38, 0x43, 0xe7, 0x6b, 0x25, 0x4b, 0x08, 0x77,
+	0xd2, 0xa6, 0xfb, 0x59, 0x45, 0x9e, 0x9c, 0xe5, 0xa9, 0x68, 0xd9, 0xca, 0xc1, 0x85, 0xd3, 0x34,
+	0x0e, 0x66, 0x82, 0x0d, 0xd6, 0x58, 0xa2, 0xef, 0x38, 0x80, 0x3a, 0x9a, 0x25, 0x77, 0xd6, 0xc6,
+	0x71, 0x68, 0xba, 0xd3, 0x1d, 0x03, 0x23, 0xdc, 0xbc, 0xca, 0x1b, 0xd1, 0x89, 0x80, 0x73, 0xda,
+	0x88, 0x3e, 0xe6, 0x00, 0x44, 0xa4, 0x42, 0x82, 0x04, 0xb7, 0x03, 0xba, 0x2f, 0xf4, 0xd9, 0xf1,
+	0xda, 0x65, 0xb4, 0xd0, 0x74, 0x08, 0xb1, 0x62, 0x86, 0x35, 0xc6, 0xe8, 0x02, 0x0c, 0xb3, 0x2d,
+	0x81, 0x54, 0x49, 0x95, 0xed, 0x21, 0x7d, 0x9a, 0xb4, 0x92, 0x05, 0x38, 0xc5, 0xd1, 0xce, 0x79,
+	0x83, 0x0c, 0xbb, 0xcb, 0x39, 0x0f, 0xad, 0xc2, 0xa9, 0x4a, 0x18, 0xc4, 0xa4, 0xd2, 0xa6, 0x8b,
+	0x93, 0x16, 0xb6, 0x23, 0xc2, 0x23, 0x8d, 0xfb, 0x66, 0xef, 0x93, 0xb7, 0x0f, 0xe7, 0x3a, 0x51,
+	0x70, 0x5e, 0xbd, 0xc9, 0x97, 0xe0, 0x5c, 0x97, 0xa1, 0xcf, 0x71, 0x6e, 0xbf, 0xd7, 0x0c, 0x07,
+	0x39, 0xc4, 0xea, 0xd7, 0x1d, 0xe1, 0xff, 0x12, 0x60, 0x70, 0x7e, 0x66, 0x71, 0xc3, 0x8b, 0xb7,
+	0x7b, 0x53, 0x1c, 0x65, 0x00, 0x43, 0x56, 0x57, 0x91, 0xe6, 0x0c, 0xac, 0x30, 0x50, 0x00, 0x03,
+	0x7e, 0x40, 0x37, 0x77, 0x21, 0x63, 0x2c, 0x78, 0xd2, 0x95, 0xb1, 0x8d, 0xc9, 0x9f, 0x25, 0x46,
+	0x1d, 0x0b, 0x2e, 0xe8, 0x55, 0x18, 0xf6, 0xe4, 0x7d, 0x5c, 0x71, 0x12, 0x5c, 0xb6, 0x61, 0xd4,
+	0x16, 0x24, 0xf5, 0x08, 0x5f, 0x01, 0xc2, 0x29, 0x43, 0xf4, 0x11, 0x07, 0x46, 0x64, 0xd7, 0x31,
+	0xd9, 0x12, 0xe7, 0x89, 0x55, 0x7b, 0x7d, 0xc6, 0x64, 0x8b, 0x87, 0x7f, 0x6a, 0x00, 0xac, 0xb3,
+	0xec, 0xb0, 0x9e, 0x15, 0x7b, 0xb1, 0x9e, 0xa1, 0x5d, 0x18, 0xde, 0xf5, 0x93, 0x3a, 0x3b, 0xeb,
+	0x89, 0xa8, 0x91, 0x85, 0x3b, 0x6f, 0x35, 0x25, 0x97, 0x8e, 0xd8, 0x75, 0xc9, 0x00, 0xa7, 0xbc,
+	0xe8, 0x67, 0x49, 0x7f, 0x30, 0x3d, 0x9e, 0x7d, 0x68, 0xc3, 0x66, 0x05, 0x56, 0x80, 0x53, 0x1c,
+	0x3a, 0xc4, 0xa3, 0xf4, 0x57, 0x99, 0xbc, 0xd4, 0xa6, 0xbb, 0x84, 0x08, 0xe9, 0xb7, 0xb0, 0xae,
+	0x24, 0x45, 0x3e, 0x58, 0xd7, 0x35, 0x1e, 0xd8, 0xe0, 0x48, 0xbf, 0x91, 0xdd, 0x3a, 0x09, 0xc4,
+	0x05, 0x45, 0xf5, 0x8d, 0x5c, 0xaf, 0x93, 0x00, 0xb3, 0x12, 0xf4, 0x2a, 0xb7, 0xe6, 0x71, 0xb3,
+	0x92, 0x08, 0xcf, 0x5f, 0xb1, 0x63, 0xe9, 0xe2, 0x34, 0xf9, 0x1d, 0xc1, 0xf4, 0x37, 0xd6, 0xf8,
+	0x51, 0xc9, 0x15, 0x06, 0x97, 0x6e, 0xf8, 0x89, 0xb8, 0xd9, 0xa8, 0x24, 0xd7, 0x1a, 0x83, 0x62,
+	0x51, 0xca, 0xa3, 0x13, 0xe9, 0x22, 0x88, 0xd9, 0x35, 0xc6, 0x61, 0x3d, 0x3a, 0x91, 0x81, 0xb1,
+	0x2c, 0x47, 0x7f, 0xcf, 0x81, 0x62, 0x3d, 0x0c, 0xb7, 0xe3, 0xd2, 0x18, 0x5b, 0x1c, 0x16, 0xac,
+	0x2b, 0x42, 0xe2, 0x4c, 0x5f, 0xa6, 0x64, 0xcd, 0xbb, 0xda, 0x45, 0x06, 0xbb, 0xb5, 0x3f, 0x35,
+	0xbe, 0xe2, 0x6f, 0x91, 0xca, 0x5e, 0xa5, 0x41, 0x18, 0xe4, 0xf5, 0x37, 0x35, 0xc8, 0xa5, 0x1d,
+	0x2a, 0xda, 0x79, 0xab, 0x26, 0x3f, 0xe5, 0x00, 0xa4, 0x84, 0x72, 0x24, 0x25, 0x31, 0x25, 0xa5,
+	0x05, 0xd3, 0xaa, 0xd1, 0x34, 0x5d, 0x9c, 0xfe, 0x1b, 0x07, 0x46, 0x68, 0xe7, 0xa4, 0x08, 0x7c,
+	0x04, 0x06, 0x12, 0x2f, 0xaa, 0x11, 0xe9, 0x3b, 0x57, 0xd3, 0xb1, 0xc1, 0xa0, 0x58, 0x94, 0xa2,
+	0x00, 0x8a, 0x89, 0x17, 0x6f, 0x4b, 0x83, 0xce, 0x92, 0xb5, 0x21, 0x4e, 0x6d, 0x39, 0xf4, 0x57,
+	0x8c, 0x39, 0x1b, 0xf4, 0x28, 0x0c, 0xd1, 0x2d, 0x6c, 0xc1, 0x8b, 0x65, 0x74, 0xea, 0x28, 0x15,
+	0xe2, 0x0b, 0x02, 0x86, 0x55, 0xa9, 0xfb, 0xd9, 0x02, 0xf4, 0xcf, 0x73, 0xd3, 0xde, 0x40, 0x1c,
+	0xb6, 0xa3, 0x0a, 0x11, 0x67, 0x67, 0x0b, 0x6b, 0x9a, 0xd2, 0x2d, 0x33, 0x9a, 0x9a, 0x71, 0x8d,
+	0xfd, 0xc6, 0x82, 0x17, 0xfa, 0x9c, 0x03, 0xe3, 0x49, 0xe4, 0x05, 0xf1, 0x16, 0x8b, 0x52, 0xf0,
+	0xc3, 0x40, 0x0c, 0x91, 0x85, 0x55, 0xb8, 0x61, 0xd0, 0x2d, 0x27, 0xa4, 0x95, 0x06, 0x4b, 0x98,
+	0x65, 0x38, 0xd3, 0x06, 0xf7, 0x37, 0x1c, 0x80, 0xb4, 0xf5, 0xe8, 0x93, 0x0e, 0x8c, 0x79, 0xfa,
+	0x95, 0x0a, 0x31, 0x46, 0x6b, 0xf6, 0x7c, 0xaa, 0x8c, 0x2c, 0x37, 0x85, 0x19, 0x20, 0x6c, 0x32,
+	0x76, 0xdf, 0x09, 0x45, 0xf6, 0x75, 0x30, 0xbb, 0x82, 0x38, 0xa2, 0x64, 0xdd, 0x1e, 0xf2, 0xe8,
+	0x82, 0x15, 0x86, 0xfb, 0x02, 0x8c, 0x5f, 0xba, 0x41, 0xf5, 0x91, 0x30, 0xe2, 0x87, 0x9c, 0x2e,
+	0x57, 0x68, 0x9d, 0x23, 0x5d, 0xa1, 0xfd, 0x96, 0x03, 0x23, 0x5a, 0x7c, 0x3d, 0xdd, 0xa9, 0x6b,
+	0x73, 0x65, 0x6e, 0xea, 0x16, 0x43, 0xb5, 0x6c, 0x25, 0x82, 0x9f, 0x93, 0x4c, 0xb7, 0x11, 0x05,
+	0xc2, 0x29, 0xc3, 0xdb, 0x84, 0xb0, 0xbb, 0xbf, 0xef, 0xc0, 0x99, 0xdc, 0xcb, 0x00, 0x6f, 0x71,
+	0xb3, 0x2f, 0xc0, 0xf0, 0x36, 0xd9, 0x33, 0x62, 0x7b, 0x54, 0x85, 0x65, 0x59, 0x80, 0x53, 0x1c,
+	0xf7, 0xdb, 0x0e, 0xa4, 0x94, 0xa8, 0x28, 0xda, 0x4c, 0x5b, 0xae, 0x89, 0x22, 0xc1, 0x49, 0x94,
+	0xa2, 0x57, 0xe1, 0x9c, 0x39, 0x83, 0x47, 0x74, 0x86, 0x73, 0xfb, 0x4f, 0x3e, 0x25, 0xdc, 0x8d,
+	0x85, 0x7b, 0x0d, 0x8a, 0x8b, 0x5e, 0xbb, 0x46, 0x7a, 0xf2, 0x9b, 0x50, 0x31, 0x16, 0x11, 0xaf,
+	0x91, 0xc8, 0x43, 0xa0, 0x10, 0x63, 0x58, 0xc0, 0xb0, 0x2a, 0x75, 0x7f, 0x50, 0x84, 0x11, 0xed,
+	0xca, 0x27, 0xdd, 0xc7, 0x23, 0xd2, 0x0a, 0xb3, 0xba, 0x2e, 0x9d, 0x6c, 0xcc, 0x4a, 0xe8, 0xf7,
+	0x13, 0x91, 0x1d, 0x3f, 0xe6, 0x22, 0xc7, 0xf8, 0x7e, 0xb0, 0x80, 0x63, 0x85, 0x81, 0xa6, 0xa0,
+	0x58, 0x25, 0xad, 0xa4, 0xce, 0xa4, 0x69, 0x3f, 0x0f, 0x5d, 0x9f, 0xa7, 0x00, 0xcc, 0xe1, 0x14,
+	0x61, 0x8b, 0x24, 0x95, 0x3a, 0x3b, 0xb8, 0x89, 0xd8, 0xf6, 0x05, 0x0a, 0xc0, 0x1c, 0x9e, 0x13,
+	0x94, 0x53, 0x3c, 0xfe, 0xa0, 0x9c, 0x01, 0xcb, 0x41, 0x39, 0xa8, 0x05, 0xa7, 0xe2, 0xb8, 0xbe,
+	0x1e, 0xf9, 0x3b, 0x5e, 0x42, 0xd2, 0x95, 0x33, 0x78, 0x18, 0x3e, 0xe7, 0x58, 0xca, 0x96, 0xf2,
+	0xe5, 0x2c, 0x15, 0x9c, 0x47, 0x1a, 0x95, 0xe1, 0x8c, 0xcf, 0x8e, 0x52, 0x11, 0x59, 0xaa, 0x05,
+	0x61, 0x44, 0x2e, 0x87, 0x31, 0x25, 0x27, 0x12, 0x4e, 0xa8, 0xdb, 0x1e, 0x4b, 0x79, 0x48, 0x38,
+	0xbf, 0x2e, 0x5a, 0x84, 0x93, 0x55, 0x3f, 0xf6, 0x36, 0x1b, 0xa4, 0xdc, 0xde, 0x6c, 0x86, 0xdc,
+	0x7e, 0x36, 0xcc, 0x08, 0x2a, 0x73, 0xcf, 0x7c, 0x16, 0x01, 0x77, 0xd6, 0x41, 0x4f, 0xc1, 0x68,
+	0xec, 0x07, 0xb5, 0x06, 0x99, 0x8d, 0xbc, 0xa0, 0x52, 0x17, 0x99, 0x2a, 0x94, 0xd7, 0xb4, 0xac,
+	0x95, 0x61, 0x03, 0x93, 0x7d, 0xaf, 0xbc, 0x4e, 0x46, 0x93, 0x13, 0xd8, 0xa2, 0xd4, 0xfd, 0xa1,
+	0x03, 0xa3, 0xfa, 0x35, 0x2d, 0xaa, 0x25, 0x43, 0x7d, 0x7e, 0xa1, 0xcc, 0xe5, 0xb8, 0xbd, 0xdd,
+	0xfa, 0xb2, 0xa2, 0x99, 0x1e, 0xb8, 0x53, 0x18, 0xd6, 0x78, 0xf6, 0x90, 0xa2, 0xe5, 0x21, 0x28,
+	0x6e, 0x85, 0x54, 0x99, 0xe8, 0x33, 0xdd, 0xad, 0x0b, 0x14, 0x88, 0x79, 0x99, 0xfb, 0x3f, 0x1c,
+	0x38, 0x9b, 0x7f, 0x03, 0xed, 0x67, 0xa1, 0x93, 0x17, 0x01, 0x68, 0x57, 0x0c, 0x81, 0xac, 0x39,
+	0x27, 0x64, 0x09, 0xd6, 0xb0, 0x7a, 0xeb, 0xf6, 0x9f, 0x52, 0x85, 0x36, 0xe5, 0xf3, 0x86, 0x03,
+	0x63, 0x94, 0xed, 0x72, 0xb4, 0x69, 0xf4, 0x76, 0xcd, 0x4e, 0x6f, 0x15, 0xd9, 0xd4, 0xab, 0x6c,
+	0x80, 0xb1, 0xc9, 0x1c, 0xfd, 0x22, 0x0c, 0x7b, 0xd5, 0x6a, 0x44, 0xe2, 0x58, 0xc5, 0x67, 0xb0,
+	0x88, 0xb3, 0x19, 0x09, 0xc4, 0x69, 0x39, 0x15, 0xa2, 0xf5, 0xea, 0x56, 0x4c, 0xe5, 0x52, 0xd6,
+	0x77, 0x44, 0x99, 0x50, 0x38, 0x56, 0x18, 0xee, 0xdf, 0xea, 0x07, 0x93, 0x37, 0xaa, 0xc2, 0x89,
+	0xed, 0x68, 0x73, 0x8e, 0x85, 0x91, 0x1d, 0x25, 0xf2, 0x8b, 0x45, 0x64, 0x2d, 0x9b, 0x14, 0x70,
+	0x96, 0xa4, 0xe0, 0xb2, 0x4c, 0xf6, 0x12, 0x6f, 0xf3, 0xc8, 0x71, 0x5f, 0xcb, 0x26, 0x05, 0x9c,
+	0x25, 0x89, 0xde, 0x09, 0x23, 0xdb, 0xd1, 0xa6, 0x14, 0xd1, 0xd9, 0xd0, 0xcd, 0xe5, 0xb4, 0x08,
+	0xeb, 0x78, 0x74, 0x08, 0xb7, 0xa3, 0x4d, 0xba, 0xa5, 0xc9, 0x94, 0x45, 0x6a, 0x08, 0x97, 0x05,
+	0x1c, 0x2b, 0x0c, 0xd4, 0x02, 0xb4, 0x2d, 0x47, 0x4f, 0x59, 0xab, 0xc5, 0x4e, 0xd2, 0xbb, 0x49,
+	0x9b, 0x05, 0xec, 0x2d, 0x77, 0xd0, 0xc1, 0x39, 0xb4, 0xd1, 0x73, 0x70, 0x6e, 0x3b, 0xda, 0x14,
+	0x1b, 0xfd, 0x7a, 0xe4, 0x07, 0x15, 0xbf, 0x65, 0xa4, 0x27, 0x9a, 0x12, 0xcd, 0x3d, 0xb7, 0x9c,
+	0x8f, 0x86, 0xbb, 0xd5, 0x77, 0x7f, 0xa7, 0x1f, 0x58, 0xaa, 0x04, 0x2a, 0x0b, 0x9b, 0x24, 0xa9,
+	0x87, 0xd5, 0xac, 0xee, 0xb2, 0xca, 0xa0, 0x58, 0x94, 0xca, 0x3b, 0x10, 0x85, 0x2e, 0x77, 0x20,
+	0x76, 0x61, 0xb0, 0x4e, 0xbc, 0x2a, 0x89, 0xa4, 0x21, 0x77, 0xc5, 0x4e, 0x72, 0x87, 0xcb, 0x8c,
+	0x68, 0x7a, 0x84, 0xe6, 0xbf, 0x63, 0x2c, 0xb9, 0xa1, 0x77, 0xc1, 0x38, 0xd5, 0x42, 0xc2, 0x76,
+	0x22, 0x7d, 0x64, 0xfd, 0xcc, 0x44, 0xc8, 0x76, 0xd4, 0x0d, 0xa3, 0x04, 0x67, 0x30, 0xd1, 0x3c,
+	0x4c, 0x08, 0x7f, 0x96, 0x32, 0x10, 0x8b, 0x81, 0x55, 0x79, 0xa3, 0xca, 0x99, 0x72, 0xdc, 0x51,
+	0x83, 0x05, 0xbd, 0x87, 0x55, 0x1e, 0x79, 0xa3, 0x07, 0xbd, 0x87, 0xd5, 0x3d, 0xcc, 0x4a, 0xd0,
+	0xcb, 0x30, 0x44, 0xff, 0x2e, 0x44, 0x61, 0x53, 0xd8, 0x55, 0xd6, 0xed, 0x8c, 0x0e, 0xe5, 0x21,
+	0x4e, 0x79, 0x4c, 0x3b, 0x9b, 0x15, 0x5c, 0xb0, 0xe2, 0x47, 0xcf, 0x1a, 0x72, 0x1f, 0x2e, 0x6f,
+	0xfb, 0xad, 0x6b, 0x24, 0xf2, 0xb7, 0xf6, 0x98, 0xd2, 0x30, 0x94, 0x9e, 0x35, 0x96, 0x3a, 0x30,
+	0x70, 0x4e, 0x2d, 0xf7, 0x8d, 0x02, 0x8c, 0xea, 0x19, 0x37, 0x6e, 0x77, 0x31, 0x26, 0x4e, 0x17,
+	0x05, 0x3f, 0x59, 0x5e, 0xb6, 0xd0, 0xed, 0xdb, 0x2d, 0x88, 0x3a, 0xf4, 0x7b, 0x6d, 0xa1, 0x2d,
+	0x5a, 0x31, 0x60, 0xb1, 0x1e, 0xb7, 0x93, 0x3a, 0xbf, 0x9a, 0xcd, 0xae, 0xac, 0x30, 0x0e, 0xee,
+	0xc7, 0xfa, 0x60, 0x48, 0x16, 0xa2, 0x8f, 0x3a, 0x00, 0x69, 0xd4, 0xab, 0x10, 0xa5, 0xeb, 0x36,
+	0x42, 0x22, 0xf5, 0x80, 0x5d, 0xcd, 0xa5, 0xa1, 0xe0, 0x58, 0xe3, 0x8b, 0x12, 0x18, 0x08, 0x69,
+	0xe3, 0x2e, 0xda, 0xcb, 0x1a, 0xb3, 0x46, 0x19, 0x5f, 0x64, 0xdc, 0x53, 0x93, 0x17, 0x83, 0x61,
+	0xc1, 0x8b, 0x9e, 0xde, 0x36, 0x65, 0x08, 0xbc, 0x3d, 0xf3, 0xb0, 0x8a, 0xaa, 0x4f, 0x0f, 0x63,
+	0x0a, 0x84, 0x53, 0x86, 0xee, 0x13, 0x30, 0x6e, 0x7e, 0x0c, 0xf4, 0x44, 0xb0, 0xb9, 0x97, 0x10,
+	0x6e, 0x2b, 0x18, 0xe5, 0x27, 0x82, 0x59, 0x0a, 0xc0, 0x1c, 0xee, 0x7e, 0x9f, 0xea, 0x01, 0x4a,
+	0xbc, 0xf4, 0x60, 0x9e, 0x7f, 0x48, 0x37, 0x74, 0x75, 0x3b, 0x33, 0x7d, 0x18, 0x86, 0xd9, 0x3f,
+	0xec, 0x43, 0xef, 0xb3, 0x15, 0xa7, 0x95, 0xb6, 0x53, 0x7c, 0xea, 0x4c, 0x27, 0xb8, 0x26, 0x19,
+	0xe1, 0x94, 0xa7, 0x1b, 0xc2, 0x44, 0x16, 0x1b, 0xbd, 0x0f, 0x46, 0x63, 0xb9, 0xad, 0xa6, 0x57,
+	0xc0, 0x7b, 0xdc, 0x7e, 0xb9, 0xaf, 0x53, 0xab, 0x8e, 0x0d, 0x62, 0xee, 0x1a, 0x0c, 0x58, 0x1d,
+	0x42, 0xf7, 0x1b, 0x0e, 0x0c, 0xb3, 0x08, 0x80, 0x5a, 0xe4, 0x35, 0xd3, 0x2a, 0x7d, 0x07, 0x8c,
+	0x7a, 0x0c, 0x83, 0xfc, 0x7c, 0x2d, 0x3d, 0x77, 0x16, 0xa4, 0x0c, 0x4f, 0x0d, 0x9b, 0x4a, 0x19,
+	0x7e, 0x90, 0x8f, 0xb1, 0xe4, 0xe4, 0x7e, 0xbc, 0x00, 0x03, 0x4b, 0x41, 0xab, 0xfd, 0x97, 0x3e,
+	0x3d, 0xe9, 0x2a, 0xf4, 0x2f, 0x25, 0xa4, 0x69, 0x66, 0xd1, 0x1d, 0x9d, 0x7d, 0x58, 0xcf, 0xa0,
+	0x5b, 0x32, 0x33, 0xe8, 0x62, 0x6f, 0x57, 0xc6, 0x3f, 0x0b, 0xfb, 0x6e, 0x7a, 0x0d, 0xfe, 0x71,
+	0x18, 0x5e, 0xf1, 0x36, 0x49, 0x63, 0x99, 0xec, 0xb1, 0x4b, 0xeb, 0x3c, 0x16, 0xcf, 0x49, 0x0f,
+	0xf6, 0x46, 0xdc, 0xdc, 0x3c, 0x8c, 0x33, 0x6c, 0xf5, 0x31, 0xd0, 0x93, 0x03, 0x49, 0x53, 0x10,
+	0x3a, 0xe6, 0xc9, 0x41, 0x4b, 0x3f, 0xa8, 0x61, 0xb9, 0xd3, 0x30, 0x92, 0x52, 0xe9, 0x81, 0xeb,
+	0x4f, 0x0b, 0x30, 0x66, 0x98, 0xa9, 0x0d, 0xe7, 0x9d, 0x73, 0x5b, 0xe7, 0x9d, 0xe1, 0x4c, 0x2b,
+	0xbc, 0xd5, 0xce, 0xb4, 0xbe, 0xbb, 0xef, 0x4c, 0x33, 0x27, 0xa9, 0xbf, 0xa7, 0x49, 0x6a, 0x40,
+	0xff, 0x8a, 0x1f, 0x6c, 0xf7, 0x26, 0x67, 0xe2, 0x4a, 0xd8, 0xea, 0x90, 0x33, 0x65, 0x0a, 0xc4,
+	0xbc, 0x4c, 0x6a, 0x2e, 0x7d, 0xf9, 0x9a, 0x8b, 0xfb, 0x51, 0x07, 0x46, 0x57, 0xbd, 0xc0, 0xdf,
+	0x22, 0x71, 0xc2, 0xd6, 0x55, 0x72, 0xac, 0x97, 0x97, 0x47, 0xbb, 0xe4, 0xf0, 0xf9, 0xc7, 0x0e,
+	0x9c, 0x5c, 0x25, 0xcd, 0xd0, 0x7f, 0xd9, 0x4b, 0xaf, 0x17, 0xd0, 0xb6, 0xd7, 0xfd, 0x44, 0x44,
+	0x53, 0xab, 0xb6, 0x5f, 0xf6, 0x13, 0x4c, 0xe1, 0xb7, 0xb1, 0xc1, 0xb2, 0x7b, 0x84, 0xf4, 0x80,
+	0xa6, 0x5d, 0xa8, 0x4f, 0x2f, 0x0e, 0xc8, 0x02, 0x9c, 0xe2, 0xa8, 0x0a, 0x1b, 0x7b, 0x2d, 0x22,
+	0x26, 0xcb, 0xac, 0xc0, 0x2f, 0xa2, 0x2b, 0x1c, 0xf7, 0x77, 0x1d, 0x18, 0xe4, 0xad, 0x26, 0xb2,
+	0x31, 0x4e, 0x97, 0xc6, 0xd4, 0xa1, 0xc8, 0xea, 0x89, 0xcf, 0x60, 0xd1, 0x82, 0xbe, 0xc4, 0xae,
+	0x45, 0xb1, 0x8f, 0x96, 0xfd, 0x8b, 0x39, 0x03, 0x76, 0xce, 0xf1, 0x6e, 0xcc, 0xa8, 0xab, 0x18,
+	0xe9, 0x39, 0x87, 0x41, 0xb1, 0x28, 0x75, 0xbf, 0xdc, 0x07, 0x43, 0x2a, 0xd7, 0x25, 0xcb, 0x44,
+	0x14, 0x04, 0x61, 0xe2, 0xf1, 0x18, 0x15, 0x2e, 0xdc, 0xdf, 0x67, 0x2f, 0xd7, 0xe6, 0xf4, 0x4c,
+	0x4a, 0x9d, 0x3b, 0xeb, 0xd4, 0xa9, 0x55, 0x2b, 0xc1, 0x7a, 0x23, 0xd0, 0x87, 0x60, 0xa0, 0x41,
+	0xc5, 0x95, 0x94, 0xf5, 0xd7, 0x2c, 0x36, 0x87, 0xc9, 0x41, 0xd1, 0x12, 0x35, 0x42, 0x1c, 0x88,
+	0x05, 0xd7, 0xc9, 0xa7, 0x61, 0x22, 0xdb, 0xea, 0xdb, 0x25, 0x08, 0x18, 0xd6, 0xd3, 0x0b, 0xfc,
+	0xb2, 0x10, 0xb7, 0x87, 0xaf, 0xea, 0x3e, 0x0b, 0x23, 0xab, 0x24, 0x89, 0xfc, 0x0a, 0x23, 0x70,
+	0xbb, 0xc5, 0xd5, 0x93, 0xc2, 0xf1, 0x09, 0xb6, 0x58, 0x29, 0xcd, 0x18, 0xbd, 0x0a, 0xd0, 0x8a,
+	0x42, 0x7a, 0xe0, 0x25, 0x6d, 0x39, 0xd9, 0x16, 0x14, 0xe8, 0x75, 0x45, 0x93, 0xfb, 0x97, 0xd3,
+	0xdf, 0x58, 0xe3, 0xe7, 0xfe, 0xb6, 0x03, 0xc5, 0xd5, 0x76, 0x42, 0x6e, 0xf4, 0x16, 0x2d, 0x42,
+	0xe7, 0x6b, 0xd3, 0x8b, 0xa5, 0x75, 0x3e, 0x8d, 0x67, 0x16, 0x70, 0xac, 0x30, 0xd0, 0x55, 0x18,
+	0x14, 0x27, 0x5f, 0x21, 0xed, 0x0f, 0x1b, 0x92, 0xc6, 0xae, 0xdd, 0x88, 0xc3, 0x34, 0x96, 0xb4,
+	0xdc, 0xf7, 0xc1, 0x28, 0x6b, 0xef, 0xe5, 0xb0, 0x41, 0x37, 0x77, 0x3a, 0xde, 0x4d, 0xfa, 0x3b,
+	0xeb, 0x57, 0x60, 0x48, 0x98, 0x97, 0xd1, 0xef, 0xb0, 0x1e, 0x36, 0xaa, 0xea, 0x0e, 0xb3, 0x5a,
+	0x65, 0x97, 0x19, 0x14, 0x8b, 0x52, 0xf7, 0x57, 0x0b, 0x30, 0xc2, 0x2a, 0x0a, 0xa1, 0xb7, 0x07,
+	0x83, 0x75, 0xce, 0x47, 0x4c, 0x8c, 0x85, 0xb0, 0x3e, 0xbd, 0xf5, 0xda, 0x89, 0x92, 0x03, 0xb0,
+	0xe4, 0x47, 0x59, 0xef, 0x7a, 0x7e, 0x42, 0x59, 0x17, 0x8e, 0x97, 0xf5, 0x75, 0xce, 0x06, 0x4b,
+	0x7e, 0xee, 0xe7, 0x0b, 0x00, 0x2c, 0x1f, 0x2a, 0x4f, 0xa1, 0xf1, 0x76, 0x19, 0x8c, 0x6f, 0xfa,
+	0x0a, 0x55, 0x30, 0x3e, 0x4b, 0x12, 0x62, 0x04, 0xe2, 0x6b, 0x57, 0xd0, 0x0a, 0x07, 0x5f, 0x41,
+	0x43, 0x2d, 0x18, 0x0c, 0xdb, 0x09, 0x55, 0x69, 0xc5, 0x2a, 0xb1, 0xe0, 0x2a, 0x5f, 0xe3, 0x04,
+	0xf9, 0x02, 0x12, 0x3f, 0xb0, 0x64, 0x83, 0x9e, 0x82, 0xa1, 0x56, 0x14, 0xd6, 0xe8, 0x16, 0x2f,
+	0x36, 0x96, 0xfb, 0xe5, 0x2a, 0x5e, 0x17, 0xf0, 0x5b, 0xda, 0xff, 0x58, 0x61, 0xbb, 0xff, 0x6a,
+	0x82, 0x8f, 0x8b, 0x58, 0x1c, 0x93, 0x50, 0xf0, 0xa5, 0x01, 0x0b, 0x04, 0x89, 0xc2, 0xd2, 0x3c,
+	0x2e, 0xf8, 0x55, 0xf5, 0x31, 0x15, 0xba, 0x7e, 0x4c, 0xef, 0x84, 0x91, 0xaa, 0x1f, 0xb7, 0x1a,
+	0xde, 0xde, 0x95, 0x1c, 0xeb, 0xe1, 0x7c, 0x5a, 0x84, 0x75, 0x3c, 0xf4, 0xb8, 0xb8, 0x70, 0xd8,
+	0x6f, 0x58, 0x8c, 0xe4, 0x85, 0xc3, 0x34, 0x45, 0x0b, 0xbf, 0x6b, 0x98, 0x4d, 0x65, 0x53, 0xec,
+	0x39, 0x95, 0x4d, 0x56, 0x61, 0x1b, 0xb8, 0xfb, 0x0a, 0xdb, 0xbb, 0x61, 0x4c, 0xfe, 0x64, 0x5a,
+	0x54, 0xe9, 0x34, 0x6b, 0xbd, 0xb2, 0x6a, 0x6f, 0xe8, 0x85, 0xd8, 0xc4, 0x4d, 0x17, 0xed, 0x60,
+	0xaf, 0x8b, 0xf6, 0x22, 0xc0, 0x66, 0xd8, 0x0e, 0xaa, 0x5e, 0xb4, 0xb7, 0x34, 0x2f, 0xe2, 0xbe,
+	0x95, 0x7e, 0x38, 0xab, 0x4a, 0xb0, 0x86, 0xa5, 0x2f, 0xf4, 0xe1, 0xdb, 0x2c, 0xf4, 0xf7, 0xc1,
+	0x30, 0x8b, 0x91, 0x67, 0x77, 0x53, 0xe0, 0xf0, 0x77, 0x66, 0x54, 0x7c, 0xa3, 0x24, 0x82, 0x53,
+	0x7a, 0x99, 0x9b, 0x2f, 0x23, 0xc7, 0x70, 0xf3, 0xe5, 0x24, 0x89, 0x13, 0xbf, 0xe9, 0x25, 0xa4,
+	0xaa, 0x72, 0x15, 0x94, 0x98, 0xc9, 0x53, 0xdd, 0x52, 0xb8, 0x94, 0x45, 0xb8, 0x95, 0x07, 0xc4,
+	0x9d, 0x84, 0x10, 0x81, 0xd3, 0x1d, 0xc0, 0xf5, 0x5f, 0x7e, 0x7b, 0xe9, 0x3e, 0xc6, 0x40, 0x06,
+	0x12, 0x9d, 0xbe, 0x94, 0x83, 0x93, 0xcf, 0x23, 0x97, 0x9c, 0xf1, 0xe1, 0x4f, 0x1e, 0xe6, 0xc3,
+	0x47, 0xff, 0xd3, 0x81, 0x93, 0x11, 0xe1, 0x11, 0x2c, 0xb1, 0xea, 0xff, 0x19, 0x26, 0x96, 0x2b,
+	0x36, 0xde, 0x3f, 0x51, 0xd9, 0xc7, 0x70, 0x96, 0x0b, 0xd7, 0x8a, 0x88, 0x1c, 0xe4, 0x8e, 0xf2,
+	0x5b, 0x79, 0xc0, 0xd7, 0xdf, 0x9c, 0x9a, 0xea, 0x7c, 0x8c, 0x47, 0x11, 0xa7, 0x1f, 0xf8, 0xdf,
+	0x78, 0x73, 0x6a, 0x42, 0xfe, 0x4e, 0xe7, 0xa6, 0xa3, 0x93, 0x74, 0x7b, 0x6d, 0x85, 0xd5, 0xa5,
+	0x75, 0x11, 0x55, 0xa6, 0xb6, 0xd7, 0x75, 0x0a, 0xc4, 0xbc, 0x0c, 0x3d, 0x4a, 0x15, 0x03, 0xd2,
+	0x0c, 0x03, 0x95, 0xc9, 0x7e, 0x94, 0x2b, 0x05, 0x1c, 0x86, 0x55, 0x29, 0x6a, 0xc0, 0x80, 0xcf,
+	0x0c, 0x18, 0x22, 0x84, 0xd4, 0x82, 0xd5, 0x84, 0x1b, 0x44, 0x64, 0x00, 0x29, 0x93, 0xf5, 0x82,
+	0x87, 0xbe, 0xb9, 0x9c, 0xb8, 0x3b, 0x9b, 0xcb, 0xa3, 0x30, 0x54, 0xa9, 0xfb, 0x8d, 0x6a, 0x44,
+	0x82, 0xd2, 0x04, 0x3b, 0xc9, 0x8f, 0xf2, 0x1c, 0xfd, 0x1c, 0x86, 0x55, 0x29, 0xfa, 0x25, 0x18,
+	0x0b, 0xdb, 0x09, 0x93, 0x25, 0x74, 0xfe, 0xe3, 0xd2, 0x49, 0x86, 0xce, 0x02, 0x82, 0xd6, 0xf4,
+	0x02, 0x6c, 0xe2, 0x51, 0x99, 0x5e, 0x0f, 0x63, 0x96, 0xb2, 0x8e, 0xc9, 0xf4, 0xb3, 0xa6, 0x4c,
+	0xbf, 0xac, 0x95, 0x61, 0x03, 0x13, 0x7d, 0xd1, 0x81, 0x93, 0xcd, 0xec, 0xc1, 0xae, 0x74, 0x8e,
+	0x8d, 0x4c, 0xd9, 0x86, 0x3e, 0x9f, 0x21, 0xcd, 0xa3, 0xf2, 0x3b, 0xc0, 0xb8, 0xb3, 0x11, 0x2c,
+	0x79, 0x64, 0xbc, 0x17, 0x54, 0xea, 0x51, 0x18, 0x98, 0xcd, 0xbb, 0xd7, 0xd6, 0xd5, 0x62, 0xf6,
+	0x95, 0xe5, 0xb1, 0x98, 0xbd, 0xf7, 0xe6, 0xfe, 0xd4, 0x99, 0xdc, 0x22, 0x9c, 0xdf, 0xa8, 0xc9,
+	0x79, 0x38, 0x9b, 0xff, 0xa5, 0xde, 0xee, 0x60, 0xd1, 0xa7, 0x1f, 0x2c, 0x16, 0xe0, 0xde, 0xae,
+	0x8d, 0xa2, 0x5b, 0x8b, 0xd4, 0xff, 0x1c, 0x73, 0x6b, 0xe9, 0xd0, 0xd7, 0xc6, 0x61, 0x54, 0x7f,
+	0x42, 0xc9, 0xfd, 0x3f, 0x7d, 0x00, 0xa9, 0xfd, 0x1c, 0x79, 0x30, 0xce, 0x6d, 0xf5, 0x4b, 0xf3,
+	0x47, 0xce, 0x0e, 0x33, 0x67, 0x10, 0xc0, 0x19, 0x82, 0xa8, 0x09, 0x88, 0x43, 0xf8, 0xef, 0xa3,
+	0xf8, 0x5c, 0x99, 0x8b, 0x72, 0xae, 0x83, 0x08, 0xce, 0x21, 0x4c, 0x7b, 0x94, 0x84, 0xdb, 0x24,
+	0xb8, 0x8a, 0x57, 0x8e, 0x92, 0x62, 0x88, 0x7b, 0xe9, 0x0c, 0x02, 0x38, 0x43, 0x10, 0xb9, 0x30,
+	0xc0, 0x6c, 0x36, 0x32, 0xe8, 0x9a, 0x89, 0x17, 0xa6, 0x5a, 0xc4, 0x58, 0x94, 0xa0, 0xcf, 0x3b,
+	0x30, 0x2e, 0x33, 0x25, 0x31, 0x2b, 0xa9, 0x0c, 0xb7, 0xbe, 0x6a, 0xcb, 0xff, 0x71, 0x49, 0xa7,
+	0x9e, 0x06, 0x33, 0x1a, 0xe0, 0x18, 0x67, 0x1a, 0xe1, 0x3e, 0x07, 0xa7, 0x72, 0xaa, 0x5b, 0x39,
+	0xb8, 0x7e, 0xcb, 0x81, 0x11, 0x2d, 0x99, 0x2f, 0x7a, 0x15, 0x86, 0xc3, 0xb2, 0xf5, 0x08, 0xba,
+	0xb5, 0x72, 0x47, 0x04, 0x9d, 0x02, 0xe1, 0x94, 0x61, 0x2f, 0x81, 0x7f, 0xb9, 0x99, 0x87, 0xdf,
+	0xe2, 0x66, 0x1f, 0x3a, 0xf0, 0xef, 0xdf, 0xf7, 0x43, 0x4a, 0xe9, 0x90, 0x19, 0xbc, 0xd2, 0x30,
+	0xc1, 0xc2, 0x81, 0x61, 0x82, 0x55, 0x38, 0xe1, 0x31, 0x1f, 0xf3, 0x11, 0xf3, 0x76, 0xf1, 0x5c,
+	0xee, 0x26, 0x05, 0x9c, 0x25, 0x49, 0xb9, 0xc4, 0x69, 0x55, 0xc6, 0xa5, 0xff, 0xd0, 0x5c, 0xca,
+	0x26, 0x05, 0x9c, 0x25, 0x89, 0x5e, 0x80, 0x52, 0x85, 0xa5, 0x5f, 0xe0, 0x7d, 0x5c, 0xda, 0xba,
+	0x12, 0x26, 0xeb, 0x11, 0x89, 0x49, 0x90, 0x88, 0x84, 0x9b, 0x0f, 0x8a, 0x51, 0x28, 0xcd, 0x75,
+	0xc1, 0xc3, 0x5d, 0x29, 0xd0, 0x73, 0x09, 0x73, 0x52, 0xfb, 0xc9, 0x1e, 0x13, 0x22, 0xc2, 0x7b,
+	0xaf, 0xce, 0x25, 0x65, 0xbd, 0x10, 0x9b, 0xb8, 0xe8, 0x6f, 0x3a, 0x30, 0xd6, 0x90, 0x66, 0x7c,
+	0xdc, 0x6e, 0xc8, 0xd4, 0xd3, 0xd8, 0xca, 0xf2, 0x5b, 0xd1, 0x29, 0x73, 0x5d, 0xc2, 0x00, 0x61,
+	0x93, 0xb7, 0xfb, 0x7d, 0x07, 0x26, 0xb2, 0xd5, 0xd0, 0x36, 0x3c, 0xd0, 0xf4, 0xa2, 0xed, 0xa5,
+	0x60, 0x2b, 0x62, 0xb7, 0x24, 0x12, 0x3e, 0xab, 0x33, 0x5b, 0x09, 0x89, 0xe6, 0xbd, 0x3d, 0xee,
+	0xdf, 0x2c, 0xaa, 0x27, 0x0b, 0x1f, 0x58, 0x3d, 0x08, 0x19, 0x1f, 0x4c, 0x0b, 0x95, 0xe1, 0x0c,
+	0x45, 0x60, 0xb9, 0x4f, 0xfd, 0x30, 0x48, 0x99, 0x14, 0x18, 0x13, 0x15, 0xed, 0xb7, 0x9a, 0x87,
+	0x84, 0xf3, 0xeb, 0xba, 0x43, 0x30, 0xc0, 0xef, 0x1f, 0xba, 0xff, 0xae, 0x00, 0x52, 0x49, 0xfb,
+	0xcb, 0xed, 0x32, 0xa3, 0x1b, 0x5a, 0xc4, 0xec, 0x39, 0xc2, 0xd4, 0xc0, 0x36, 0x34, 0x91, 0x28,
+	0x58, 0x94, 0x50, 0xed, 0x95, 0xdc, 0xf0, 0x93, 0xb9, 0xb0, 0x2a, 0x0d, 0x0c, 0x4c, 0x7b, 0xbd,
+	0x24, 0x60, 0x58, 0x95, 0xba, 0x1f, 0x75, 0x60, 0x8c, 0xf6, 0xb2, 0xd1, 0x20, 0x8d, 0x72, 0x42,
+	0x5a, 0x31, 0x8a, 0xa1, 0x18, 0xd3, 0x7f, 0xec, 0x19, 0xca, 0xd2, 0x4b, 0x8c, 0xa4, 0xa5, 0x27,
+	0x6b, 0x23, 0xad, 0x18, 0x73, 0x5e, 0xee, 0x37, 0xfb, 0x60, 0x58, 0x0d, 0x76, 0x0f, 0x16, 0xcc,
+	0x8b, 0x69, 0x0e, 0x6f, 0x2e, 0x0d, 0x4b, 0x5a, 0xfe, 0xee, 0x5b, 0x74, 0xe8, 0x82, 0x3d, 0x9e,
+	0xf4, 0x27, 0x4d, 0xe6, 0xfd, 0xb8, 0xe9, 0x0e, 0x3e, 0xab, 0xfb, 0x18, 0x35, 0x7c, 0xe1, 0x17,
+	0xbe, 0xa1, 0x7b, 0xe3, 0xfb, 0x6d, 0xed, 0x2c, 0xca, 0xd5, 0xd8, 0xdd, 0x0d, 0x9f, 0x79, 0x49,
+	0xae, 0xd8, 0xd3, 0x4b, 0x72, 0x8f, 0x41, 0x3f, 0x09, 0xda, 0x4d, 0xa6, 0xb6, 0x0c, 0x33, 0x75,
+	0xbd, 0xff, 0x52, 0xd0, 0x6e, 0x9a, 0x3d, 0x63, 0x28, 0xe8, 0x69, 0x18, 0xa9, 0x92, 0xb8, 0x12,
+	0xf9, 0x2c, 0x93, 0x8d, 0x30, 0xab, 0xdc, 0xcf, 0x6c, 0x55, 0x29, 0xd8, 0xac, 0xa8, 0x57, 0x70,
+	0x5f, 0x86, 0x81, 0xf5, 0x46, 0xbb, 0xe6, 0x07, 0xa8, 0x05, 0x03, 0x3c, 0xaf, 0x8d, 0xd8, 0x79,
+	0x2d, 0x9c, 0x01, 0xf9, 0xd7, 0xae, 0x45, 0x8a, 0xf0, 0xdb, 0xc7, 0x82, 0x8f, 0xfb, 0x4f, 0x1c,
+	0xa0, 0x07, 0xd6, 0xc5, 0x39, 0xf4, 0x57, 0x3b, 0x9e, 0x42, 0xfb, 0xb9, 0x9c, 0xa7, 0xd0, 0xc6,
+	0x18, 0x72, 0xce, 0x2b, 0x68, 0x0d, 0x18, 0x63, 0xfe, 0x08, 0xb9, 0x1f, 0x09, 0x15, 0xf7, 0xc9,
+	0x1e, 0x53, 0xc1, 0xe8, 0x55, 0x85, 0x74, 0xd6, 0x41, 0xd8, 0x24, 0xee, 0xfe, 0x5e, 0x3f, 0x68,
+	0x66, 0xfb, 0x1e, 0x96, 0xf7, 0x4b, 0x19, 0x27, 0xcd, 0xaa, 0x15, 0x27, 0x8d, 0xf4, 0x7c, 0x70,
+	0x91, 0x61, 0xfa, 0x65, 0x68, 0xa3, 0xea, 0xa4, 0xd1, 0x12, 0x1f, 0x87, 0x6a, 0xd4, 0x65, 0xd2,
+	0x68, 0x61, 0x56, 0xa2, 0x6e, 0xd8, 0xf5, 0x77, 0xbd, 0x61, 0x57, 0x87, 0x62, 0xcd, 0x6b, 0xd7,
+	0x88, 0x08, 0x6b, 0xb4, 0xe0, 0x8f, 0x63, 0x57, 0x0e, 0xb8, 0x3f, 0x8e, 0xfd, 0x8b, 0x39, 0x03,
+	0xfa, 0x75, 0xd6, 0x65, 0x9c, 0x87, 0x30, 0x69, 0x5a, 0xf8, 0x3a, 0x55, 0xe8, 0x08, 0xff, 0x3a,
+	0xd5, 0x4f, 0x9c, 0x32, 0x43, 0x2d, 0x18, 0xac, 0xf0, 0x0c, 0x52, 0x62, 0xc3, 0x5f, 0xb2, 0x71,
+	0x85, 0x90, 0x11, 0xe4, 0xa6, 0x08, 0xf1, 0x03, 0x4b, 0x36, 0xee, 0x6f, 0x3a, 0x30, 0x8c, 0x59,
+	0x4e, 0xbe, 0xa6, 0x9f, 0xf4, 0xe6, 0xc1, 0x6e, 0xb0, 0xc4, 0x19, 0x7c, 0xe7, 0x55, 0x02, 0x97,
+	0xe7, 0xca, 0xe0, 0x65, 0x08, 0xc3, 0x40, 0x8b, 0x44, 0x7e, 0x58, 0x3d, 0xa2, 0x4f, 0x87, 0x2d,
+	0xa1, 0x75, 0x46, 0x01, 0x0b, 0x4a, 0xee, 0x05, 0x18, 0xd1, 0xde, 0x7a, 0xa2, 0x2d, 0x55, 0x59,
+	0x96, 0xb4, 0x96, 0xce, 0x7b, 0x89, 0x87, 0x59, 0x89, 0xfb, 0xb5, 0x7e, 0x50, 0xb6, 0x2b, 0xfd,
+	0x66, 0x9e, 0x57, 0xd1, 0x72, 0xc2, 0x19, 0x09, 0x07, 0xc2, 0x00, 0x8b, 0x52, 0xaa, 0xbd, 0x35,
+	0x49, 0x54, 0x53, 0xa7, 0x65, 0xb1, 0x11, 0x28, 0xed, 0x6d, 0x55, 0x2f, 0xc4, 0x26, 0x2e, 0x55,
+	0xbd, 0x9b, 0xc2, 0x41, 0x9f, 0x0d, 0x7f, 0x96, 0x8e, 0x7b, 0xac, 0x30, 0x58, 0x6a, 0x88, 0xa6,
+	0xe6, 0xcf, 0x17, 0x61, 0x98, 0x36, 0x1c, 0x39, 0x1a, 0x55, 0x1e, 0x2e, 0xa5, 0x43, 0xb0, 0xc1,
+	0x15, 0x2d, 0xc2, 0xc9, 0x98, 0x24, 0x6b, 0xbb, 0x01, 0x89, 0x54, 0x3e, 0x06, 0x91, 0x0e, 0x46,
+	0xdd, 0x7d, 0x28, 0x67, 0x11, 0x70, 0x67, 0x9d, 0xdc, 0xc8, 0xd5, 0xe2, 0xa1, 0x23, 0x57, 0xe7,
+	0x61, 0x62, 0x8b, 0x5f, 0x90, 0xef, 0x1a, 0xff, 0xba, 0x90, 0x29, 0xc7, 0x1d, 0x35, 0xd8, 0xf5,
+	0x9b, 0x86, 0x57, 0x8b, 0x4b, 0x83, 0xda, 0xf5, 0x1b, 0x0a, 0xc0, 0x1c, 0xee, 0xfe, 0x43, 0x07,
+	0x78, 0xba, 0xb8, 0x99, 0xad, 0x2d, 0x3f, 0xf0, 0x93, 0x3d, 0xf4, 0x25, 0x07, 0x26, 0x82, 0xb0,
+	0x4a, 0x66, 0x82, 0xc4, 0x97, 0x40, 0x7b, 0x6f, 0x93, 0x30, 0x5e, 0x57, 0x32, 0xe4, 0x79, 0x52,
+	0x97, 0x2c, 0x14, 0x77, 0x34, 0xc3, 0x3d, 0x07, 0x67, 0x72, 0x09, 0xb8, 0xdf, 0xef, 0x03, 0x33,
+	0xeb, 0x1d, 0x7a, 0x56, 0x7e, 0xa7, 0xce, 0x11, 0xd3, 0x19, 0x0e, 0x77, 0x7c, 0xd5, 0xf3, 0x30,
+	0xc2, 0x52, 0xe9, 0x89, 0xf4, 0x43, 0xfc, 0x8b, 0x70, 0xd3, 0xd7, 0x57, 0x55, 0xd1, 0x2d, 0xf3,
+	0x27, 0xd6, 0xab, 0xa1, 0x57, 0x60, 0x70, 0x93, 0x67, 0x56, 0xb6, 0xe7, 0xca, 0x13, 0xa9, 0x9a,
+	0x99, 0xd6, 0x25, 0xf3, 0x36, 0xdf, 0x4a, 0xff, 0xc5, 0x92, 0x23, 0xda, 0x83, 0x21, 0x4f, 0xce,
+	0x69, 0xbf, 0xad, 0xeb, 0x14, 0xc6, 0xfa, 0x11, 0xf1, 0x32, 0x72, 0x0e, 0x15, 0xbb, 0x4c, 0x60,
+	0x51, 0xb1, 0xa7, 0xc0, 0xa2, 0x6f, 0x38, 0x00, 0xe9, 0x93, 0x54, 0xe8, 0x06, 0x0c, 0xc5, 0x4f,
+	0x1a, 0xe6, 0x08, 0x1b, 0x77, 0xe0, 0x05, 0x45, 0xed, 0x9e, 0xa8, 0x80, 0x60, 0xc5, 0xed, 0x76,
+	0x26, 0x94, 0x9f, 0x3a, 0x70, 0x3a, 0xef, 0xe9, 0xac, 0xb7, 0xb0, 0xc5, 0x87, 0xb5, 0x9e, 0x88,
+	0x0a, 0xeb, 0x11, 0xd9, 0xf2, 0x6f, 0x64, 0x63, 0x8f, 0x96, 0x65, 0x01, 0x4e, 0x71, 0xdc, 0x6f,
+	0x0f, 0x80, 0x62, 0x7c, 0x4c, 0xd6, 0x96, 0x47, 0xe8, 0x69, 0xac, 0x96, 0x66, 0xfc, 0x56, 0x78,
+	0x98, 0x41, 0xb1, 0x28, 0xa5, 0x27, 0x32, 0x19, 0x12, 0x2f, 0x44, 0x36, 0x5b, 0x85, 0x32, 0x74,
+	0x1e, 0xab, 0xd2, 0x3c, 0xfb, 0x4d, 0xf1, 0xae, 0xd8, 0x6f, 0x06, 0xec, 0xdb, 0x6f, 0x1e, 0x83,
+	0xc1, 0x28, 0x6c, 0x90, 0x19, 0x7c, 0x45, 0x9c, 0x33, 0xd2, 0xb7, 0x18, 0x38, 0x18, 0xcb, 0xf2,
+	0x6c, 0x1a, 0xf8, 0xa1, 0xde, 0xd2, 0xc0, 0xa3, 0x6f, 0x3b, 0x07, 0x98, 0x88, 0x86, 0x6d, 0xed,
+	0x09, 0xb9, 0x39, 0x40, 0xd9, 0xa1, 0xe9, 0x28, 0x76, 0xa7, 0x2f, 0x3b, 0x70, 0x92, 0x04, 0x95,
+	0x68, 0x8f, 0xd1, 0x11, 0xd4, 0x84, 0x2b, 0xf9, 0xaa, 0x8d, 0x8f, 0xef, 0x52, 0x96, 0x38, 0x77,
+	0xe0, 0x74, 0x80, 0x71, 0x67, 0x33, 0xdc, 0x1f, 0x17, 0xe0, 0x54, 0x0e, 0x05, 0x76, 0xdb, 0xa9,
+	0x49, 0x17, 0xd0, 0x52, 0x35, 0xfb, 0xf9, 0x2c, 0x0b, 0x38, 0x56, 0x18, 0x68, 0x1d, 0x4e, 0x6f,
+	0x37, 0xe3, 0x94, 0xca, 0x5c, 0x18, 0x24, 0xe4, 0x86, 0xfc, 0x98, 0xa4, 0xbb, 0xf6, 0xf4, 0x72,
+	0x0e, 0x0e, 0xce, 0xad, 0x49, 0xb5, 0x0d, 0x12, 0x78, 0x9b, 0x0d, 0x92, 0x16, 0x89, 0xbb, 0x7a,
+	0x4a, 0xdb, 0xb8, 0x94, 0x29, 0xc7, 0x1d, 0x35, 0xd0, 0x27, 0x1d, 0xb8, 0x2f, 0x26, 0xd1, 0x0e,
+	0x89, 0xca, 0x7e, 0x95, 0xcc, 0xb5, 0xe3, 0x24, 0x6c, 0x92, 0xe8, 0x88, 0x36, 0xcc, 0xa9, 0x9b,
+	0xfb, 0x53, 0xf7, 0x95, 0xbb, 0x53, 0xc3, 0x07, 0xb1, 0x72, 0x3f, 0xe9, 0xc0, 0x78, 0x99, 0x9d,
+	0xaa, 0x95, 0xea, 0x6b, 0x3b, 0x69, 0xf3, 0x23, 0x2a, 0x33, 0x44, 0x46, 0x88, 0x99, 0xb9, 0x1c,
+	0xdc, 0xef, 0x15, 0x60, 0xa2, 0x4c, 0x9a, 0x5e, 0xab, 0xce, 0x6e, 0xda, 0xf2, 0xb8, 0xa5, 0x0b,
+	0x30, 0x1c, 0x4b, 0x58, 0xf6, 0x01, 0x3a, 0x85, 0x8c, 0x53, 0x1c, 0xf4, 0x30, 0x8f, 0xb1, 0x92,
+	0xf7, 0x75, 0x86, 0xf9, 0x71, 0x86, 0x07, 0x66, 0xc5, 0x58, 0x96, 0xa1, 0xaf, 0x39, 0x30, 0xc6,
+	0xff, 0xbf, 0x4e, 0xfc, 0x5a, 0x5d, 0x65, 0x34, 0x26, 0x36, 0x92, 0xc5, 0x98, 0x7d, 0x98, 0xbe,
+	0xac, 0xf3, 0xe1, 0x1e, 0xf8, 0xf4, 0x2e, 0xa5, 0x5e, 0x86, 0xcd, 0x26, 0x4d, 0xbe, 0x17, 0x50,
+	0x67, 0xdd, 0xdb, 0xf9, 0x04, 0x8b, 0xba, 0x4f, 0xf0, 0xab, 0x7d, 0x30, 0x9a, 0x0e, 0x13, 0xd9,
+	0x42, 0x35, 0x38, 0x51, 0xd1, 0xae, 0xf4, 0xa5, 0x97, 0x29, 0x7a, 0xbf, 0xfd, 0xc7, 0xd3, 0xd8,
+	0x9b, 0x44, 0x70, 0x96, 0x2a, 0x7a, 0x25, 0x13, 0xdd, 0x67, 0x25, 0xb3, 0x57, 0x79, 0x2f, 0xa8,
+	0xa8, 0xd8, 0x40, 0xb2, 0x25, 0xe3, 0x02, 0x3a, 0x82, 0x05, 0x37, 0x60, 0x60, 0x97, 0x0d, 0x99,
+	0x50, 0x1d, 0x8f, 0xf8, 0xb8, 0x06, 0x1f, 0x76, 0x2c, 0x68, 0xe9, 0x21, 0x88, 0xfd, 0x16, 0x43,
+	0x10, 0x3f, 0x5d, 0x80, 0x13, 0x6a, 0x8e, 0x84, 0xbb, 0xf6, 0xb5, 0x6c, 0xa4, 0x20, 0xb6, 0xbf,
+	0x2e, 0x0f, 0x88, 0x16, 0x7c, 0x2d, 0x1b, 0x2d, 0x78, 0xac, 0xec, 0x3b, 0x3c, 0xd0, 0xdf, 0x28,
+	0xc0, 0x90, 0x4a, 0xa9, 0xf4, 0x2c, 0x14, 0x99, 0x0d, 0xe2, 0xce, 0x0e, 0x28, 0xcc, 0x9e, 0x81,
+	0x39, 0x25, 0x4a, 0x52, 0x7f, 0xe5, 0xe3, 0x88, 0x24, 0x8d, 0x77, 0x3e, 0x96, 0xf5, 0x77, 0x3e,
+	0x0e, 0x4f, 0x70, 0x50, 0x7f, 0xe9, 0x83, 0xe5, 0x97, 0xe3, 0x0a, 0x69, 0xe6, 0xe1, 0x37, 0xa1,
+	0x8d, 0x8a, 0x52, 0xf7, 0x33, 0x0e, 0x18, 0x49, 0x1c, 0xbb, 0x25, 0x9c, 0xe3, 0x1e, 0x96, 0x43,
+	0x27, 0x9c, 0xcb, 0x1c, 0x45, 0x0a, 0x3d, 0x1d, 0x45, 0xfe, 0x69, 0x3f, 0x0c, 0x94, 0xdb, 0x9b,
+	0xf4, 0x1c, 0xf8, 0x75, 0x07, 0x4e, 0xed, 0x66, 0x9e, 0x41, 0x48, 0x05, 0xce, 0x55, 0x7b, 0x26,
+	0x7d, 0x3d, 0x08, 0x50, 0xf5, 0x32, 0xa7, 0x10, 0xe7, 0x35, 0xc7, 0x48, 0x7b, 0xde, 0x77, 0x2c,
+	0x69, 0xcf, 0x6f, 0x1c, 0xf3, 0x65, 0x99, 0xb1, 0xae, 0x17, 0x65, 0x9e, 0x86, 0x71, 0xbf, 0x4a,
+	0x9a, 0xad, 0x30, 0x21, 0x41, 0x65, 0x6f, 0x99, 0xec, 0x89, 0x15, 0xa5, 0x7c, 0xff, 0x4b, 0x46,
+	0x29, 0xce, 0x60, 0xa3, 0x18, 0x4e, 0x6a, 0x10, 0x9e, 0x17, 0xf5, 0x88, 0xc9, 0xaa, 0x99, 0xf2,
+	0xb7, 0x94, 0x25, 0x86, 0x3b, 0xe9, 0xbb, 0xbf, 0x57, 0x04, 0xe0, 0x4b, 0x68, 0xad, 0x95, 0xf4,
+	0x62, 0xa8, 0x7e, 0x0a, 0x46, 0x6b, 0x24, 0x20, 0x91, 0x8c, 0x4b, 0xcd, 0x3c, 0xce, 0xb8, 0xa8,
+	0x95, 0x61, 0x03, 0x93, 0xad, 0x70, 0xba, 0xa9, 0xf2, 0x03, 0x59, 0xf6, 0x16, 0x8f, 0x2a, 0xc1,
+	0x1a, 0x16, 0x9a, 0x36, 0x1c, 0x7f, 0x3c, 0x9e, 0x63, 0xfc, 0x00, 0x3f, 0xdd, 0xd3, 0x30, 0x6e,
+	0xa6, 0xb3, 0x11, 0xa7, 0x10, 0x35, 0x07, 0x66, 0x16, 0x1c, 0x9c, 0xc1, 0xa6, 0xd2, 0xa0, 0x1a,
+	0xed, 0xe1, 0x76, 0x20, 0x8e, 0x23, 0x4a, 0x1a, 0xcc, 0x33, 0x28, 0x16, 0xa5, 0x2c, 0x97, 0x08,
+	0xd3, 0xf4, 0x38, 0x5c, 0xe4, 0x23, 0x49, 0x73, 0x89, 0x68, 0x65, 0xd8, 0xc0, 0xa4, 0x1c, 0x84,
+	0xa1, 0x1f, 0x4c, 0x79, 0x93, 0xb1, 0xce, 0xb7, 0x60, 0x3c, 0x34, 0xed, 0x7e, 0x3c, 0x36, 0xf4,
+	0x1d, 0x3d, 0x7e, 0x2f, 0x46, 0x5d, 0x1e, 0x37, 0x93, 0x31, 0x13, 0x66, 0xe8, 0xd3, 0xf3, 0x98,
+	0x7e, 0x77, 0x65, 0xd4, 0x0c, 0x6b, 0xee, 0x7a, 0xbd, 0x64, 0x1d, 0x4e, 0xb7, 0xc2, 0xea, 0x7a,
+	0xe4, 0x87, 0x91, 0x9f, 0xec, 0xcd, 0x35, 0xbc, 0x38, 0x66, 0x0b, 0x63, 0xcc, 0x54, 0xfc, 0xd7,
+	0x73, 0x70, 0x70, 0x6e, 0x4d, 0x7a, 0x72, 0x6e, 0x09, 0x20, 0x8b, 0x35, 0x2c, 0x72, 0xdd, 0x43,
+	0x22, 0x62, 0x55, 0xea, 0x9e, 0x82, 0x93, 0xe5, 0x76, 0xab, 0xd5, 0xf0, 0x49, 0x55, 0x39, 0xd6,
+	0xdc, 0xf7, 0xc0, 0x09, 0x91, 0x22, 0x5b, 0xa9, 0xd9, 0x87, 0x7a, 0x77, 0xc4, 0x7d, 0x3b, 0x9c,
+	0xc8, 0x28, 0x3f, 0xb7, 0x09, 0xc0, 0x71, 0xbf, 0xd3, 0xc7, 0xab, 0x68, 0xb1, 0x60, 0xe8, 0x95,
+	0xac, 0x36, 0x6d, 0x27, 0xb3, 0xb0, 0xa6, 0x60, 0x72, 0x59, 0x94, 0xab, 0x99, 0xd7, 0xe5, 0xd5,
+	0x0a, 0x6b, 0xf7, 0xa4, 0xd8, 0x05, 0x04, 0xbe, 0x19, 0x1b, 0xf7, 0x33, 0x6e, 0xc0, 0x70, 0x24,
+	0x5d, 0x15, 0xf6, 0xae, 0x72, 0x2b, 0xef, 0x07, 0xef, 0xa3, 0xfa, 0x89, 0x53, 0x66, 0xdc, 0x1a,
+	0xdd, 0x68, 0x6c, 0x7a, 0x95, 0x6d, 0x39, 0xd1, 0x99, 0xd8, 0xfa, 0x89, 0x85, 0x4c, 0x39, 0xee,
+	0xa8, 0xe1, 0x7e, 0xa2, 0x0f, 0xf2, 0x03, 0x08, 0xd1, 0x87, 0x3a, 0x27, 0xf0, 0x59, 0x8b, 0x13,
+	0x28, 0x22, 0x18, 0xbb, 0xcf, 0x61, 0x60, 0xce, 0xe1, 0xaa, 0xa5, 0x39, 0x14, 0x7c, 0x3b, 0x67,
+	0xf2, 0x43, 0x9d, 0x33, 0x79, 0x5c, 0xfd, 0xcd, 0x9b, 0x4f, 0xf7, 0xcf, 0x1c, 0x18, 0xd9, 0xd8,
+	0x58, 0xd1, 0xf2, 0x62, 0x9f, 0x8d, 0x79, 0xe2, 0x0d, 0x16, 0x1e, 0x32, 0x17, 0x36, 0x5b, 0x3c,
+	0x5a, 0x44, 0xe8, 0x58, 0x2c, 0x3f, 0x7c, 0x39, 0x17, 0x03, 0x77, 0xa9, 0x89, 0x96, 0xe0, 0x94,
+	0x5e, 0x52, 0xd6, 0x5e, 0xc3, 0x2e, 0x8a, 0x64, 0x57, 0x9d, 0xc5, 0x38, 0xaf, 0x4e, 0x96, 0x94,
+	0x50, 0xe4, 0xd8, 0xc0, 0xe5, 0x90, 0x12, 0xc5, 0x38, 0xaf, 0x8e, 0xbb, 0x06, 0x23, 0x1b, 0x5e,
+	0xa4, 0x3a, 0xfe, 0x5e, 0x98, 0xa8, 0x84, 0x4d, 0xa9, 0xe5, 0xad, 0x90, 0x1d, 0xd2, 0x10, 0x5d,
+	0xe6, 0xaf, 0xa7, 0x65, 0xca, 0x70, 0x07, 0xb6, 0xfb, 0xdf, 0xce, 0x83, 0xba, 0x48, 0xdc, 0xc3,
+	0x9e, 0xde, 0x52, 0xa1, 0xdd, 0x45, 0xcb, 0xa1, 0xdd, 0x6a, 0x77, 0xcb, 0x84, 0x77, 0x27, 0x69,
+	0x78, 0xf7, 0x80, 0xed, 0xf0, 0x6e, 0x75, 0xd6, 0xe9, 0x08, 0xf1, 0xfe, 0x82, 0x03, 0xa3, 0x41,
+	0x58, 0x25, 0x2a, 0x06, 0x60, 0x90, 0x1d, 0xb8, 0x5e, 0xb0, 0x77, 0x35, 0x86, 0x87, 0x2a, 0x0b,
+	0xf2, 0xdc, 0xfc, 0xa0, 0x94, 0x02, 0xbd, 0x08, 0x1b, 0xed, 0x40, 0x0b, 0x9a, 0x0b, 0x84, 0x7b,
+	0x1a, 0xef, 0xcf, 0x33, 0x11, 0xdc, 0xd6, 0x9f, 0x71, 0x43, 0x53, 0xaf, 0x87, 0x6d, 0x99, 0xf6,
+	0xe5, 0x65, 0x4f, 0xcd, 0x61, 0x2a, 0x9f, 0x38, 0x48, 0xd5, 0x6e, 0x17, 0x06, 0xf8, 0x4d, 0x01,
+	0x91, 0x56, 0x8d, 0x9d, 0xe9, 0xf9, 0x2d, 0x02, 0x2c, 0x4a, 0x50, 0x22, 0xe3, 0x8c, 0x46, 0x6c,
+	0xbd, 0xab, 0x65, 0xc4, 0x31, 0xe5, 0x07, 0x1a, 0xa1, 0x67, 0x74, 0x13, 0xdb, 0x68, 0x2f, 0x26,
+	0xb6, 0xb1, 0xae, 0xe6, 0xb5, 0x37, 0x1c, 0x18, 0xad, 0x68, 0xef, 0x5c, 0x95, 0x1e, 0x65, 0xf4,
+	0xae, 0xd9, 0x7d, 0x3d, 0x4b, 0x65, 0xd6, 0x66, 0xee, 0x61, 0xe3, 0x5d, 0x2d, 0x83, 0x3b, 0xcb,
+	0x03, 0xcb, 0xec, 0x89, 0xe2, 0xe5, 0x02, 0x0b, 0xe9, 0x63, 0x4c, 0xfb, 0xa4, 0x8c, 0x9d, 0xa6,
+	0x30, 0x2c, 0x78, 0xa1, 0x57, 0x61, 0x48, 0x5e, 0x36, 0x11, 0x57, 0x41, 0xb0, 0x0d, 0x7f, 0x9d,
+	0x19, 0x14, 0x20, 0xb3, 0x47, 0x72, 0x28, 0x56, 0x1c, 0x51, 0x1d, 0xfa, 0xaa, 0x5e, 0x4d, 0x5c,
+	0x0a, 0x59, 0xb5, 0x93, 0x9c, 0x57, 0xf2, 0x64, 0x96, 0x81, 0xf9, 0x99, 0x45, 0x4c, 0x59, 0xa0,
+	0x1b, 0xe9, 0x0b, 0x2c, 0x13, 0xd6, 0x76, 0x43, 0x53, 0x31, 0xe5, 0x56, 0xaa, 0x8e, 0x07, 0x5d,
+	0xaa, 0x22, 0x8e, 0xe2, 0xff, 0x63, 0x6c, 0x17, 0xec, 0x64, 0xf7, 0xe5, 0xe9, 0x88, 0xd2, 0x58,
+	0x0c, 0xca, 0xa5, 0x9e, 0x24, 0xad, 0xd2, 0x2f, 0xd8, 0xe2, 0xc2, 0x92, 0xea, 0x30, 0x2e, 0xf4,
+	0x3f, 0xcc, 0xa8, 0xa3, 0x06, 0x0c, 0xb4, 0x58, 0xf0, 0x58, 0xe9, 0x17, 0x6d, 0xed, 0x2d, 0x3c,
+	0x18, 0x4d, 0x04, 0xa4, 0xb0, 0xff, 0xb1, 0xe0, 0x81, 0x2e, 0xc1, 0x20, 0x7f, 0xef, 0x8e, 0x5f,
+	0xca, 0x19, 0xb9, 0x38, 0xd9, 0xfd, 0xd5, 0xbc, 0x74, 0xa3, 0xe0, 0xbf, 0x63, 0x2c, 0xeb, 0xa2,
+	0x4f, 0x3b, 0x30, 0x4e, 0x25, 0x6a, 0xfa, 0x40, 0x5f, 0x09, 0xd9, 0x92, 0x59, 0x57, 0x63, 0xaa,
+	0x91, 0x48, 0x59, 0x93, 0x1a, 0x07, 0x0c, 0x76, 0x38, 0xc3, 0x1e, 0xbd, 0x06, 0x43, 0xb1, 0x5f,
+	0x25, 0x15, 0x2f, 0x8a, 0x4b, 0xa7, 0x8e, 0xa7, 0x29, 0xa9, 0xe7, 0x56, 0x30, 0xc2, 0x8a, 0x25,
+	0xfa, 0x0c, 0x7b, 0xf9, 0xbd, 0x52, 0xf7, 0x77, 0xc8, 0x4a, 0x58, 0xe1, 0x07, 0xa9, 0xd3, 0xb6,
+	0xbe, 0x7d, 0xe9, 0xa3, 0x96, 0x94, 0x85, 0x43, 0xd3, 0x64, 0x87, 0xb3, 0xfc, 0xd1, 0x5f, 0x77,
+	0xe0, 0x0c, 0x7f, 0x8a, 0x24, 0xfb, 0xea, 0xd1, 0x99, 0x23, 0x5a, 0x06, 0xd9, 0x6d, 0xa2, 0x99,
+	0x3c, 0x92, 0x38, 0x9f, 0x13, 0xcb, 0x36, 0x6d, 0xbe, 0xa7, 0x78, 0xd6, 0x6a, 0x04, 0x43, 0xef,
+	0x6f, 0x28, 0xa2, 0x27, 0x60, 0xa4, 0x25, 0xb6, 0x43, 0x3f, 0x6e, 0xb2, 0xbb, 0x61, 0x7d, 0xfc,
+	0x9a, 0xee, 0x7a, 0x0a, 0xc6, 0x3a, 0x8e, 0x91, 0x7a, 0xfc, 0xb1, 0x83, 0x52, 0x8f, 0xa3, 0xab,
+	0x30, 0x92, 0x84, 0x0d, 0x12, 0x09, 0xdb, 0x40, 0x89, 0xad, 0xc0, 0xf3, 0x79, 0xdf, 0xd6, 0x86,
+	0x42, 0x4b, 0x6d, 0x07, 0x29, 0x2c, 0xc6, 0x3a, 0x1d, 0x16, 0x8f, 0x2f, 0x5e, 0xd8, 0x88, 0x98,
+	0xd1, 0xe0, 0xde, 0x4c, 0x3c, 0xbe, 0x5e, 0x88, 0x4d, 0x5c, 0xb4, 0x08, 0x27, 0x5b, 0x1d, 0x56,
+	0x07, 0x7e, 0x3b, 0x54, 0x05, 0x47, 0x75, 0x9a, 0x1c, 0x3a, 0xeb, 0x18, 0xf6, 0x86, 0xfb, 0x0e,
+	0xb2, 0x37, 0x74, 0x49, 0xc4, 0x7d, 0xff, 0x51, 0x12, 0x71, 0xa3, 0x2a, 0xdc, 0xef, 0xb5, 0x93,
+	0x90, 0x25, 0x8e, 0x32, 0xab, 0xf0, 0xab, 0x09, 0x0f, 0xf2, 0xdb, 0x0e, 0x37, 0xf7, 0xa7, 0xee,
+	0x9f, 0x39, 0x00, 0x0f, 0x1f, 0x48, 0x05, 0xbd, 0x0c, 0x43, 0x44, 0x24, 0x13, 0x2f, 0xfd, 0x9c,
+	0x2d, 0x25, 0xc1, 0x4c, 0x4f, 0x2e, 0x23, 0xcd, 0x39, 0x0c, 0x2b, 0x7e, 0x68, 0x03, 0x46, 0xea,
+	0x61, 0x9c, 0xcc, 0x34, 0x7c, 0x2f, 0x26, 0x71, 0xe9, 0x01, 0xb6, 0x68, 0x72, 0x75, 0xaf, 0xcb,
+	0x12, 0x2d, 0x5d, 0x33, 0x97, 0xd3, 0x9a, 0x58, 0x27, 0x83, 0x08, 0x8b, 0x63, 0x60, 0xf7, 0x32,
+	0xa4, 0x8f, 0xf9, 0x3c, 0xeb, 0xd8, 0x23, 0x79, 0x94, 0xd7, 0xc3, 0x6a, 0xd9, 0xc4, 0x56, 0x81,
+	0x0c, 0x3a, 0x10, 0x67, 0x69, 0xa2, 0xa7, 0x60, 0xb4, 0x15, 0x56, 0xcb, 0x2d, 0x52, 0x59, 0xf7,
+	0x92, 0x4a, 0xbd, 0x34, 0x65, 0xda, 0x39, 0xd7, 0xb5, 0x32, 0x6c, 0x60, 0xa2, 0x16, 0x0c, 0x36,
+	0x79, 0x82, 0x90, 0xd2, 0x43, 0xb6, 0xce, 0x36, 0x22, 0xe3, 0x08, 0xd7, 0x17, 0xc4, 0x0f, 0x2c,
+	0xd9, 0xa0, 0xbf, 0xef, 0xc0, 0x89, 0xcc, 0x6d, 0xc7, 0xd2, 0xcf, 0xdb, 0xf4, 0x03, 0x6a, 0x84,
+	0x67, 0x1f, 0x61, 0xc3, 0x67, 0x02, 0x6f, 0x75, 0x82, 0x70, 0xb6, 0x45, 0x7c, 0x5c, 0x58, 0x96,
+	0x9f, 0xd2, 0xc3, 0xf6, 0xc6, 0x85, 0x11, 0x94, 0xe3, 0xc2, 0x7e, 0x60, 0xc9, 0x06, 0x3d, 0x96,
+	0x3a, 0x11, 0x1f, 0x31, 0x83, 0x51, 0xb2, 0x8e, 0xc1, 0xc9, 0xf7, 0xc0, 0xc9, 0x8e, 0xa3, 0xdb,
+	0xa1, 0x52, 0xcd, 0xfc, 0x86, 0x03, 0x7a, 0x3e, 0x04, 0xeb, 0x2f, 0xf8, 0x3c, 0x05, 0xa3, 0x15,
+	0xfe, 0xda, 0x35, 0xcf, 0xa8, 0xd0, 0x6f, 0x5a, 0x9c, 0xe7, 0xb4, 0x32, 0x6c, 0x60, 0xba, 0x97,
+	0x01, 0x75, 0x3e, 0xaf, 0x70, 0xa4, 0xc4, 0x67, 0xff, 0xc0, 0x81, 0x31, 0x43, 0x67, 0xb0, 0x1e,
+	0xbf, 0xb0, 0x00, 0xa8, 0xe9, 0x47, 0x51, 0x18, 0xe9, 0x6f, 0x18, 0x8b, 0x8c, 0x35, 0xec, 0x9a,
+	0xe9, 0x6a, 0x47, 0x29, 0xce, 0xa9, 0xe1, 0xfe, 0xa3, 0x7e, 0x48, 0xaf, 0x5a, 0xa8, 0x1c, 0xd8,
+	0x4e, 0xd7, 0x1c, 0xd8, 0x8f, 0xc3, 0xd0, 0x8b, 0x71, 0x18, 0xac, 0xa7, 0x99, 0xb2, 0xd5, 0x5c,
+	0x3c, 0x53, 0x5e, 0xbb, 0xc2, 0x30, 0x15, 0x06, 0xc3, 0x7e, 0x69, 0xc1, 0x6f, 0x24, 0x9d, 0xa9,
+	0x94, 0x9f, 0x79, 0x96, 0xc3, 0xb1, 0xc2, 0x60, 0xcf, 0x19, 0xef, 0x10, 0xe5, 0x8a, 0x48, 0x9f,
+	0x33, 0xe6, 0x2f, 0xa7, 0xb0, 0x32, 0x74, 0x01, 0x86, 0x95, 0x1b, 0x23, 0x9b, 0x34, 0x4b, 0xf9,
+	0x3a, 0x70, 0x8a, 0xc3, 0x14, 0x42, 0x61, 0xfa, 0x16, 0x26, 0x94, 0xb2, 0x8d, 0xe3, 0x49, 0xc6,
+	0x98, 0xce, 0x65, 0xbb, 0x04, 0x63, 0xc5, 0x32, 0x2f, 0xb6, 0x61, 0xf8, 0x58, 0x62, 0x1b, 0xb4,
+	0x7b, 0x3f, 0xc5, 0x5e, 0xef, 0xfd, 0x98, 0x6b, 0x7b, 0xa8, 0xa7, 0xb5, 0xfd, 0xb1, 0x3e, 0x18,
+	0xbc, 0x46, 0x22, 0xf6, 0x82, 0xc0, 0x63, 0x30, 0xb8, 0xc3, 0xff, 0xcd, 0x5e, 0xe0, 0x16, 0x18,
+	0x58, 0x96, 0xd3, 0x79, 0xdb, 0x6c, 0xfb, 0x8d, 0xea, 0x7c, 0xfa, 0x15, 0xa7, 0xc9, 0x47, 0x65,
+	0x01, 0x4e, 0x71, 0x68, 0x85, 0x1a, 0xd5, 0xec, 0x9b, 0xd2, 0xca, 0xaa, 0x55, 0x58, 0x94, 0x05,
+	0x38, 0xc5, 0x41, 0x8f, 0xc0, 0x40, 0xcd, 0x4f, 0x36, 0xbc, 0x5a, 0xd6, 0x41, 0xbd, 0xc8, 0xa0,
+	0x58, 0x94, 0x32, 0xc7, 0x9c, 0x9f, 0x6c, 0x44, 0x84, 0x59, 0x5a, 0x3b, 0x12, 0xc6, 0x2c, 0x6a,
+	0x65, 0xd8, 0xc0, 0x64, 0x4d, 0x0a, 0x45, 0xcf, 0x44, 0x3c, 0x77, 0xda, 0x24, 0x59, 0x80, 0x53,
+	0x1c, 0xba, 0xfe, 0x2b, 0x61, 0xb3, 0xe5, 0x37, 0xc4, 0x95, 0x08, 0x6d, 0xfd, 0xcf, 0x09, 0x38,
+	0x56, 0x18, 0x14, 0x9b, 0x8a, 0x30, 0x2a, 0x7e, 0xb2, 0x6f, 0x72, 0xae, 0x0b, 0x38, 0x56, 0x18,
+	0xee, 0x35, 0x18, 0xe3, 0x5f, 0xf2, 0x5c, 0xc3, 0xf3, 0x9b, 0x8b, 0x73, 0xe8, 0x52, 0xc7, 0xbd,
+	0x9f, 0xc7, 0x72, 0xee, 0xfd, 0x9c, 0x31, 0x2a, 0x75, 0xde, 0xff, 0x71, 0x7f, 0x58, 0x80, 0xa1,
+	0xbb, 0xf8, 0xfa, 0x76, 0xcb, 0x78, 0x7d, 0xdb, 0xf6, 0xe3, 0xb6, 0x79, 0x2f, 0x6f, 0xdf, 0xc8,
+	0xbc, 0xbc, 0xbd, 0x6e, 0xf3, 0x1a, 0xdf, 0x81, 0xaf, 0x6e, 0xff, 0x97, 0x02, 0x9c, 0x95, 0xa8,
+	0xf2, 0x2c, 0xb7, 0x38, 0xc7, 0xde, 0xb1, 0x3b, 0xfe, 0x81, 0x8e, 0x8c, 0x81, 0x5e, 0xb7, 0x77,
+	0x1a, 0x5d, 0x9c, 0xeb, 0x3a, 0xd4, 0x2f, 0x67, 0x86, 0x1a, 0x5b, 0xe5, 0x7a, 0xf0, 0x60, 0xff,
+	0xb9, 0x03, 0x93, 0xf9, 0x83, 0x7d, 0x17, 0x1e, 0x3b, 0x7f, 0xcd, 0x7c, 0xec, 0xfc, 0x57, 0xec,
+	0x2d, 0x31, 0xb3, 0x2b, 0x5d, 0x9e, 0x3d, 0xff, 0x53, 0x07, 0x4e, 0xcb, 0x0a, 0x6c, 0xf7, 0x9c,
+	0xf5, 0x03, 0x16, 0x43, 0x75, 0xfc, 0xcb, 0xec, 0x55, 0x63, 0x99, 0x3d, 0x6f, 0xaf, 0xe3, 0x7a,
+	0x3f, 0xba, 0x2d, 0x38, 0xf7, 0x4f, 0x1c, 0x28, 0xe5, 0x55, 0xb8, 0x0b, 0x53, 0xfe, 0x8a, 0x39,
+	0xe5, 0xd7, 0x8e, 0xa7, 0xe7, 0xdd, 0x27, 0xbc, 0xd4, 0x6d, 0xa0, 0x50, 0x43, 0xea, 0x55, 0x8e,
+	0x2d, 0x1f, 0x37, 0x67, 0x91, 0xaf, 0xa0, 0x35, 0x60, 0x20, 0x66, 0x71, 0x32, 0x62, 0x09, 0x5c,
+	0xb6, 0xa1, 0x6d, 0x51, 0x7a, 0xc2, 0xc6, 0xce, 0xfe, 0xc7, 0x82, 0x87, 0xfb, 0x47, 0x0e, 0xa8,
+	0x87, 0xca, 0xef, 0xc2, 0x24, 0x87, 0xe6, 0x24, 0x3f, 0x63, 0x6f, 0x92, 0xbb, 0x4c, 0xec, 0x7e,
+	0x11, 0x3a, 0x1e, 0xcc, 0x46, 0x1f, 0x77, 0x54, 0x6c, 0x0c, 0x0f, 0xc4, 0x7c, 0xbf, 0xbd, 0x76,
+	0x1c, 0x26, 0x63, 0x29, 0xfa, 0x72, 0x26, 0x8d, 0x6b, 0xc1, 0x56, 0xba, 0xb0, 0x8e, 0xd6, 0x1c,
+	0x21, 0x9d, 0xeb, 0x17, 0x1c, 0x00, 0xde, 0x4e, 0x91, 0x36, 0x9e, 0xb6, 0x6d, 0xf3, 0xd8, 0x46,
+	0x8a, 0x32, 0xe1, 0x4d, 0x53, 0x02, 0x32, 0x2d, 0xc0, 0x5a, 0x4b, 0xee, 0x20, 0x4f, 0xeb, 0x1d,
+	0xa7, 0x88, 0xfd, 0xb4, 0x03, 0x27, 0x32, 0xcd, 0xcd, 0xa9, 0xbf, 0x65, 0x3e, 0x3e, 0x69, 0x41,
+	0x57, 0x30, 0x93, 0x89, 0xeb, 0xe6, 0x80, 0x3f, 0x76, 0xd3, 0x0f, 0x98, 0x49, 0xab, 0x57, 0x60,
+	0x58, 0x9e, 0xe5, 0xe5, 0xf2, 0xb6, 0xf9, 0x08, 0xaf, 0x52, 0xd8, 0x25, 0x24, 0xc6, 0x29, 0xbf,
+	0x4c, 0xe8, 0x5d, 0xa1, 0xa7, 0xd0, 0xbb, 0xb7, 0xf6, 0x09, 0xdf, 0x7c, 0x4b, 0x6b, 0xff, 0xb1,
+	0x58, 0x5a, 0xef, 0xb7, 0x6e, 0x69, 0x7d, 0xe0, 0x2e, 0x5b, 0x5a, 0x35, 0xb7, 0x57, 0xf1, 0x0e,
+	0xdc, 0x5e, 0xaf, 0xc0, 0xe9, 0x9d, 0xf4, 0x18, 0xa5, 0x56, 0x92, 0x48, 0x8d, 0xf5, 0x58, 0xae,
+	0x7d, 0x95, 0x1e, 0x09, 0xe3, 0x84, 0x04, 0x89, 0x76, 0x00, 0x4b, 0xa3, 0xfe, 0xae, 0xe5, 0x90,
+	0xc3, 0xb9, 0x4c, 0xb2, 0xfe, 0x8b, 0xc1, 0x1e, 0xfc, 0x17, 0xdf, 0x74, 0xe0, 0x8c, 0xd7, 0x71,
+	0xc1, 0x11, 0x93, 0x2d, 0x11, 0x44, 0x71, 0xdd, 0x9e, 0x5e, 0x6e, 0x90, 0x17, 0x8e, 0xa2, 0xbc,
+	0x22, 0x9c, 0xdf, 0x20, 0xf4, 0x70, 0xea, 0x4c, 0xe6, 0xb1, 0xa2, 0xf9, 0x9e, 0xdf, 0x2f, 0x67,
+	0x23, 0x54, 0x80, 0x0d, 0xfd, 0x07, 0xed, 0x9e, 0x1f, 0x2d, 0x44, 0xa9, 0x8c, 0xdc, 0x41, 0x94,
+	0x4a, 0xc6, 0x99, 0x34, 0x6a, 0xc9, 0x99, 0x14, 0xc0, 0x84, 0xdf, 0xf4, 0x6a, 0x64, 0xbd, 0xdd,
+	0x68, 0xf0, 0x1b, 0x57, 0xf2, 0x99, 0xe4, 0x5c, 0x9b, 0xd4, 0x4a, 0x58, 0xf1, 0x1a, 0x22, 0xdb,
+	0x88, 0x8a, 0x93, 0x55, 0x91, 0x83, 0x4b, 0x19, 0x4a, 0xb8, 0x83, 0x36, 0x5d, 0xb0, 0x2c, 0x47,
+	0x23, 0x49, 0xe8, 0x68, 0xb3, 0x50, 0x88, 0x21, 0xbe, 0x60, 0x2f, 0xa7, 0x60, 0xac, 0xe3, 0xa0,
+	0x65, 0x18, 0xae, 0x06, 0xb1, 0xb8, 0xab, 0x7d, 0x82, 0x09, 0xb3, 0xb7, 0x51, 0x11, 0x38, 0x7f,
+	0xa5, 0xac, 0x6e, 0x69, 0xdf, 0x9f, 0x93, 0xfe, 0x53, 0x95, 0xe3, 0xb4, 0x3e, 0x5a, 0x65, 0xc4,
+	0xc4, 0x3b, 0x74, 0x3c, 0x42, 0xe1, 0xc1, 0x2e, 0x2e, 0x90, 0xf9, 0x2b, 0xf2, 0x25, 0xbd, 0x31,
+	0xc1, 0x4e, 0x3c, 0x28, 0x97, 0x52, 0xd0, 0x9e, 0xab, 0x3e, 0x79, 0xe0, 0x73, 0xd5, 0x2c, 0xbd,
+	0x70, 0xd2, 0x50, 0x0e, 0xcf, 0xf3, 0xd6, 0xd2, 0x0b, 0xa7, 0xb1, 0x7f, 0x22, 0xbd, 0x70, 0x0a,
+	0xc0, 0x3a, 0x4b, 0xb4, 0xd6, 0xcd, 0xf1, 0x7b, 0x8a, 0x09, 0x8d, 0xc3, 0xbb, 0x71, 0x75, 0x0f,
+	0xe0, 0xe9, 0x03, 0x3d, 0x80, 0x1d, 0x1e, 0xcb, 0x33, 0x87, 0xf0, 0x58, 0xd6, 0x59, 0x46, 0xd6,
+	0xc5, 0x39, 0xe1, 0x24, 0xb6, 0x70, 0x62, 0x61, 0xd9, 0x6e, 0x78, 0x2c, 0x27, 0xfb, 0x17, 0x73,
+	0x06, 0x5d, 0x83, 0xb2, 0xcf, 0x1d, 0x39, 0x28, 0x9b, 0x8a, 0xe7, 0x14, 0xce, 0x32, 0x08, 0x17,
+	0x85, 0x78, 0x4e, 0xc1, 0x58, 0xc7, 0xc9, 0xfa, 0xff, 0xee, 0x3d, 0x36, 0xff, 0xdf, 0xe4, 0x5d,
+	0xf0, 0xff, 0xdd, 0xd7, 0xb3, 0xff, 0xef, 0x35, 0x38, 0xd5, 0x0a, 0xab, 0xf3, 0x7e, 0x1c, 0xb5,
+	0xd9, 0x15, 0xd4, 0xd9, 0x76, 0xb5, 0x46, 0x12, 0xe6, 0x40, 0x1c, 0xb9, 0x78, 0x51, 0x6f, 0x64,
+	0x8b, 0x7d, 0xc8, 0xd3, 0x3b, 0x4f, 0x6c, 0x92, 0x84, 0x4f, 0x66, 0xb6, 0x16, 0xb3, 0x08, 0xb0,
+	0x60, 0xd2, 0x9c, 0x42, 0x9c, 0xc7, 0x47, 0x77, 0x3f, 0x3e, 0x78, 0x77, 0xdc, 0x8f, 0xef, 0x85,
+	0xa1, 0xb8, 0xde, 0x4e, 0xaa, 0xe1, 0x6e, 0xc0, 0x7c, 0xcc, 0xc3, 0xb3, 0x3f, 0xaf, 0x2c, 0xb4,
+	0x02, 0x7e, 0x6b, 0x7f, 0x6a, 0x42, 0xfe, 0xaf, 0x19, 0x67, 0x05, 0x04, 0x7d, 0xa5, 0xcb, 0xed,
+	0x25, 0xf7, 0x38, 0x6f, 0x2f, 0x9d, 0x3b, 0xd4, 0xcd, 0xa5, 0x3c, 0x1f, 0xeb, 0x43, 0x3f, 0x73,
+	0x3e, 0xd6, 0x2f, 0x39, 0x30, 0xb6, 0xa3, 0x5b, 0xc2, 0x85, 0x1f, 0xd8, 0x42, 0x3c, 0x8a, 0x61,
+	0x60, 0x9f, 0x75, 0xa9, 0xb0, 0x33, 0x40, 0xb7, 0xb2, 0x00, 0x6c, 0xb6, 0x24, 0x27, 0x56, 0xe6,
+	0xe1, 0xb7, 0x2a, 0x56, 0xe6, 0x35, 0x26, 0xcc, 0xe4, 0x49, 0x97, 0x39, 0x87, 0xed, 0x86, 0xca,
+	0x4a, 0xc1, 0xa8, 0x22, 0x65, 0x75, 0x7e, 0xe8, 0x0d, 0x07, 0x26, 0xe4, 0xe1, 0x4c, 0x78, 0xb2,
+	0x62, 0x11, 0xec, 0x67, 0xf3, 0x4c, 0xc8, 0xa2, 0xc5, 0x37, 0x32, 0x7c, 0x70, 0x07, 0x67, 0x2a,
+	0xda, 0x55, 0x6c, 0x55, 0x2d, 0x66, 0x31, 0xad, 0x42, 0x91, 0x99, 0x49, 0xc1, 0x58, 0xc7, 0x41,
+	0x5f, 0x73, 0xa0, 0x58, 0x0f, 0xc3, 0xed, 0xb8, 0xf4, 0x18, 0x93, 0xea, 0xcf, 0x59, 0x56, 0x50,
+	0x2f, 0x53, 0xda, 0x5c, 0x33, 0x95, 0x49, 0xe4, 0x8b, 0x0c, 0x76, 0x6b, 0x7f, 0x6a, 0xdc, 0x78,
+	0x4b, 0x2b, 0x7e, 0xfd, 0x4d, 0x0d, 0x22, 0x4c, 0x76, 0xac, 0x69, 0xe8, 0x73, 0x0e, 0x4c, 0xec,
+	0x66, 0xac, 0x1a, 0x22, 0xda, 0x11, 0xdb, 0xb7, 0x97, 0xf0, 0xe1, 0xce, 0x42, 0x71, 0x47, 0x0b,
+	0xd0, 0xab, 0x00, 0x9e, 0xb2, 0x76, 0x8b, 0xa8, 0xc8, 0x15, 0x9b, 0x1e, 0x04, 0x7e, 0x43, 0x2e,
+	0xfd, 0x8d, 0x35, 0x7e, 0x77, 0x1c, 0xe8, 0x30, 0xf9, 0x29, 0x07, 0x20, 0x9d, 0x9e, 0x9c, 0xaa,
+	0xc4, 0x34, 0xb3, 0x58, 0xf8, 0xbc, 0x8d, 0x09, 0xd7, 0xad, 0x2c, 0x6f, 0x9c, 0x85, 0x71, 0xd3,
+	0x49, 0x85, 0xde, 0x61, 0x3e, 0x79, 0x72, 0x3e, 0xfb, 0x7a, 0xc4, 0x98, 0xc4, 0x37, 0x5e, 0x90,
+	0x30, 0x9e, 0x78, 0x28, 0x1c, 0xeb, 0x13, 0x0f, 0x7d, 0x77, 0xe7, 0x89, 0x87, 0x89, 0xe3, 0x7e,
+	0xe2, 0xe1, 0xf4, 0xf1, 0x3d, 0xf1, 0x70, 0xf2, 0x50, 0x4f, 0x3c, 0xcc, 0xc0, 0x09, 0xf9, 0xff,
+	0x3a, 0x89, 0x2a, 0x24, 0x48, 0x98, 0x52, 0x5e, 0x9c, 0x3d, 0x27, 0x08, 0x9c, 0x58, 0x37, 0x8b,
+	0x71, 0x16, 0x1f, 0xed, 0xc2, 0xa4, 0x6a, 0x14, 0x26, 0x4d, 0xcf, 0x0f, 0xfc, 0xa0, 0xa6, 0x86,
+	0xf2, 0x2c, 0xeb, 0xe9, 0x2f, 0x09, 0x6a, 0x93, 0x97, 0xba, 0x62, 0xe6, 0xf7, 0xf7, 0x00, 0xd2,
+	0xfa, 0x2b, 0x24, 0xfd, 0xb7, 0x79, 0x85, 0x64, 0x06, 0x4e, 0xc8, 0x7b, 0x3e, 0x44, 0xbc, 0x3b,
+	0xc0, 0x5d, 0xfc, 0xaa, 0x9b, 0x73, 0x66, 0x31, 0xce, 0xe2, 0xa3, 0x4f, 0x39, 0x50, 0x0c, 0x58,
+	0xcd, 0x01, 0x5b, 0x0f, 0x93, 0x99, 0x5f, 0x1f, 0x33, 0x2c, 0x08, 0xb9, 0x2d, 0x23, 0x9b, 0x8b,
+	0x0c, 0x76, 0x4b, 0xfe, 0x83, 0x79, 0x0b, 0xd0, 0x0b, 0x50, 0x0a, 0xb7, 0xb6, 0x1a, 0xa1, 0x57,
+	0x4d, 0xdf, 0xd0, 0x90, 0x31, 0x08, 0xfc, 0x66, 0xac, 0x4a, 0xf4, 0xbc, 0xd6, 0x05, 0x0f, 0x77,
+	0xa5, 0x80, 0xbe, 0x49, 0xb5, 0xb5, 0x24, 0x8c, 0x48, 0x35, 0xb5, 0x62, 0x0d, 0xdb, 0x4a, 0x3a,
+	0x92, 0xe9, 0x73, 0xd9, 0xe4, 0xc3, 0x7b, 0xaf, 0x26, 0x25, 0x53, 0x8a, 0xb3, 0xcd, 0x42, 0x11,
+	0x9c, 0x6d, 0xe5, 0x19, 0xd1, 0x62, 0x71, 0x3b, 0xe9, 0x20, 0x53, 0x9e, 0x94, 0x6e, 0x67, 0x73,
+	0xcd, 0x70, 0x31, 0xee, 0x42, 0x59, 0x7f, 0x5d, 0x63, 0xe8, 0xee, 0xbc, 0xae, 0xf1, 0x61, 0x80,
+	0x8a, 0xcc, 0x00, 0x28, 0xcd, 0x32, 0xcb, 0x56, 0xae, 0xcd, 0x70, 0x9a, 0xda, 0x43, 0xc7, 0x8a,
+	0x0d, 0xd6, 0x58, 0xa2, 0xff, 0x9d, 0xfb, 0x10, 0x0c, 0xb7, 0x3d, 0xd5, 0xac, 0xaf, 0x89, 0x9f,
+	0xb9, 0xc7, 0x60, 0x7e, 0xd3, 0x81, 0x49, 0xbe, 0xf2, 0xb2, 0x27, 0x1e, 0xaa, 0x6f, 0x89, 0x7b,
+	0x3c, 0xb6, 0xc3, 0x54, 0x58, 0xc4, 0x5e, 0xd9, 0xe0, 0xca, 0x9c, 0xda, 0x07, 0xb4, 0x04, 0x7d,
+	0x21, 0xe7, 0x9c, 0x75, 0xc2, 0x96, 0x35, 0x37, 0xff, 0x11, 0x91, 0x53, 0x37, 0x7b, 0x39, 0x5a,
+	0xfd, 0x76, 0x57, 0x63, 0x33, 0x62, 0xcd, 0xfb, 0x6b, 0xc7, 0x64, 0x6c, 0xd6, 0x5f, 0x3a, 0x39,
+	0x94, 0xc9, 0xf9, 0xd3, 0x0e, 0x4c, 0x78, 0x99, 0xb0, 0x12, 0x66, 0x21, 0xb3, 0x62, 0xad, 0x9b,
+	0x89, 0xd2, 0x58, 0x15, 0xa6, 0xf9, 0x66, 0x23, 0x58, 0x70, 0x07, 0xf3, 0xc9, 0x8f, 0x3b, 0xfc,
+	0x15, 0xb6, 0xae, 0xaa, 0xe3, 0xa6, 0xa9, 0x3a, 0xae, 0xd8, 0x7c, 0xa0, 0x49, 0xd7, 0x61, 0x7f,
+	0xcd, 0x81, 0xd3, 0x79, 0x62, 0x3b, 0xa7, 0x49, 0x1f, 0x34, 0x9b, 0x64, 0xf1, 0x7c, 0xa6, 0x37,
+	0xc8, 0xce, 0xab, 0x34, 0x7f, 0x32, 0xac, 0x39, 0x1d, 0x13, 0xd2, 0xb2, 0x1e, 0x84, 0x1c, 0xc0,
+	0x80, 0x1f, 0x34, 0xfc, 0x80, 0x88, 0x0b, 0x87, 0x36, 0x4f, 0xab, 0xe2, 0x15, 0x28, 0x4a, 0x1d,
+	0x0b, 0x2e, 0x6f, 0xb1, 0x0f, 0x32, 0xfb, 0x90, 0x5e, 0xff, 0xdd, 0x7f, 0x48, 0x6f, 0x17, 0x86,
+	0x77, 0xfd, 0xa4, 0xce, 0x62, 0x27, 0x84, 0x6b, 0xcf, 0xc2, 0x45, 0x3d, 0x4a, 0x2e, 0xed, 0xfb,
+	0x75, 0xc9, 0x00, 0xa7, 0xbc, 0xd0, 0x05, 0xce, 0x98, 0x85, 0x1e, 0x67, 0x63, 0x42, 0xaf, 0xcb,
+	0x02, 0x9c, 0xe2, 0xd0, 0xc1, 0x1a, 0xa5, 0xbf, 0x64, 0x2e, 0x29, 0x91, 0x2b, 0xdb, 0x46, 0x6a,
+	0x51, 0x41, 0x91, 0x5f, 0x87, 0xbd, 0xae, 0xf1, 0xc0, 0x06, 0x47, 0x95, 0xae, 0x7c, 0xa8, 0x6b,
+	0xba, 0xf2, 0x57, 0x99, 0x16, 0x92, 0xf8, 0x41, 0x9b, 0xac, 0x05, 0x22, 0x60, 0x79, 0xc5, 0xce,
+	0xe5, 0x5d, 0x4e, 0x93, 0x1f, 0xbd, 0xd3, 0xdf, 0x58, 0xe3, 0xa7, 0x79, 0x58, 0x46, 0x0e, 0xf4,
+	0xb0, 0xa4, 0xc6, 0x95, 0x51, 0xeb, 0xc6, 0x95, 0x84, 0xb4, 0xac, 0x18, 0x57, 0x7e, 0xa6, 0xcc,
+	0x00, 0x7f, 0xee, 0x00, 0x52, 0xca, 0x84, 0x17, 0x6f, 0x8b, 0xd7, 0x4f, 0x8f, 0x3f, 0x2a, 0xf0,
+	0x23, 0x0e, 0x40, 0xa0, 0x9e, 0x5b, 0xb5, 0xbb, 0x6b, 0x71, 0x9a, 0x69, 0x03, 0x52, 0x18, 0xd6,
+	0x78, 0xba, 0xff, 0xdd, 0x49, 0x83, 0x6f, 0xd3, 0xbe, 0xdf, 0x85, 0x98, 0xb1, 0x3d, 0x33, 0x66,
+	0x6c, 0xc3, 0xa2, 0x91, 0x5e, 0x75, 0xa3, 0x4b, 0xf4, 0xd8, 0x4f, 0x0a, 0x70, 0x42, 0x47, 0x2e,
+	0x93, 0xbb, 0x31, 0xd9, 0xbb, 0x46, 0x08, 0xe8, 0x55, 0xbb, 0xfd, 0x2d, 0x0b, 0x5f, 0x4f, 0x5e,
+	0xb8, 0xf1, 0x87, 0x33, 0xe1, 0xc6, 0xd7, 0xed, 0xb3, 0x3e, 0x38, 0xe6, 0xf8, 0xbf, 0x3a, 0x70,
+	0x2a, 0x53, 0xe3, 0x2e, 0x2c, 0xb0, 0x1d, 0x73, 0x81, 0x3d, 0x6b, 0xbd, 0xd7, 0x5d, 0x56, 0xd7,
+	0xd7, 0x0b, 0x1d, 0xbd, 0x65, 0x27, 0x93, 0x8f, 0x39, 0x50, 0x4c, 0xbc, 0x78, 0x5b, 0x86, 0x6f,
+	0x7d, 0xf0, 0x58, 0x56, 0xc0, 0x34, 0xfd, 0x5f, 0x48, 0x67, 0xd5, 0x3e, 0x06, 0xc3, 0x9c, 0xfb,
+	0xe4, 0x47, 0x1d, 0x80, 0x14, 0xe9, 0xad, 0x52, 0x59, 0xdd, 0x6f, 0x15, 0xe0, 0x4c, 0xee, 0x32,
+	0x42, 0x9f, 0x50, 0x66, 0x26, 0xc7, 0x76, 0x70, 0xa2, 0xc1, 0x48, 0xb7, 0x36, 0x8d, 0x19, 0xd6,
+	0x26, 0x61, 0x64, 0x7a, 0xab, 0x0e, 0x1c, 0x42, 0x4c, 0x6b, 0x83, 0xf5, 0x63, 0x27, 0x8d, 0x77,
+	0x55, 0x89, 0x79, 0xfe, 0x02, 0xde, 0x42, 0x71, 0x7f, 0xa2, 0x85, 0xe8, 0xcb, 0x8e, 0xde, 0x05,
+	0x59, 0xb1, 0x6b, 0xca, 0x0a, 0x6c, 0xdf, 0x63, 0xdc, 0x45, 0x58, 0xbc, 0x04, 0x79, 0x2e, 0xe4,
+	0xde, 0xf2, 0x28, 0x1a, 0xf7, 0x39, 0x0b, 0x3d, 0xdf, 0xe7, 0x1c, 0x83, 0x91, 0xe7, 0x7d, 0x95,
+	0x87, 0x74, 0x76, 0xfa, 0xbb, 0x3f, 0x3a, 0x7f, 0xcf, 0x1f, 0xfe, 0xe8, 0xfc, 0x3d, 0x3f, 0xfc,
+	0xd1, 0xf9, 0x7b, 0x3e, 0x72, 0xf3, 0xbc, 0xf3, 0xdd, 0x9b, 0xe7, 0x9d, 0x3f, 0xbc, 0x79, 0xde,
+	0xf9, 0xe1, 0xcd, 0xf3, 0xce, 0x7f, 0xbc, 0x79, 0xde, 0xf9, 0xdb, 0x7f, 0x7c, 0xfe, 0x9e, 0xe7,
+	0x87, 0x64, 0xc7, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe7, 0xb2, 0x91, 0x19, 0xaf, 0xe0,
+	0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -5241,6 +5502,11 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Checksum)
+	copy(dAtA[i:], m.Checksum)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Checksum)))
+	i--
+	dAtA[i] = 0x72
 	i--
 	if m.Deleted {
 		dAtA[i] = 1
@@ -5829,6 +6095,34 @@ func (m *ArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactRepositoryCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactRepositoryCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactRepositoryCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactRepositoryRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6499,6 +6793,54 @@ func (m *BasicAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlackoutWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlackoutWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlackoutWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Cache) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6519,6 +6861,18 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ArtifactRepository != nil {
+		{
+			size, err := m.ArtifactRepository.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ConfigMap != nil {
 		{
 			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
@@ -7052,6 +7406,123 @@ func (m *CronWorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CronWorkflowRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x1a
+	if m.ScheduledTime != nil {
+		{
+			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CronWorkflowSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Timezone)
+	copy(dAtA[i:], m.Timezone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7072,6 +7543,72 @@ func (m *CronWorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Jitter != nil {
+		{
+			size, err := m.Jitter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.StopStrategy != nil {
+		{
+			size, err := m.StopStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.BlackoutCalendars) > 0 {
+		for iNdEx := len(m.BlackoutCalendars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlackoutCalendars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.BlackoutWindows) > 0 {
+		for iNdEx := len(m.BlackoutWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlackoutWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.WorkflowMetadata != nil {
 		{
 			size, err := m.WorkflowMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -7155,6 +7692,58 @@ func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failed))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Succeeded))
+	i--
+	dAtA[i] = 0x30
+	if len(m.RecentRuns) > 0 {
+		for iNdEx := len(m.RecentRuns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LastScheduledTimes) > 0 {
+		keysForLastScheduledTimes := make([]string, 0, len(m.LastScheduledTimes))
+		for k := range m.LastScheduledTimes {
+			keysForLastScheduledTimes = append(keysForLastScheduledTimes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLastScheduledTimes)
+		for iNdEx := len(keysForLastScheduledTimes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.LastScheduledTimes[string(keysForLastScheduledTimes[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLastScheduledTimes[iNdEx])
+			copy(dAtA[i:], keysForLastScheduledTimes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLastScheduledTimes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Conditions) > 0 {
 		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8725,6 +9314,11 @@ func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CacheType)
+	copy(dAtA[i:], m.CacheType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheType)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.CacheName)
 	copy(dAtA[i:], m.CacheName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
@@ -8952,6 +9546,26 @@ func (m *Mutex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i--
+	if m.Database {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -9114,6 +9728,11 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EstimatedDurationP90))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd8
 	i -= len(m.Progress)
 	copy(dAtA[i:], m.Progress)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
@@ -10042,6 +10661,49 @@ func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != nil {
+		{
+			size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RawArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10575,6 +11237,28 @@ func (m *SemaphoreHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HolderWeights) > 0 {
+		keysForHolderWeights := make([]string, 0, len(m.HolderWeights))
+		for k := range m.HolderWeights {
+			keysForHolderWeights = append(keysForHolderWeights, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHolderWeights)
+		for iNdEx := len(keysForHolderWeights) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.HolderWeights[string(keysForHolderWeights[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForHolderWeights[iNdEx])
+			copy(dAtA[i:], keysForHolderWeights[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHolderWeights[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Holders) > 0 {
 		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Holders[iNdEx])
@@ -10612,6 +11296,42 @@ func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Weight != nil {
+		{
+			size, err := m.Weight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Database != nil {
+		{
+			size, err := m.Database.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ConfigMapKeyRef != nil {
 		{
 			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
@@ -10742,6 +11462,37 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StopStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Submit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10762,6 +11513,23 @@ func (m *Submit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IdempotencyWindow != nil {
+		{
+			size, err := m.IdempotencyWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.IdempotencyKey)
+	copy(dAtA[i:], m.IdempotencyKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IdempotencyKey)))
+	i--
+	dAtA[i] = 0x22
 	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10948,6 +11716,34 @@ func (m *SuspendTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncDatabaseRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncDatabaseRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncDatabaseRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Synchronization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10968,6 +11764,23 @@ func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.FallbackTemplate)
+	copy(dAtA[i:], m.FallbackTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FallbackTemplate)))
+	i--
+	dAtA[i] = 0x22
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Mutex != nil {
 		{
 			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
@@ -11015,6 +11828,18 @@ func (m *SynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Mutex != nil {
 		{
 			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
@@ -12881,6 +13706,21 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EstimatedRemainingDuration))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb0
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ProgressPercent))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa8
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EstimatedDurationP90))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa0
 	if m.ArtifactGCStatus != nil {
 		{
 			size, err := m.ArtifactGCStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -13824,6 +14664,8 @@ func (m *Artifact) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	l = len(m.Checksum)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13997,6 +14839,17 @@ func (m *ArtifactRepository) Size() (n int) {
 	return n
 }
 
+func (m *ArtifactRepositoryCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ArtifactRepositoryRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14230,6 +15083,21 @@ func (m *BasicAuth) Size() (n int) {
 	return n
 }
 
+func (m *BlackoutWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Start.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Cache) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14240,6 +15108,10 @@ func (m *Cache) Size() (n int) {
 		l = m.ConfigMap.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ArtifactRepository != nil {
+		l = m.ArtifactRepository.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -14426,6 +15298,48 @@ func (m *CronWorkflowList) Size() (n int) {
 	return n
 }
 
+func (m *CronWorkflowRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Duration.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *CronWorkflowSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timezone)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *CronWorkflowSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14454,6 +15368,32 @@ func (m *CronWorkflowSpec) Size() (n int) {
 		l = m.WorkflowMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.BlackoutWindows) > 0 {
+		for _, e := range m.BlackoutWindows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.BlackoutCalendars) > 0 {
+		for _, e := range m.BlackoutCalendars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.StopStrategy != nil {
+		l = m.StopStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Jitter != nil {
+		l = m.Jitter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -14479,6 +15419,24 @@ func (m *CronWorkflowStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.LastScheduledTimes) > 0 {
+		for k, v := range m.LastScheduledTimes {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RecentRuns) > 0 {
+		for _, e := range m.RecentRuns {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.Succeeded))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
 	return n
 }
 
@@ -15055,6 +16013,8 @@ func (m *MemoizationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.CacheName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CacheType)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15136,6 +16096,11 @@ func (m *Mutex) Size() (n int) {
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15270,6 +16235,7 @@ func (m *NodeStatus) Size() (n int) {
 	}
 	l = len(m.Progress)
 	n += 2 + l + sovGenerated(uint64(l))
+	n += 2 + sovGenerated(uint64(m.EstimatedDurationP90))
 	return n
 }
 
@@ -15551,6 +16517,22 @@ func (m *Prometheus) Size() (n int) {
 	return n
 }
 
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Period != nil {
+		l = m.Period.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RawArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15749,6 +16731,14 @@ func (m *SemaphoreHolding) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.HolderWeights) > 0 {
+		for k, v := range m.HolderWeights {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -15762,6 +16752,18 @@ func (m *SemaphoreRef) Size() (n int) {
 		l = m.ConfigMapKeyRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Database != nil {
+		l = m.Database.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Weight != nil {
+		l = m.Weight.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15809,6 +16811,18 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *StopStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Submit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15823,6 +16837,12 @@ func (m *Submit) Size() (n int) {
 	}
 	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.IdempotencyKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.IdempotencyWindow != nil {
+		l = m.IdempotencyWindow.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15884,6 +16904,17 @@ func (m *SuspendTemplate) Size() (n int) {
 	return n
 }
 
+func (m *SyncDatabaseRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Synchronization) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15898,6 +16929,12 @@ func (m *Synchronization) Size() (n int) {
 		l = m.Mutex.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.FallbackTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15915,6 +16952,10 @@ func (m *SynchronizationStatus) Size() (n int) {
 		l = m.Mutex.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16610,6 +17651,9 @@ func (m *WorkflowStatus) Size() (n int) {
 		l = m.ArtifactGCStatus.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	n += 2 + sovGenerated(uint64(m.EstimatedDurationP90))
+	n += 2 + sovGenerated(uint64(m.ProgressPercent))
+	n += 2 + sovGenerated(uint64(m.EstimatedRemainingDuration))
 	return n
 }
 
@@ -16913,6 +17957,7 @@ func (this *Artifact) String() string {
 		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
 		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
 		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17035,6 +18080,16 @@ func (this *ArtifactRepository) String() string {
 	}, "")
 	return s
 }
+func (this *ArtifactRepositoryCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactRepositoryCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ArtifactResult) String() string {
 	if this == nil {
 		return "nil"
@@ -17204,12 +18259,25 @@ func (this *BasicAuth) String() string {
 	}, "")
 	return s
 }
+func (this *BlackoutWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlackoutWindow{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Start:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Start), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Cache) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Cache{`,
 		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ArtifactRepository:` + strings.Replace(this.ArtifactRepository.String(), "ArtifactRepositoryCache", "ArtifactRepositoryCache", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17367,10 +18435,57 @@ func (this *CronWorkflowList) String() string {
 	}, "")
 	return s
 }
+func (this *CronWorkflowRun) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowRun{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Time", "v11.Time", 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]Parameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&CronWorkflowSchedule{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CronWorkflowSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSchedules := "[]CronWorkflowSchedule{"
+	for _, f := range this.Schedules {
+		repeatedStringForSchedules += strings.Replace(strings.Replace(f.String(), "CronWorkflowSchedule", "CronWorkflowSchedule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSchedules += "}"
+	repeatedStringForBlackoutWindows := "[]BlackoutWindow{"
+	for _, f := range this.BlackoutWindows {
+		repeatedStringForBlackoutWindows += strings.Replace(strings.Replace(f.String(), "BlackoutWindow", "BlackoutWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBlackoutWindows += "}"
+	repeatedStringForBlackoutCalendars := "[]ConfigMapKeySelector{"
+	for _, f := range this.BlackoutCalendars {
+		repeatedStringForBlackoutCalendars += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForBlackoutCalendars += "}"
 	s := strings.Join([]string{`&CronWorkflowSpec{`,
 		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
@@ -17381,6 +18496,11 @@ func (this *CronWorkflowSpec) String() string {
 		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
 		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
 		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
+		`Schedules:` + repeatedStringForSchedules + `,`,
+		`BlackoutWindows:` + repeatedStringForBlackoutWindows + `,`,
+		`BlackoutCalendars:` + repeatedStringForBlackoutCalendars + `,`,
+		`StopStrategy:` + strings.Replace(this.StopStrategy.String(), "StopStrategy", "StopStrategy", 1) + `,`,
+		`Jitter:` + strings.Replace(fmt.Sprintf("%v", this.Jitter), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17399,10 +18519,30 @@ func (this *CronWorkflowStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForRecentRuns := "[]CronWorkflowRun{"
+	for _, f := range this.RecentRuns {
+		repeatedStringForRecentRuns += strings.Replace(strings.Replace(f.String(), "CronWorkflowRun", "CronWorkflowRun", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRecentRuns += "}"
+	keysForLastScheduledTimes := make([]string, 0, len(this.LastScheduledTimes))
+	for k := range this.LastScheduledTimes {
+		keysForLastScheduledTimes = append(keysForLastScheduledTimes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLastScheduledTimes)
+	mapStringForLastScheduledTimes := "map[string]v11.Time{"
+	for _, k := range keysForLastScheduledTimes {
+		mapStringForLastScheduledTimes += fmt.Sprintf("%v: %v,", k, this.LastScheduledTimes[k])
+	}
+	mapStringForLastScheduledTimes += "}"
 	s := strings.Join([]string{`&CronWorkflowStatus{`,
 		`Active:` + repeatedStringForActive + `,`,
 		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
+		`LastScheduledTimes:` + mapStringForLastScheduledTimes + `,`,
+		`RecentRuns:` + repeatedStringForRecentRuns + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17827,6 +18967,7 @@ func (this *MemoizationStatus) String() string {
 		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
+		`CacheType:` + fmt.Sprintf("%v", this.CacheType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17906,6 +19047,8 @@ func (this *Mutex) String() string {
 	}
 	s := strings.Join([]string{`&Mutex{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Database:` + fmt.Sprintf("%v", this.Database) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17994,6 +19137,7 @@ func (this *NodeStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`SynchronizationStatus:` + strings.Replace(this.SynchronizationStatus.String(), "NodeSynchronizationStatus", "NodeSynchronizationStatus", 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`EstimatedDurationP90:` + fmt.Sprintf("%v", this.EstimatedDurationP90) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18201,6 +19345,18 @@ func (this *Prometheus) String() string {
 	}, "")
 	return s
 }
+func (this *RateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RateLimit{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Period:` + strings.Replace(fmt.Sprintf("%v", this.Period), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RawArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -18331,9 +19487,20 @@ func (this *SemaphoreHolding) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForHolderWeights := make([]string, 0, len(this.HolderWeights))
+	for k := range this.HolderWeights {
+		keysForHolderWeights = append(keysForHolderWeights, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHolderWeights)
+	mapStringForHolderWeights := "map[string]int32{"
+	for _, k := range keysForHolderWeights {
+		mapStringForHolderWeights += fmt.Sprintf("%v: %v,", k, this.HolderWeights[k])
+	}
+	mapStringForHolderWeights += "}"
 	s := strings.Join([]string{`&SemaphoreHolding{`,
 		`Semaphore:` + fmt.Sprintf("%v", this.Semaphore) + `,`,
 		`Holders:` + fmt.Sprintf("%v", this.Holders) + `,`,
+		`HolderWeights:` + mapStringForHolderWeights + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18344,6 +19511,9 @@ func (this *SemaphoreRef) String() string {
 	}
 	s := strings.Join([]string{`&SemaphoreRef{`,
 		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`Database:` + strings.Replace(this.Database.String(), "SyncDatabaseRef", "SyncDatabaseRef", 1) + `,`,
+		`Weight:` + strings.Replace(fmt.Sprintf("%v", this.Weight), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18382,6 +19552,17 @@ func (this *Sequence) String() string {
 	}, "")
 	return s
 }
+func (this *StopStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopStrategy{`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Submit) String() string {
 	if this == nil {
 		return "nil"
@@ -18390,6 +19571,8 @@ func (this *Submit) String() string {
 		`WorkflowTemplateRef:` + strings.Replace(strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1), `&`, ``, 1) + `,`,
 		`Arguments:` + strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1) + `,`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`IdempotencyWindow:` + strings.Replace(fmt.Sprintf("%v", this.IdempotencyWindow), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18434,6 +19617,16 @@ func (this *SuspendTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *SyncDatabaseRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncDatabaseRef{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Synchronization) String() string {
 	if this == nil {
 		return "nil"
@@ -18441,6 +19634,8 @@ func (this *Synchronization) String() string {
 	s := strings.Join([]string{`&Synchronization{`,
 		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreRef", "SemaphoreRef", 1) + `,`,
 		`Mutex:` + strings.Replace(this.Mutex.String(), "Mutex", "Mutex", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RateLimit", "RateLimit", 1) + `,`,
+		`FallbackTemplate:` + fmt.Sprintf("%v", this.FallbackTemplate) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18452,6 +19647,7 @@ func (this *SynchronizationStatus) String() string {
 	s := strings.Join([]string{`&SynchronizationStatus{`,
 		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreStatus", "SemaphoreStatus", 1) + `,`,
 		`Mutex:` + strings.Replace(this.Mutex.String(), "MutexStatus", "MutexStatus", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "SemaphoreStatus", "SemaphoreStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18944,6 +20140,9 @@ func (this *WorkflowStatus) String() string {
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
 		`ArtifactGCStatus:` + strings.Replace(this.ArtifactGCStatus.String(), "ArtGCStatus", "ArtGCStatus", 1) + `,`,
+		`EstimatedDurationP90:` + fmt.Sprintf("%v", this.EstimatedDurationP90) + `,`,
+		`ProgressPercent:` + fmt.Sprintf("%v", this.ProgressPercent) + `,`,
+		`EstimatedRemainingDuration:` + fmt.Sprintf("%v", this.EstimatedRemainingDuration) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20198,6 +21397,38 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Deleted = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21707,6 +22938,88 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ArtifactRepositoryCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactRepositoryCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactRepositoryCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23842,6 +25155,154 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlackoutWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlackoutWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlackoutWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Cache) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23907,6 +25368,42 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepository", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactRepository == nil {
+				m.ArtifactRepository = &ArtifactRepositoryCache{}
+			}
+			if err := m.ArtifactRepository.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25324,7 +26821,7 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25347,17 +26844,17 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25367,30 +26864,29 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25400,27 +26896,31 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &v11.Time{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25448,93 +26948,13 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			m.Phase = WorkflowPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Suspend = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.StartingDeadlineSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SuccessfulJobsHistoryLimit = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FailedJobsHistoryLimit = &v
-		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25544,27 +26964,28 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25591,10 +27012,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
-			}
-			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25619,7 +27037,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25642,17 +27060,17 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25662,31 +27080,29 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25696,31 +27112,59 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
-			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25747,8 +27191,8 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25773,7 +27217,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25796,17 +27240,17 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25816,27 +27260,28 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25864,13 +27309,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25880,30 +27325,29 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25913,31 +27357,75 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Suspend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.StartingDeadlineSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SuccessfulJobsHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
 			}
-			iNdEx = postIndex
-		case 5:
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedJobsHistoryLimit = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25965,11 +27453,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Timezone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25996,16 +27484,18 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WorkflowMetadata == nil {
+				m.WorkflowMetadata = &v11.ObjectMeta{}
+			}
+			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26015,27 +27505,29 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
+			m.Schedules = append(m.Schedules, CronWorkflowSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackoutWindows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26062,18 +27554,16 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
-			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlackoutWindows = append(m.BlackoutWindows, BlackoutWindow{})
+			if err := m.BlackoutWindows[len(m.BlackoutWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackoutCalendars", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26083,27 +27573,824 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.BlackoutCalendars = append(m.BlackoutCalendars, v1.ConfigMapKeySelector{})
+			if err := m.BlackoutCalendars[len(m.BlackoutCalendars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StopStrategy == nil {
+				m.StopStrategy = &StopStrategy{}
+			}
+			if err := m.StopStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Jitter == nil {
+				m.Jitter = &v11.Duration{}
+			}
+			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Active = append(m.Active, v1.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScheduledTime == nil {
+				m.LastScheduledTime = &v11.Time{}
+			}
+			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScheduledTimes == nil {
+				m.LastScheduledTimes = make(map[string]v11.Time)
+			}
+			var mapkey string
+			mapvalue := &v11.Time{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.Time{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LastScheduledTimes[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentRuns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentRuns = append(m.RecentRuns, CronWorkflowRun{})
+			if err := m.RecentRuns[len(m.RecentRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithParam = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
+			}
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30318,17 +32605,103 @@ func (m *ManifestFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManifestFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifact == nil {
+				m.Artifact = &Artifact{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManifestFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30338,83 +32711,17 @@ func (m *ManifestFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Artifact == nil {
-				m.Artifact = &Artifact{}
-			}
-			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
+			m.Hit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30424,15 +32731,27 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hit = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30460,11 +32779,11 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30492,7 +32811,7 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheName = string(dAtA[iNdEx:postIndex])
+			m.CacheType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31060,63 +33379,179 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prometheus = append(m.Prometheus, &Prometheus{})
+			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mutex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Metrics) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31126,81 +33561,17 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Prometheus = append(m.Prometheus, &Prometheus{})
-			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Mutex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Database = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31210,23 +33581,27 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32539,6 +34914,25 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Progress = Progress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDurationP90", wireType)
+			}
+			m.EstimatedDurationP90 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDurationP90 |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34710,6 +37104,143 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = &v11.Duration{}
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -36073,7 +38604,519 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
+func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3EncryptionOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3EncryptionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsEncryptionContext", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsEncryptionContext = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEncryption", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableEncryption = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideCustomerKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServerSideCustomerKeySecret == nil {
+				m.ServerSideCustomerKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semaphore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HolderWeights == nil {
+				m.HolderWeights = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HolderWeights[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36096,17 +39139,17 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3EncryptionOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3EncryptionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KmsKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36116,29 +39159,33 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KmsKeyId = string(dAtA[iNdEx:postIndex])
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KmsEncryptionContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36148,29 +39195,33 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KmsEncryptionContext = string(dAtA[iNdEx:postIndex])
+			if m.Database == nil {
+				m.Database = &SyncDatabaseRef{}
+			}
+			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableEncryption", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36180,15 +39231,31 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableEncryption = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Weight == nil {
+				m.Weight = &intstr.IntOrString{}
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideCustomerKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36215,10 +39282,10 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServerSideCustomerKeySecret == nil {
-				m.ServerSideCustomerKeySecret = &v1.SecretKeySelector{}
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
 			}
-			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -36243,7 +39310,7 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36266,15 +39333,15 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36301,15 +39368,16 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holding = append(m.Holding, SemaphoreHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36319,23 +39387,25 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Waiting = append(m.Waiting, SemaphoreHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36358,7 +39428,7 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+func (m *Sequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36381,17 +39451,17 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36401,29 +39471,33 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Semaphore = string(dAtA[iNdEx:postIndex])
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36433,77 +39507,31 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Start == nil {
+				m.Start = &intstr.IntOrString{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36530,13 +39558,45 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			if m.End == nil {
+				m.End = &intstr.IntOrString{}
 			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36558,7 +39618,7 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
+func (m *StopStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36581,17 +39641,17 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
 			}
-			var msglen int
+			m.ConsecutiveFailures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36601,31 +39661,16 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConsecutiveFailures |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Holding = append(m.Holding, SemaphoreHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36635,25 +39680,23 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, SemaphoreHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36676,7 +39719,7 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sequence) Unmarshal(dAtA []byte) error {
+func (m *Submit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36699,15 +39742,15 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36734,16 +39777,13 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
-			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36770,16 +39810,16 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &intstr.IntOrString{}
+			if m.Arguments == nil {
+				m.Arguments = &Arguments{}
 			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36806,16 +39846,13 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.End == nil {
-				m.End = &intstr.IntOrString{}
-			}
-			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36843,94 +39880,11 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Submit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36957,43 +39911,10 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Arguments == nil {
-				m.Arguments = &Arguments{}
+			if m.IdempotencyWindow == nil {
+				m.IdempotencyWindow = &v11.Duration{}
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IdempotencyWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37552,6 +40473,88 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncDatabaseRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncDatabaseRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncDatabaseRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Synchronization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37653,6 +40656,74 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37775,6 +40846,42 @@ func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &SemaphoreStatus{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44240,6 +47347,63 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDurationP90", wireType)
+			}
+			m.EstimatedDurationP90 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDurationP90 |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressPercent", wireType)
+			}
+			m.ProgressPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProgressPercent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedRemainingDuration", wireType)
+			}
+			m.EstimatedRemainingDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedRemainingDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -967,6 +967,10 @@ type Artifact struct {
 
 	// Has this been deleted?
 	Deleted bool `json:"deleted,omitempty" protobuf:"varint,13,opt,name=deleted"`
+
+	// Checksum is the checksum of the content of the artifact, e.g. "sha256:<hex>". It is recorded when an output
+	// artifact is saved, and is used to derive automatic memoization keys.
+	Checksum string `json:"checksum,omitempty" protobuf:"bytes,14,opt,name=checksum"`
 }
 
 // ArtifactGC returns the ArtifactGC that was defined by the artifact.  If none was provided, a default value is returned.
@@ -1605,6 +1609,11 @@ type Synchronization struct {
 	Semaphore *SemaphoreRef `json:"semaphore,omitempty" protobuf:"bytes,1,opt,name=semaphore"`
 	// Mutex holds the Mutex lock details
 	Mutex *Mutex `json:"mutex,omitempty" protobuf:"bytes,2,opt,name=mutex"`
+	// RateLimit holds the RateLimit configuration
+	RateLimit *RateLimit `json:"rateLimit,omitempty" protobuf:"bytes,3,opt,name=rateLimit"`
+	// FallbackTemplate is the name of the template to run instead of this template when it times out waiting for its
+	// semaphore or mutex. It is only supported by template-level synchronization.
+	FallbackTemplate string `json:"fallbackTemplate,omitempty" protobuf:"bytes,4,opt,name=fallbackTemplate"`
 }
 
 func (s *Synchronization) getSemaphoreConfigMapRef() *apiv1.ConfigMapKeySelector {
@@ -1619,6 +1628,7 @@ type SynchronizationType string
 const (
 	SynchronizationTypeSemaphore SynchronizationType = "Semaphore"
 	SynchronizationTypeMutex     SynchronizationType = "Mutex"
+	SynchronizationTypeRateLimit SynchronizationType = "RateLimit"
 	SynchronizationTypeUnknown   SynchronizationType = "Unknown"
 )
 
@@ -1627,20 +1637,70 @@ func (s *Synchronization) GetType() SynchronizationType {
 		return SynchronizationTypeSemaphore
 	} else if s.Mutex != nil {
 		return SynchronizationTypeMutex
+	} else if s.RateLimit != nil {
+		return SynchronizationTypeRateLimit
 	}
 	return SynchronizationTypeUnknown
 }
 
+// GetTimeout returns the maximum duration to wait for the semaphore or mutex, or zero if there is no timeout
+func (s *Synchronization) GetTimeout() time.Duration {
+	if s.Semaphore != nil && s.Semaphore.Timeout != nil {
+		return s.Semaphore.Timeout.Duration
+	} else if s.Mutex != nil && s.Mutex.Timeout != nil {
+		return s.Mutex.Timeout.Duration
+	}
+	return 0
+}
+
 // SemaphoreRef is a reference of Semaphore
 type SemaphoreRef struct {
 	// ConfigMapKeyRef is configmap selector for Semaphore configuration
 	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,1,opt,name=configMapKeyRef"`
+	// Database is a reference to a semaphore whose limit and holders are stored in the controller's database,
+	// so that the limit is shared by every controller using the same database
+	Database *SyncDatabaseRef `json:"database,omitempty" protobuf:"bytes,2,opt,name=database"`
+	// Weight is the number of permits of the semaphore to acquire, which may be an integer or an expression
+	// (e.g. "{{inputs.parameters.gpus}}") that evaluates to one. Defaults to 1.
+	Weight *intstr.IntOrString `json:"weight,omitempty" protobuf:"bytes,3,opt,name=weight"`
+	// Timeout is the maximum duration to wait for the semaphore, after which the node or workflow fails, or the
+	// fallback template runs instead
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout"`
+}
+
+// SyncDatabaseRef is a reference to a semaphore stored in the database
+type SyncDatabaseRef struct {
+	// Key is the name of the semaphore in the database
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
 }
 
 // Mutex holds Mutex configuration
 type Mutex struct {
 	// name of the mutex
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Database indicates that the mutex is stored in the controller's database,
+	// so that it is shared by every controller using the same database
+	Database bool `json:"database,omitempty" protobuf:"varint,2,opt,name=database"`
+	// Timeout is the maximum duration to wait for the mutex, after which the node or workflow fails, or the
+	// fallback template runs instead
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+}
+
+// RateLimit limits how many workflows or templates can start in a period
+type RateLimit struct {
+	// Name of the rate limit, which is shared by workflows and templates in the same namespace with the same name
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Limit is the maximum number of starts per period
+	Limit int32 `json:"limit" protobuf:"varint,2,opt,name=limit"`
+	// Period is the length of the window that the limit applies to. Defaults to 1m.
+	Period *metav1.Duration `json:"period,omitempty" protobuf:"bytes,3,opt,name=period"`
+}
+
+func (r *RateLimit) GetPeriod() time.Duration {
+	if r == nil || r.Period == nil {
+		return time.Minute
+	}
+	return r.Period.Duration
 }
 
 // WorkflowTemplateRef is a reference to a WorkflowTemplate resource.
@@ -1867,9 +1927,21 @@ type WorkflowStatus struct {
 	// EstimatedDuration in seconds.
 	EstimatedDuration EstimatedDuration `json:"estimatedDuration,omitempty" protobuf:"varint,16,opt,name=estimatedDuration,casttype=EstimatedDuration"`
 
+	// EstimatedDurationP90 in seconds, is the duration that 90% of recent workflows completed within.
+	// Only set by the statistical estimator.
+	EstimatedDurationP90 EstimatedDuration `json:"estimatedDurationP90,omitempty" protobuf:"varint,20,opt,name=estimatedDurationP90,casttype=EstimatedDuration"`
+
 	// Progress to completion
 	Progress Progress `json:"progress,omitempty" protobuf:"bytes,17,opt,name=progress,casttype=Progress"`
 
+	// ProgressPercent is the percentage of the workflow that is complete, weighting each node by its estimated duration.
+	// Only set when the controller's progress mode is Duration.
+	ProgressPercent int32 `json:"progressPercent,omitempty" protobuf:"varint,21,opt,name=progressPercent"`
+
+	// EstimatedRemainingDuration in seconds, is the estimated time until the workflow completes.
+	// Only set when the controller's progress mode is Duration.
+	EstimatedRemainingDuration EstimatedDuration `json:"estimatedRemainingDuration,omitempty" protobuf:"varint,22,opt,name=estimatedRemainingDuration,casttype=EstimatedDuration"`
+
 	// A human readable message indicating details about why the workflow is in this condition.
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 
@@ -2162,6 +2234,10 @@ type NodeStatus struct {
 	// EstimatedDuration in seconds.
 	EstimatedDuration EstimatedDuration `json:"estimatedDuration,omitempty" protobuf:"varint,24,opt,name=estimatedDuration,casttype=EstimatedDuration"`
 
+	// EstimatedDurationP90 in seconds, is the duration that 90% of recent runs of this node completed within.
+	// Only set by the statistical estimator.
+	EstimatedDurationP90 EstimatedDuration `json:"estimatedDurationP90,omitempty" protobuf:"varint,27,opt,name=estimatedDurationP90,casttype=EstimatedDuration"`
+
 	// Progress to completion
 	Progress Progress `json:"progress,omitempty" protobuf:"bytes,26,opt,name=progress,casttype=Progress"`
 
@@ -3517,9 +3593,13 @@ type Counter struct {
 	Value string `json:"value" protobuf:"bytes,1,opt,name=value"`
 }
 
+// MemoizeKeyAuto is the memoization key that derives the key from the template and its inputs
+const MemoizeKeyAuto = "auto"
+
 // Memoization enables caching for the Outputs of the template
 type Memoize struct {
-	// Key is the key to use as the caching key
+	// Key is the key to use as the caching key. If the key is "auto", the key is derived from a hash of the resolved
+	// template, its input parameters and the checksums of its input artifacts.
 	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
 	// Cache sets and configures the kind of cache
 	Cache *Cache `json:"cache" protobuf:"bytes,2,opt,name=cache"`
@@ -3536,12 +3616,35 @@ type MemoizationStatus struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 	// Cache is the name of the cache that was used
 	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+	// CacheType is the type of the cache that was used, "ArtifactRepositoryCache" or "ConfigMapCache" (the default)
+	CacheType string `json:"cacheType,omitempty" protobuf:"bytes,4,opt,name=cacheType"`
 }
 
 // Cache is the configuration for the type of cache to be used
 type Cache struct {
 	// ConfigMap sets a ConfigMap-based cache
-	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// ArtifactRepository sets a cache whose entries are stored as objects in the artifact repository of the workflow,
+	// which is not limited in size like a ConfigMap
+	ArtifactRepository *ArtifactRepositoryCache `json:"artifactRepository,omitempty" protobuf:"bytes,2,opt,name=artifactRepository"`
+}
+
+// GetName returns the name of the cache
+func (c *Cache) GetName() string {
+	if c == nil {
+		return ""
+	} else if c.ArtifactRepository != nil {
+		return c.ArtifactRepository.Name
+	} else if c.ConfigMap != nil {
+		return c.ConfigMap.Name
+	}
+	return ""
+}
+
+// ArtifactRepositoryCache is a cache stored in the artifact repository
+type ArtifactRepositoryCache struct {
+	// Name of the cache. The entries are stored in the artifact repository under "memoization-caches/{name}/{key}.json"
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
 type SynchronizationAction interface {
@@ -3556,6 +3659,8 @@ type SemaphoreHolding struct {
 	// Holders stores the list of current holder names in the workflow.
 	// +listType=atomic
 	Holders []string `json:"holders,omitempty" protobuf:"bytes,2,opt,name=holders"`
+	// HolderWeights stores the number of permits held by each holder which holds more than one permit.
+	HolderWeights map[string]int32 `json:"holderWeights,omitempty" protobuf:"bytes,3,rep,name=holderWeights"`
 }
 
 type SemaphoreStatus struct {
@@ -3614,6 +3719,34 @@ func (ss *SemaphoreStatus) LockAcquired(holderKey, lockKey string, currentHolder
 	return false
 }
 
+// LockAcquiredWithWeight records that the holder acquired weight permits of the semaphore
+func (ss *SemaphoreStatus) LockAcquiredWithWeight(holderKey, lockKey string, currentHolders []string, weight int32) bool {
+	updated := ss.LockAcquired(holderKey, lockKey, currentHolders)
+	if weight <= 1 {
+		return updated
+	}
+	i, semaphoreHolding := ss.GetHolding(lockKey)
+	items := strings.Split(holderKey, "/")
+	holdingName := items[len(items)-1]
+	if semaphoreHolding.HolderWeights[holdingName] != weight {
+		if semaphoreHolding.HolderWeights == nil {
+			semaphoreHolding.HolderWeights = make(map[string]int32)
+		}
+		semaphoreHolding.HolderWeights[holdingName] = weight
+		ss.Holding[i] = semaphoreHolding
+		return true
+	}
+	return updated
+}
+
+// GetHolderWeight returns the number of permits held by the holder
+func (sh SemaphoreHolding) GetHolderWeight(holder string) int32 {
+	if weight, ok := sh.HolderWeights[holder]; ok {
+		return weight
+	}
+	return 1
+}
+
 func (ss *SemaphoreStatus) LockReleased(holderKey, lockKey string) bool {
 	i, semaphoreHolding := ss.GetHolding(lockKey)
 	items := strings.Split(holderKey, "/")
@@ -3623,6 +3756,7 @@ func (ss *SemaphoreStatus) LockReleased(holderKey, lockKey string) bool {
 	holdingName := items[len(items)-1]
 	if i >= 0 {
 		semaphoreHolding.Holders = slice.RemoveString(semaphoreHolding.Holders, holdingName)
+		delete(semaphoreHolding.HolderWeights, holdingName)
 		ss.Holding[i] = semaphoreHolding
 		return true
 	}
@@ -3729,6 +3863,8 @@ type SynchronizationStatus struct {
 	Semaphore *SemaphoreStatus `json:"semaphore,omitempty" protobuf:"bytes,1,opt,name=semaphore"`
 	// Mutex stores this workflow's mutex holder details
 	Mutex *MutexStatus `json:"mutex,omitempty" protobuf:"bytes,2,opt,name=mutex"`
+	// RateLimit stores the details of this workflow's starts that were admitted by, or are waiting for, a rate limit
+	RateLimit *SemaphoreStatus `json:"rateLimit,omitempty" protobuf:"bytes,3,opt,name=rateLimit"`
 }
 
 func (ss *SynchronizationStatus) GetStatus(syncType SynchronizationType) SynchronizationAction {
@@ -3737,6 +3873,8 @@ func (ss *SynchronizationStatus) GetStatus(syncType SynchronizationType) Synchro
 		return ss.Semaphore
 	case SynchronizationTypeMutex:
 		return ss.Mutex
+	case SynchronizationTypeRateLimit:
+		return ss.RateLimit
 	default:
 		panic("invalid syncType in GetStatus")
 	}
@@ -383,6 +383,22 @@ func (in *ArtifactRepository) DeepCopy() *ArtifactRepository {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactRepositoryCache) DeepCopyInto(out *ArtifactRepositoryCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactRepositoryCache.
+func (in *ArtifactRepositoryCache) DeepCopy() *ArtifactRepositoryCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactRepositoryCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactRepositoryRef) DeepCopyInto(out *ArtifactRepositoryRef) {
 	*out = *in
@@ -723,6 +739,24 @@ func (in *BasicAuth) DeepCopy() *BasicAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutWindow) DeepCopyInto(out *BlackoutWindow) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutWindow.
+func (in *BlackoutWindow) DeepCopy() *BlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cache) DeepCopyInto(out *Cache) {
 	*out = *in
@@ -731,6 +765,11 @@ func (in *Cache) DeepCopyInto(out *Cache) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArtifactRepository != nil {
+		in, out := &in.ArtifactRepository, &out.ArtifactRepository
+		*out = new(ArtifactRepositoryCache)
+		**out = **in
+	}
 	return
 }
 
@@ -1075,6 +1114,51 @@ func (in *CronWorkflowList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowRun) DeepCopyInto(out *CronWorkflowRun) {
+	*out = *in
+	if in.ScheduledTime != nil {
+		in, out := &in.ScheduledTime, &out.ScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowRun.
+func (in *CronWorkflowRun) DeepCopy() *CronWorkflowRun {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowSchedule) DeepCopyInto(out *CronWorkflowSchedule) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowSchedule.
+func (in *CronWorkflowSchedule) DeepCopy() *CronWorkflowSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 	*out = *in
@@ -1099,6 +1183,37 @@ func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 		*out = new(metav1.ObjectMeta)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]CronWorkflowSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]BlackoutWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlackoutCalendars != nil {
+		in, out := &in.BlackoutCalendars, &out.BlackoutCalendars
+		*out = make([]v1.ConfigMapKeySelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StopStrategy != nil {
+		in, out := &in.StopStrategy, &out.StopStrategy
+		*out = new(StopStrategy)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1129,6 +1244,20 @@ func (in *CronWorkflowStatus) DeepCopyInto(out *CronWorkflowStatus) {
 		*out = make(Conditions, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastScheduledTimes != nil {
+		in, out := &in.LastScheduledTimes, &out.LastScheduledTimes
+		*out = make(map[string]metav1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.RecentRuns != nil {
+		in, out := &in.RecentRuns, &out.RecentRuns
+		*out = make([]CronWorkflowRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1997,6 +2126,11 @@ func (in *Metrics) DeepCopy() *Metrics {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Mutex) DeepCopyInto(out *Mutex) {
 	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2535,6 +2669,27 @@ func (in *Prometheus) DeepCopy() *Prometheus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RawArtifact) DeepCopyInto(out *RawArtifact) {
 	*out = *in
@@ -2788,6 +2943,13 @@ func (in *SemaphoreHolding) DeepCopyInto(out *SemaphoreHolding) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.HolderWeights != nil {
+		in, out := &in.HolderWeights, &out.HolderWeights
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2809,6 +2971,21 @@ func (in *SemaphoreRef) DeepCopyInto(out *SemaphoreRef) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(SyncDatabaseRef)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2883,6 +3060,22 @@ func (in *Sequence) DeepCopy() *Sequence {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StopStrategy) DeepCopyInto(out *StopStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StopStrategy.
+func (in *StopStrategy) DeepCopy() *StopStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(StopStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Submit) DeepCopyInto(out *Submit) {
 	*out = *in
@@ -2893,6 +3086,11 @@ func (in *Submit) DeepCopyInto(out *Submit) {
 		*out = new(Arguments)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IdempotencyWindow != nil {
+		in, out := &in.IdempotencyWindow, &out.IdempotencyWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2969,6 +3167,22 @@ func (in *SuspendTemplate) DeepCopy() *SuspendTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncDatabaseRef) DeepCopyInto(out *SyncDatabaseRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncDatabaseRef.
+func (in *SyncDatabaseRef) DeepCopy() *SyncDatabaseRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncDatabaseRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Synchronization) DeepCopyInto(out *Synchronization) {
 	*out = *in
@@ -2980,7 +3194,12 @@ func (in *Synchronization) DeepCopyInto(out *Synchronization) {
 	if in.Mutex != nil {
 		in, out := &in.Mutex, &out.Mutex
 		*out = new(Mutex)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -3008,6 +3227,11 @@ func (in *SynchronizationStatus) DeepCopyInto(out *SynchronizationStatus) {
 		*out = new(MutexStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(SemaphoreStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
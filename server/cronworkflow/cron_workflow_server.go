@@ -14,6 +14,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
+	"github.com/argoproj/argo-workflows/v3/workflow/cron"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 
@@ -83,6 +84,22 @@ func (c *cronWorkflowServiceServer) GetCronWorkflow(ctx context.Context, req *cr
 	return c.getCronWorkflowAndValidate(ctx, req.Namespace, req.Name, options)
 }
 
+func (c *cronWorkflowServiceServer) GetCronWorkflowBlackoutWindows(ctx context.Context, req *cronworkflowpkg.GetCronWorkflowBlackoutWindowsRequest) (*cronworkflowpkg.CronWorkflowBlackoutWindowsResponse, error) {
+	cronWf, err := c.getCronWorkflowAndValidate(ctx, req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	windows, err := cron.GetBlackoutWindows(ctx, auth.GetKubeClient(ctx), cronWf)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	resp := &cronworkflowpkg.CronWorkflowBlackoutWindowsResponse{}
+	for i := range windows {
+		resp.Windows = append(resp.Windows, &windows[i])
+	}
+	return resp, nil
+}
+
 func (c *cronWorkflowServiceServer) UpdateCronWorkflow(ctx context.Context, req *cronworkflowpkg.UpdateCronWorkflowRequest) (*v1alpha1.CronWorkflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 	_, err := c.getCronWorkflowAndValidate(ctx, req.Namespace, req.CronWorkflow.Name, metav1.GetOptions{})
@@ -3,9 +3,13 @@ package cronworkflow
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -94,6 +98,31 @@ metadata:
 			assert.Error(t, err)
 		})
 	})
+	t.Run("GetCronWorkflowBlackoutWindows", func(t *testing.T) {
+		x := cronWf.DeepCopy()
+		x.Name = "my-calendar"
+		x.Spec.BlackoutWindows = []wfv1.BlackoutWindow{{Name: "freeze", Start: metav1.Date(2023, 12, 18, 0, 0, 0, 0, time.UTC), End: metav1.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)}}
+		x.Spec.BlackoutCalendars = []corev1.ConfigMapKeySelector{{LocalObjectReference: corev1.LocalObjectReference{Name: "holidays"}, Key: "calendar.ics"}}
+		_, err := wfClientset.ArgoprojV1alpha1().CronWorkflows("my-ns").Create(ctx, x, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		kubeClient := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "holidays", Namespace: "my-ns"},
+			Data: map[string]string{"calendar.ics": `BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:Christmas Day
+DTSTART;VALUE=DATE:20231225
+END:VEVENT
+END:VCALENDAR`},
+		})
+		ctx := context.WithValue(ctx, auth.KubeKey, kubeClient)
+		resp, err := server.GetCronWorkflowBlackoutWindows(ctx, &cronworkflowpkg.GetCronWorkflowBlackoutWindowsRequest{Namespace: "my-ns", Name: "my-calendar"})
+		if assert.NoError(t, err) && assert.Len(t, resp.Windows, 2) {
+			assert.Equal(t, "freeze", resp.Windows[0].Name)
+			assert.Equal(t, "Christmas Day", resp.Windows[1].Name)
+		}
+		_, err = server.GetCronWorkflowBlackoutWindows(ctx, &cronworkflowpkg.GetCronWorkflowBlackoutWindowsRequest{Namespace: "my-ns", Name: "unlabelled"})
+		assert.Error(t, err)
+	})
 	t.Run("UpdateCronWorkflow", func(t *testing.T) {
 		t.Run("Invalid", func(t *testing.T) {
 			x := cronWf.DeepCopy()
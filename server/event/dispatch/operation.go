@@ -2,18 +2,22 @@ package dispatch
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/antonmedv/expr"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/metadata"
 	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
@@ -28,6 +32,10 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
+// defaultIdempotencyWindow is how long a workflow is reused for events with the same idempotency key, if the binding
+// does not say
+const defaultIdempotencyWindow = time.Hour
+
 type Operation struct {
 	ctx               context.Context
 	eventRecorder     record.EventRecorder
@@ -52,31 +60,36 @@ func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eve
 
 // not to be converted with sutils, parent calling function should handle this
 // responsibility
-func (o *Operation) Dispatch(ctx context.Context) error {
+func (o *Operation) Dispatch(ctx context.Context) ([]*eventpkg.EventSubmission, error) {
 	log.Debug("Executing event dispatch")
 
 	data, _ := json.MarshalIndent(o.env, "", "  ")
 	log.Debugln(string(data))
 
+	var submissions []*eventpkg.EventSubmission
 	var errs []error
 	for _, event := range o.events {
+		var submission *eventpkg.EventSubmission
 		err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
-			_, err := o.dispatch(ctx, event)
+			var err error
+			submission, err = o.dispatch(ctx, event)
 			return !errorsutil.IsTransientErr(err), err
 		})
 		if err != nil {
 			log.WithError(err).WithFields(log.Fields{"namespace": event.Namespace, "event": event.Name}).Error("failed to dispatch from event")
 			o.eventRecorder.Event(&event, corev1.EventTypeWarning, "WorkflowEventBindingError", "failed to dispatch event: "+err.Error())
 			errs = append(errs, err)
+		} else if submission != nil {
+			submissions = append(submissions, submission)
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf("failed to dispatch event: %v", errs)
+		return nil, fmt.Errorf("failed to dispatch event: %v", errs)
 	}
-	return nil
+	return submissions, nil
 }
 
-func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding) (*wfv1.Workflow, error) {
+func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding) (*eventpkg.EventSubmission, error) {
 	selector := wfeb.Spec.Event.Selector
 	matched, err := argoexpr.EvalBool(selector, o.env)
 	if err != nil {
@@ -86,6 +99,25 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 	submit := wfeb.Spec.Submit
 	if matched && submit != nil {
 		client := auth.GetWfClient(o.ctx)
+		var idempotencyKey string
+		if submit.IdempotencyKey != "" {
+			idempotencyKey, err = o.evaluateStringExpression(submit.IdempotencyKey, "idempotency key")
+			if err != nil {
+				return nil, err
+			}
+		}
+		var generation int
+		if idempotencyKey != "" {
+			var existing *wfv1.Workflow
+			existing, generation, err = o.findIdempotentWorkflow(ctx, wfeb, idempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				log.WithFields(log.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "workflow": existing.Name}).Info("Workflow already submitted for idempotency key")
+				return &eventpkg.EventSubmission{WorkflowEventBinding: wfeb.Name, WorkflowName: existing.Name, Deduplicated: true}, nil
+			}
+		}
 		ref := wfeb.Spec.Submit.WorkflowTemplateRef
 		var tmpl wfv1.WorkflowSpecHolder
 		var err error
@@ -108,7 +140,7 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 			return nil, err
 		}
 
-		if wf.Name == "" {
+		if wf.Name == "" && idempotencyKey == "" {
 			wf.SetName(wf.GetGenerateName() + util.RandSuffix())
 		}
 
@@ -116,6 +148,13 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 		// so we label with creator (which is a standard) and the name of the triggering event
 		creator.Label(o.ctx, wf)
 		labels.Label(wf, common.LabelKeyWorkflowEventBinding, wfeb.Name)
+		if idempotencyKey != "" {
+			labels.Label(wf, common.LabelKeyIdempotencyKey, hashIdempotencyKey(idempotencyKey))
+			if wf.Annotations == nil {
+				wf.Annotations = map[string]string{}
+			}
+			wf.Annotations[common.AnnotationKeyIdempotencyKey] = idempotencyKey
+		}
 		if submit.Arguments != nil {
 			for _, p := range submit.Arguments.Parameters {
 				if p.ValueFrom == nil {
@@ -132,15 +171,103 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 				wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: p.Name, Value: wfv1.AnyStringPtr(wfv1.Item{Value: data})})
 			}
 		}
+		if idempotencyKey != "" {
+			return o.createIdempotentWorkflow(ctx, wfeb, wf, idempotencyKey, generation)
+		}
 		wf, err = client.ArgoprojV1alpha1().Workflows(wfeb.Namespace).Create(ctx, wf, metav1.CreateOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create workflow: %w", err)
 		}
-		return wf, nil
+		return &eventpkg.EventSubmission{WorkflowEventBinding: wfeb.Name, WorkflowName: wf.Name}, nil
 	}
 	return nil, nil
 }
 
+// findIdempotentWorkflow returns the latest workflow that the binding submitted with the idempotency key within its
+// idempotency window, or nil if there is none, and the number of workflows that it submitted with the key
+func (o *Operation) findIdempotentWorkflow(ctx context.Context, wfeb wfv1.WorkflowEventBinding, idempotencyKey string) (*wfv1.Workflow, int, error) {
+	options := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s,%s=%s", common.LabelKeyWorkflowEventBinding, wfeb.Name, common.LabelKeyIdempotencyKey, hashIdempotencyKey(idempotencyKey))}
+	o.instanceIDService.With(&options)
+	list, err := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(wfeb.Namespace).List(ctx, options)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list workflows for idempotency key: %w", err)
+	}
+	var latest *wfv1.Workflow
+	var submitted int
+	since := time.Now().Add(-idempotencyWindow(wfeb))
+	for i, wf := range list.Items {
+		// the hash could collide, so the key is checked too
+		if wf.Annotations[common.AnnotationKeyIdempotencyKey] != idempotencyKey {
+			continue
+		}
+		submitted++
+		if wf.CreationTimestamp.Time.Before(since) {
+			continue
+		}
+		if latest == nil || wf.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			latest = &list.Items[i]
+		}
+	}
+	return latest, submitted, nil
+}
+
+// createIdempotentWorkflow creates the workflow with a name derived from the idempotency key, so that when an event is
+// dispatched more than once at the same time, only one workflow is created and the others are deduplicated to it. The
+// generation is the number of workflows already submitted with the key, which are outside the idempotency window, and
+// it is increased for as long as the name is taken by a workflow outside the window.
+func (o *Operation) createIdempotentWorkflow(ctx context.Context, wfeb wfv1.WorkflowEventBinding, wf *wfv1.Workflow, idempotencyKey string, generation int) (*eventpkg.EventSubmission, error) {
+	client := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(wfeb.Namespace)
+	named := wf.Name != ""
+	since := time.Now().Add(-idempotencyWindow(wfeb))
+	for ; ; generation++ {
+		if !named {
+			wf.SetName(idempotentWorkflowName(wf.GetGenerateName(), idempotencyKey, generation))
+		}
+		created, err := client.Create(ctx, wf, metav1.CreateOptions{})
+		if err == nil {
+			return &eventpkg.EventSubmission{WorkflowEventBinding: wfeb.Name, WorkflowName: created.Name}, nil
+		}
+		if !apierr.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create workflow: %w", err)
+		}
+		existing, getErr := client.Get(ctx, wf.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return nil, fmt.Errorf("failed to get existing workflow for idempotency key: %w", getErr)
+		}
+		if existing.Labels[common.LabelKeyWorkflowEventBinding] == wfeb.Name && existing.Annotations[common.AnnotationKeyIdempotencyKey] == idempotencyKey && !existing.CreationTimestamp.Time.Before(since) {
+			log.WithFields(log.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "workflow": existing.Name}).Info("Workflow already submitted for idempotency key")
+			return &eventpkg.EventSubmission{WorkflowEventBinding: wfeb.Name, WorkflowName: existing.Name, Deduplicated: true}, nil
+		}
+		if named {
+			return nil, fmt.Errorf("failed to create workflow: %w", err)
+		}
+	}
+}
+
+// idempotencyWindow returns how long a workflow is reused for events with the same idempotency key
+func idempotencyWindow(wfeb wfv1.WorkflowEventBinding) time.Duration {
+	if wfeb.Spec.Submit.IdempotencyWindow != nil {
+		return wfeb.Spec.Submit.IdempotencyWindow.Duration
+	}
+	return defaultIdempotencyWindow
+}
+
+// hashIdempotencyKey returns a hash of the key that can be a label value, as the key may be longer than a label value
+// can be or contain characters that it cannot
+func hashIdempotencyKey(key string) string {
+	return fmt.Sprintf("%x", sha256.Sum224([]byte(key)))
+}
+
+// idempotentWorkflowName returns the name of the workflow submitted with the idempotency key, which is the prefix
+// followed by the start of the hash of the key, and the generation if it is not the first
+func idempotentWorkflowName(prefix, idempotencyKey string, generation int) string {
+	name := prefix + hashIdempotencyKey(idempotencyKey)[:10]
+	if generation > 0 {
+		name += fmt.Sprintf("-%d", generation)
+	}
+	return name
+}
+
 func (o *Operation) populateWorkflowMetadata(wf *wfv1.Workflow, metadata *metav1.ObjectMeta) error {
 	if len(metadata.Name) > 0 {
 		evalName, err := o.evaluateStringExpression(metadata.Name, "name")
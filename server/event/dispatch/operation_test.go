@@ -5,14 +5,20 @@ import (
 	"encoding/json"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
 
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
@@ -164,7 +170,7 @@ func TestNewOperation(t *testing.T) {
 		},
 	}, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{"foo": {"bar": "baz"}, "formatted": "My%Test%"}`)})
 	assert.NoError(t, err)
-	err = operation.Dispatch(ctx)
+	_, err = operation.Dispatch(ctx)
 	assert.Error(t, err)
 
 	expectedParamValues := []string{
@@ -353,7 +359,7 @@ func Test_populateWorkflowMetadata(t *testing.T) {
 		&wfv1.Item{Value: json.RawMessage(`{"foo": {"bar": "baz", "numeric": 8675309, "bool": true, "pr": 112}, "list": ["one", "two"]}`)})
 
 	assert.NoError(t, err)
-	err = operation.Dispatch(ctx)
+	_, err = operation.Dispatch(ctx)
 	assert.Error(t, err)
 
 	list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
@@ -403,6 +409,151 @@ func Test_populateWorkflowMetadata(t *testing.T) {
 	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: workflow name expression must evaluate to a string, not a <nil>", <-recorder.Events)
 }
 
+func TestIdempotencyKey(t *testing.T) {
+	idempotentWorkflow := func(name, key string, created time.Time) *wfv1.Workflow {
+		return &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "my-ns",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				common.LabelKeyControllerInstanceID: "my-instanceid",
+				common.LabelKeyWorkflowEventBinding: "my-wfeb",
+				common.LabelKeyIdempotencyKey:       hashIdempotencyKey(key),
+			},
+			Annotations: map[string]string{common.AnnotationKeyIdempotencyKey: key},
+		}}
+	}
+	client := fake.NewSimpleClientset(
+		&wfv1.WorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+		},
+		idempotentWorkflow("my-wf-recent", "recent", time.Now().Add(-10*time.Minute)),
+		idempotentWorkflow("my-wf-old", "old", time.Now().Add(-2*time.Hour)),
+		idempotentWorkflow(idempotentWorkflowName("my-wft-", "deleted", 1), "deleted", time.Now().Add(-2*time.Hour)),
+	)
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, client), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	wfeb := wfv1.WorkflowEventBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowEventBindingSpec{
+			Event: wfv1.Event{Selector: "true"},
+			Submit: &wfv1.Submit{
+				WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"},
+				IdempotencyKey:      "payload.id",
+			},
+		},
+	}
+	dispatch := func(wfeb wfv1.WorkflowEventBinding, payload string) ([]*eventpkg.EventSubmission, error) {
+		operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), record.NewFakeRecorder(1), []wfv1.WorkflowEventBinding{wfeb}, "my-ns", "", &wfv1.Item{Value: json.RawMessage(payload)})
+		require.NoError(t, err)
+		return operation.Dispatch(ctx)
+	}
+
+	t.Run("Duplicate", func(t *testing.T) {
+		submissions, err := dispatch(wfeb, `{"id": "recent"}`)
+		require.NoError(t, err)
+		assert.Equal(t, []*eventpkg.EventSubmission{{WorkflowEventBinding: "my-wfeb", WorkflowName: "my-wf-recent", Deduplicated: true}}, submissions)
+	})
+	t.Run("OutsideWindow", func(t *testing.T) {
+		submissions, err := dispatch(wfeb, `{"id": "old"}`)
+		require.NoError(t, err)
+		require.Len(t, submissions, 1)
+		assert.False(t, submissions[0].Deduplicated)
+		wf, err := client.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, submissions[0].WorkflowName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, idempotentWorkflowName("my-wft-", "old", 1), wf.Name)
+		assert.Equal(t, hashIdempotencyKey("old"), wf.Labels[common.LabelKeyIdempotencyKey])
+		assert.Equal(t, "old", wf.Annotations[common.AnnotationKeyIdempotencyKey])
+	})
+	t.Run("NameTaken", func(t *testing.T) {
+		// the first workflow submitted with the key was deleted, so the name of the next generation is taken
+		submissions, err := dispatch(wfeb, `{"id": "deleted"}`)
+		require.NoError(t, err)
+		require.Len(t, submissions, 1)
+		assert.False(t, submissions[0].Deduplicated)
+		assert.Equal(t, idempotentWorkflowName("my-wft-", "deleted", 2), submissions[0].WorkflowName)
+	})
+	t.Run("Window", func(t *testing.T) {
+		wfeb := *wfeb.DeepCopy()
+		wfeb.Spec.Submit.IdempotencyWindow = &metav1.Duration{Duration: 3 * time.Hour}
+		submissions, err := dispatch(wfeb, `{"id": "old"}`)
+		require.NoError(t, err)
+		assert.Equal(t, []*eventpkg.EventSubmission{{WorkflowEventBinding: "my-wfeb", WorkflowName: "my-wf-old", Deduplicated: true}}, submissions)
+	})
+	t.Run("OtherKey", func(t *testing.T) {
+		submissions, err := dispatch(wfeb, `{"id": "new"}`)
+		require.NoError(t, err)
+		require.Len(t, submissions, 1)
+		assert.False(t, submissions[0].Deduplicated)
+	})
+	t.Run("EmptyKey", func(t *testing.T) {
+		submissions, err := dispatch(wfeb, `{"id": ""}`)
+		require.NoError(t, err)
+		require.Len(t, submissions, 1)
+		assert.False(t, submissions[0].Deduplicated)
+		wf, err := client.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, submissions[0].WorkflowName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, wf.Labels, common.LabelKeyIdempotencyKey)
+	})
+	t.Run("InvalidKey", func(t *testing.T) {
+		_, err := dispatch(wfeb, `{"id": 1}`)
+		assert.EqualError(t, err, "failed to dispatch event: [workflow idempotency key expression must evaluate to a string, not a float64]")
+	})
+}
+
+func TestIdempotencyKeyConcurrency(t *testing.T) {
+	client := fake.NewSimpleClientset(&wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+	})
+	client.PrependReactor("create", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		action.(k8stesting.CreateAction).GetObject().(*wfv1.Workflow).CreationTimestamp = metav1.Now()
+		return false, nil, nil
+	})
+	// every dispatch looks for an existing workflow before any has been created
+	client.PrependReactor("list", "workflows", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &wfv1.WorkflowList{}, nil
+	})
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, client), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	wfeb := wfv1.WorkflowEventBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowEventBindingSpec{
+			Event: wfv1.Event{Selector: "true"},
+			Submit: &wfv1.Submit{
+				WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"},
+				IdempotencyKey:      "payload.id",
+			},
+		},
+	}
+
+	const dispatches = 10
+	submissions := make([][]*eventpkg.EventSubmission, dispatches)
+	var wg sync.WaitGroup
+	for i := 0; i < dispatches; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), record.NewFakeRecorder(1), []wfv1.WorkflowEventBinding{wfeb}, "my-ns", "", &wfv1.Item{Value: json.RawMessage(`{"id": "concurrent"}`)})
+			require.NoError(t, err)
+			submissions[i], err = operation.Dispatch(ctx)
+			require.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	obj, err := client.Tracker().List(wfv1.SchemeGroupVersion.WithResource("workflows"), wfv1.WorkflowSchemaGroupVersionKind, "my-ns")
+	require.NoError(t, err)
+	list := obj.(*wfv1.WorkflowList)
+	require.Len(t, list.Items, 1)
+	var submitted int
+	for _, s := range submissions {
+		require.Len(t, s, 1)
+		assert.Equal(t, list.Items[0].Name, s[0].WorkflowName)
+		if !s[0].Deduplicated {
+			submitted++
+		}
+	}
+	assert.Equal(t, 1, submitted)
+}
+
 func Test_expressionEnvironment(t *testing.T) {
 	env, err := expressionEnvironment(context.TODO(), "my-ns", "my-d", &wfv1.Item{Value: []byte(`{"foo":"bar"}`)})
 	if assert.NoError(t, err) {
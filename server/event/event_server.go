@@ -51,7 +51,7 @@ func (s *Controller) Run(stopCh <-chan struct{}) {
 		go func() {
 			defer wg.Done()
 			for operation := range s.operationQueue {
-				_ = operation.Dispatch(context.Background())
+				_, _ = operation.Dispatch(context.Background())
 			}
 		}()
 		wg.Add(1)
@@ -83,10 +83,11 @@ func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventReques
 	}
 
 	if !s.asyncDispatch {
-		if err := operation.Dispatch(ctx); err != nil {
+		submissions, err := operation.Dispatch(ctx)
+		if err != nil {
 			return nil, sutils.ToStatusError(err, codes.Internal)
 		}
-		return &eventpkg.EventResponse{}, nil
+		return &eventpkg.EventResponse{Submissions: submissions}, nil
 	}
 
 	select {
@@ -0,0 +1,140 @@
+package memoization
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	argotime "github.com/argoproj/pkg/time"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+)
+
+type memoizationServer struct {
+	artifactRepositories artifactrepositories.Interface
+}
+
+// NewMemoizationServer returns a new memoizationServer. Artifact repository caches are stored in the default artifact
+// repository of the namespace, and are not supported if artifactRepositories is nil.
+func NewMemoizationServer(artifactRepositories artifactrepositories.Interface) memoizationpkg.MemoizationServiceServer {
+	return &memoizationServer{artifactRepositories}
+}
+
+func (s *memoizationServer) ListCacheEntries(ctx context.Context, req *memoizationpkg.ListCacheEntriesRequest) (*memoizationpkg.CacheEntryList, error) {
+	c, err := s.getCache(ctx, req.Namespace, req.Name, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.List(ctx)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	items := make([]*memoizationpkg.CacheEntry, 0, len(entries))
+	for key, entry := range entries {
+		items = append(items, newCacheEntry(key, entry))
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Key < items[j].Key })
+	return &memoizationpkg.CacheEntryList{Items: items}, nil
+}
+
+func (s *memoizationServer) GetCacheEntry(ctx context.Context, req *memoizationpkg.GetCacheEntryRequest) (*memoizationpkg.CacheEntry, error) {
+	c, err := s.getCache(ctx, req.Namespace, req.Name, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := c.Get(ctx, req.Key)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if entry == nil {
+		return nil, status.Errorf(codes.NotFound, "cache %s has no entry %s", req.Name, req.Key)
+	}
+	return newCacheEntry(req.Key, entry), nil
+}
+
+func (s *memoizationServer) DeleteCacheEntry(ctx context.Context, req *memoizationpkg.DeleteCacheEntryRequest) (*memoizationpkg.CacheEntryDeletedResponse, error) {
+	c, err := s.getCache(ctx, req.Namespace, req.Name, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Delete(ctx, req.Key); err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return &memoizationpkg.CacheEntryDeletedResponse{}, nil
+}
+
+func (s *memoizationServer) PruneCache(ctx context.Context, req *memoizationpkg.PruneCacheRequest) (*memoizationpkg.PruneCacheResponse, error) {
+	if req.OlderThan == "" {
+		return nil, status.Error(codes.InvalidArgument, "olderThan is required")
+	}
+	olderThan, err := argotime.ParseDuration(req.OlderThan)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid olderThan: %v", err)
+	}
+	c, err := s.getCache(ctx, req.Namespace, req.Name, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.List(ctx)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	keys := []string{}
+	for key, entry := range entries {
+		if time.Since(entry.CreationTimestamp.Time) > *olderThan {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		if err := c.Delete(ctx, key); err != nil {
+			return nil, sutils.ToStatusError(fmt.Errorf("deleted %d of %d entries: %w", i, len(keys), err), codes.Internal)
+		}
+	}
+	return &memoizationpkg.PruneCacheResponse{Keys: keys}, nil
+}
+
+func (s *memoizationServer) getCache(ctx context.Context, namespace, name, cacheType string) (controllercache.MemoizationCache, error) {
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	kubeClient := auth.GetKubeClient(ctx)
+	switch controllercache.CacheType(cacheType) {
+	case "", controllercache.ConfigMapCache:
+		return controllercache.NewConfigMapCache(namespace, kubeClient, name), nil
+	case controllercache.ArtifactRepositoryCache:
+		if s.artifactRepositories == nil {
+			return nil, status.Error(codes.Unimplemented, "artifact repository caches are only supported by the Argo Server")
+		}
+		ref, err := s.artifactRepositories.Resolve(ctx, nil, namespace)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		repo, err := s.artifactRepositories.Get(ctx, ref)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		return controllercache.NewArtifactRepositoryCache(namespace, kubeClient, repo, name), nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown cache type %q, must be %s or %s", cacheType, controllercache.ConfigMapCache, controllercache.ArtifactRepositoryCache)
+	}
+}
+
+func newCacheEntry(key string, entry *controllercache.Entry) *memoizationpkg.CacheEntry {
+	creationTimestamp := entry.CreationTimestamp
+	lastHitTimestamp := entry.LastHitTimestamp
+	return &memoizationpkg.CacheEntry{
+		Key:               key,
+		NodeId:            entry.NodeID,
+		Outputs:           entry.Outputs,
+		CreationTimestamp: &creationTimestamp,
+		LastHitTimestamp:  &lastHitTimestamp,
+	}
+}
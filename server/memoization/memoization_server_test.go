@@ -0,0 +1,70 @@
+package memoization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	memoizationpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoization"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+)
+
+func Test_memoizationServer(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "my-ns"},
+		Data: map[string]string{
+			"old": `{"nodeID":"old-node","outputs":{"parameters":[{"name":"hello","value":"world"}]},"creationTimestamp":"2020-01-01T00:00:00Z","lastHitTimestamp":"2020-01-01T00:00:00Z"}`,
+			"new": `{"nodeID":"new-node","outputs":{},"creationTimestamp":"2999-01-01T00:00:00Z","lastHitTimestamp":"2999-01-01T00:00:00Z"}`,
+		},
+	})
+	ctx := context.WithValue(context.TODO(), auth.KubeKey, kubeClient)
+	s := NewMemoizationServer(nil)
+
+	t.Run("ListCacheEntries", func(t *testing.T) {
+		list, err := s.ListCacheEntries(ctx, &memoizationpkg.ListCacheEntriesRequest{Namespace: "my-ns", Name: "my-cache"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 2) {
+			assert.Equal(t, "new", list.Items[0].Key)
+			assert.Equal(t, "old", list.Items[1].Key)
+			assert.Equal(t, "old-node", list.Items[1].NodeId)
+		}
+	})
+	t.Run("GetCacheEntry", func(t *testing.T) {
+		entry, err := s.GetCacheEntry(ctx, &memoizationpkg.GetCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "old"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "old-node", entry.NodeId)
+			assert.Equal(t, "world", entry.Outputs.Parameters[0].Value.String())
+		}
+		_, err = s.GetCacheEntry(ctx, &memoizationpkg.GetCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("UnsupportedType", func(t *testing.T) {
+		_, err := s.ListCacheEntries(ctx, &memoizationpkg.ListCacheEntriesRequest{Namespace: "my-ns", Name: "my-cache", Type: "ArtifactRepositoryCache"})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		_, err = s.ListCacheEntries(ctx, &memoizationpkg.ListCacheEntriesRequest{Namespace: "my-ns", Name: "my-cache", Type: "Unknown"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("PruneCache", func(t *testing.T) {
+		_, err := s.PruneCache(ctx, &memoizationpkg.PruneCacheRequest{Namespace: "my-ns", Name: "my-cache"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.PruneCache(ctx, &memoizationpkg.PruneCacheRequest{Namespace: "my-ns", Name: "my-cache", OlderThan: "a week"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		res, err := s.PruneCache(ctx, &memoizationpkg.PruneCacheRequest{Namespace: "my-ns", Name: "my-cache", OlderThan: "1d"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"old"}, res.Keys)
+		}
+	})
+	t.Run("DeleteCacheEntry", func(t *testing.T) {
+		_, err := s.DeleteCacheEntry(ctx, &memoizationpkg.DeleteCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "new"})
+		assert.NoError(t, err)
+		list, err := s.ListCacheEntries(ctx, &memoizationpkg.ListCacheEntriesRequest{Namespace: "my-ns", Name: "my-cache"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, list.Items)
+		}
+	})
+}
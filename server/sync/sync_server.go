@@ -0,0 +1,135 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	wfsync "github.com/argoproj/argo-workflows/v3/workflow/sync"
+
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+)
+
+type syncServer struct {
+	instanceIDService instanceid.Service
+	hydrator          hydrator.Interface
+	session           sqlbuilder.Database
+	leaseDuration     time.Duration
+}
+
+// NewSyncServer returns a new syncServer, which reports the synchronization locks recorded in the statuses of the
+// workflows that have not completed. If session is not nil, the database locks are read from it, ignoring controllers
+// that have not sent a heartbeat for leaseDuration.
+func NewSyncServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, session sqlbuilder.Database, leaseDuration time.Duration) syncpkg.SyncServiceServer {
+	return &syncServer{instanceIDService, hydrator.New(offloadNodeStatusRepo), session, leaseDuration}
+}
+
+func (s *syncServer) ListSyncLocks(ctx context.Context, req *syncpkg.ListSyncLocksRequest) (*syncpkg.SyncLockList, error) {
+	locks, err := s.getLocks(ctx, req.Namespace)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return &syncpkg.SyncLockList{Items: locks}, nil
+}
+
+func (s *syncServer) GetSyncLock(ctx context.Context, req *syncpkg.GetSyncLockRequest) (*syncpkg.SyncLock, error) {
+	lockKey := fmt.Sprintf("%s/%s/%s", req.Namespace, req.Kind, req.Name)
+	if req.Key != "" {
+		lockKey = fmt.Sprintf("%s/%s", lockKey, req.Key)
+	}
+	lockName, err := wfsync.DecodeLockName(lockKey)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	locks, err := s.getLocks(ctx, req.Namespace)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	for _, lock := range locks {
+		if lock.LockName == lockName.EncodeName() {
+			return lock, nil
+		}
+	}
+	return nil, sutils.ToStatusError(fmt.Errorf("lock %s is not held or waited for by any workflow", lockName.EncodeName()), codes.NotFound)
+}
+
+func (s *syncServer) getLocks(ctx context.Context, namespace string) ([]*syncpkg.SyncLock, error) {
+	listOpts := &metav1.ListOptions{LabelSelector: common.LabelKeyCompleted + "!=true"}
+	s.instanceIDService.With(listOpts)
+	wfList, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows(namespace).List(ctx, *listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	waitingSince := make(map[string]metav1.Time)
+	for i := range wfList.Items {
+		wf := &wfList.Items[i]
+		if wf.Status.Synchronization == nil {
+			continue
+		}
+		if err := s.hydrator.Hydrate(wf); err != nil {
+			log.WithError(err).WithField("workflow", wf.Name).Warn("Failed to hydrate workflow, its nodes waiting for locks are not reported")
+		}
+		waitingSince[fmt.Sprintf("%s/%s", wf.Namespace, wf.Name)] = wf.CreationTimestamp
+		for _, node := range wf.Status.Nodes {
+			if node.SynchronizationStatus != nil && node.SynchronizationStatus.Waiting != "" {
+				waitingSince[fmt.Sprintf("%s/%s/%s", wf.Namespace, wf.Name, node.ID)] = node.StartedAt
+			}
+		}
+	}
+
+	manager := wfsync.NewLockManagerFromWorkflows(wfsync.GetConfigMapSyncLimitFunc(ctx, auth.GetKubeClient(ctx)), wfList.Items, s.session, s.leaseDuration)
+	var locks []*syncpkg.SyncLock
+	for _, info := range manager.GetLocks() {
+		lockName, err := wfsync.DecodeLockName(info.Name)
+		if err != nil || (namespace != "" && lockName.Namespace != namespace) {
+			continue
+		}
+		lock := &syncpkg.SyncLock{
+			LockName:  info.Name,
+			Namespace: lockName.Namespace,
+			Kind:      string(lockName.Kind),
+			Name:      lockName.ResourceName,
+			Key:       lockName.Key,
+			Limit:     int32(info.Limit),
+			Available: int32(info.Available),
+		}
+		for _, key := range info.Holders {
+			lock.Holders = append(lock.Holders, newSyncLockHolder(key, info.GetWeight(key), nil))
+		}
+		for _, key := range info.Pending {
+			since, ok := waitingSince[key]
+			if !ok {
+				lock.Pending = append(lock.Pending, newSyncLockHolder(key, info.GetWeight(key), nil))
+				continue
+			}
+			lock.Pending = append(lock.Pending, newSyncLockHolder(key, info.GetWeight(key), &since))
+		}
+		locks = append(locks, lock)
+	}
+	return locks, nil
+}
+
+func newSyncLockHolder(key string, weight int, waitingSince *metav1.Time) *syncpkg.SyncLockHolder {
+	holder := &syncpkg.SyncLockHolder{Key: key, Weight: int32(weight), WaitingSince: waitingSince}
+	items := strings.SplitN(key, "/", 3)
+	if len(items) > 1 {
+		holder.WorkflowName = items[1]
+	}
+	if len(items) > 2 {
+		holder.NodeId = items[2]
+	}
+	return holder
+}
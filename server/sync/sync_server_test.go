@@ -0,0 +1,162 @@
+package sync
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wftFake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+)
+
+func Test_syncServer(t *testing.T) {
+	var holder, waiter, completed wfv1.Workflow
+	wfv1.MustUnmarshal(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: holder
+  namespace: my-ns
+spec:
+  entrypoint: main
+  synchronization:
+    mutex:
+      name: my-mutex
+status:
+  phase: Running
+  synchronization:
+    mutex:
+      holding:
+      - mutex: my-ns/Mutex/my-mutex
+        holder: holder
+`, &holder)
+	wfv1.MustUnmarshal(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: waiter
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    synchronization:
+      mutex:
+        name: my-mutex
+status:
+  phase: Running
+  nodes:
+    waiter:
+      id: waiter
+      name: waiter
+      phase: Pending
+      startedAt: "2022-01-01T00:00:00Z"
+      synchronizationStatus:
+        waiting: my-ns/Mutex/my-mutex
+  synchronization:
+    mutex:
+      waiting:
+      - mutex: my-ns/Mutex/my-mutex
+        holder: holder
+`, &waiter)
+	wfv1.MustUnmarshal(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: completed
+  namespace: my-ns
+  labels:
+    workflows.argoproj.io/completed: "true"
+status:
+  phase: Succeeded
+  synchronization:
+    mutex:
+      holding:
+      - mutex: my-ns/Mutex/other-mutex
+        holder: completed
+`, &completed)
+
+	wfClientset := wftFake.NewSimpleClientset(&holder, &waiter, &completed)
+	ctx := context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.KubeKey, kubefake.NewSimpleClientset())
+	server := NewSyncServer(instanceid.NewService(""), sqldb.ExplosiveOffloadNodeStatusRepo, nil, 0)
+
+	expected := &syncpkg.SyncLock{
+		LockName:  "my-ns/Mutex/my-mutex",
+		Namespace: "my-ns",
+		Kind:      "Mutex",
+		Name:      "my-mutex",
+		Limit:     1,
+		Holders:   []*syncpkg.SyncLockHolder{{Key: "my-ns/holder", WorkflowName: "holder", Weight: 1}},
+		Pending: []*syncpkg.SyncLockHolder{{
+			Key:          "my-ns/waiter/waiter",
+			WorkflowName: "waiter",
+			NodeId:       "waiter",
+			Weight:       1,
+			WaitingSince: &metav1.Time{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		}},
+	}
+
+	t.Run("ListSyncLocks", func(t *testing.T) {
+		locks, err := server.ListSyncLocks(ctx, &syncpkg.ListSyncLocksRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) && assert.Len(t, locks.Items, 1) {
+			assert.Equal(t, expected.Holders, locks.Items[0].Holders)
+			assert.Equal(t, expected.Pending[0].Key, locks.Items[0].Pending[0].Key)
+			assert.True(t, expected.Pending[0].WaitingSince.Equal(locks.Items[0].Pending[0].WaitingSince))
+		}
+	})
+	t.Run("GetSyncLock", func(t *testing.T) {
+		lock, err := server.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "my-ns", Kind: "Mutex", Name: "my-mutex"})
+		if assert.NoError(t, err) {
+			lock.Pending[0].WaitingSince = expected.Pending[0].WaitingSince
+			assert.Equal(t, expected, lock)
+		}
+	})
+	t.Run("GetSyncLockNotFound", func(t *testing.T) {
+		_, err := server.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "my-ns", Kind: "Mutex", Name: "other-mutex"})
+		assert.Error(t, err)
+	})
+	t.Run("GetSyncLockInvalid", func(t *testing.T) {
+		_, err := server.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "my-ns", Kind: "ConfigMap", Name: "my-config"})
+		assert.Error(t, err)
+	})
+}
+
+func Test_syncServerDatabase(t *testing.T) {
+	session, tableName, err := sqldb.CreateSQLiteDBSession(&config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "argo.db"), TableName: "argo_workflows"}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	require.NoError(t, sqldb.NewMigrate(session, "default", tableName).Exec(context.Background()))
+	_, err = session.InsertInto("argo_sync_controllers").Columns("controller").Values("controller-1").Exec()
+	require.NoError(t, err)
+	_, err = session.InsertInto("argo_sync_limit").Columns("name", "sizelimit").Values("my-ns/Database/my-sem", 3).Exec()
+	require.NoError(t, err)
+	_, err = session.InsertInto("argo_sync_state").
+		Columns("name", "controller", "holderkey", "held", "priority", "creationtime").
+		Values("my-ns/Database/my-sem", "controller-1", "my-ns/holder", true, 0, time.Now().UTC()).
+		Exec()
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wftFake.NewSimpleClientset()), auth.KubeKey, kubefake.NewSimpleClientset())
+	server := NewSyncServer(instanceid.NewService(""), sqldb.ExplosiveOffloadNodeStatusRepo, session, time.Minute)
+
+	lock, err := server.GetSyncLock(ctx, &syncpkg.GetSyncLockRequest{Namespace: "my-ns", Kind: "Database", Name: "my-sem"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, &syncpkg.SyncLock{
+			LockName:  "my-ns/Database/my-sem",
+			Namespace: "my-ns",
+			Kind:      "Database",
+			Name:      "my-sem",
+			Limit:     3,
+			Available: 2,
+			Holders:   []*syncpkg.SyncLockHolder{{Key: "my-ns/holder", WorkflowName: "holder", Weight: 1}},
+		}, lock)
+	}
+}
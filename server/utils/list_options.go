@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// ListOrderFields are the fields that workflows can be ordered by
+var ListOrderFields = []string{"startedAt", "finishedAt", "duration"}
+
+// ListOptions are the options to list archived workflows, every option that is set must match
+type ListOptions struct {
+	Namespace              string
+	Name                   string
+	NamePrefix             string
+	MinStartedAt           time.Time
+	MaxStartedAt           time.Time
+	LabelRequirements      labels.Requirements
+	Limit                  int
+	Offset                 int
+	ShowRemainingItemCount bool
+	// Phases matches workflows in any of the phases
+	Phases        []wfv1.WorkflowPhase
+	MinFinishedAt time.Time
+	MaxFinishedAt time.Time
+	// MinDuration and MaxDuration match workflows that ran for at least and at most the durations
+	MinDuration time.Duration
+	MaxDuration time.Duration
+	// MessageContains matches workflows whose message, e.g. why they failed, contains the string
+	MessageContains string
+	// OrderBy is one of the ListOrderFields, prefixed with "-" for descending order. If empty, workflows are listed
+	// with the most recently started first.
+	OrderBy string
+}
+
+func (l ListOptions) WithLimit(limit int) ListOptions {
+	l.Limit = limit
+	return l
+}
+
+func (l ListOptions) WithOffset(offset int) ListOptions {
+	l.Offset = offset
+	return l
+}
+
+// Order returns the field that workflows are ordered by, and whether they are in descending order
+func (l ListOptions) Order() (string, bool, error) {
+	if l.OrderBy == "" {
+		return "startedAt", true, nil
+	}
+	field := strings.TrimPrefix(l.OrderBy, "-")
+	for _, f := range ListOrderFields {
+		if f == field {
+			return field, strings.HasPrefix(l.OrderBy, "-"), nil
+		}
+	}
+	return "", false, fmt.Errorf("cannot order by %q, must be one of %s, optionally prefixed with \"-\"", l.OrderBy, strings.Join(ListOrderFields, ", "))
+}
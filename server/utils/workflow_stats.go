@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// StatsResourceNames are the resources whose durations are summed by the stats of workflows
+var StatsResourceNames = []apiv1.ResourceName{apiv1.ResourceCPU, apiv1.ResourceMemory}
+
+// StatsOptions are the options to aggregate the stats of workflows, the list options filter the workflows
+type StatsOptions struct {
+	ListOptions
+	// GroupBy is the key of the label that workflows are grouped by the value of, workflows without the label are not
+	// aggregated
+	GroupBy string
+	// Bucket is the size of the time buckets that workflows are grouped by when they started in. If zero, workflows
+	// are grouped in a single bucket.
+	Bucket time.Duration
+}
+
+// BucketStart returns the start of the bucket of the time, buckets are aligned with the Unix epoch
+func (o StatsOptions) BucketStart(t time.Time) time.Time {
+	seconds := int64(o.Bucket.Seconds())
+	if seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(t.Unix()/seconds*seconds, 0).UTC()
+}
+
+// WorkflowStatsKey is the group and the time bucket of aggregated workflows
+type WorkflowStatsKey struct {
+	Group       string
+	BucketStart time.Time
+}
+
+// WorkflowStats are the stats of aggregated workflows
+type WorkflowStats struct {
+	Phases map[wfv1.WorkflowPhase]int64
+	// Durations are the number of completed workflows by their duration, so that the stats of many workflows take as
+	// much memory as their distinct durations
+	Durations         map[time.Duration]int64
+	ResourcesDuration wfv1.ResourcesDuration
+	// FailureMessages is the number of failed and errored workflows by their message
+	FailureMessages map[string]int64
+}
+
+func newWorkflowStats() *WorkflowStats {
+	return &WorkflowStats{Phases: map[wfv1.WorkflowPhase]int64{}, Durations: map[time.Duration]int64{}, ResourcesDuration: wfv1.ResourcesDuration{}, FailureMessages: map[string]int64{}}
+}
+
+// DurationPercentile returns the percentile, between 0 and 1, of the durations using the nearest-rank method
+func (s *WorkflowStats) DurationPercentile(p float64) time.Duration {
+	var durations []time.Duration
+	var total int64
+	for duration, count := range s.Durations {
+		durations = append(durations, duration)
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	rank := int64(math.Ceil(p * float64(total)))
+	for _, duration := range durations {
+		rank -= s.Durations[duration]
+		if rank <= 0 {
+			return duration
+		}
+	}
+	return durations[len(durations)-1]
+}
+
+// TopFailureMessages returns the n most common failure messages, the most common first
+func (s *WorkflowStats) TopFailureMessages(n int) []string {
+	var messages []string
+	for message := range s.FailureMessages {
+		messages = append(messages, message)
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		if s.FailureMessages[messages[i]] != s.FailureMessages[messages[j]] {
+			return s.FailureMessages[messages[i]] > s.FailureMessages[messages[j]]
+		}
+		return messages[i] < messages[j]
+	})
+	if len(messages) > n {
+		messages = messages[:n]
+	}
+	return messages
+}
+
+func (s *WorkflowStats) merge(other *WorkflowStats) {
+	for phase, count := range other.Phases {
+		s.Phases[phase] += count
+	}
+	for duration, count := range other.Durations {
+		s.Durations[duration] += count
+	}
+	for name, duration := range other.ResourcesDuration {
+		s.ResourcesDuration[name] += duration
+	}
+	for message, count := range other.FailureMessages {
+		s.FailureMessages[message] += count
+	}
+}
+
+// WorkflowStatsMap are the stats of aggregated workflows by their group and time bucket
+type WorkflowStatsMap map[WorkflowStatsKey]*WorkflowStats
+
+// Get returns the stats of the key, adding them if they do not exist
+func (m WorkflowStatsMap) Get(key WorkflowStatsKey) *WorkflowStats {
+	if _, ok := m[key]; !ok {
+		m[key] = newWorkflowStats()
+	}
+	return m[key]
+}
+
+// AddWorkflow aggregates the workflow if it has the label the options group by, it does not check any other option
+func (m WorkflowStatsMap) AddWorkflow(options StatsOptions, wf *wfv1.Workflow) {
+	group, ok := wf.Labels[options.GroupBy]
+	if !ok {
+		return
+	}
+	startedAt := wf.Status.StartedAt.Time
+	if startedAt.IsZero() {
+		startedAt = wf.CreationTimestamp.Time
+	}
+	s := m.Get(WorkflowStatsKey{Group: group, BucketStart: options.BucketStart(startedAt)})
+	s.Phases[wf.Status.Phase]++
+	if wf.Status.Fulfilled() && !wf.Status.FinishedAt.IsZero() {
+		s.Durations[wf.Status.FinishedAt.Sub(wf.Status.StartedAt.Time)]++
+	}
+	for _, name := range StatsResourceNames {
+		if duration := wf.Status.ResourcesDuration[name]; duration > 0 {
+			s.ResourcesDuration[name] += duration
+		}
+	}
+	if wf.Status.Phase == wfv1.WorkflowFailed || wf.Status.Phase == wfv1.WorkflowError {
+		s.FailureMessages[wf.Status.Message]++
+	}
+}
+
+// Merge adds the other stats to these
+func (m WorkflowStatsMap) Merge(other WorkflowStatsMap) {
+	for key, s := range other {
+		m.Get(key).merge(s)
+	}
+}
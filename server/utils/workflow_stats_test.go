@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestWorkflowStats(t *testing.T) {
+	t.Run("DurationPercentile", func(t *testing.T) {
+		s := &WorkflowStats{Durations: map[time.Duration]int64{4: 1, 1: 1, 3: 1, 2: 1}}
+		assert.Equal(t, time.Duration(2), s.DurationPercentile(0.5))
+		assert.Equal(t, time.Duration(4), s.DurationPercentile(0.99))
+		assert.Equal(t, time.Duration(1), s.DurationPercentile(0))
+		s = &WorkflowStats{Durations: map[time.Duration]int64{1: 5, 2: 4, 3: 1}}
+		assert.Equal(t, time.Duration(1), s.DurationPercentile(0.5))
+		assert.Equal(t, time.Duration(2), s.DurationPercentile(0.9))
+		assert.Equal(t, time.Duration(3), s.DurationPercentile(0.95))
+		assert.Zero(t, (&WorkflowStats{}).DurationPercentile(0.5))
+	})
+	t.Run("TopFailureMessages", func(t *testing.T) {
+		s := &WorkflowStats{FailureMessages: map[string]int64{"b": 2, "a": 2, "c": 3, "d": 1}}
+		assert.Equal(t, []string{"c", "a", "b"}, s.TopFailureMessages(3))
+		assert.Len(t, s.TopFailureMessages(10), 4)
+	})
+}
+
+func TestWorkflowStatsMap(t *testing.T) {
+	started := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	newWorkflow := func(group string, phase wfv1.WorkflowPhase) *wfv1.Workflow {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"my-label": group}},
+			Status: wfv1.WorkflowStatus{
+				Phase:             phase,
+				StartedAt:         metav1.Time{Time: started},
+				Message:           string(phase),
+				ResourcesDuration: wfv1.ResourcesDuration{"cpu": 1, "nvidia.com/gpu": 1},
+			},
+		}
+		if wf.Status.Fulfilled() {
+			wf.Status.FinishedAt = metav1.Time{Time: started.Add(time.Minute)}
+		}
+		return wf
+	}
+	options := StatsOptions{GroupBy: "my-label", Bucket: 24 * time.Hour}
+	m := WorkflowStatsMap{}
+	m.AddWorkflow(options, newWorkflow("a", wfv1.WorkflowFailed))
+	m.AddWorkflow(options, newWorkflow("a", wfv1.WorkflowRunning))
+	m.AddWorkflow(options, &wfv1.Workflow{})
+	other := WorkflowStatsMap{}
+	other.AddWorkflow(options, newWorkflow("a", wfv1.WorkflowSucceeded))
+	m.Merge(other)
+
+	key := WorkflowStatsKey{Group: "a", BucketStart: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)}
+	if assert.Len(t, m, 1) && assert.Contains(t, m, key) {
+		s := m[key]
+		assert.Equal(t, map[wfv1.WorkflowPhase]int64{wfv1.WorkflowFailed: 1, wfv1.WorkflowRunning: 1, wfv1.WorkflowSucceeded: 1}, s.Phases)
+		assert.Equal(t, map[time.Duration]int64{time.Minute: 2}, s.Durations)
+		assert.Equal(t, wfv1.ResourcesDuration{"cpu": 3}, s.ResourcesDuration)
+		assert.Equal(t, map[string]int64{"Failed": 1}, s.FailureMessages)
+	}
+}
@@ -3,13 +3,14 @@ package workflowarchive
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	argotime "github.com/argoproj/pkg/time"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,12 +24,17 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 
 	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
 )
 
-const disableValueListRetrievalKeyPattern = "DISABLE_VALUE_LIST_RETRIEVAL_KEY_PATTERN"
+const (
+	disableValueListRetrievalKeyPattern = "DISABLE_VALUE_LIST_RETRIEVAL_KEY_PATTERN"
+	// exportPageSize is the number of workflows that are listed from the archive at a time when exporting
+	exportPageSize = 500
+)
 
 type archivedWorkflowServer struct {
 	wfArchive sqldb.WorkflowArchive
@@ -41,7 +47,6 @@ func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive) workflowarchivepk
 
 func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ListArchivedWorkflowsRequest) (*wfv1.WorkflowList, error) {
 	options := req.ListOptions
-	namePrefix := req.NamePrefix
 	if options == nil {
 		options = &metav1.ListOptions{}
 	}
@@ -60,6 +65,69 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 		return nil, status.Error(codes.InvalidArgument, "listOptions.continue must >= 0")
 	}
 
+	listOptions, showRemainingItemCount, err := parseListOptions(req)
+	if err != nil {
+		return nil, err
+	}
+	namespace := listOptions.Namespace
+
+	// verify if we have permission to list Workflows
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, namespace, "")
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflows in namespace \"%s\". Maybe you want to specify a namespace with query parameter `.namespace=%s`?", namespace, namespace))
+	}
+
+	// When the zero value is passed, we should treat this as returning all results
+	// to align ourselves with the behavior of the `List` endpoints in the Kubernetes API
+	loadAll := limit == 0
+	limitWithMore := 0
+
+	if !loadAll {
+		// Attempt to load 1 more record than we actually need as an easy way to determine whether or not more
+		// records exist than we're currently requesting
+		limitWithMore = limit + 1
+	}
+
+	items, err := w.wfArchive.ListWorkflows(listOptions.WithLimit(limitWithMore).WithOffset(offset))
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+
+	meta := metav1.ListMeta{}
+
+	if showRemainingItemCount && !loadAll {
+		total, err := w.wfArchive.CountWorkflows(listOptions)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		count := total - int64(offset) - int64(items.Len())
+		if len(items) > limit {
+			count = count + 1
+		}
+		if count < 0 {
+			count = 0
+		}
+		meta.RemainingItemCount = &count
+	}
+
+	if !loadAll && len(items) > limit {
+		items = items[0:limit]
+		meta.Continue = fmt.Sprintf("%v", offset+limit)
+	}
+	return &wfv1.WorkflowList{ListMeta: meta, Items: items}, nil
+}
+
+// parseListOptions returns the options to list the archived workflows that match the request, and whether to show the
+// remaining item count
+func parseListOptions(req *workflowarchivepkg.ListArchivedWorkflowsRequest) (sutils.ListOptions, bool, error) {
+	options := req.ListOptions
+	if options == nil {
+		options = &metav1.ListOptions{}
+	}
+	var err error
 	// namespace is now specified as its own query parameter
 	// note that for backward compatibility, the field selector 'metadata.namespace' is also supported for now
 	namespace := req.Namespace // optional
@@ -81,7 +149,7 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 			case fieldSelectedNamespace:
 				break
 			default:
-				return nil, status.Errorf(codes.InvalidArgument,
+				return sutils.ListOptions{}, false, status.Errorf(codes.InvalidArgument,
 					"'namespace' query param (%q) and fieldselector 'metadata.namespace' (%q) are both specified and contradict each other", namespace, fieldSelectedNamespace)
 			}
 		} else if strings.HasPrefix(selector, "metadata.name=") {
@@ -90,78 +158,102 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 			minStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt>"))
 			if err != nil {
 				// startedAt is populated by us, it should therefore be valid.
-				return nil, sutils.ToStatusError(err, codes.Internal)
+				return sutils.ListOptions{}, false, sutils.ToStatusError(err, codes.Internal)
 			}
 		} else if strings.HasPrefix(selector, "spec.startedAt<") {
 			maxStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt<"))
 			if err != nil {
 				// no need to use sutils here
-				return nil, sutils.ToStatusError(err, codes.Internal)
+				return sutils.ListOptions{}, false, sutils.ToStatusError(err, codes.Internal)
 			}
 		} else if strings.HasPrefix(selector, "ext.showRemainingItemCount") {
 			showRemainingItemCount, err = strconv.ParseBool(strings.TrimPrefix(selector, "ext.showRemainingItemCount="))
 			if err != nil {
 				// populated by us, it should therefore be valid.
-				return nil, sutils.ToStatusError(err, codes.Internal)
+				return sutils.ListOptions{}, false, sutils.ToStatusError(err, codes.Internal)
 			}
 		} else {
-			return nil, sutils.ToStatusError(fmt.Errorf("unsupported requirement %s", selector), codes.InvalidArgument)
+			return sutils.ListOptions{}, false, sutils.ToStatusError(fmt.Errorf("unsupported requirement %s", selector), codes.InvalidArgument)
 		}
 	}
 	requirements, err := labels.ParseToRequirements(options.LabelSelector)
 	if err != nil {
-		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
-	}
-
-	// verify if we have permission to list Workflows
-	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, namespace, "")
-	if err != nil {
-		return nil, sutils.ToStatusError(err, codes.Internal)
-	}
-	if !allowed {
-		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflows in namespace \"%s\". Maybe you want to specify a namespace with query parameter `.namespace=%s`?", namespace, namespace))
+		return sutils.ListOptions{}, false, sutils.ToStatusError(err, codes.InvalidArgument)
 	}
-
-	// When the zero value is passed, we should treat this as returning all results
-	// to align ourselves with the behavior of the `List` endpoints in the Kubernetes API
-	loadAll := limit == 0
-	limitWithMore := 0
-
-	if !loadAll {
-		// Attempt to load 1 more record than we actually need as an easy way to determine whether or not more
-		// records exist than we're currently requesting
-		limitWithMore = limit + 1
-	}
-
-	items, err := w.wfArchive.ListWorkflows(namespace, name, namePrefix, minStartedAt, maxStartedAt, requirements, limitWithMore, offset)
+	listOptions, err := buildListOptions(req, requirements)
 	if err != nil {
-		return nil, sutils.ToStatusError(err, codes.Internal)
+		return sutils.ListOptions{}, false, sutils.ToStatusError(err, codes.InvalidArgument)
 	}
+	listOptions.Namespace = namespace
+	listOptions.Name = name
+	listOptions.NamePrefix = req.NamePrefix
+	listOptions.MinStartedAt = minStartedAt
+	listOptions.MaxStartedAt = maxStartedAt
 
-	meta := metav1.ListMeta{}
+	return listOptions, showRemainingItemCount, nil
+}
 
-	if showRemainingItemCount && !loadAll {
-		total, err := w.wfArchive.CountWorkflows(namespace, name, namePrefix, minStartedAt, maxStartedAt, requirements)
+// buildListOptions returns the options of the filters of the request other than its list options
+func buildListOptions(req *workflowarchivepkg.ListArchivedWorkflowsRequest, requirements labels.Requirements) (sutils.ListOptions, error) {
+	listOptions := sutils.ListOptions{MessageContains: req.MessageContains, OrderBy: req.OrderBy}
+	if _, _, err := listOptions.Order(); err != nil {
+		return listOptions, err
+	}
+	for _, phase := range req.Phases {
+		listOptions.Phases = append(listOptions.Phases, wfv1.WorkflowPhase(phase))
+	}
+	for _, t := range []struct {
+		value string
+		time  *time.Time
+	}{
+		{req.MinFinishedAt, &listOptions.MinFinishedAt},
+		{req.MaxFinishedAt, &listOptions.MaxFinishedAt},
+	} {
+		if t.value == "" {
+			continue
+		}
+		v, err := time.Parse(time.RFC3339, t.value)
 		if err != nil {
-			return nil, sutils.ToStatusError(err, codes.Internal)
+			return listOptions, err
 		}
-		count := total - int64(offset) - int64(items.Len())
-		if len(items) > limit {
-			count = count + 1
+		*t.time = v
+	}
+	for _, d := range []struct {
+		value    string
+		duration *time.Duration
+	}{
+		{req.MinDuration, &listOptions.MinDuration},
+		{req.MaxDuration, &listOptions.MaxDuration},
+	} {
+		if d.value == "" {
+			continue
 		}
-		if count < 0 {
-			count = 0
+		v, err := argotime.ParseDuration(d.value)
+		if err != nil {
+			return listOptions, err
 		}
-		meta.RemainingItemCount = &count
-	}
-
-	if !loadAll && len(items) > limit {
-		items = items[0:limit]
-		meta.Continue = fmt.Sprintf("%v", offset+limit)
+		*d.duration = *v
+	}
+	// workflows are labelled with the templates and cron workflows that they were created from
+	for _, owner := range []struct {
+		label string
+		name  string
+	}{
+		{common.LabelKeyWorkflowTemplate, req.WorkflowTemplate},
+		{common.LabelKeyClusterWorkflowTemplate, req.ClusterWorkflowTemplate},
+		{common.LabelKeyCronWorkflow, req.CronWorkflow},
+	} {
+		if owner.name == "" {
+			continue
+		}
+		requirement, err := labels.NewRequirement(owner.label, selection.Equals, []string{owner.name})
+		if err != nil {
+			return listOptions, err
+		}
+		requirements = append(requirements, *requirement)
 	}
-
-	sort.Sort(items)
-	return &wfv1.WorkflowList{ListMeta: meta, Items: items}, nil
+	listOptions.LabelRequirements = requirements
+	return listOptions, nil
 }
 
 func (w *archivedWorkflowServer) GetArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.GetArchivedWorkflowRequest) (*wfv1.Workflow, error) {
@@ -254,6 +346,92 @@ func (w *archivedWorkflowServer) ListArchivedWorkflowLabelValues(ctx context.Con
 	return labels, nil
 }
 
+// ExportArchivedWorkflows streams the workflows page by page, so that large archives do not need to fit in memory
+func (w *archivedWorkflowServer) ExportArchivedWorkflows(req *workflowarchivepkg.ListArchivedWorkflowsRequest, stream workflowarchivepkg.ArchivedWorkflowService_ExportArchivedWorkflowsServer) error {
+	ctx := stream.Context()
+	listOptions, _, err := parseListOptions(req)
+	if err != nil {
+		return err
+	}
+	// the full workflows are exported, so we must be allowed to get them as well as list them
+	for _, verb := range []string{"list", "get"} {
+		allowed, err := auth.CanI(ctx, verb, workflow.WorkflowPlural, listOptions.Namespace, "")
+		if err != nil {
+			return sutils.ToStatusError(err, codes.Internal)
+		}
+		if !allowed {
+			return status.Errorf(codes.PermissionDenied, "Permission denied, you are not allowed to %s workflows in namespace %q", verb, listOptions.Namespace)
+		}
+	}
+	// the oldest workflows are exported first, so that workflows archived during the export do not shift the pages
+	if listOptions.OrderBy == "" {
+		listOptions.OrderBy = "startedAt"
+	}
+	// a limit caps the number of exported workflows
+	limit := 0
+	if req.ListOptions != nil {
+		limit = int(req.ListOptions.Limit)
+	}
+	for offset := 0; ; {
+		pageSize := exportPageSize
+		if limit > 0 && limit-offset < pageSize {
+			pageSize = limit - offset
+		}
+		if pageSize <= 0 {
+			return nil
+		}
+		// each page is read at once, without the workflows deleted since they were listed, so a page can be short
+		// before the last one
+		wfs, err := w.wfArchive.ListWorkflowsWithStatus(listOptions.WithLimit(pageSize).WithOffset(offset))
+		if err != nil {
+			return sutils.ToStatusError(err, codes.Internal)
+		}
+		if len(wfs) == 0 {
+			return nil
+		}
+		for i := range wfs {
+			if err := stream.Send(&wfs[i]); err != nil {
+				return err
+			}
+		}
+		offset += pageSize
+	}
+}
+
+// ImportArchivedWorkflows archives each workflow as it is received, keeping its UID and labels
+func (w *archivedWorkflowServer) ImportArchivedWorkflows(stream workflowarchivepkg.ArchivedWorkflowService_ImportArchivedWorkflowsServer) error {
+	ctx := stream.Context()
+	// whether we are allowed to create workflows in each namespace
+	allowed := make(map[string]bool)
+	imported := int64(0)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&workflowarchivepkg.ArchivedWorkflowsImportedResponse{Imported: imported})
+		}
+		if err != nil {
+			return err
+		}
+		wf := req.Workflow
+		if wf == nil || wf.UID == "" {
+			return status.Errorf(codes.InvalidArgument, "workflow #%d must have a UID", imported+1)
+		}
+		if _, ok := allowed[wf.Namespace]; !ok {
+			allowed[wf.Namespace], err = auth.CanI(ctx, "create", workflow.WorkflowPlural, wf.Namespace, "")
+			if err != nil {
+				return sutils.ToStatusError(err, codes.Internal)
+			}
+		}
+		if !allowed[wf.Namespace] {
+			return status.Errorf(codes.PermissionDenied, "Permission denied, you are not allowed to create workflows in namespace %q", wf.Namespace)
+		}
+		if err := w.wfArchive.ArchiveWorkflow(wf); err != nil {
+			return sutils.ToStatusError(err, codes.Internal)
+		}
+		imported++
+	}
+}
+
 func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 
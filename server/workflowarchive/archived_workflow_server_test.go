@@ -2,18 +2,22 @@ package workflowarchive
 
 import (
 	"context"
+	"io"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
@@ -22,6 +26,7 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
@@ -48,18 +53,27 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		}, nil
 	})
 	// two pages of results for limit 1
-	repo.On("ListWorkflows", "", "", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}, {}}, nil)
-	repo.On("ListWorkflows", "", "", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, 1).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sutils.ListOptions{Limit: 2}).Return(wfv1.Workflows{{}, {}}, nil)
+	repo.On("ListWorkflows", sutils.ListOptions{Limit: 2, Offset: 1}).Return(wfv1.Workflows{{}}, nil)
 	minStartAt, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
 	maxStartAt, _ := time.Parse(time.RFC3339, "2020-01-02T00:00:00Z")
 	createdTime := metav1.Time{Time: time.Now().UTC()}
 	finishedTime := metav1.Time{Time: createdTime.Add(time.Second * 2)}
-	repo.On("ListWorkflows", "", "", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
-	repo.On("ListWorkflows", "", "my-name", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
-	repo.On("ListWorkflows", "", "", "my-", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
-	repo.On("ListWorkflows", "", "my-name", "my-", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
-	repo.On("ListWorkflows", "user-ns", "", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}, {}}, nil)
-	repo.On("CountWorkflows", "", "my-name", "my-", minStartAt, maxStartAt, labels.Requirements(nil)).Return(int64(5), nil)
+	repo.On("ListWorkflows", sutils.ListOptions{MinStartedAt: minStartAt, MaxStartedAt: maxStartAt, Limit: 2}).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sutils.ListOptions{Name: "my-name", MinStartedAt: minStartAt, MaxStartedAt: maxStartAt, Limit: 2}).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sutils.ListOptions{NamePrefix: "my-", MinStartedAt: minStartAt, MaxStartedAt: maxStartAt, Limit: 2}).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sutils.ListOptions{Name: "my-name", NamePrefix: "my-", MinStartedAt: minStartAt, MaxStartedAt: maxStartAt, Limit: 2}).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sutils.ListOptions{Namespace: "user-ns", Limit: 2}).Return(wfv1.Workflows{{}, {}}, nil)
+	repo.On("CountWorkflows", sutils.ListOptions{Name: "my-name", NamePrefix: "my-", MinStartedAt: minStartAt, MaxStartedAt: maxStartAt}).Return(int64(5), nil)
+	cronWorkflowRequirement, _ := labels.NewRequirement(common.LabelKeyCronWorkflow, selection.Equals, []string{"my-cron"})
+	repo.On("ListWorkflows", sutils.ListOptions{
+		Phases:            []wfv1.WorkflowPhase{wfv1.WorkflowFailed},
+		MinFinishedAt:     minStartAt,
+		MinDuration:       time.Hour,
+		MessageContains:   "OOMKilled",
+		LabelRequirements: labels.Requirements{*cronWorkflowRequirement},
+		OrderBy:           "-duration",
+	}).Return(wfv1.Workflows{{ObjectMeta: metav1.ObjectMeta{Name: "short"}}, {ObjectMeta: metav1.ObjectMeta{Name: "long"}}}, nil)
 	repo.On("GetWorkflow", "").Return(nil, nil)
 	repo.On("GetWorkflow", "my-uid").Return(&wfv1.Workflow{
 		ObjectMeta: metav1.ObjectMeta{Name: "my-name"},
@@ -102,6 +116,14 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		}, nil
 	})
 	repo.On("DeleteWorkflow", "my-uid").Return(nil)
+	repo.On("ListWorkflowsWithStatus", sutils.ListOptions{Namespace: "export-ns", OrderBy: "startedAt", Limit: exportPageSize}).Return(wfv1.Workflows{
+		{ObjectMeta: metav1.ObjectMeta{Name: "my-name", UID: "my-uid"}},
+	}, nil)
+	repo.On("ListWorkflowsWithStatus", sutils.ListOptions{Namespace: "export-ns", OrderBy: "startedAt", Limit: exportPageSize, Offset: exportPageSize}).Return(wfv1.Workflows{}, nil)
+	repo.On("ListWorkflowsWithStatus", sutils.ListOptions{Namespace: "export-ns", OrderBy: "startedAt", Limit: 1}).Return(wfv1.Workflows{
+		{ObjectMeta: metav1.ObjectMeta{Name: "my-name", UID: "my-uid"}},
+	}, nil)
+	repo.On("ArchiveWorkflow", &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "imported-wf", Namespace: "import-ns", UID: "imported-uid"}}).Return(nil)
 	repo.On("ListWorkflowsLabelKeys").Return(&wfv1.LabelKeys{
 		Items: []string{"foo", "bar"},
 	}, nil)
@@ -163,6 +185,21 @@ func Test_archivedWorkflowServer(t *testing.T) {
 			assert.Equal(t, *resp.ListMeta.RemainingItemCount, int64(4))
 			assert.Empty(t, resp.Continue)
 		}
+		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{
+			Phases:          []string{"Failed"},
+			MinFinishedAt:   "2020-01-01T00:00:00Z",
+			MinDuration:     "1h",
+			CronWorkflow:    "my-cron",
+			MessageContains: "OOMKilled",
+			OrderBy:         "-duration",
+		})
+		if assert.NoError(t, err) && assert.Len(t, resp.Items, 2) {
+			assert.Equal(t, "short", resp.Items[0].Name, "the order of the archive is kept")
+		}
+		_, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{OrderBy: "name"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{MinDuration: "soon"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
 		/////// Currently, for the purpose of backward compatibility, namespace is supported both as its own query parameter and as part of the field selector
 		/////// need to test both
 		// pass namespace as its own query parameter
@@ -209,6 +246,36 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		_, err = w.DeleteArchivedWorkflow(ctx, &workflowarchivepkg.DeleteArchivedWorkflowRequest{Uid: "my-uid"})
 		assert.NoError(t, err)
 	})
+	t.Run("ExportArchivedWorkflows", func(t *testing.T) {
+		allowed = false
+		err := w.ExportArchivedWorkflows(&workflowarchivepkg.ListArchivedWorkflowsRequest{Namespace: "export-ns"}, &testExportServer{testServerStream: testServerStream{ctx}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		stream := &testExportServer{testServerStream: testServerStream{ctx}}
+		err = w.ExportArchivedWorkflows(&workflowarchivepkg.ListArchivedWorkflowsRequest{Namespace: "export-ns"}, stream)
+		if assert.NoError(t, err) && assert.Len(t, stream.sent, 1) {
+			assert.Equal(t, "my-name", stream.sent[0].Name)
+		}
+		stream = &testExportServer{testServerStream: testServerStream{ctx}}
+		err = w.ExportArchivedWorkflows(&workflowarchivepkg.ListArchivedWorkflowsRequest{Namespace: "export-ns", ListOptions: &metav1.ListOptions{Limit: 1}}, stream)
+		if assert.NoError(t, err) {
+			assert.Len(t, stream.sent, 1)
+		}
+	})
+	t.Run("ImportArchivedWorkflows", func(t *testing.T) {
+		wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "imported-wf", Namespace: "import-ns", UID: "imported-uid"}}
+		allowed = false
+		err := w.ImportArchivedWorkflows(&testImportServer{testServerStream: testServerStream{ctx}, reqs: []*workflowarchivepkg.ImportArchivedWorkflowRequest{{Workflow: wf}}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		err = w.ImportArchivedWorkflows(&testImportServer{testServerStream: testServerStream{ctx}, reqs: []*workflowarchivepkg.ImportArchivedWorkflowRequest{{Workflow: &wfv1.Workflow{}}}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		stream := &testImportServer{testServerStream: testServerStream{ctx}, reqs: []*workflowarchivepkg.ImportArchivedWorkflowRequest{{Workflow: wf}, {Workflow: wf}}}
+		err = w.ImportArchivedWorkflows(stream)
+		if assert.NoError(t, err) && assert.NotNil(t, stream.resp) {
+			assert.Equal(t, int64(2), stream.resp.Imported)
+		}
+	})
 	t.Run("ListArchivedWorkflowLabelKeys", func(t *testing.T) {
 		resp, err := w.ListArchivedWorkflowLabelKeys(ctx, &workflowarchivepkg.ListArchivedWorkflowLabelKeysRequest{})
 		assert.NoError(t, err)
@@ -238,3 +305,63 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		assert.NotNil(t, wf)
 	})
 }
+
+type testServerStream struct {
+	ctx context.Context
+}
+
+var _ grpc.ServerStream = &testServerStream{}
+
+func (t testServerStream) SetHeader(md metadata.MD) error {
+	panic("implement me")
+}
+
+func (t testServerStream) SendHeader(md metadata.MD) error {
+	return nil
+}
+
+func (t testServerStream) SetTrailer(md metadata.MD) {
+	panic("implement me")
+}
+
+func (t testServerStream) Context() context.Context {
+	return t.ctx
+}
+
+func (t testServerStream) SendMsg(interface{}) error {
+	panic("implement me")
+}
+
+func (t testServerStream) RecvMsg(interface{}) error {
+	panic("implement me")
+}
+
+type testExportServer struct {
+	testServerStream
+	sent []*wfv1.Workflow
+}
+
+func (t *testExportServer) Send(wf *wfv1.Workflow) error {
+	t.sent = append(t.sent, wf)
+	return nil
+}
+
+type testImportServer struct {
+	testServerStream
+	reqs []*workflowarchivepkg.ImportArchivedWorkflowRequest
+	resp *workflowarchivepkg.ArchivedWorkflowsImportedResponse
+}
+
+func (t *testImportServer) Recv() (*workflowarchivepkg.ImportArchivedWorkflowRequest, error) {
+	if len(t.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := t.reqs[0]
+	t.reqs = t.reqs[1:]
+	return req, nil
+}
+
+func (t *testImportServer) SendAndClose(resp *workflowarchivepkg.ArchivedWorkflowsImportedResponse) error {
+	t.resp = resp
+	return nil
+}
@@ -0,0 +1,188 @@
+package workflowstats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	argotime "github.com/argoproj/pkg/time"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	workflowstatspkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowstats"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const defaultTopFailureMessages = 5
+
+type workflowStatsServer struct {
+	instanceIDService instanceid.Service
+	wfArchive         sqldb.WorkflowArchive
+}
+
+// NewWorkflowStatsServer returns a new workflowStatsServer, which aggregates both the live workflows and the workflows in
+// the archive
+func NewWorkflowStatsServer(instanceIDService instanceid.Service, wfArchive sqldb.WorkflowArchive) workflowstatspkg.WorkflowStatsServiceServer {
+	return &workflowStatsServer{instanceIDService, wfArchive}
+}
+
+func (s *workflowStatsServer) GetWorkflowStats(ctx context.Context, req *workflowstatspkg.WorkflowStatsRequest) (*workflowstatspkg.WorkflowStatsResponse, error) {
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, req.Namespace, "")
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	options, err := parseStatsOptions(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	m, err := s.wfArchive.GetWorkflowStats(options)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	live, err := s.getLiveWorkflowStats(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	m.Merge(live)
+	topFailureMessages := int(req.TopFailureMessages)
+	if topFailureMessages <= 0 {
+		topFailureMessages = defaultTopFailureMessages
+	}
+	return newWorkflowStatsResponse(m, topFailureMessages), nil
+}
+
+// getLiveWorkflowStats aggregates the workflows in the cluster, except for those that are already in the archive
+func (s *workflowStatsServer) getLiveWorkflowStats(ctx context.Context, options sutils.StatsOptions) (sutils.WorkflowStatsMap, error) {
+	requirement, err := labels.NewRequirement(options.GroupBy, selection.Exists, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	selector := labels.NewSelector().Add(*requirement).Add(options.LabelRequirements...)
+	listOptions := &metav1.ListOptions{LabelSelector: selector.String()}
+	s.instanceIDService.With(listOptions)
+	wfList, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows(options.Namespace).List(ctx, *listOptions)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	m := sutils.WorkflowStatsMap{}
+	for i := range wfList.Items {
+		wf := &wfList.Items[i]
+		if s.wfArchive.IsEnabled() && wf.Labels[common.LabelKeyWorkflowArchivingStatus] == "Archived" {
+			continue
+		}
+		startedAt := wf.Status.StartedAt.Time
+		if startedAt.IsZero() {
+			startedAt = wf.CreationTimestamp.Time
+		}
+		if (!options.MinStartedAt.IsZero() && startedAt.Before(options.MinStartedAt)) || (!options.MaxStartedAt.IsZero() && startedAt.After(options.MaxStartedAt)) {
+			continue
+		}
+		m.AddWorkflow(options, wf)
+	}
+	return m, nil
+}
+
+func parseStatsOptions(req *workflowstatspkg.WorkflowStatsRequest) (sutils.StatsOptions, error) {
+	options := sutils.StatsOptions{ListOptions: sutils.ListOptions{Namespace: req.Namespace}, GroupBy: req.GroupBy}
+	if req.GroupBy == "" {
+		options.GroupBy = common.LabelKeyWorkflowTemplate
+	} else if label, ok := common.GroupByLabels[req.GroupBy]; ok {
+		options.GroupBy = label
+	}
+	// the key of the label is used in queries of the archive, so it must be validated
+	if errs := validation.IsQualifiedName(options.GroupBy); len(errs) > 0 {
+		return options, fmt.Errorf("invalid groupBy %q: %s", options.GroupBy, strings.Join(errs, "; "))
+	}
+	requirements, err := labels.ParseToRequirements(req.LabelSelector)
+	if err != nil {
+		return options, err
+	}
+	if req.Group != "" {
+		requirement, err := labels.NewRequirement(options.GroupBy, selection.Equals, []string{req.Group})
+		if err != nil {
+			return options, err
+		}
+		requirements = append(requirements, *requirement)
+	}
+	options.LabelRequirements = requirements
+	for _, t := range []struct {
+		value string
+		time  *time.Time
+	}{
+		{req.StartedAfter, &options.MinStartedAt},
+		{req.StartedBefore, &options.MaxStartedAt},
+	} {
+		if t.value == "" {
+			continue
+		}
+		v, err := time.Parse(time.RFC3339, t.value)
+		if err != nil {
+			return options, err
+		}
+		*t.time = v
+	}
+	if req.Bucket != "" {
+		bucket, err := argotime.ParseDuration(req.Bucket)
+		if err != nil {
+			return options, err
+		}
+		options.Bucket = *bucket
+	}
+	return options, nil
+}
+
+func newWorkflowStatsResponse(m sutils.WorkflowStatsMap, topFailureMessages int) *workflowstatspkg.WorkflowStatsResponse {
+	keys := make([]sutils.WorkflowStatsKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if c := strings.Compare(keys[i].Group, keys[j].Group); c != 0 {
+			return c < 0
+		}
+		return keys[i].BucketStart.Before(keys[j].BucketStart)
+	})
+	items := make([]*workflowstatspkg.WorkflowStats, 0, len(keys))
+	for _, key := range keys {
+		stats := m[key]
+		item := &workflowstatspkg.WorkflowStats{
+			Group:  key.Group,
+			Phases: map[string]int64{},
+			Durations: &workflowstatspkg.DurationPercentiles{
+				P50: int64(stats.DurationPercentile(0.5).Seconds()),
+				P90: int64(stats.DurationPercentile(0.9).Seconds()),
+				P95: int64(stats.DurationPercentile(0.95).Seconds()),
+				P99: int64(stats.DurationPercentile(0.99).Seconds()),
+			},
+			ResourcesDuration: map[string]int64{},
+		}
+		if !key.BucketStart.IsZero() {
+			item.BucketStart = &metav1.Time{Time: key.BucketStart}
+		}
+		for phase, count := range stats.Phases {
+			item.Phases[string(phase)] = count
+		}
+		for name, duration := range stats.ResourcesDuration {
+			item.ResourcesDuration[string(name)] = int64(duration)
+		}
+		for _, message := range stats.TopFailureMessages(topFailureMessages) {
+			item.TopFailureMessages = append(item.TopFailureMessages, &workflowstatspkg.FailureMessage{Message: message, Count: stats.FailureMessages[message]})
+		}
+		items = append(items, item)
+	}
+	return &workflowstatspkg.WorkflowStatsResponse{Items: items}
+}
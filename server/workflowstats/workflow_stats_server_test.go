@@ -0,0 +1,113 @@
+package workflowstats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
+	workflowstatspkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowstats"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func Test_workflowStatsServer(t *testing.T) {
+	started := time.Now().Add(-time.Hour).Truncate(time.Second)
+	newWorkflow := func(name, template string, phase wfv1.WorkflowPhase, archivingStatus string) *wfv1.Workflow {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowTemplate: template}},
+			Status:     wfv1.WorkflowStatus{Phase: phase, StartedAt: metav1.Time{Time: started}},
+		}
+		if wf.Status.Fulfilled() {
+			wf.Status.FinishedAt = metav1.Time{Time: started.Add(2 * time.Minute)}
+			wf.Status.Message = "OOMKilled"
+		}
+		if archivingStatus != "" {
+			wf.Labels[common.LabelKeyWorkflowArchivingStatus] = archivingStatus
+		}
+		return wf
+	}
+	wfClient := argofake.NewSimpleClientset(
+		newWorkflow("failed", "my-wftmpl", wfv1.WorkflowFailed, "Pending"),
+		newWorkflow("archived", "my-wftmpl", wfv1.WorkflowFailed, "Archived"),
+		newWorkflow("running", "other-wftmpl", wfv1.WorkflowRunning, ""),
+	)
+	kubeClient := &kubefake.Clientset{}
+	allowed := true
+	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	repo := &mocks.WorkflowArchive{}
+	repo.On("IsEnabled").Return(true)
+	// the server merges the live workflows into the returned stats, so they are returned anew each time
+	repo.On("GetWorkflowStats", mock.Anything).Return(func(sutils.StatsOptions) sutils.WorkflowStatsMap {
+		return sutils.WorkflowStatsMap{
+			{Group: "my-wftmpl"}: {
+				Phases:            map[wfv1.WorkflowPhase]int64{wfv1.WorkflowSucceeded: 2},
+				Durations:         map[time.Duration]int64{time.Minute: 1, 3 * time.Minute: 1},
+				ResourcesDuration: wfv1.ResourcesDuration{"cpu": 10},
+				FailureMessages:   map[string]int64{},
+			},
+		}
+	}, nil)
+	s := NewWorkflowStatsServer(instanceid.NewService(""), repo)
+	ctx := context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClient), auth.KubeKey, kubeClient)
+
+	t.Run("GetWorkflowStats", func(t *testing.T) {
+		res, err := s.GetWorkflowStats(ctx, &workflowstatspkg.WorkflowStatsRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) && assert.Len(t, res.Items, 2) {
+			item := res.Items[0]
+			assert.Equal(t, "my-wftmpl", item.Group)
+			assert.Nil(t, item.BucketStart)
+			assert.Equal(t, map[string]int64{"Succeeded": 2, "Failed": 1}, item.Phases)
+			assert.Equal(t, int64(120), item.Durations.P50)
+			assert.Equal(t, int64(180), item.Durations.P99)
+			assert.Equal(t, map[string]int64{"cpu": 10}, item.ResourcesDuration)
+			if assert.Len(t, item.TopFailureMessages, 1) {
+				assert.Equal(t, "OOMKilled", item.TopFailureMessages[0].Message)
+				assert.Equal(t, int64(1), item.TopFailureMessages[0].Count)
+			}
+			assert.Equal(t, "other-wftmpl", res.Items[1].Group)
+			assert.Equal(t, map[string]int64{"Running": 1}, res.Items[1].Phases)
+		}
+	})
+	t.Run("StartedAfter", func(t *testing.T) {
+		res, err := s.GetWorkflowStats(ctx, &workflowstatspkg.WorkflowStatsRequest{Namespace: "my-ns", Group: "other-wftmpl", StartedAfter: time.Now().Format(time.RFC3339)})
+		if assert.NoError(t, err) && assert.Len(t, res.Items, 1) {
+			assert.Equal(t, "my-wftmpl", res.Items[0].Group, "only the archived workflows are aggregated")
+		}
+	})
+	t.Run("InvalidArgument", func(t *testing.T) {
+		for _, req := range []*workflowstatspkg.WorkflowStatsRequest{
+			{Namespace: "my-ns", GroupBy: "not a label!"},
+			{Namespace: "my-ns", Bucket: "not a duration"},
+			{Namespace: "my-ns", StartedAfter: "yesterday"},
+			{Namespace: "my-ns", LabelSelector: "!!"},
+		} {
+			_, err := s.GetWorkflowStats(ctx, req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		}
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		allowed = false
+		defer func() { allowed = true }()
+		_, err := s.GetWorkflowStats(ctx, &workflowstatspkg.WorkflowStatsRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
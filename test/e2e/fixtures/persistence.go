@@ -17,7 +17,16 @@ type Persistence struct {
 
 func newPersistence(kubeClient kubernetes.Interface, wcConfig *config.Config) *Persistence {
 	persistence := wcConfig.Persistence
-	if persistence != nil {
+	if persistence != nil && persistence.ObjectStorage != nil {
+		if persistence.ObjectStorage.ArtifactRepository.S3 != nil {
+			persistence.ObjectStorage.ArtifactRepository.S3.Endpoint = "localhost:9000"
+		}
+		workflowArchive, err := sqldb.NewObjectStorageWorkflowArchive(kubeClient, Namespace, persistence.ObjectStorage, persistence.GetClusterName(), Namespace, instanceid.NewService(wcConfig.InstanceID))
+		if err != nil {
+			panic(err)
+		}
+		return &Persistence{offloadNodeStatusRepo: sqldb.ExplosiveOffloadNodeStatusRepo, workflowArchive: workflowArchive}
+	} else if persistence != nil {
 		if persistence.PostgreSQL != nil {
 			persistence.PostgreSQL.Host = "localhost"
 		}
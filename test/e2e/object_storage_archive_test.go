@@ -0,0 +1,87 @@
+//go:build functional
+// +build functional
+
+package e2e
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/test/e2e/fixtures"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+)
+
+// ObjectStorageArchiveSuite tests the object storage workflow archive against the MinIO of the test environment
+type ObjectStorageArchiveSuite struct {
+	fixtures.E2ESuite
+}
+
+func (s *ObjectStorageArchiveSuite) TestArchive() {
+	t := s.T()
+	cfg := &config.ObjectStorageConfig{
+		ArtifactRepository: wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{
+			Bucket:          "my-bucket",
+			Endpoint:        "localhost:9000",
+			Insecure:        pointer.BoolPtr(true),
+			AccessKeySecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-minio-cred"}, Key: "accesskey"},
+			SecretKeySecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-minio-cred"}, Key: "secretkey"},
+		}}},
+	}
+	// a unique cluster name isolates the test from earlier runs
+	clusterName := fmt.Sprintf("e2e-%d", time.Now().UnixNano())
+	archive, err := sqldb.NewObjectStorageWorkflowArchive(s.KubeClient, fixtures.Namespace, cfg, clusterName, fixtures.Namespace, instanceid.NewService(""))
+	s.Require().NoError(err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for i, name := range []string{"old", "new"} {
+		started := now.Add(time.Duration(i-2) * 24 * time.Hour)
+		s.Require().NoError(archive.ArchiveWorkflow(&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: fixtures.Namespace, UID: types.UID(clusterName + "-" + name), Labels: map[string]string{"my-label": name}},
+			Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded, StartedAt: metav1.Time{Time: started}, FinishedAt: metav1.Time{Time: started}},
+		}))
+	}
+
+	wfs, err := archive.ListWorkflows(sutils.ListOptions{Namespace: fixtures.Namespace})
+	if assert.NoError(t, err) && assert.Len(t, wfs, 2) {
+		assert.Equal(t, "new", wfs[0].Name)
+	}
+	requirements, err := labels.ParseToRequirements("my-label=old")
+	s.Require().NoError(err)
+	count, err := archive.CountWorkflows(sutils.ListOptions{Namespace: fixtures.Namespace, LabelRequirements: requirements})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	values, err := archive.ListWorkflowsLabelValues("my-label")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"new", "old"}, values.Items)
+	}
+	wf, err := archive.GetWorkflow(clusterName + "-new")
+	if assert.NoError(t, err) && assert.NotNil(t, wf) {
+		assert.Equal(t, "new", wf.Name)
+	}
+
+	s.Require().NoError(archive.DeleteExpiredWorkflows(36 * time.Hour))
+	wf, err = archive.GetWorkflow(clusterName + "-old")
+	assert.NoError(t, err)
+	assert.Nil(t, wf)
+	s.Require().NoError(archive.DeleteWorkflow(clusterName + "-new"))
+	count, err = archive.CountWorkflows(sutils.ListOptions{Namespace: fixtures.Namespace})
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestObjectStorageArchiveSuite(t *testing.T) {
+	suite.Run(t, new(ObjectStorageArchiveSuite))
+}
@@ -34,7 +34,7 @@ export const CronWorkflowEditor = ({
                           {
                               key: 'status',
                               title: 'Status',
-                              content: <CronWorkflowStatusViewer spec={cronWorkflow.spec} status={cronWorkflow.status} />
+                              content: <CronWorkflowStatusViewer namespace={cronWorkflow.metadata.namespace} spec={cronWorkflow.spec} status={cronWorkflow.status} />
                           }
                       ]
                     : []),
@@ -6,7 +6,7 @@ import {ConditionsPanel} from '../../shared/conditions-panel';
 import {WorkflowLink} from '../../workflows/components/workflow-link';
 import {PrettySchedule} from './pretty-schedule';
 
-export const CronWorkflowStatusViewer = ({spec, status}: {spec: CronWorkflowSpec; status: CronWorkflowStatus}) => {
+export const CronWorkflowStatusViewer = ({namespace, spec, status}: {namespace: string; spec: CronWorkflowSpec; status: CronWorkflowStatus}) => {
     if (status === null) {
         return null;
     }
@@ -19,11 +19,35 @@ export const CronWorkflowStatusViewer = ({spec, status}: {spec: CronWorkflowSpec
                         title: 'Schedule',
                         value: (
                             <>
-                                <code>{spec.schedule}</code> <PrettySchedule schedule={spec.schedule} />
+                                {spec.schedule && (
+                                    <div>
+                                        <code>{spec.schedule}</code> <PrettySchedule schedule={spec.schedule} />
+                                    </div>
+                                )}
+                                {(spec.schedules || []).map(schedule => (
+                                    <div key={schedule.name || schedule.schedule}>
+                                        {schedule.name && <b>{schedule.name}: </b>}
+                                        <code>{schedule.schedule}</code> <PrettySchedule schedule={schedule.schedule} />
+                                        {schedule.timezone && <> ({schedule.timezone})</>}
+                                    </div>
+                                ))}
                             </>
                         )
                     },
                     {title: 'Last Scheduled Time', value: <Timestamp date={status.lastScheduledTime} />},
+                    {title: 'Runs', value: `${status.succeeded || 0} succeeded, ${status.failed || 0} failed (${status.consecutiveFailures || 0} consecutive)`},
+                    {
+                        title: 'Recent Runs',
+                        value: status.recentRuns ? (
+                            status.recentRuns.map(run => (
+                                <div key={run.name}>
+                                    <WorkflowLink namespace={namespace} name={run.name} /> {run.phase} in {run.duration}, <Timestamp date={run.finishedAt} />
+                                </div>
+                            ))
+                        ) : (
+                            <i>No Recent Runs</i>
+                        )
+                    },
                     {title: 'Conditions', value: <ConditionsPanel conditions={status.conditions} />}
                 ].map(attr => (
                     <div className='row white-box__details-row' key={attr.title}>
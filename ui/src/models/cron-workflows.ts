@@ -1,5 +1,5 @@
 import * as kubernetes from 'argo-ui/src/models/kubernetes';
-import {Condition, WorkflowSpec} from './workflows';
+import {Condition, Parameter, WorkflowPhase, WorkflowSpec} from './workflows';
 
 export interface CronWorkflow {
     apiVersion?: string;
@@ -14,19 +14,55 @@ export type ConcurrencyPolicy = 'Allow' | 'Forbid' | 'Replace';
 export interface CronWorkflowSpec {
     workflowSpec: WorkflowSpec;
     workflowMetadata?: kubernetes.ObjectMeta;
-    schedule: string;
+    schedule?: string;
+    schedules?: CronWorkflowSchedule[];
     concurrencyPolicy?: ConcurrencyPolicy;
     suspend?: boolean;
     startingDeadlineSeconds?: number;
     successfulJobsHistoryLimit?: number;
     failedJobsHistoryLimit?: number;
     timezone?: string;
+    blackoutWindows?: BlackoutWindow[];
+    blackoutCalendars?: {name: string; key: string; optional?: boolean}[];
+    stopStrategy?: StopStrategy;
+    jitter?: string;
+}
+
+export interface StopStrategy {
+    consecutiveFailures?: number;
+    expression?: string;
+}
+
+export interface BlackoutWindow {
+    name?: string;
+    start: kubernetes.Time;
+    end: kubernetes.Time;
+}
+
+export interface CronWorkflowSchedule {
+    name?: string;
+    schedule: string;
+    timezone?: string;
+    parameters?: Parameter[];
 }
 
 export interface CronWorkflowStatus {
     active: kubernetes.ObjectReference[];
     lastScheduledTime: kubernetes.Time;
     conditions?: Condition[];
+    lastScheduledTimes?: {[name: string]: kubernetes.Time};
+    recentRuns?: CronWorkflowRun[];
+    succeeded?: number;
+    failed?: number;
+    consecutiveFailures?: number;
+}
+
+export interface CronWorkflowRun {
+    name: string;
+    scheduledTime?: kubernetes.Time;
+    phase: WorkflowPhase;
+    finishedAt?: kubernetes.Time;
+    duration?: string;
 }
 
 export interface CronWorkflowList {
@@ -24,6 +24,8 @@ export interface WorkflowEventBinding {
         submit?: {
             workflowTemplateRef: WorkflowTemplateRef;
             arguments?: Arguments;
+            idempotencyKey?: string;
+            idempotencyWindow?: string;
         };
     };
 }
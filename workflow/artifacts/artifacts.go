@@ -254,3 +254,13 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 
 	return nil, ErrUnsupportedDriver
 }
+
+// NewArtifactAtKey returns an artifact at the key in the location of an artifact repository, it returns an error if
+// the keys of the artifact repository cannot be set
+func NewArtifactAtKey(location *wfv1.ArtifactLocation, name, key string) (*wfv1.Artifact, error) {
+	art := &wfv1.Artifact{Name: name, ArtifactLocation: *location.DeepCopy()}
+	if err := art.SetKey(key); err != nil {
+		return nil, err
+	}
+	return art, nil
+}
@@ -0,0 +1,73 @@
+package fake
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// MemoryDriver is an artifact driver that stores the objects in memory, keyed by the key of the artifact
+type MemoryDriver struct {
+	common.ArtifactDriver
+	mutex   sync.Mutex
+	Objects map[string][]byte
+	// DeleteNotSupported is whether the driver behaves like that of a repository that cannot delete objects
+	DeleteNotSupported bool
+}
+
+func NewMemoryDriver() *MemoryDriver {
+	return &MemoryDriver{Objects: make(map[string][]byte)}
+}
+
+func (d *MemoryDriver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	key, _ := a.GetKey()
+	data, ok := d.Objects[key]
+	if !ok {
+		return nil, argoerrs.New(argoerrs.CodeNotFound, "object not found")
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (d *MemoryDriver) Save(path string, a *wfv1.Artifact) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	key, _ := a.GetKey()
+	d.Objects[key] = data
+	return nil
+}
+
+func (d *MemoryDriver) Delete(a *wfv1.Artifact) error {
+	if d.DeleteNotSupported {
+		return common.ErrDeleteNotSupported
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	key, _ := a.GetKey()
+	delete(d.Objects, key)
+	return nil
+}
+
+func (d *MemoryDriver) ListObjects(a *wfv1.Artifact) ([]string, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	dir, _ := a.GetKey()
+	var keys []string
+	for key := range d.Objects {
+		if strings.HasPrefix(key, dir+"/") {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
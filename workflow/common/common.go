@@ -39,6 +39,9 @@ const (
 	// AnnotationKeyCronWfScheduledTime is the workflow metadata annotation key containing the time when the workflow
 	// was scheduled to run by CronWorkflow.
 	AnnotationKeyCronWfScheduledTime = workflow.WorkflowFullName + "/scheduled-time"
+	// AnnotationKeyIdempotencyKey is the workflow metadata annotation key containing the idempotency key of the event
+	// that the workflow was submitted for
+	AnnotationKeyIdempotencyKey = workflow.WorkflowFullName + "/idempotency-key"
 
 	// AnnotationKeyWorkflowName is the name of the workflow
 	AnnotationKeyWorkflowName = workflow.WorkflowFullName + "/workflow-name"
@@ -87,6 +90,9 @@ const (
 	LabelKeyWorkflowTemplate = workflow.WorkflowFullName + "/workflow-template"
 	// LabelKeyWorkflowEventBinding is a label applied to Workflows that are submitted from a WorkflowEventBinding
 	LabelKeyWorkflowEventBinding = workflow.WorkflowFullName + "/workflow-event-binding"
+	// LabelKeyIdempotencyKey is a label applied to Workflows that are submitted from a WorkflowEventBinding, containing
+	// a hash of the idempotency key of the event
+	LabelKeyIdempotencyKey = workflow.WorkflowFullName + "/idempotency-key"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from ClusterWorkflowtemplate
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
@@ -204,6 +210,8 @@ const (
 	LabelValueTypeConfigMapParameter = "Parameter"
 	// LabelValueTypeConfigMapExecutorPlugin is a key for configmaps that contains an executor plugin.
 	LabelValueTypeConfigMapExecutorPlugin = "ExecutorPlugin"
+	// LabelValueTypeConfigMapArchiveSpool is a key for configmaps that contain workflows that failed to be archived.
+	LabelValueTypeConfigMapArchiveSpool = "ArchiveSpool"
 
 	// LocalVarPodName is a step level variable that references the name of the pod
 	LocalVarPodName = "pod.name"
@@ -249,6 +257,14 @@ var AnnotationKeyKillCmd = func(containerName string) string { return workflow.W
 // GlobalVarWorkflowRootTags is a list of root tags in workflow which could be used for variable reference
 var GlobalVarValidWorkflowVariablePrefix = []string{"item.", "steps.", "inputs.", "outputs.", "pod.", "workflow.", "tasks."}
 
+// GroupByLabels are the labels that workflows are labelled with by the resources they were created from, keyed by the
+// name used to group workflows by them
+var GroupByLabels = map[string]string{
+	"workflowTemplate":        LabelKeyWorkflowTemplate,
+	"clusterWorkflowTemplate": LabelKeyClusterWorkflowTemplate,
+	"cronWorkflow":            LabelKeyCronWorkflow,
+}
+
 func UnstructuredHasCompletedLabel(obj interface{}) bool {
 	if wf, ok := obj.(*unstructured.Unstructured); ok {
 		return wf.GetLabels()[LabelKeyCompleted] == "true"
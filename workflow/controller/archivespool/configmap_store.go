@@ -0,0 +1,161 @@
+package archivespool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// maxConfigMapSize is the maximum size of the workflows in a ConfigMap, well below the 1MiB limit of a ConfigMap
+const maxConfigMapSize = 512 * 1024
+
+// configMapStore stores the workflows in chunks of ConfigMaps, each workflow is gzipped JSON in the binary data of a
+// ConfigMap keyed by its UID
+type configMapStore struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	lock       sync.Mutex
+}
+
+// NewConfigMapStore returns a store of workflows in ConfigMaps in the namespace
+func NewConfigMapStore(kubeClient kubernetes.Interface, namespace string) Store {
+	return &configMapStore{kubeClient: kubeClient, namespace: namespace}
+}
+
+func (s *configMapStore) list(ctx context.Context) ([]apiv1.ConfigMap, error) {
+	list, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeyConfigMapType + "=" + common.LabelValueTypeConfigMapArchiveSpool,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archive spool config maps: %w", err)
+	}
+	// fill the oldest ConfigMaps first
+	sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].Name < list.Items[j].Name })
+	return list.Items, nil
+}
+
+func size(cm *apiv1.ConfigMap) int {
+	n := 0
+	for _, data := range cm.BinaryData {
+		n += len(data)
+	}
+	return n
+}
+
+func (s *configMapStore) Put(ctx context.Context, wf *wfv1.Workflow) error {
+	data, err := marshalWorkflow(wf)
+	if err != nil {
+		return err
+	}
+	if len(data) > maxConfigMapSize {
+		return fmt.Errorf("workflow %s/%s is too large to spool (%d bytes)", wf.Namespace, wf.Name, len(data))
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	cms, err := s.list(ctx)
+	if err != nil {
+		return err
+	}
+	key := string(wf.UID)
+	// an earlier version of the workflow is replaced in its ConfigMap if the workflow still fits, otherwise the
+	// workflow is moved to another ConfigMap
+	var previous, chunk *apiv1.ConfigMap
+	for i := range cms {
+		cm := &cms[i]
+		if old, ok := cm.BinaryData[key]; ok {
+			previous = cm
+			if size(cm)-len(old)+len(data) <= maxConfigMapSize {
+				chunk = cm
+				break
+			}
+		} else if chunk == nil && size(cm)+len(data) <= maxConfigMapSize {
+			chunk = cm
+		}
+	}
+	if chunk == nil {
+		_, err = s.kubeClient.CoreV1().ConfigMaps(s.namespace).Create(ctx, &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				// the name orders the ConfigMaps by when they were created
+				Name:   fmt.Sprintf("argo-archive-spool-%d", time.Now().UnixNano()),
+				Labels: map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapArchiveSpool},
+			},
+			BinaryData: map[string][]byte{key: data},
+		}, metav1.CreateOptions{})
+	} else {
+		if chunk.BinaryData == nil {
+			chunk.BinaryData = map[string][]byte{}
+		}
+		chunk.BinaryData[key] = data
+		_, err = s.kubeClient.CoreV1().ConfigMaps(s.namespace).Update(ctx, chunk, metav1.UpdateOptions{})
+	}
+	if err != nil || previous == nil || previous == chunk {
+		return err
+	}
+	// the earlier version is only removed once the workflow has been written, so that it is never lost
+	return s.remove(ctx, previous, key)
+}
+
+// remove removes the workflow from the ConfigMap, deleting the ConfigMap if it is then empty
+func (s *configMapStore) remove(ctx context.Context, cm *apiv1.ConfigMap, key string) error {
+	delete(cm.BinaryData, key)
+	if len(cm.BinaryData) == 0 {
+		return s.kubeClient.CoreV1().ConfigMaps(s.namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{})
+	}
+	_, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *configMapStore) Delete(ctx context.Context, uid types.UID) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	cms, err := s.list(ctx)
+	if err != nil {
+		return err
+	}
+	// a workflow may be in two ConfigMaps if the controller stopped while moving it
+	for i := range cms {
+		if _, ok := cms[i].BinaryData[string(uid)]; ok {
+			if err := s.remove(ctx, &cms[i], string(uid)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *configMapStore) List(ctx context.Context) ([]*wfv1.Workflow, error) {
+	cms, err := s.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var wfs []*wfv1.Workflow
+	// a workflow in two ConfigMaps is listed once, as the version that finished last
+	indexes := map[types.UID]int{}
+	for _, cm := range cms {
+		for key, data := range cm.BinaryData {
+			wf, err := unmarshalWorkflow(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal spooled workflow %s in config map %s: %w", key, cm.Name, err)
+			}
+			if i, ok := indexes[wf.UID]; ok {
+				if wfs[i].Status.FinishedAt.Before(&wf.Status.FinishedAt) {
+					wfs[i] = wf
+				}
+				continue
+			}
+			indexes[wf.UID] = len(wfs)
+			wfs = append(wfs, wf)
+		}
+	}
+	return wfs, nil
+}
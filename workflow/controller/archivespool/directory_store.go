@@ -0,0 +1,88 @@
+package archivespool
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const fileSuffix = ".json.gz"
+
+// directoryStore stores each workflow as a gzipped JSON file named after its UID in a directory
+type directoryStore struct {
+	dir string
+}
+
+// NewDirectoryStore returns a store of workflows in the directory, creating it if it does not exist
+func NewDirectoryStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create archive spool directory: %w", err)
+	}
+	return &directoryStore{dir: dir}, nil
+}
+
+func (s *directoryStore) path(uid types.UID) string {
+	return filepath.Join(s.dir, string(uid)+fileSuffix)
+}
+
+func (s *directoryStore) Put(_ context.Context, wf *wfv1.Workflow) error {
+	data, err := marshalWorkflow(wf)
+	if err != nil {
+		return err
+	}
+	// write to a temporary file and rename it, so a crash never leaves a partial workflow in the spool
+	f, err := os.CreateTemp(s.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path(wf.UID))
+}
+
+func (s *directoryStore) Delete(_ context.Context, uid types.UID) error {
+	err := os.Remove(s.path(uid))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *directoryStore) List(context.Context) ([]*wfv1.Workflow, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var wfs []*wfv1.Workflow
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), fileSuffix) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		wf, err := unmarshalWorkflow(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal spooled workflow %s: %w", entry.Name(), err)
+		}
+		wfs = append(wfs, wf)
+	}
+	return wfs, nil
+}
@@ -0,0 +1,146 @@
+package archivespool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+)
+
+// Spool stores the workflows that failed to be archived, and retries archiving them with backoff
+type Spool struct {
+	store          Store
+	archive        func(wf *wfv1.Workflow) error
+	onArchived     func(ctx context.Context, wf *wfv1.Workflow) error
+	maxWorkflows   int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	lock   sync.Mutex
+	loaded bool
+	// versions are the resource versions of the spooled workflows, keyed by UID
+	versions map[types.UID]string
+	backoff  time.Duration
+}
+
+// New returns a spool of workflows in the store. Archive archives a workflow, and onArchived is called after a spooled
+// workflow has been archived and removed from the spool.
+func New(store Store, archive func(wf *wfv1.Workflow) error, onArchived func(ctx context.Context, wf *wfv1.Workflow) error, maxWorkflows int, initialBackoff, maxBackoff time.Duration) *Spool {
+	return &Spool{
+		store:          store,
+		archive:        archive,
+		onArchived:     onArchived,
+		maxWorkflows:   maxWorkflows,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		versions:       map[types.UID]string{},
+		backoff:        initialBackoff,
+	}
+}
+
+// Contains returns whether the workflow is in the spool, as far as is known since the spool was last retried
+func (s *Spool) Contains(uid types.UID) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.versions[uid]
+	return ok
+}
+
+// Add adds the workflow to the spool, replacing any earlier version of it
+func (s *Spool) Add(ctx context.Context, wf *wfv1.Workflow) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.versions[wf.UID]; !ok && len(s.versions) >= s.maxWorkflows {
+		return fmt.Errorf("archive spool is full (%d workflows)", s.maxWorkflows)
+	}
+	if err := s.store.Put(ctx, wf); err != nil {
+		return fmt.Errorf("failed to spool workflow: %w", err)
+	}
+	s.versions[wf.UID] = wf.ResourceVersion
+	metrics.ArchiveSpoolDepthMetric.Set(float64(len(s.versions)))
+	return nil
+}
+
+// Retry tries to archive the workflows in the spool, stopping at the first failure, and returns how long to wait
+// before retrying again
+func (s *Spool) Retry(ctx context.Context) time.Duration {
+	s.lock.Lock()
+	if s.loaded && len(s.versions) == 0 {
+		s.lock.Unlock()
+		return s.initialBackoff
+	}
+	// the workflows are listed every time, so that workflows spooled by an earlier controller are retried
+	wfs, err := s.store.List(ctx)
+	if err != nil {
+		s.lock.Unlock()
+		log.WithError(err).Error("failed to list the workflows in the archive spool")
+		return s.failed()
+	}
+	s.loaded = true
+	s.versions = map[types.UID]string{}
+	for _, wf := range wfs {
+		s.versions[wf.UID] = wf.ResourceVersion
+	}
+	metrics.ArchiveSpoolDepthMetric.Set(float64(len(s.versions)))
+	s.lock.Unlock()
+
+	for _, wf := range wfs {
+		logCtx := log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "uid": wf.UID})
+		if err := s.archive(wf); err != nil {
+			metrics.ArchiveFailuresMetric.Inc()
+			logCtx.WithError(err).Warn("failed to archive spooled workflow")
+			return s.failed()
+		}
+		removed, err := s.remove(ctx, wf)
+		if err != nil {
+			logCtx.WithError(err).Error("failed to remove archived workflow from the archive spool")
+			return s.failed()
+		}
+		if !removed {
+			logCtx.Info("spooled workflow was updated while it was archived, archiving the update on the next retry")
+			continue
+		}
+		logCtx.Info("archived spooled workflow")
+		if err := s.onArchived(ctx, wf); err != nil {
+			logCtx.WithError(err).Error("failed to mark spooled workflow as archived")
+		}
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.backoff = s.initialBackoff
+	return s.backoff
+}
+
+// remove removes the archived workflow from the spool, unless a newer version of it has been spooled since it was
+// listed, and returns whether it was removed
+func (s *Spool) remove(ctx context.Context, wf *wfv1.Workflow) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if version, ok := s.versions[wf.UID]; ok && version != wf.ResourceVersion {
+		return false, nil
+	}
+	if err := s.store.Delete(ctx, wf.UID); err != nil {
+		return false, err
+	}
+	delete(s.versions, wf.UID)
+	metrics.ArchiveSpoolDepthMetric.Set(float64(len(s.versions)))
+	return true, nil
+}
+
+// failed doubles the backoff, up to the maximum, and returns it
+func (s *Spool) failed() time.Duration {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	backoff := s.backoff
+	s.backoff *= 2
+	if s.backoff > s.maxBackoff {
+		s.backoff = s.maxBackoff
+	}
+	return backoff
+}
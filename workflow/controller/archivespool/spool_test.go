@@ -0,0 +1,87 @@
+package archivespool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestSpool(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewDirectoryStore(t.TempDir())
+	require.NoError(t, err)
+	archiveErr := errors.New("database unavailable")
+	var archived, marked []string
+	archive := func(wf *wfv1.Workflow) error {
+		if archiveErr != nil {
+			return archiveErr
+		}
+		archived = append(archived, wf.Name)
+		return nil
+	}
+	onArchived := func(_ context.Context, wf *wfv1.Workflow) error {
+		marked = append(marked, wf.Name)
+		return nil
+	}
+	s := New(store, archive, onArchived, 2, time.Second, 3*time.Second)
+
+	require.NoError(t, s.Add(ctx, newWorkflow("foo")))
+	require.NoError(t, s.Add(ctx, newWorkflow("bar")))
+	assert.True(t, s.Contains("uid-foo"))
+	assert.Error(t, s.Add(ctx, newWorkflow("baz")), "the spool is full")
+	assert.NoError(t, s.Add(ctx, newWorkflow("foo")), "replacing a workflow does not need room")
+
+	// the backoff doubles, up to the maximum
+	assert.Equal(t, time.Second, s.Retry(ctx))
+	assert.Equal(t, 2*time.Second, s.Retry(ctx))
+	assert.Equal(t, 3*time.Second, s.Retry(ctx))
+	assert.Equal(t, 3*time.Second, s.Retry(ctx))
+	assert.Empty(t, archived)
+
+	archiveErr = nil
+	assert.Equal(t, time.Second, s.Retry(ctx))
+	assert.ElementsMatch(t, []string{"foo", "bar"}, archived)
+	assert.ElementsMatch(t, []string{"foo", "bar"}, marked)
+	assert.False(t, s.Contains("uid-foo"))
+	assert.Empty(t, names(t, store))
+
+	t.Run("UpdatedWhileArchiving", func(t *testing.T) {
+		s := New(store, archive, onArchived, 2, time.Second, 3*time.Second)
+		wf := newWorkflow("updated")
+		wf.ResourceVersion = "1"
+		require.NoError(t, s.Add(ctx, wf))
+		updated := wf.DeepCopy()
+		updated.ResourceVersion = "2"
+		var archivedVersions []string
+		s.archive = func(wf *wfv1.Workflow) error {
+			archivedVersions = append(archivedVersions, wf.ResourceVersion)
+			if wf.ResourceVersion == "1" {
+				// the workflow is updated after the spool was listed, and before the archived version is removed
+				require.NoError(t, s.Add(ctx, updated))
+			}
+			return nil
+		}
+		marked = nil
+		s.Retry(ctx)
+		assert.True(t, s.Contains(wf.UID), "the update is kept")
+		assert.Empty(t, marked, "the update is not marked as archived")
+		s.Retry(ctx)
+		assert.Equal(t, []string{"1", "2"}, archivedVersions)
+		assert.Equal(t, []string{"updated"}, marked)
+		assert.False(t, s.Contains(wf.UID))
+		assert.Empty(t, names(t, store))
+	})
+	t.Run("Restart", func(t *testing.T) {
+		require.NoError(t, store.Put(ctx, newWorkflow("spooled-before-restart")))
+		archived = nil
+		s := New(store, archive, onArchived, 2, time.Second, 3*time.Second)
+		s.Retry(ctx)
+		assert.Equal(t, []string{"spooled-before-restart"}, archived)
+	})
+}
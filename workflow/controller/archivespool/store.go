@@ -0,0 +1,37 @@
+package archivespool
+
+import (
+	"context"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/file"
+)
+
+// Store durably stores the workflows in the spool, keyed by their UIDs
+type Store interface {
+	// Put stores the workflow, replacing any workflow with the same UID
+	Put(ctx context.Context, wf *wfv1.Workflow) error
+	// Delete deletes the workflow, it is not an error if the workflow is not stored
+	Delete(ctx context.Context, uid types.UID) error
+	List(ctx context.Context) ([]*wfv1.Workflow, error)
+}
+
+func marshalWorkflow(wf *wfv1.Workflow) ([]byte, error) {
+	data, err := json.Marshal(wf)
+	if err != nil {
+		return nil, err
+	}
+	return file.CompressContent(data), nil
+}
+
+func unmarshalWorkflow(data []byte) (*wfv1.Workflow, error) {
+	data, err := file.DecompressContent(data)
+	if err != nil {
+		return nil, err
+	}
+	wf := &wfv1.Workflow{}
+	return wf, json.Unmarshal(data, wf)
+}
@@ -0,0 +1,120 @@
+package archivespool
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func newWorkflow(name string) *wfv1.Workflow {
+	return &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", UID: types.UID("uid-" + name)}}
+}
+
+func names(t *testing.T, store Store) []string {
+	wfs, err := store.List(context.Background())
+	require.NoError(t, err)
+	var names []string
+	for _, wf := range wfs {
+		names = append(names, wf.Name)
+	}
+	return names
+}
+
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+	assert.Empty(t, names(t, store))
+	require.NoError(t, store.Put(ctx, newWorkflow("foo")))
+	require.NoError(t, store.Put(ctx, newWorkflow("bar")))
+	wf := newWorkflow("foo")
+	wf.Status.Phase = wfv1.WorkflowFailed
+	require.NoError(t, store.Put(ctx, wf))
+	assert.ElementsMatch(t, []string{"foo", "bar"}, names(t, store))
+
+	require.NoError(t, store.Delete(ctx, "uid-foo"))
+	require.NoError(t, store.Delete(ctx, "uid-foo"))
+	assert.Equal(t, []string{"bar"}, names(t, store))
+}
+
+func TestDirectoryStore(t *testing.T) {
+	store, err := NewDirectoryStore(t.TempDir())
+	require.NoError(t, err)
+	testStore(t, store)
+}
+
+func TestConfigMapStore(t *testing.T) {
+	t.Run("Store", func(t *testing.T) {
+		testStore(t, NewConfigMapStore(fake.NewSimpleClientset(), "argo"))
+	})
+	t.Run("Chunks", func(t *testing.T) {
+		ctx := context.Background()
+		kubeClient := fake.NewSimpleClientset()
+		store := NewConfigMapStore(kubeClient, "argo")
+		// random data does not compress, so only one of these workflows fits in a ConfigMap
+		data := make([]byte, maxConfigMapSize/2)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		for _, name := range []string{"foo", "bar"} {
+			wf := newWorkflow(name)
+			wf.Status.Message = base64.StdEncoding.EncodeToString(data)
+			require.NoError(t, store.Put(ctx, wf))
+		}
+		cms, err := kubeClient.CoreV1().ConfigMaps("argo").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, cms.Items, 2)
+		assert.ElementsMatch(t, []string{"foo", "bar"}, names(t, store))
+
+		// empty ConfigMaps are deleted
+		require.NoError(t, store.Delete(ctx, "uid-foo"))
+		cms, err = kubeClient.CoreV1().ConfigMaps("argo").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, cms.Items, 1)
+	})
+	t.Run("Grow", func(t *testing.T) {
+		ctx := context.Background()
+		kubeClient := fake.NewSimpleClientset()
+		store := NewConfigMapStore(kubeClient, "argo")
+		// two of these workflows fit in a ConfigMap, until one of them grows
+		data := make([]byte, maxConfigMapSize/2)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		for _, name := range []string{"foo", "bar"} {
+			wf := newWorkflow(name)
+			wf.Status.Message = base64.StdEncoding.EncodeToString(data[:maxConfigMapSize*3/10])
+			require.NoError(t, store.Put(ctx, wf))
+		}
+		// a workflow that no longer fits in its ConfigMap is moved to another
+		wf := newWorkflow("foo")
+		wf.Status.Message = base64.StdEncoding.EncodeToString(data)
+		wf.Status.Phase = wfv1.WorkflowFailed
+		cms, err := kubeClient.CoreV1().ConfigMaps("argo").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, cms.Items, 1)
+		require.NoError(t, store.Put(ctx, wf))
+		cms, err = kubeClient.CoreV1().ConfigMaps("argo").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		if assert.Len(t, cms.Items, 2) {
+			for _, cm := range cms.Items {
+				assert.Len(t, cm.BinaryData, 1)
+				assert.LessOrEqual(t, size(&cm), maxConfigMapSize)
+			}
+		}
+		wfs, err := store.List(ctx)
+		require.NoError(t, err)
+		if assert.Len(t, wfs, 2) {
+			for _, wf := range wfs {
+				if wf.Name == "foo" {
+					assert.Equal(t, wfv1.WorkflowFailed, wf.Status.Phase)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,276 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// artifactRepositoryCacheDir is the directory of the artifact repository in which the caches are stored, each entry
+// is stored as a JSON object at <dir>/<cache name>/<key>.json
+const artifactRepositoryCacheDir = "memoization-caches"
+
+// artifactRepositoryCache is a cache whose entries are stored as objects in an artifact repository, so that it
+// is not limited by the size of a ConfigMap, and can memoize large outputs and many entries
+type artifactRepositoryCache struct {
+	namespace  string
+	name       string
+	location   *wfv1.ArtifactLocation
+	kubeClient kubernetes.Interface
+	newDriver  artifact.NewDriverFunc
+}
+
+func NewArtifactRepositoryCache(ns string, ki kubernetes.Interface, repo *wfv1.ArtifactRepository, n string) MemoizationCache {
+	return newArtifactRepositoryCache(ns, ki, repo, n, artifact.NewDriver)
+}
+
+func newArtifactRepositoryCache(ns string, ki kubernetes.Interface, repo *wfv1.ArtifactRepository, n string, newDriver artifact.NewDriverFunc) *artifactRepositoryCache {
+	return &artifactRepositoryCache{
+		namespace:  ns,
+		name:       n,
+		location:   repo.ToArtifactLocation(),
+		kubeClient: ki,
+		newDriver:  newDriver,
+	}
+}
+
+func (c *artifactRepositoryCache) logError(err error, fields log.Fields, message string) {
+	log.WithFields(log.Fields{"namespace": c.namespace, "name": c.name}).WithFields(fields).WithError(err).Debug(message)
+}
+
+func (c *artifactRepositoryCache) logInfo(fields log.Fields, message string) {
+	log.WithFields(log.Fields{"namespace": c.namespace, "name": c.name}).WithFields(fields).Info(message)
+}
+
+// entryArtifact returns the artifact in which the entry of the key is stored
+func (c *artifactRepositoryCache) entryArtifact(key string) (*wfv1.Artifact, error) {
+	return c.artifact(key, path.Join(artifactRepositoryCacheDir, c.name, key+".json"))
+}
+
+// artifact returns the artifact at the key in the artifact repository
+func (c *artifactRepositoryCache) artifact(name, key string) (*wfv1.Artifact, error) {
+	if _, err := c.location.Get(); err != nil {
+		return nil, fmt.Errorf("artifact repository cache %s requires an artifact repository", c.name)
+	}
+	art, err := artifact.NewArtifactAtKey(c.location, name, key)
+	if err != nil {
+		return nil, fmt.Errorf("artifact repository cache %s is not supported by the artifact repository: %w", c.name, err)
+	}
+	return art, nil
+}
+
+func (c *artifactRepositoryCache) Load(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	art, err := c.entryArtifact(key)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := c.newDriver(ctx, art, resources{c.kubeClient, c.namespace})
+	if err != nil {
+		return nil, fmt.Errorf("could not load artifact repository cache: %w", err)
+	}
+
+	hitTime := time.Now()
+	entry, err := c.load(driver, art)
+	if err != nil {
+		c.logError(err, log.Fields{"key": key}, "Error loading artifact repository cache")
+		return nil, err
+	}
+	if entry == nil {
+		c.logInfo(log.Fields{"key": key}, "artifact repository cache miss: entry does not exist")
+		return nil, nil
+	}
+	c.logInfo(log.Fields{"key": key}, "artifact repository cache loaded")
+
+	// the entry is returned even if the last hit timestamp cannot be recorded, as the outputs are still valid
+	entry.LastHitTimestamp = metav1.Time{Time: hitTime}
+	if err := c.save(driver, art, entry); err != nil {
+		c.logError(err, log.Fields{"key": key}, "Error updating last hit timestamp on cache")
+	}
+	return entry, nil
+}
+
+// load reads the entry from the artifact repository, it returns nil if the entry does not exist
+func (c *artifactRepositoryCache) load(driver common.ArtifactDriver, art *wfv1.Artifact) (*Entry, error) {
+	stream, err := driver.OpenStream(art)
+	if err != nil {
+		if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not load artifact repository cache: %w", err)
+	}
+	defer func() { _ = stream.Close() }()
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("could not load artifact repository cache: %w", err)
+	}
+
+	var entry Entry
+	err = json.Unmarshal(data, &entry)
+	if err != nil {
+		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+	}
+	return &entry, nil
+}
+
+func (c *artifactRepositoryCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error {
+	if !cacheKeyRegex.MatchString(key) {
+		err := fmt.Errorf("invalid cache key: %s", key)
+		c.logError(err, log.Fields{"key": key}, err.Error())
+		return err
+	}
+	c.logInfo(log.Fields{"key": key, "nodeId": nodeId}, "Saving artifact repository cache entry")
+
+	art, err := c.entryArtifact(key)
+	if err != nil {
+		return err
+	}
+	driver, err := c.newDriver(ctx, art, resources{c.kubeClient, c.namespace})
+	if err != nil {
+		return fmt.Errorf("could not save to artifact repository cache: %w", err)
+	}
+	creationTime := time.Now()
+	err = c.save(driver, art, &Entry{
+		NodeID:            nodeId,
+		Outputs:           value,
+		CreationTimestamp: metav1.Time{Time: creationTime},
+		LastHitTimestamp:  metav1.Time{Time: creationTime},
+	})
+	if err != nil {
+		c.logError(err, log.Fields{"key": key, "nodeId": nodeId}, "Error saving to artifact repository cache")
+		return fmt.Errorf("could not save to artifact repository cache: %w", err)
+	}
+	return nil
+}
+
+func (c *artifactRepositoryCache) Get(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	art, err := c.entryArtifact(key)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := c.newDriver(ctx, art, resources{c.kubeClient, c.namespace})
+	if err != nil {
+		return nil, fmt.Errorf("could not load artifact repository cache: %w", err)
+	}
+	return c.load(driver, art)
+}
+
+func (c *artifactRepositoryCache) List(ctx context.Context) (map[string]*Entry, error) {
+	dir, err := c.artifact(c.name, path.Join(artifactRepositoryCacheDir, c.name))
+	if err != nil {
+		return nil, err
+	}
+	driver, err := c.newDriver(ctx, dir, resources{c.kubeClient, c.namespace})
+	if err != nil {
+		return nil, fmt.Errorf("could not list artifact repository cache: %w", err)
+	}
+	objects, err := driver.ListObjects(dir)
+	if err != nil {
+		if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+			return map[string]*Entry{}, nil
+		}
+		return nil, fmt.Errorf("could not list artifact repository cache: %w", err)
+	}
+
+	entries := make(map[string]*Entry)
+	for _, object := range objects {
+		key := strings.TrimSuffix(path.Base(object), ".json")
+		if !strings.HasSuffix(object, ".json") || !cacheKeyRegex.MatchString(key) {
+			continue
+		}
+		art, err := c.entryArtifact(key)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := c.load(driver, art)
+		if err != nil {
+			return nil, fmt.Errorf("could not load entry %s: %w", key, err)
+		}
+		if entry != nil {
+			entries[key] = entry
+		}
+	}
+	return entries, nil
+}
+
+func (c *artifactRepositoryCache) Delete(ctx context.Context, key string) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+	art, err := c.entryArtifact(key)
+	if err != nil {
+		return err
+	}
+	driver, err := c.newDriver(ctx, art, resources{c.kubeClient, c.namespace})
+	if err != nil {
+		return fmt.Errorf("could not delete from artifact repository cache: %w", err)
+	}
+	c.logInfo(log.Fields{"key": key}, "Deleting artifact repository cache entry")
+	if err := driver.Delete(art); err != nil && !argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		return fmt.Errorf("could not delete from artifact repository cache: %w", err)
+	}
+	return nil
+}
+
+// save writes the entry to a temporary file, which the driver uploads to the artifact repository
+func (c *artifactRepositoryCache) save(driver common.ArtifactDriver, art *wfv1.Artifact, entry *Entry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	f, err := os.CreateTemp("", "memoization-cache-entry-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	_, err = f.Write(entryJSON)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return driver.Save(f.Name(), art)
+}
+
+// resources gives the artifact drivers access to the credentials of the artifact repository, which are in the
+// namespace of the workflow
+type resources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func (r resources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r resources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	artifactsfake "github.com/argoproj/argo-workflows/v3/workflow/artifacts/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+func newTestArtifactRepositoryCache(repo *wfv1.ArtifactRepository) (*artifactRepositoryCache, *artifactsfake.MemoryDriver) {
+	driver := artifactsfake.NewMemoryDriver()
+	newDriver := func(context.Context, *wfv1.Artifact, resource.Interface) (common.ArtifactDriver, error) {
+		return driver, nil
+	}
+	return newArtifactRepositoryCache("default", fake.NewSimpleClientset(), repo, "my-cache", newDriver), driver
+}
+
+func TestArtifactRepositoryCache(t *testing.T) {
+	ctx := context.Background()
+	repo := &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, KeyFormat: "{{workflow.name}}/{{pod.name}}"}}
+	outputs := &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("foobar")}}}
+
+	t.Run("Miss", func(t *testing.T) {
+		c, _ := newTestArtifactRepositoryCache(repo)
+		entry, err := c.Load(ctx, "hi-there-world")
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+		entry, err = c.Get(ctx, "hi-there-world")
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+	t.Run("Hit", func(t *testing.T) {
+		c, driver := newTestArtifactRepositoryCache(repo)
+		err := c.Save(ctx, "hi-there-world", "my-node", outputs)
+		assert.NoError(t, err)
+		assert.Contains(t, driver.Objects, "memoization-caches/my-cache/hi-there-world.json")
+
+		entry, err := c.Get(ctx, "hi-there-world")
+		assert.NoError(t, err)
+		if assert.NotNil(t, entry) {
+			assert.Equal(t, entry.CreationTimestamp, entry.LastHitTimestamp, "getting an entry is not a hit")
+		}
+
+		entry, err = c.Load(ctx, "hi-there-world")
+		assert.NoError(t, err)
+		if assert.NotNil(t, entry) {
+			assert.Equal(t, "my-node", entry.NodeID)
+			assert.Equal(t, outputs, entry.GetOutputs())
+			assert.False(t, entry.LastHitTimestamp.Before(&entry.CreationTimestamp))
+			_, ok := entry.GetOutputsWithMaxAge(time.Hour)
+			assert.True(t, ok)
+			entry.CreationTimestamp.Time = time.Now().Add(-2 * time.Hour)
+			_, ok = entry.GetOutputsWithMaxAge(time.Hour)
+			assert.False(t, ok)
+		}
+	})
+	t.Run("ListAndDelete", func(t *testing.T) {
+		c, driver := newTestArtifactRepositoryCache(repo)
+		entries, err := c.List(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, entries)
+
+		assert.NoError(t, c.Save(ctx, "one", "node-1", outputs))
+		assert.NoError(t, c.Save(ctx, "two", "node-2", outputs))
+		driver.Objects["memoization-caches/other-cache/three.json"] = []byte("{}")
+		entries, err = c.List(ctx)
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 2) {
+			assert.Equal(t, "node-1", entries["one"].NodeID)
+			assert.Equal(t, "node-2", entries["two"].NodeID)
+		}
+
+		assert.NoError(t, c.Delete(ctx, "one"))
+		assert.NoError(t, c.Delete(ctx, "missing"))
+		entries, err = c.List(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+		assert.Contains(t, entries, "two")
+	})
+	t.Run("InvalidKey", func(t *testing.T) {
+		c, _ := newTestArtifactRepositoryCache(repo)
+		_, err := c.Load(ctx, "hi there")
+		assert.EqualError(t, err, "invalid cache key: hi there")
+		err = c.Save(ctx, "hi there", "my-node", outputs)
+		assert.EqualError(t, err, "invalid cache key: hi there")
+	})
+	t.Run("NoArtifactRepository", func(t *testing.T) {
+		c, _ := newTestArtifactRepositoryCache(&wfv1.ArtifactRepository{})
+		_, err := c.Load(ctx, "hi-there-world")
+		assert.EqualError(t, err, "artifact repository cache my-cache requires an artifact repository")
+	})
+}
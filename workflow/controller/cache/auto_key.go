@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// ErrNoContentIdentity is returned by AutoKey when the content of an input artifact cannot be identified
+var ErrNoContentIdentity = errors.New("an input artifact has no checksum, so the memoization key cannot identify its content")
+
+// AutoKey returns the key of a template whose memoization key is "auto". The key is a hash of the resolved template,
+// which includes the values of its input parameters and its input artifacts. An input artifact with a checksum is
+// identified by its checksum, so that the key changes when its content changes, and not when it is stored elsewhere.
+// A raw input artifact is identified by its content. The content of any other input artifact, such as an object in
+// a bucket that may be overwritten, cannot be identified by its location, so ErrNoContentIdentity is returned.
+func AutoKey(tmpl *wfv1.Template) (string, error) {
+	t := tmpl.DeepCopy()
+	// these fields do not change the outputs of the template
+	t.Memoize = nil
+	t.Metrics = nil
+	t.Synchronization = nil
+	t.RetryStrategy = nil
+	t.ActiveDeadlineSeconds = nil
+	t.Timeout = ""
+	for i, art := range t.Inputs.Artifacts {
+		switch {
+		case art.Checksum != "":
+			t.Inputs.Artifacts[i] = wfv1.Artifact{Name: art.Name, Path: art.Path, Mode: art.Mode, Checksum: art.Checksum}
+		case art.Raw != nil, !art.HasLocationOrKey():
+		default:
+			return "", fmt.Errorf("%w: %s", ErrNoContentIdentity, art.Name)
+		}
+	}
+	// maps are marshalled with sorted keys, so the JSON is canonical
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal template to derive the memoization key: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
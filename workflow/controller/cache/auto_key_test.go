@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestAutoKey(t *testing.T) {
+	tmpl := &wfv1.Template{
+		Name: "main",
+		Inputs: wfv1.Inputs{
+			Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}},
+			Artifacts: []wfv1.Artifact{{
+				Name:             "data",
+				Path:             "/tmp/data",
+				ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/data.tgz"}},
+				Checksum:         "sha256:abc",
+			}},
+		},
+		Memoize:   &wfv1.Memoize{Key: wfv1.MemoizeKeyAuto, Cache: &wfv1.Cache{ConfigMap: &apiv1.ConfigMapKeySelector{}}},
+		Container: &apiv1.Container{Image: "alpine"},
+	}
+	key, err := AutoKey(tmpl)
+	assert.NoError(t, err)
+	assert.Regexp(t, cacheKeyRegex, key)
+
+	keyOf := func(f func(tmpl *wfv1.Template)) string {
+		t.Helper()
+		tmpl := tmpl.DeepCopy()
+		f(tmpl)
+		key, err := AutoKey(tmpl)
+		assert.NoError(t, err)
+		return key
+	}
+	assert.Equal(t, key, keyOf(func(tmpl *wfv1.Template) {}))
+	assert.Equal(t, key, keyOf(func(tmpl *wfv1.Template) { tmpl.Memoize.MaxAge = "1h" }), "cache settings are ignored")
+	assert.Equal(t, key, keyOf(func(tmpl *wfv1.Template) { tmpl.Inputs.Artifacts[0].S3.Key = "other-wf/data.tgz" }), "artifacts with checksums are identified by their checksum")
+	assert.NotEqual(t, key, keyOf(func(tmpl *wfv1.Template) { tmpl.Inputs.Artifacts[0].Checksum = "sha256:def" }))
+	assert.NotEqual(t, key, keyOf(func(tmpl *wfv1.Template) { tmpl.Inputs.Parameters[0].Value = wfv1.AnyStringPtr("world") }))
+	assert.NotEqual(t, key, keyOf(func(tmpl *wfv1.Template) { tmpl.Container.Image = "debian" }))
+	assert.NotEqual(t, key, keyOf(func(tmpl *wfv1.Template) {
+		tmpl.Inputs.Artifacts[0] = wfv1.Artifact{Name: "data", Path: "/tmp/data", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "hello"}}}
+	}), "raw artifacts are identified by their content")
+
+	t.Run("NoChecksum", func(t *testing.T) {
+		tmpl := tmpl.DeepCopy()
+		tmpl.Inputs.Artifacts[0].Checksum = ""
+		_, err := AutoKey(tmpl)
+		assert.ErrorIs(t, err, ErrNoContentIdentity, "the content at the location of the artifact may change")
+	})
+}
@@ -16,6 +16,12 @@ var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
 type MemoizationCache interface {
 	Load(ctx context.Context, key string) (*Entry, error)
 	Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error
+	// Get returns the entry of the key, or nil if it does not exist, without updating its last hit timestamp
+	Get(ctx context.Context, key string) (*Entry, error)
+	// List returns the entries of the cache by key, without updating their last hit timestamps
+	List(ctx context.Context) (map[string]*Entry, error)
+	// Delete deletes the entry of the key, it is not an error if the entry does not exist
+	Delete(ctx context.Context, key string) error
 }
 
 type Entry struct {
@@ -55,6 +61,8 @@ type cacheFactory struct {
 
 type Factory interface {
 	GetCache(ct CacheType, name string) MemoizationCache
+	// GetArtifactRepositoryCache returns a cache stored in the artifact repository, whose credentials are in the namespace
+	GetArtifactRepositoryCache(namespace string, repo *wfv1.ArtifactRepository, name string) MemoizationCache
 }
 
 func NewCacheFactory(ki kubernetes.Interface, ns string) Factory {
@@ -68,8 +76,9 @@ func NewCacheFactory(ki kubernetes.Interface, ns string) Factory {
 type CacheType string
 
 const (
-	// Only config maps are currently supported for caching
 	ConfigMapCache CacheType = "ConfigMapCache"
+	// ArtifactRepositoryCache stores each entry as an object in an artifact repository
+	ArtifactRepositoryCache CacheType = "ArtifactRepositoryCache"
 )
 
 // Returns a cache if it exists and creates it otherwise
@@ -87,3 +96,9 @@ func (cf *cacheFactory) GetCache(ct CacheType, name string) MemoizationCache {
 		return nil
 	}
 }
+
+// GetArtifactRepositoryCache returns a cache stored in the artifact repository. The entries of the cache are in the
+// artifact repository, so it does not need to be shared.
+func (cf *cacheFactory) GetArtifactRepositoryCache(namespace string, repo *wfv1.ArtifactRepository, name string) MemoizationCache {
+	return NewArtifactRepositoryCache(namespace, cf.kubeclient, repo, name)
+}
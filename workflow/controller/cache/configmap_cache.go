@@ -145,3 +145,80 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 	}
 	return nil
 }
+
+func (c *configMapCache) Get(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not load config map cache: %w", err)
+	}
+	rawEntry, ok := cm.Data[key]
+	if !ok || rawEntry == "" {
+		return nil, nil
+	}
+	var entry Entry
+	if err := json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+	}
+	return &entry, nil
+}
+
+func (c *configMapCache) List(ctx context.Context) (map[string]*Entry, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return map[string]*Entry{}, nil
+		}
+		return nil, fmt.Errorf("could not load config map cache: %w", err)
+	}
+
+	entries := make(map[string]*Entry)
+	for key, rawEntry := range cm.Data {
+		var entry Entry
+		if err := json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+			return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", key, err)
+		}
+		entries[key] = &entry
+	}
+	return entries, nil
+}
+
+func (c *configMapCache) Delete(ctx context.Context, key string) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("could not load config map cache: %w", err)
+	}
+	if _, ok := cm.Data[key]; !ok {
+		return nil
+	}
+
+	c.logInfo(log.Fields{"key": key}, "Deleting ConfigMap cache entry")
+	delete(cm.Data, key)
+	_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("error deleting cache entry: %w", err)
+	}
+	return nil
+}
@@ -98,3 +98,30 @@ func TestConfigMapCacheSave(t *testing.T) {
 	wfv1.MustUnmarshal([]byte(cm.Data["hi-there-world"]), &entry)
 	assert.Equal(t, entry.LastHitTimestamp.Time, entry.CreationTimestamp.Time)
 }
+
+func TestConfigMapCacheListAndDelete(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+
+	ctx := context.Background()
+	c := cache.NewConfigMapCache("default", controller.kubeclientset, "whalesay-cache")
+	entries, err := c.List(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, &sampleConfigMapCacheEntry, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	entries, err = c.List(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "memoized-simple-workflow-5wj2p", entries["hi-there-world"].NodeID)
+		// listing does not record a hit
+		assert.True(t, entries["hi-there-world"].LastHitTimestamp.IsZero())
+	}
+
+	assert.NoError(t, c.Delete(ctx, "hi-there-world"))
+	assert.NoError(t, c.Delete(ctx, "missing"))
+	entries, err = c.List(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -11,9 +11,12 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3"
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/archivespool"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 )
 
@@ -27,9 +30,28 @@ func (wfc *WorkflowController) updateConfig() error {
 	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
 	wfc.offloadNodeStatusRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = sqldb.NullWorkflowArchive
+	wfc.archiveSpool.Store(nil)
 	wfc.archiveLabelSelector = labels.Everything()
 	persistence := wfc.Config.Persistence
-	if persistence != nil {
+	if persistence != nil && persistence.ObjectStorage != nil {
+		log.Info("Object storage persistence configuration enabled")
+		if persistence.NodeStatusOffload {
+			return fmt.Errorf("node status offloading requires a database, it is not supported by object storage persistence")
+		}
+		if persistence.Archive {
+			wfc.archiveLabelSelector, err = persistence.GetArchiveLabelSelector()
+			if err != nil {
+				return err
+			}
+			wfc.wfArchive, err = sqldb.NewObjectStorageWorkflowArchive(wfc.kubeclientset, wfc.namespace, persistence.ObjectStorage, persistence.GetClusterName(), wfc.managedNamespace, instanceid.NewService(wfc.Config.InstanceID))
+			if err != nil {
+				return err
+			}
+			log.Info("Workflow archiving is enabled")
+		} else {
+			log.Info("Workflow archiving is disabled")
+		}
+	} else if persistence != nil {
 		log.Info("Persistence configuration enabled")
 		session, tableName, err := sqldb.CreateDBSession(wfc.kubeclientset, wfc.namespace, persistence)
 		if err != nil {
@@ -70,6 +92,14 @@ func (wfc *WorkflowController) updateConfig() error {
 	} else {
 		log.Info("Persistence configuration disabled")
 	}
+	if persistence != nil && persistence.Archive && persistence.ArchiveSpool != nil {
+		spool, err := wfc.newArchiveSpool(persistence.ArchiveSpool)
+		if err != nil {
+			return err
+		}
+		wfc.archiveSpool.Store(spool)
+		log.Info("Workflow archive spool is enabled")
+	}
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
 	wfc.updateEstimatorFactory()
 	wfc.rateLimiter = wfc.newRateLimiter()
@@ -81,6 +111,20 @@ func (wfc *WorkflowController) updateConfig() error {
 	return nil
 }
 
+// newArchiveSpool returns a spool of the workflows that failed to be archived
+func (wfc *WorkflowController) newArchiveSpool(c *config.ArchiveSpoolConfig) (*archivespool.Spool, error) {
+	store := archivespool.NewConfigMapStore(wfc.kubeclientset, wfc.namespace)
+	if c.Directory != "" {
+		var err error
+		store, err = archivespool.NewDirectoryStore(c.Directory)
+		if err != nil {
+			return nil, err
+		}
+	}
+	archive := func(wf *wfv1.Workflow) error { return wfc.wfArchive.ArchiveWorkflow(wf) }
+	return archivespool.New(store, archive, wfc.markWorkflowArchived, c.GetMaxWorkflows(), c.GetInitialBackoff(), c.GetMaxBackoff()), nil
+}
+
 func (wfc *WorkflowController) newRateLimiter() *rate.Limiter {
 	return rate.NewLimiter(rate.Limit(wfc.Config.GetResourceRateLimit().Limit), wfc.Config.GetResourceRateLimit().Burst)
 }
@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -36,7 +36,6 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/config"
-	argoErr "github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
@@ -49,6 +48,7 @@ import (
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/archivespool"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/estimation"
@@ -111,6 +111,7 @@ type WorkflowController struct {
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
 	wfArchive             sqldb.WorkflowArchive
+	archiveSpool          atomic.Pointer[archivespool.Spool] // nil unless the archive spool is configured, replaced when the configuration changes
 	estimatorFactory      estimation.EstimatorFactory
 	syncManager           *sync.Manager
 	metrics               *metrics.Metrics
@@ -202,17 +203,36 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 
 func (wfc *WorkflowController) newThrottler() sync.Throttler {
 	f := func(key string) { wfc.wfQueue.AddRateLimited(key) }
+	parallelismThrottler := sync.NewThrottler(wfc.Config.Parallelism, sync.SingleBucket, f)
+	if fairShare := wfc.Config.FairShare; fairShare != nil {
+		parallelismThrottler = sync.NewFairShareThrottler(wfc.Config.Parallelism, wfc.tenantFunc(fairShare.TenantLabel), fairShare.GetShare, f)
+	}
 	return sync.ChainThrottler{
-		sync.NewThrottler(wfc.Config.Parallelism, sync.SingleBucket, f),
+		parallelismThrottler,
 		sync.NewThrottler(wfc.Config.NamespaceParallelism, sync.NamespaceBucket, f),
 	}
 }
 
+// tenantFunc returns the tenant of a workflow for fair share, which is the value of its tenant label, or its namespace
+func (wfc *WorkflowController) tenantFunc(tenantLabel string) sync.BucketFunc {
+	return func(key sync.Key) sync.BucketKey {
+		if tenantLabel != "" && wfc.wfInformer != nil {
+			obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(key)
+			if err == nil && exists {
+				if tenant := obj.(*unstructured.Unstructured).GetLabels()[tenantLabel]; tenant != "" {
+					return tenant
+				}
+			}
+		}
+		return sync.NamespaceBucket(key)
+	}
+}
+
 // runGCcontroller runs the workflow garbage collector controller
 func (wfc *WorkflowController) runGCcontroller(ctx context.Context, workflowTTLWorkers int) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
-	gcCtrl := gccontroller.NewController(wfc.wfclientset, wfc.wfInformer, wfc.metrics, wfc.Config.RetentionPolicy)
+	gcCtrl := gccontroller.NewController(wfc.wfclientset, wfc.wfInformer, wfc.metrics, wfc.Config.RetentionPolicy, func() bool { return wfc.archiveSpool.Load() != nil })
 	err := gcCtrl.Run(ctx.Done(), workflowTTLWorkers)
 	if err != nil {
 		panic(err)
@@ -222,7 +242,7 @@ func (wfc *WorkflowController) runGCcontroller(ctx context.Context, workflowTTLW
 func (wfc *WorkflowController) runCronController(ctx context.Context) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
-	cronController := cron.NewCronController(wfc.wfclientset, wfc.dynamicInterface, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager)
+	cronController := cron.NewCronController(wfc.wfclientset, wfc.kubeclientset, wfc.dynamicInterface, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager)
 	cronController.Run(ctx)
 }
 
@@ -268,6 +288,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 
 	// Create Synchronization Manager
 	wfc.createSynchronizationManager(ctx)
+	wfc.metrics.SetSemaphoreStatesFunc(wfc.getSemaphoreStates)
 	// init managers: throttler and SynchronizationManager
 	if err := wfc.initManagers(ctx); err != nil {
 		log.Fatal(err)
@@ -305,6 +326,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	}
 	go wfc.workflowGarbageCollector(ctx.Done())
 	go wfc.archivedWorkflowGarbageCollector(ctx.Done())
+	go wfc.runArchiveSpool(ctx)
 
 	go wfc.runGCcontroller(ctx, workflowTTLWorkers)
 	go wfc.runCronController(ctx)
@@ -312,6 +334,10 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	go wait.Until(wfc.syncPodPhaseMetrics, 15*time.Second, ctx.Done())
 
 	go wait.Until(wfc.syncManager.CheckWorkflowExistence, workflowExistenceCheckPeriod, ctx.Done())
+	if wfc.session != nil && wfc.Config.Synchronization != nil {
+		go wait.Until(wfc.syncManager.Heartbeat, wfc.Config.Synchronization.GetHeartbeatPeriod(), ctx.Done())
+		go wait.Until(wfc.syncManager.PollDatabaseLocks, wfc.Config.Synchronization.GetPollPeriod(), ctx.Done())
+	}
 
 	for i := 0; i < wfWorkers; i++ {
 		go wait.Until(wfc.runWorker, time.Second, ctx.Done())
@@ -324,22 +350,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 
 // Create and the Synchronization Manager
 func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context) {
-	getSyncLimit := func(lockKey string) (int, error) {
-		lockName, err := sync.DecodeLockName(lockKey)
-		if err != nil {
-			return 0, err
-		}
-		configMap, err := wfc.kubeclientset.CoreV1().ConfigMaps(lockName.Namespace).Get(ctx, lockName.ResourceName, metav1.GetOptions{})
-		if err != nil {
-			return 0, err
-		}
-
-		value, found := configMap.Data[lockName.Key]
-		if !found {
-			return 0, argoErr.New(argoErr.CodeBadRequest, fmt.Sprintf("Sync configuration key '%s' not found in ConfigMap", lockName.Key))
-		}
-		return strconv.Atoi(value)
-	}
+	getSyncLimit := sync.GetConfigMapSyncLimitFunc(ctx, wfc.kubeclientset)
 
 	nextWorkflow := func(key string) {
 		wfc.wfQueue.AddAfter(key, semaphoreNotifyDelay)
@@ -354,6 +365,12 @@ func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context)
 		return exists
 	}
 
+	if wfc.session != nil && wfc.Config.Synchronization != nil {
+		controllerName := wfc.Config.Synchronization.GetControllerName(wfc.Config.Persistence.GetClusterName(), wfc.Config.InstanceID)
+		log.WithField("controllerName", controllerName).Info("Database synchronization is enabled")
+		wfc.syncManager = sync.NewLockManagerWithDatabase(getSyncLimit, nextWorkflow, isWFDeleted, wfc.session, controllerName, wfc.Config.Synchronization.GetLeaseDuration())
+		return
+	}
 	wfc.syncManager = sync.NewLockManager(getSyncLimit, nextWorkflow, isWFDeleted)
 }
 
@@ -652,6 +669,23 @@ func (wfc *WorkflowController) deleteOffloadedNodesForWorkflow(uid string, versi
 	return nil
 }
 
+// runArchiveSpool retries archiving the workflows in the archive spool, if it is configured
+func (wfc *WorkflowController) runArchiveSpool(ctx context.Context) {
+	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
+	for {
+		// the spool is replaced when the configuration changes
+		delay := time.Minute
+		if spool := wfc.archiveSpool.Load(); spool != nil {
+			delay = spool.Retry(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
 func (wfc *WorkflowController) archivedWorkflowGarbageCollector(stopCh <-chan struct{}) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
@@ -879,10 +913,14 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 		},
 		Handler: cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
-				wfc.archiveWorkflow(ctx, obj)
+				wfc.archiveWorkflow(ctx, obj, false)
 			},
 			UpdateFunc: func(_, obj interface{}) {
-				wfc.archiveWorkflow(ctx, obj)
+				wfc.archiveWorkflow(ctx, obj, false)
+			},
+			// a workflow that is deleted before it is archived, e.g. by a user, is archived as it was when deleted
+			DeleteFunc: func(obj interface{}) {
+				wfc.archiveWorkflow(ctx, obj, true)
 			},
 		},
 	},
@@ -897,7 +935,8 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 	})
 }
 
-func (wfc *WorkflowController) archiveWorkflow(ctx context.Context, obj interface{}) {
+// archiveWorkflow archives the workflow, and labels it as archived unless it has been deleted
+func (wfc *WorkflowController) archiveWorkflow(ctx context.Context, obj interface{}, deleted bool) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		log.Error("failed to get key for object")
@@ -905,13 +944,13 @@ func (wfc *WorkflowController) archiveWorkflow(ctx context.Context, obj interfac
 	}
 	wfc.workflowKeyLock.Lock(key)
 	defer wfc.workflowKeyLock.Unlock(key)
-	err = wfc.archiveWorkflowAux(ctx, obj)
+	err = wfc.archiveWorkflowAux(ctx, obj, deleted)
 	if err != nil {
 		log.WithField("key", key).WithError(err).Error("failed to archive workflow")
 	}
 }
 
-func (wfc *WorkflowController) archiveWorkflowAux(ctx context.Context, obj interface{}) error {
+func (wfc *WorkflowController) archiveWorkflowAux(ctx context.Context, obj interface{}, deleted bool) error {
 	un, ok := obj.(*unstructured.Unstructured)
 	if !ok {
 		return nil
@@ -924,11 +963,34 @@ func (wfc *WorkflowController) archiveWorkflowAux(ctx context.Context, obj inter
 	if err != nil {
 		return fmt.Errorf("failed to hydrate workflow: %w", err)
 	}
-	log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "uid": wf.UID}).Info("archiving workflow")
+	logCtx := log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "uid": wf.UID})
+	spool := wfc.archiveSpool.Load()
+	if spool != nil && spool.Contains(wf.UID) {
+		// the spool retries archiving the workflow, so only replace the spooled version with this one
+		logCtx.Info("updating spooled workflow")
+		return spool.Add(ctx, wf)
+	}
+	logCtx.Info("archiving workflow")
 	err = wfc.wfArchive.ArchiveWorkflow(wf)
 	if err != nil {
-		return fmt.Errorf("failed to archive workflow: %w", err)
+		metrics.ArchiveFailuresMetric.Inc()
+		if spool == nil {
+			return fmt.Errorf("failed to archive workflow: %w", err)
+		}
+		if spoolErr := spool.Add(ctx, wf); spoolErr != nil {
+			return fmt.Errorf("failed to archive workflow: %v: %w", err, spoolErr)
+		}
+		logCtx.WithError(err).Warn("failed to archive workflow, spooled it to retry later")
+		return nil
 	}
+	if deleted {
+		return nil
+	}
+	return wfc.markWorkflowArchived(ctx, wf)
+}
+
+// markWorkflowArchived labels the workflow as archived, so that it can be garbage collected
+func (wfc *WorkflowController) markWorkflowArchived(ctx context.Context, wf *wfv1.Workflow) error {
 	data, err := json.Marshal(map[string]interface{}{
 		"metadata": metav1.ObjectMeta{
 			Labels: map[string]string{
@@ -939,9 +1001,9 @@ func (wfc *WorkflowController) archiveWorkflowAux(ctx context.Context, obj inter
 	if err != nil {
 		return fmt.Errorf("failed to marshal patch: %w", err)
 	}
-	_, err = wfc.wfclientset.ArgoprojV1alpha1().Workflows(un.GetNamespace()).Patch(
+	_, err = wfc.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Patch(
 		ctx,
-		un.GetName(),
+		wf.Name,
 		types.MergePatchType,
 		data,
 		metav1.PatchOptions{},
@@ -1101,6 +1163,13 @@ func (wfc *WorkflowController) newConfigMapInformer() cache.SharedIndexInformer
 // call this func whenever the configuration changes, or when the workflow informer changes
 func (wfc *WorkflowController) updateEstimatorFactory() {
 	wfc.estimatorFactory = estimation.NewEstimatorFactory(wfc.wfInformer, wfc.hydrator, wfc.wfArchive)
+	if wfc.Config.Estimation.GetStrategy() == config.EstimationStrategyStatistical {
+		if wfc.wfArchive.IsEnabled() {
+			wfc.estimatorFactory = estimation.NewStatisticalEstimatorFactory(wfc.estimatorFactory, wfc.wfArchive, wfc.Config.Estimation.GetRuns())
+		} else {
+			log.Warn("the statistical estimation strategy requires the workflow archive, estimating from the latest workflow instead")
+		}
+	}
 }
 
 // setWorkflowDefaults sets values in the workflow.Spec with defaults from the
@@ -1203,6 +1272,30 @@ func (wfc *WorkflowController) syncWorkflowPhaseMetrics() {
 	}
 }
 
+// getSemaphoreStates returns the state of the synchronization locks for the semaphore metrics
+func (wfc *WorkflowController) getSemaphoreStates() []metrics.SemaphoreState {
+	var states []metrics.SemaphoreState
+	for _, lock := range wfc.syncManager.GetLocks() {
+		lockName, err := sync.DecodeLockName(lock.Name)
+		if err != nil {
+			continue
+		}
+		name := lockName.ResourceName
+		if lockName.Key != "" {
+			name = name + "/" + lockName.Key
+		}
+		states = append(states, metrics.SemaphoreState{
+			Namespace: lockName.Namespace,
+			Kind:      string(lockName.Kind),
+			Name:      name,
+			Holders:   len(lock.Holders),
+			Pending:   len(lock.Pending),
+			Limit:     lock.Limit,
+		})
+	}
+	return states
+}
+
 func (wfc *WorkflowController) syncPodPhaseMetrics() {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
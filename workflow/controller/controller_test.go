@@ -2,11 +2,14 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/argoproj/pkg/sync"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +27,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	sqldbmocks "github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/scheme"
@@ -530,6 +534,10 @@ func TestParallelism(t *testing.T) {
 		"NamespaceParallelism": func(x *WorkflowController) {
 			x.Config.NamespaceParallelism = 1
 		},
+		"FairShare": func(x *WorkflowController) {
+			x.Config.Parallelism = 1
+			x.Config.FairShare = &config.FairShareConfig{TenantLabel: "team"}
+		},
 	} {
 		t.Run(tt, func(t *testing.T) {
 			cancel, controller := newController(
@@ -575,6 +583,29 @@ spec:
 	}
 }
 
+func TestTenantFunc(t *testing.T) {
+	cancel, controller := newController(
+		wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+  labels:
+    team: my-team
+`),
+		wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-other-wf
+  namespace: my-ns
+`),
+	)
+	defer cancel()
+
+	assert.Equal(t, "my-team", controller.tenantFunc("team")("my-ns/my-wf"))
+	assert.Equal(t, "my-ns", controller.tenantFunc("team")("my-ns/my-other-wf"))
+	assert.Equal(t, "my-ns", controller.tenantFunc("team")("my-ns/deleted-wf"))
+	assert.Equal(t, "my-ns", controller.tenantFunc("")("my-ns/my-wf"))
+}
+
 func TestWorkflowController_archivedWorkflowGarbageCollector(t *testing.T) {
 	cancel, controller := newController()
 	defer cancel()
@@ -736,6 +767,10 @@ func TestParallelismWithInitializeRunningWorkflows(t *testing.T) {
 		"NamespaceParallelism": func(x *WorkflowController) {
 			x.Config.NamespaceParallelism = 1
 		},
+		"FairShare": func(x *WorkflowController) {
+			x.Config.Parallelism = 1
+			x.Config.FairShare = &config.FairShareConfig{TenantLabel: "team"}
+		},
 	} {
 		t.Run(tt, func(t *testing.T) {
 			cancel, controller := newController(
@@ -829,3 +864,66 @@ spec:
 	podCleanupKey := "test/my-wf/labelPodCompleted"
 	assert.Equal(t, 0, controller.podCleanupQueue.NumRequeues(podCleanupKey))
 }
+
+func TestArchiveWorkflowSpool(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	wf.UID = "my-uid"
+	wf.Labels = map[string]string{common.LabelKeyWorkflowArchivingStatus: "Pending"}
+	archive := &sqldbmocks.WorkflowArchive{}
+	archive.On("ArchiveWorkflow", mock.Anything).Return(errors.New("database unavailable")).Once()
+	archive.On("ArchiveWorkflow", mock.Anything).Return(nil)
+	cancel, controller := newController(func(wfc *WorkflowController) {
+		wfc.wfArchive = archive
+		wfc.Config.Persistence = &config.PersistConfig{Archive: true, ArchiveSpool: &config.ArchiveSpoolConfig{}}
+	})
+	defer cancel()
+	ctx := context.Background()
+	// the workflow is not in the informer, so only this test archives it
+	_, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+	require.NoError(t, err)
+	spool, err := controller.newArchiveSpool(controller.Config.Persistence.ArchiveSpool)
+	require.NoError(t, err)
+	controller.archiveSpool.Store(spool)
+	un, err := util.ToUnstructured(wf)
+	require.NoError(t, err)
+
+	// the workflow is spooled, and stays pending archiving
+	require.NoError(t, controller.archiveWorkflowAux(ctx, un, false))
+	assert.True(t, spool.Contains("my-uid"))
+	wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Pending", wf.Labels[common.LabelKeyWorkflowArchivingStatus])
+
+	// the spool archives the workflow when it is retried
+	spool.Retry(ctx)
+	assert.False(t, spool.Contains("my-uid"))
+	wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Archived", wf.Labels[common.LabelKeyWorkflowArchivingStatus])
+	archive.AssertNumberOfCalls(t, "ArchiveWorkflow", 2)
+}
+
+func TestArchiveDeletedWorkflow(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	wf.UID = "my-uid"
+	wf.Labels = map[string]string{common.LabelKeyWorkflowArchivingStatus: "Pending"}
+	archive := &sqldbmocks.WorkflowArchive{}
+	archive.On("ArchiveWorkflow", mock.Anything).Return(nil)
+	cancel, controller := newController(func(wfc *WorkflowController) {
+		wfc.wfArchive = archive
+	})
+	defer cancel()
+	ctx := context.Background()
+	// the workflow is not in the informer, so only this test archives it
+	_, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+	require.NoError(t, err)
+	un, err := util.ToUnstructured(wf)
+	require.NoError(t, err)
+
+	// a deleted workflow is archived, but not labelled
+	require.NoError(t, controller.archiveWorkflowAux(ctx, un, true))
+	archive.AssertNumberOfCalls(t, "ArchiveWorkflow", 1)
+	wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Pending", wf.Labels[common.LabelKeyWorkflowArchivingStatus])
+}
@@ -15,3 +15,11 @@ func (e *dummyEstimator) EstimateWorkflowDuration() wfv1.EstimatedDuration {
 func (e *dummyEstimator) EstimateNodeDuration(string) wfv1.EstimatedDuration {
 	return wfv1.NewEstimatedDuration(time.Second)
 }
+
+func (e *dummyEstimator) EstimateWorkflowDurationP90() wfv1.EstimatedDuration {
+	return 0
+}
+
+func (e *dummyEstimator) EstimateNodeDurationP90(string) wfv1.EstimatedDuration {
+	return 0
+}
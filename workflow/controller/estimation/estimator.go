@@ -12,6 +12,10 @@ import (
 type Estimator interface {
 	EstimateWorkflowDuration() wfv1.EstimatedDuration
 	EstimateNodeDuration(nodeName string) wfv1.EstimatedDuration
+	// EstimateWorkflowDurationP90 returns the duration that 90% of workflows complete within, or zero if unknown
+	EstimateWorkflowDurationP90() wfv1.EstimatedDuration
+	// EstimateNodeDurationP90 returns the duration that 90% of the runs of the node complete within, or zero if unknown
+	EstimateNodeDurationP90(nodeName string) wfv1.EstimatedDuration
 }
 
 type estimator struct {
@@ -39,3 +43,11 @@ func (e *estimator) EstimateNodeDuration(nodeName string) wfv1.EstimatedDuration
 	}
 	return wfv1.NewEstimatedDuration(node.GetDuration())
 }
+
+func (e *estimator) EstimateWorkflowDurationP90() wfv1.EstimatedDuration {
+	return 0
+}
+
+func (e *estimator) EstimateNodeDurationP90(string) wfv1.EstimatedDuration {
+	return 0
+}
@@ -0,0 +1,29 @@
+package estimation
+
+import (
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// statisticalEstimator estimates the median and the 90th percentile of the durations of the recent runs
+type statisticalEstimator struct {
+	wf      *wfv1.Workflow
+	history *history
+}
+
+func (e *statisticalEstimator) EstimateWorkflowDuration() wfv1.EstimatedDuration {
+	return e.history.workflow.median
+}
+
+func (e *statisticalEstimator) EstimateWorkflowDurationP90() wfv1.EstimatedDuration {
+	return e.history.workflow.p90
+}
+
+func (e *statisticalEstimator) EstimateNodeDuration(nodeName string) wfv1.EstimatedDuration {
+	return e.history.nodes[strings.TrimPrefix(nodeName, e.wf.Name)].median
+}
+
+func (e *statisticalEstimator) EstimateNodeDurationP90(nodeName string) wfv1.EstimatedDuration {
+	return e.history.nodes[strings.TrimPrefix(nodeName, e.wf.Name)].p90
+}
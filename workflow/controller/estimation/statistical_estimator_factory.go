@@ -0,0 +1,150 @@
+package estimation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// historyTTL is how long the durations of the runs of a template are cached, as every reconciliation of a workflow
+// creates a new estimator. Templates without runs, and failures to read the archive, are cached for as long, so that
+// they do not query the archive on every reconciliation either.
+const historyTTL = time.Minute
+
+// durations are the estimates made from a set of durations
+type durations struct {
+	median wfv1.EstimatedDuration
+	p90    wfv1.EstimatedDuration
+}
+
+// history is the durations of the recent successful runs of a workflow template, cluster workflow template or cron
+// workflow, and of their nodes, keyed by node name without the workflow name
+type history struct {
+	workflow durations
+	nodes    map[string]durations
+}
+
+// cachedHistory is the result of loading a history, which is nil if there are no runs
+type cachedHistory struct {
+	history *history
+	err     error
+	expires time.Time
+}
+
+type statisticalEstimatorFactory struct {
+	fallback  EstimatorFactory
+	wfArchive sqldb.WorkflowArchive
+	runs      int
+	// loads ensures that each history is loaded once at a time, without blocking the loading of other histories
+	loads     singleflight.Group
+	mutex     sync.Mutex
+	histories map[string]cachedHistory
+}
+
+var _ EstimatorFactory = &statisticalEstimatorFactory{}
+
+// NewStatisticalEstimatorFactory returns a factory for estimators that estimate the median and the 90th percentile of
+// the durations of the last runs successful workflows in the archive. If there are none, the estimator is created by
+// the fallback.
+func NewStatisticalEstimatorFactory(fallback EstimatorFactory, wfArchive sqldb.WorkflowArchive, runs int) EstimatorFactory {
+	return &statisticalEstimatorFactory{fallback: fallback, wfArchive: wfArchive, runs: runs, histories: make(map[string]cachedHistory)}
+}
+
+func (f *statisticalEstimatorFactory) NewEstimator(wf *wfv1.Workflow) (Estimator, error) {
+	for _, labelName := range []string{common.LabelKeyWorkflowTemplate, common.LabelKeyClusterWorkflowTemplate, common.LabelKeyCronWorkflow} {
+		labelValue, exists := wf.Labels[labelName]
+		if !exists {
+			continue
+		}
+		h, err := f.getHistory(wf.Namespace, labelName, labelValue)
+		if err != nil {
+			e, _ := f.fallback.NewEstimator(wf)
+			return e, err
+		}
+		if h != nil {
+			return &statisticalEstimator{wf, h}, nil
+		}
+	}
+	return f.fallback.NewEstimator(wf)
+}
+
+// getHistory returns the history of the runs, or nil if there are no successful runs in the archive
+func (f *statisticalEstimatorFactory) getHistory(namespace, labelName, labelValue string) (*history, error) {
+	key := namespace + "/" + labelName + "=" + labelValue
+	f.mutex.Lock()
+	c, ok := f.histories[key]
+	f.mutex.Unlock()
+	if ok && time.Now().Before(c.expires) {
+		return c.history, c.err
+	}
+	v, _, _ := f.loads.Do(key, func() (interface{}, error) {
+		h, err := f.loadHistory(namespace, labelName, labelValue)
+		now := time.Now()
+		c := cachedHistory{history: h, err: err, expires: now.Add(historyTTL)}
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		for k, v := range f.histories {
+			if !now.Before(v.expires) {
+				delete(f.histories, k)
+			}
+		}
+		f.histories[key] = c
+		return c, nil
+	})
+	c = v.(cachedHistory)
+	return c.history, c.err
+}
+
+func (f *statisticalEstimatorFactory) loadHistory(namespace, labelName, labelValue string) (*history, error) {
+	requirements, err := labels.ParseToRequirements(common.LabelKeyPhase + "=" + string(wfv1.NodeSucceeded) + "," + labelName + "=" + labelValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse selector to requirements: %v", err)
+	}
+	workflows, err := f.wfArchive.ListWorkflowsWithStatus(sutils.ListOptions{Namespace: namespace, LabelRequirements: requirements, Limit: f.runs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived workflows: %v", err)
+	}
+	if len(workflows) == 0 {
+		return nil, nil
+	}
+	var workflowDurations []time.Duration
+	nodeDurations := make(map[string][]time.Duration)
+	for _, wf := range workflows {
+		workflowDurations = append(workflowDurations, wf.Status.GetDuration())
+		for _, node := range wf.Status.Nodes {
+			if node.Phase != wfv1.NodeSucceeded {
+				continue
+			}
+			name := strings.TrimPrefix(node.Name, wf.Name)
+			nodeDurations[name] = append(nodeDurations[name], node.GetDuration())
+		}
+	}
+	h := &history{workflow: newDurations(workflowDurations), nodes: make(map[string]durations, len(nodeDurations))}
+	for name, ds := range nodeDurations {
+		h.nodes[name] = newDurations(ds)
+	}
+	return h, nil
+}
+
+// newDurations returns the median and 90th percentile of the durations, using the nearest-rank method
+func newDurations(ds []time.Duration) durations {
+	if len(ds) == 0 {
+		return durations{}
+	}
+	sort.Slice(ds, func(i, j int) bool { return ds[i] < ds[j] })
+	percentile := func(p float64) wfv1.EstimatedDuration {
+		return wfv1.NewEstimatedDuration(ds[int(math.Ceil(p*float64(len(ds))))-1])
+	}
+	return durations{median: percentile(0.5), p90: percentile(0.9)}
+}
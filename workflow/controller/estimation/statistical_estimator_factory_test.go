@@ -0,0 +1,101 @@
+package estimation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	sqldbmocks "github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func Test_statisticalEstimatorFactory(t *testing.T) {
+	wfArchive := &sqldbmocks.WorkflowArchive{}
+	var runs wfv1.Workflows
+	// ten runs of 1..10 minutes, where the step takes half the time
+	for i := 1; i <= 10; i++ {
+		name := "my-wftmpl-" + string(rune('a'+i))
+		uid := types.UID(name)
+		started := metav1.Time{}
+		finished := metav1.Time{Time: started.Add(time.Duration(i) * time.Minute)}
+		runs = append(runs, wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: uid},
+			Status: wfv1.WorkflowStatus{
+				StartedAt:  started,
+				FinishedAt: finished,
+				Nodes: wfv1.Nodes{
+					name:         {Name: name, Phase: wfv1.NodeSucceeded, StartedAt: started, FinishedAt: finished},
+					name + "-1":  {Name: name + ".step", Phase: wfv1.NodeSucceeded, StartedAt: started, FinishedAt: metav1.Time{Time: started.Add(time.Duration(i) * 30 * time.Second)}},
+					name + "-99": {Name: name + ".flaky", Phase: wfv1.NodeFailed, StartedAt: started, FinishedAt: finished},
+				},
+			},
+		})
+	}
+	isTemplate := func(name string) func(options sutils.ListOptions) bool {
+		return func(options sutils.ListOptions) bool {
+			return options.Namespace == "my-ns" && options.Limit == 10 && strings.Contains(labels.NewSelector().Add(options.LabelRequirements...).String(), name)
+		}
+	}
+	wfArchive.On("ListWorkflowsWithStatus", mock.MatchedBy(isTemplate("my-wftmpl"))).Return(runs, nil).Once()
+	wfArchive.On("ListWorkflowsWithStatus", mock.MatchedBy(isTemplate("my-cwf"))).Return(wfv1.Workflows{}, nil).Once()
+	wfArchive.On("ListWorkflowsWithStatus", mock.MatchedBy(isTemplate("my-broken-wftmpl"))).Return(nil, errors.New("archive unavailable")).Once()
+	f := NewStatisticalEstimatorFactory(DummyEstimatorFactory, wfArchive, 10)
+
+	t.Run("Statistical", func(t *testing.T) {
+		wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowTemplate: "my-wftmpl"}}}
+		e, err := f.NewEstimator(wf)
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.NewEstimatedDuration(5*time.Minute), e.EstimateWorkflowDuration())
+			assert.Equal(t, wfv1.NewEstimatedDuration(9*time.Minute), e.EstimateWorkflowDurationP90())
+			assert.Equal(t, wfv1.NewEstimatedDuration(150*time.Second), e.EstimateNodeDuration("my-wf.step"))
+			assert.Equal(t, wfv1.NewEstimatedDuration(270*time.Second), e.EstimateNodeDurationP90("my-wf.step"))
+			assert.Zero(t, e.EstimateNodeDuration("my-wf.flaky"))
+			assert.Zero(t, e.EstimateNodeDuration("my-wf.new"))
+		}
+	})
+	t.Run("Cached", func(t *testing.T) {
+		e, err := f.NewEstimator(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-other-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowTemplate: "my-wftmpl"}}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.NewEstimatedDuration(5*time.Minute), e.EstimateWorkflowDuration())
+		}
+	})
+	t.Run("Fallback", func(t *testing.T) {
+		e, err := f.NewEstimator(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyCronWorkflow: "my-cwf"}}})
+		if assert.NoError(t, err) {
+			assert.IsType(t, &dummyEstimator{}, e)
+		}
+		e, err = f.NewEstimator(&wfv1.Workflow{})
+		if assert.NoError(t, err) {
+			assert.IsType(t, &dummyEstimator{}, e)
+		}
+	})
+	t.Run("CachedFallback", func(t *testing.T) {
+		e, err := f.NewEstimator(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-other-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyCronWorkflow: "my-cwf"}}})
+		if assert.NoError(t, err) {
+			assert.IsType(t, &dummyEstimator{}, e)
+		}
+	})
+	t.Run("CachedError", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			e, err := f.NewEstimator(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowTemplate: "my-broken-wftmpl"}}})
+			assert.EqualError(t, err, "failed to list archived workflows: archive unavailable")
+			assert.IsType(t, &dummyEstimator{}, e)
+		}
+	})
+	wfArchive.AssertExpectations(t)
+}
+
+func Test_newDurations(t *testing.T) {
+	assert.Equal(t, durations{}, newDurations(nil))
+	assert.Equal(t, durations{median: 1, p90: 1}, newDurations([]time.Duration{time.Second}))
+	assert.Equal(t, durations{median: 2, p90: 9}, newDurations([]time.Duration{9 * time.Second, time.Second, 2 * time.Second}))
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"math"
 	"os"
@@ -35,6 +36,7 @@ import (
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/yaml"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -246,6 +248,12 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		}
 		woc.updated = wfUpdate
 		if !acquired {
+			if woc.lockWaitExceeded(woc.execWf.Spec.Synchronization, woc.wf.CreationTimestamp.Time) {
+				woc.log.Warn("Workflow timed out waiting for the lock")
+				woc.controller.syncManager.Release(woc.wf, "", woc.execWf.Spec.Synchronization)
+				woc.markWorkflowFailed(ctx, fmt.Sprintf("Timed out after %v waiting for the synchronization lock", woc.execWf.Spec.Synchronization.GetTimeout()))
+				return
+			}
 			woc.log.Warn("Workflow processing has been postponed due to concurrency limit")
 			phase := woc.wf.Status.Phase
 			if phase == wfv1.WorkflowUnknown {
@@ -286,6 +294,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		}
 
 		woc.wf.Status.EstimatedDuration = woc.estimateWorkflowDuration()
+		woc.wf.Status.EstimatedDurationP90 = woc.getEstimator().EstimateWorkflowDurationP90()
 	} else {
 		woc.workflowDeadline = woc.getWorkflowDeadline()
 		err := woc.taskResultReconciliation()
@@ -674,6 +683,9 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 
 	resource.UpdateResourceDurations(woc.wf)
 	progress.UpdateProgress(woc.wf)
+	if woc.controller.Config.ProgressMode == config.ProgressModeDuration {
+		progress.UpdateDurationProgress(woc.wf, time.Now())
+	}
 	// You MUST not call `persistUpdates` twice.
 	// * Fails the `reapplyUpdate` cannot work unless resource versions are different.
 	// * It will double the number of Kubernetes API requests.
@@ -1067,7 +1079,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				woc.addOutputsToGlobalScope(newState.Outputs)
 				if newState.MemoizationStatus != nil {
 					if newState.Succeeded() {
-						c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, newState.MemoizationStatus.CacheName)
+						c := woc.getNodeMemoizationCache(newState.MemoizationStatus)
 						err := c.Save(ctx, newState.MemoizationStatus.Key, newState.ID, newState.Outputs)
 						if err != nil {
 							woc.log.WithFields(log.Fields{"nodeID": newState.ID}).WithError(err).Error("Failed to save node outputs to cache")
@@ -1778,16 +1790,33 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 	}
 
+	// A node that timed out waiting for its lock was replaced by a node of the fallback template
+	if node != nil && processedTmpl.Synchronization != nil && isFallbackNode(node, processedTmpl) {
+		return woc.executeTemplate(ctx, nodeName, &wfv1.WorkflowStep{Template: processedTmpl.Synchronization.FallbackTemplate}, newTmplCtx, args, opts)
+	}
+
 	// If memoization is on, check if node output exists in cache
-	if node == nil && processedTmpl.Memoize != nil {
-		memoizationCache := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, processedTmpl.Memoize.Cache.ConfigMap.Name)
+	memoize := node == nil && processedTmpl.Memoize != nil
+	var key string
+	if memoize {
+		key, memoize, err = woc.getMemoizationKey(nodeName, processedTmpl)
+		if err != nil {
+			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
+		}
+	}
+	if memoize {
+		cacheType := controllercache.ConfigMapCache
+		if processedTmpl.Memoize.Cache.ArtifactRepository != nil {
+			cacheType = controllercache.ArtifactRepositoryCache
+		}
+		memoizationCache := woc.getMemoizationCache(cacheType, processedTmpl.Memoize.Cache.GetName())
 		if memoizationCache == nil {
 			err := fmt.Errorf("cache could not be found or created")
-			woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.ConfigMap.Name}).WithError(err)
+			woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.GetName()}).WithError(err)
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
 
-		entry, err := memoizationCache.Load(ctx, processedTmpl.Memoize.Key)
+		entry, err := memoizationCache.Load(ctx, key)
 		if err != nil {
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
@@ -1812,12 +1841,15 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 
 		memoizationStatus := &wfv1.MemoizationStatus{
 			Hit:       hit,
-			Key:       processedTmpl.Memoize.Key,
-			CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
+			Key:       key,
+			CacheName: processedTmpl.Memoize.Cache.GetName(),
+			CacheType: string(cacheType),
 		}
 		if hit {
+			metrics.MemoizationCacheHitsMetric.WithLabelValues(woc.wf.Namespace, string(cacheType), memoizationStatus.CacheName).Inc()
 			node = woc.initializeCacheHitNode(nodeName, processedTmpl, templateScope, orgTmpl, opts.boundaryID, outputs, memoizationStatus)
 		} else {
+			metrics.MemoizationCacheMissesMetric.WithLabelValues(woc.wf.Namespace, string(cacheType), memoizationStatus.CacheName).Inc()
 			node = woc.initializeCacheNode(nodeName, processedTmpl, templateScope, orgTmpl, opts.boundaryID, memoizationStatus)
 		}
 		woc.wf.Status.Nodes.Set(node.ID, *node)
@@ -1845,6 +1877,7 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		if node.StartedAt.IsZero() {
 			node.StartedAt = metav1.Time{Time: time.Now().UTC()}
 			node.EstimatedDuration = woc.estimateNodeDuration(node.Name)
+			node.EstimatedDurationP90 = woc.getEstimator().EstimateNodeDurationP90(node.Name)
 			woc.wf.Status.Nodes.Set(node.ID, *node)
 			woc.updated = true
 		}
@@ -1886,6 +1919,20 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 				// unexpected behavior and is a bug.
 				panic("bug: GetLockName should not return an error after a call to TryAcquire")
 			}
+			if woc.lockWaitExceeded(processedTmpl.Synchronization, node.StartedAt.Time) {
+				woc.controller.syncManager.Release(woc.wf, node.ID, processedTmpl.Synchronization)
+				if fallbackTemplate := processedTmpl.Synchronization.FallbackTemplate; fallbackTemplate != "" {
+					// the node is replaced by a node of the fallback template, which is recognised by its template name
+					woc.log.Infof("Node %s timed out waiting for lock %s, running fallback template %s", nodeName, lockName.EncodeName(), fallbackTemplate)
+					woc.wf.Status.Nodes.Delete(node.ID)
+					woc.updated = true
+					return woc.executeTemplate(ctx, nodeName, &wfv1.WorkflowStep{Template: fallbackTemplate}, newTmplCtx, args, opts)
+				}
+				if _, err := woc.markNodeWaitingForLock(node.Name, ""); err != nil {
+					return nil, err
+				}
+				return woc.markNodePhase(nodeName, wfv1.NodeFailed, fmt.Sprintf("Timed out after %v waiting for lock %s", processedTmpl.Synchronization.GetTimeout(), lockName.EncodeName())), nil
+			}
 			return woc.markNodeWaitingForLock(node.Name, lockName.EncodeName())
 		} else {
 			woc.log.Infof("Node %s acquired synchronization lock", nodeName)
@@ -2126,6 +2173,7 @@ func (woc *wfOperationCtx) markWorkflowPhase(ctx context.Context, phase wfv1.Wor
 		woc.updated = true
 		woc.wf.Status.StartedAt = metav1.Time{Time: time.Now().UTC()}
 		woc.wf.Status.EstimatedDuration = woc.estimateWorkflowDuration()
+		woc.wf.Status.EstimatedDurationP90 = woc.getEstimator().EstimateWorkflowDurationP90()
 	}
 	if woc.wf.Status.Message != message {
 		woc.log.Infof("Updated message %s -> %s", woc.wf.Status.Message, message)
@@ -2318,6 +2366,7 @@ func (woc *wfOperationCtx) initializeNode(nodeName string, nodeType wfv1.NodeTyp
 		StartedAt:         metav1.Time{Time: time.Now().UTC()},
 		EstimatedDuration: woc.estimateNodeDuration(nodeName),
 	}
+	node.EstimatedDurationP90 = woc.getEstimator().EstimateNodeDurationP90(nodeName)
 
 	if boundaryNode, err := woc.wf.Status.Nodes.Get(boundaryID); err == nil {
 		node.DisplayName = strings.TrimPrefix(node.Name, boundaryNode.Name)
@@ -2469,6 +2518,60 @@ func (woc *wfOperationCtx) markNodePending(nodeName string, err error) *wfv1.Nod
 	return woc.markNodePhase(nodeName, wfv1.NodePending, err.Error()) // this error message will not change often
 }
 
+// getMemoizationKey returns the memoization key of the template, and whether the node is memoized. A node whose
+// key is "auto" is not memoized if the content of its input artifacts cannot be identified, as a cache hit could
+// return outputs computed from different inputs.
+func (woc *wfOperationCtx) getMemoizationKey(nodeName string, tmpl *wfv1.Template) (string, bool, error) {
+	if tmpl.Memoize.Key != wfv1.MemoizeKeyAuto {
+		return tmpl.Memoize.Key, true, nil
+	}
+	key, err := controllercache.AutoKey(tmpl)
+	if stderrors.Is(err, controllercache.ErrNoContentIdentity) {
+		woc.log.WithField("nodeName", nodeName).WithError(err).Info("Node is not memoized")
+		return "", false, nil
+	}
+	return key, err == nil, err
+}
+
+// getMemoizationCache returns the memoization cache of the type and name
+func (woc *wfOperationCtx) getMemoizationCache(cacheType controllercache.CacheType, name string) controllercache.MemoizationCache {
+	if cacheType == controllercache.ArtifactRepositoryCache {
+		return woc.controller.cacheFactory.GetArtifactRepositoryCache(woc.wf.Namespace, woc.artifactRepository, name)
+	}
+	return woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, name)
+}
+
+// getNodeMemoizationCache returns the memoization cache in which the outputs of the memoized node are saved
+func (woc *wfOperationCtx) getNodeMemoizationCache(status *wfv1.MemoizationStatus) controllercache.MemoizationCache {
+	cacheType := controllercache.CacheType(status.CacheType)
+	if cacheType == "" {
+		cacheType = controllercache.ConfigMapCache
+	}
+	return woc.getMemoizationCache(cacheType, status.CacheName)
+}
+
+// lockWaitExceeded returns true if the wait for a lock, which started at waitingSince, exceeded the timeout of the
+// synchronization. Otherwise, the workflow is requeued for when the timeout passes.
+func (woc *wfOperationCtx) lockWaitExceeded(syncRef *wfv1.Synchronization, waitingSince time.Time) bool {
+	timeout := syncRef.GetTimeout()
+	if timeout <= 0 {
+		return false
+	}
+	remaining := time.Until(waitingSince.Add(timeout))
+	if remaining <= 0 {
+		return true
+	}
+	woc.requeueAfter(remaining)
+	return false
+}
+
+// isFallbackNode returns true if the node runs the fallback template of the template, because the template timed out
+// waiting for its lock
+func isFallbackNode(node *wfv1.NodeStatus, tmpl *wfv1.Template) bool {
+	fallbackTemplate := tmpl.Synchronization.FallbackTemplate
+	return fallbackTemplate != "" && fallbackTemplate != tmpl.Name && node.TemplateRef == nil && node.TemplateName == fallbackTemplate
+}
+
 // markNodeWaitingForLock is a convenience method to mark that a node is waiting for a lock
 func (woc *wfOperationCtx) markNodeWaitingForLock(nodeName string, lockName string) (*wfv1.NodeStatus, error) {
 	node, err := woc.wf.GetNodeByName(nodeName)
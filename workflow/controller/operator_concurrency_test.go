@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -833,3 +834,127 @@ func TestSynchronizationWithStepRetry(t *testing.T) {
 	})
 
 }
+
+const wfWithMutexTimeout = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: mutex-timeout
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: nightly
+        template: nightly
+  - name: nightly
+    synchronization:
+      mutex:
+        name: nightly
+        timeout: 1m
+      fallbackTemplate: skip
+    container:
+      image: alpine:3.7
+      command: [sh, -c, "exit 0"]
+  - name: skip
+    container:
+      image: alpine:3.7
+      command: [sh, -c, "echo skipped"]
+`
+
+func TestSynchronizationTimeout(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	ctx := context.Background()
+	controller.syncManager = sync.NewLockManager(GetSyncLimitFunc(ctx, controller.kubeclientset), func(key string) {
+	}, workflowExistenceFunc)
+
+	holder := wfv1.MustUnmarshalWorkflow(wfWithMutexTimeout)
+	holder.Name = "holder"
+	holder, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Create(ctx, holder, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(holder, controller)
+	woc.operate(ctx)
+	assert.NotNil(t, woc.wf.Status.Synchronization.Mutex)
+
+	operateWaiter := func(t *testing.T, wf *wfv1.Workflow) *wfOperationCtx {
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Create(ctx, wf, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("nightly")
+		if assert.NotNil(t, node) && assert.NotNil(t, node.SynchronizationStatus) {
+			assert.Equal(t, "default/Mutex/nightly", node.SynchronizationStatus.Waiting)
+		}
+		// the node has been waiting for longer than the timeout
+		node.StartedAt = metav1.NewTime(node.StartedAt.Add(-2 * time.Minute))
+		woc.wf.Status.Nodes.Set(node.ID, *node)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		return woc
+	}
+
+	t.Run("Fail", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(wfWithMutexTimeout)
+		wf.Name = "fail"
+		wf.Spec.Templates[1].Synchronization.FallbackTemplate = ""
+		woc := operateWaiter(t, wf)
+		node := woc.wf.Status.Nodes.FindByDisplayName("nightly")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeFailed, node.Phase)
+			assert.Equal(t, "Timed out after 1m0s waiting for lock default/Mutex/nightly", node.Message)
+			assert.Nil(t, node.SynchronizationStatus)
+		}
+		assert.NotContains(t, controller.syncManager.GetLocks()[0].Pending, "default/fail/"+node.ID)
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(wfWithMutexTimeout)
+		wf.Name = "fallback"
+		woc := operateWaiter(t, wf)
+		node := woc.wf.Status.Nodes.FindByDisplayName("nightly")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, "skip", node.TemplateName)
+			assert.Equal(t, wfv1.NodePending, node.Phase)
+			assert.Nil(t, node.SynchronizationStatus)
+		}
+		assert.Empty(t, controller.syncManager.GetLocks()[0].Pending)
+		pod, err := controller.kubeclientset.CoreV1().Pods("default").Get(ctx, woc.getPodName(node.Name, "skip"), metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Contains(t, pod.Spec.Containers[1].Command, "echo skipped")
+		}
+
+		// the fallback node keeps running the fallback template
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		node = woc.wf.Status.Nodes.FindByDisplayName("nightly")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, "skip", node.TemplateName)
+			assert.Nil(t, node.SynchronizationStatus)
+		}
+	})
+
+	t.Run("WorkflowLevel", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(wfWithMutexTimeout)
+		wf.Name = "workflow-level"
+		wf.Spec.Synchronization = &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "nightly-wf", Timeout: &metav1.Duration{Duration: time.Minute}}}
+		wf.Spec.Templates[1].Synchronization = nil
+		holder := wf.DeepCopy()
+		holder.Name = "workflow-level-holder"
+		woc := newWorkflowOperationCtx(holder, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+
+		wf.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Create(ctx, wf, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc = newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Equal(t, "Timed out after 1m0s waiting for the synchronization lock", woc.wf.Status.Message)
+		for _, lock := range controller.syncManager.GetLocks() {
+			assert.NotContains(t, lock.Pending, "default/workflow-level")
+		}
+	})
+}
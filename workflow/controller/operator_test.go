@@ -5231,6 +5231,48 @@ func TestConfigMapCacheSaveOperate(t *testing.T) {
 	}
 }
 
+func TestConfigMapCacheSaveOperateAutoKey(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(strings.Replace(workflowCached, `key: "{{inputs.parameters.message}}"`, "key: auto", 1))
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("memoized-workflow-test")
+	if assert.NotNil(t, node) && assert.NotNil(t, node.MemoizationStatus) {
+		assert.Regexp(t, "^[0-9a-f]{64}$", node.MemoizationStatus.Key)
+		assert.False(t, node.MemoizationStatus.Hit)
+	}
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), withOutputs(wfv1.MustMarshallJSON(wfv1.Outputs{ExitCode: pointer.StringPtr("0")})))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "whalesay-cache", metav1.GetOptions{})
+	if assert.NoError(t, err) && node != nil && node.MemoizationStatus != nil {
+		assert.Contains(t, cm.Data, node.MemoizationStatus.Key)
+	}
+}
+
+func TestAutoKeyWithoutChecksum(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(strings.Replace(workflowCached, `key: "{{inputs.parameters.message}}"`, "key: auto", 1))
+	wf.Spec.Templates[0].Inputs.Artifacts = []wfv1.Artifact{{
+		Name:             "data",
+		Path:             "/tmp/data",
+		ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "data.txt"}},
+	}}
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	node := woc.wf.Status.Nodes.FindByDisplayName("memoized-workflow-test")
+	if assert.NotNil(t, node) {
+		assert.Nil(t, node.MemoizationStatus, "the object at the location of the artifact may change, so the node is not memoized")
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+	}
+}
+
 var propagate = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
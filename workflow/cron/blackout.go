@@ -0,0 +1,189 @@
+package cron
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// GetBlackoutWindows returns the blackout windows of the CronWorkflow, followed by the events of its blackout calendars
+func GetBlackoutWindows(ctx context.Context, kubeClient kubernetes.Interface, cronWf *v1alpha1.CronWorkflow) ([]v1alpha1.BlackoutWindow, error) {
+	windows := append([]v1alpha1.BlackoutWindow{}, cronWf.Spec.BlackoutWindows...)
+	if len(cronWf.Spec.BlackoutCalendars) == 0 {
+		return windows, nil
+	}
+	// floating times in the calendars are in the timezone of the CronWorkflow
+	loc, err := time.LoadLocation(cronWf.Spec.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone '%s': %w", cronWf.Spec.Timezone, err)
+	}
+	for _, selector := range cronWf.Spec.BlackoutCalendars {
+		optional := selector.Optional != nil && *selector.Optional
+		cm, err := kubeClient.CoreV1().ConfigMaps(cronWf.Namespace).Get(ctx, selector.Name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) && optional {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get blackout calendar %s: %w", selector.Name, err)
+		}
+		data, ok := cm.Data[selector.Key]
+		if !ok {
+			if optional {
+				continue
+			}
+			return nil, fmt.Errorf("blackout calendar %s has no key %s", selector.Name, selector.Key)
+		}
+		events, err := ParseCalendar(data, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blackout calendar %s/%s: %w", selector.Name, selector.Key, err)
+		}
+		windows = append(windows, events...)
+	}
+	return windows, nil
+}
+
+// FindBlackoutWindow returns the first of the windows that contains the time, or nil if there is none
+func FindBlackoutWindow(windows []v1alpha1.BlackoutWindow, t time.Time) *v1alpha1.BlackoutWindow {
+	for i := range windows {
+		if windows[i].Contains(t) {
+			return &windows[i]
+		}
+	}
+	return nil
+}
+
+// ParseCalendar returns the events of an iCalendar (RFC 5545) as blackout windows, named by their summaries. Floating
+// times, which have neither a UTC designator nor a TZID, are in loc. An all-day event without an end lasts for the day.
+// Recurring events are not supported.
+func ParseCalendar(data string, loc *time.Location) ([]v1alpha1.BlackoutWindow, error) {
+	var windows []v1alpha1.BlackoutWindow
+	var event map[string]calendarProperty
+	for _, line := range unfoldLines(data) {
+		name, prop, err := parseCalendarProperty(line)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case name == "BEGIN" && prop.value == "VEVENT":
+			event = map[string]calendarProperty{}
+		case name == "END" && prop.value == "VEVENT":
+			if event == nil {
+				return nil, fmt.Errorf("unexpected END:VEVENT")
+			}
+			window, err := newCalendarWindow(event, loc)
+			if err != nil {
+				return nil, err
+			}
+			windows = append(windows, window)
+			event = nil
+		case event != nil:
+			event[name] = prop
+		}
+	}
+	if event != nil {
+		return nil, fmt.Errorf("missing END:VEVENT")
+	}
+	return windows, nil
+}
+
+type calendarProperty struct {
+	params map[string]string
+	value  string
+}
+
+// unfoldLines returns the lines of the calendar, joining the continuation lines that start with a space or tab
+func unfoldLines(data string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// parseCalendarProperty parses a content line, e.g. "DTSTART;TZID=Europe/London:20231225T000000"
+func parseCalendarProperty(line string) (string, calendarProperty, error) {
+	nameAndParams, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", calendarProperty{}, fmt.Errorf("invalid line %q", line)
+	}
+	parts := strings.Split(nameAndParams, ";")
+	prop := calendarProperty{params: map[string]string{}, value: value}
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return strings.ToUpper(parts[0]), prop, nil
+}
+
+func newCalendarWindow(event map[string]calendarProperty, loc *time.Location) (v1alpha1.BlackoutWindow, error) {
+	summary := unescapeText(event["SUMMARY"].value)
+	if _, ok := event["RRULE"]; ok {
+		return v1alpha1.BlackoutWindow{}, fmt.Errorf("event %q is recurring, which is not supported", summary)
+	}
+	dtstart, ok := event["DTSTART"]
+	if !ok {
+		return v1alpha1.BlackoutWindow{}, fmt.Errorf("event %q has no DTSTART", summary)
+	}
+	start, allDay, err := parseCalendarTime(dtstart, loc)
+	if err != nil {
+		return v1alpha1.BlackoutWindow{}, fmt.Errorf("event %q has an invalid DTSTART: %w", summary, err)
+	}
+	var end time.Time
+	if dtend, ok := event["DTEND"]; ok {
+		end, _, err = parseCalendarTime(dtend, loc)
+		if err != nil {
+			return v1alpha1.BlackoutWindow{}, fmt.Errorf("event %q has an invalid DTEND: %w", summary, err)
+		}
+	} else if allDay {
+		end = start.AddDate(0, 0, 1)
+	} else {
+		return v1alpha1.BlackoutWindow{}, fmt.Errorf("event %q has no DTEND", summary)
+	}
+	if !end.After(start) {
+		return v1alpha1.BlackoutWindow{}, fmt.Errorf("event %q does not end after it starts", summary)
+	}
+	return v1alpha1.BlackoutWindow{Name: summary, Start: metav1.NewTime(start), End: metav1.NewTime(end)}, nil
+}
+
+// parseCalendarTime parses a date or a date-time, and returns whether it is a date
+func parseCalendarTime(prop calendarProperty, loc *time.Location) (time.Time, bool, error) {
+	if tzid, ok := prop.params["TZID"]; ok {
+		var err error
+		loc, err = time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+	}
+	if prop.params["VALUE"] == "DATE" || len(prop.value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", prop.value, loc)
+		return t, true, err
+	}
+	if strings.HasSuffix(prop.value, "Z") {
+		t, err := time.Parse("20060102T150405Z", prop.value)
+		return t, false, err
+	}
+	t, err := time.ParseInLocation("20060102T150405", prop.value, loc)
+	return t, false, err
+}
+
+var textUnescaper = strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
@@ -0,0 +1,98 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const holidays = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:New Year\\, observed\r\n" +
+	"DTSTART;VALUE=DATE:20230102\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:Year-end\r\n" +
+	"  freeze\r\n" +
+	"DTSTART:20231218T170000Z\r\n" +
+	"DTEND;TZID=Asia/Tokyo:20240103T090000\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:Maintenance\r\n" +
+	"DTSTART:20230601T220000\r\n" +
+	"DTEND:20230602T020000\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseCalendar(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	windows, err := ParseCalendar(holidays, loc)
+	require.NoError(t, err)
+	require.Len(t, windows, 3)
+
+	assert.Equal(t, "New Year, observed", windows[0].Name)
+	assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, loc), windows[0].Start.Time)
+	assert.Equal(t, time.Date(2023, 1, 3, 0, 0, 0, 0, loc), windows[0].End.Time, "an all-day event without an end lasts for the day")
+
+	assert.Equal(t, "Year-end freeze", windows[1].Name)
+	assert.Equal(t, time.Date(2023, 12, 18, 17, 0, 0, 0, time.UTC), windows[1].Start.Time)
+	assert.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), windows[1].End.UTC())
+
+	assert.Equal(t, time.Date(2023, 6, 2, 2, 0, 0, 0, time.UTC), windows[2].Start.UTC(), "floating times are in the location")
+
+	for _, calendar := range []string{
+		"BEGIN:VEVENT\nSUMMARY:x\nDTSTART;VALUE=DATE:20230102\nRRULE:FREQ=YEARLY\nEND:VEVENT",
+		"BEGIN:VEVENT\nSUMMARY:x\nDTSTART:20230102T000000Z\nEND:VEVENT",
+		"BEGIN:VEVENT\nSUMMARY:x\nDTSTART:20230102T000000Z\nDTEND:20230101T000000Z\nEND:VEVENT",
+		"BEGIN:VEVENT\nSUMMARY:x\nDTSTART:yesterday\nEND:VEVENT",
+		"BEGIN:VEVENT\nSUMMARY:x\n",
+	} {
+		_, err := ParseCalendar(calendar, time.UTC)
+		assert.Error(t, err, calendar)
+	}
+}
+
+func TestGetBlackoutWindows(t *testing.T) {
+	ctx := context.Background()
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf", Namespace: "argo"},
+		Spec: v1alpha1.CronWorkflowSpec{
+			BlackoutWindows: []v1alpha1.BlackoutWindow{{
+				Name:  "freeze",
+				Start: metav1.NewTime(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)),
+				End:   metav1.NewTime(time.Date(2023, 3, 8, 0, 0, 0, 0, time.UTC)),
+			}},
+			BlackoutCalendars: []apiv1.ConfigMapKeySelector{
+				{LocalObjectReference: apiv1.LocalObjectReference{Name: "holidays"}, Key: "calendar.ics"},
+				{LocalObjectReference: apiv1.LocalObjectReference{Name: "missing"}, Key: "calendar.ics", Optional: pointer.Bool(true)},
+			},
+		},
+	}
+	kubeClient := fake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "holidays", Namespace: "argo"},
+		Data:       map[string]string{"calendar.ics": holidays},
+	})
+	windows, err := GetBlackoutWindows(ctx, kubeClient, cronWf)
+	require.NoError(t, err)
+	require.Len(t, windows, 4)
+	assert.Equal(t, "freeze", windows[0].Name)
+	assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), windows[1].Start.Time, "floating times are in UTC by default")
+
+	assert.Equal(t, "freeze", FindBlackoutWindow(windows, time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)).Name, "the start is inclusive")
+	assert.Nil(t, FindBlackoutWindow(windows, time.Date(2023, 3, 8, 0, 0, 0, 0, time.UTC)), "the end is exclusive")
+
+	cronWf.Spec.BlackoutCalendars[1].Optional = nil
+	_, err = GetBlackoutWindows(ctx, kubeClient, cronWf)
+	assert.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
@@ -42,6 +43,7 @@ type Controller struct {
 	cron                 *cronFacade
 	keyLock              sync.KeyLock
 	wfClientset          versioned.Interface
+	kubeClient           kubernetes.Interface
 	wfLister             util.WorkflowLister
 	cronWfInformer       informers.GenericInformer
 	cronWfQueue          workqueue.RateLimitingInterface
@@ -68,9 +70,10 @@ func init() {
 	log.WithField("cronSyncPeriod", cronSyncPeriod).Info("cron config")
 }
 
-func NewCronController(wfclientset versioned.Interface, dynamicInterface dynamic.Interface, namespace string, managedNamespace string, instanceId string, metrics *metrics.Metrics, eventRecorderManager events.EventRecorderManager) *Controller {
+func NewCronController(wfclientset versioned.Interface, kubeClient kubernetes.Interface, dynamicInterface dynamic.Interface, namespace string, managedNamespace string, instanceId string, metrics *metrics.Metrics, eventRecorderManager events.EventRecorderManager) *Controller {
 	return &Controller{
 		wfClientset:          wfclientset,
+		kubeClient:           kubeClient,
 		namespace:            namespace,
 		managedNamespace:     managedNamespace,
 		instanceId:           instanceId,
@@ -162,7 +165,7 @@ func (cc *Controller) processNextCronItem(ctx context.Context) bool {
 		return true
 	}
 
-	cronWorkflowOperationCtx := newCronWfOperationCtx(cronWf, cc.wfClientset, cc.metrics)
+	cronWorkflowOperationCtx := newCronWfOperationCtx(cronWf, cc.wfClientset, cc.kubeClient, cc.metrics)
 
 	err = cronWorkflowOperationCtx.validateCronWorkflow()
 	if err != nil {
@@ -182,14 +185,20 @@ func (cc *Controller) processNextCronItem(ctx context.Context) bool {
 	// The job is currently scheduled, remove it and re add it.
 	cc.cron.Delete(key.(string))
 
-	lastScheduledTimeFunc, err := cc.cron.AddJob(key.(string), cronWf.Spec.GetScheduleString(), cronWorkflowOperationCtx)
-	if err != nil {
-		logCtx.WithError(err).Error("could not schedule CronWorkflow")
-		return true
+	offset := GetJitterOffset(cronWf)
+	for _, schedule := range cronWf.Spec.GetSchedules() {
+		cronSchedule, err := ParseSchedule(schedule)
+		if err != nil {
+			logCtx.WithError(err).Errorf("could not schedule CronWorkflow on schedule %q", schedule.Name)
+			cc.cron.Delete(key.(string))
+			return true
+		}
+		job := newScheduleJob(cronWorkflowOperationCtx, schedule)
+		// the job is dispatched after its scheduled time by the jitter offset
+		dispatchedTimeFunc := cc.cron.AddJob(key.(string), delaySchedule(cronSchedule, offset), job)
+		job.scheduledTimeFunc = func() time.Time { return dispatchedTimeFunc().Add(-offset) }
 	}
 
-	cronWorkflowOperationCtx.scheduledTimeFunc = lastScheduledTimeFunc
-
 	logCtx.Infof("CronWorkflow %s added", key.(string))
 
 	return true
@@ -256,7 +265,7 @@ func (cc *Controller) syncCronWorkflow(ctx context.Context, cronWf *v1alpha1.Cro
 	cc.keyLock.Lock(key)
 	defer cc.keyLock.Unlock(key)
 
-	cwoc := newCronWfOperationCtx(cronWf, cc.wfClientset, cc.metrics)
+	cwoc := newCronWfOperationCtx(cronWf, cc.wfClientset, cc.kubeClient, cc.metrics)
 	err := cwoc.enforceHistoryLimit(ctx, workflows)
 	if err != nil {
 		return err
@@ -14,7 +14,7 @@ import (
 type cronFacade struct {
 	mu       sync.Mutex
 	cron     *cron.Cron
-	entryIDs map[string]cron.EntryID
+	entryIDs map[string][]cron.EntryID
 }
 
 type ScheduledTimeFunc func() time.Time
@@ -22,7 +22,7 @@ type ScheduledTimeFunc func() time.Time
 func newCronFacade() *cronFacade {
 	return &cronFacade{
 		cron:     cron.New(),
-		entryIDs: make(map[string]cron.EntryID),
+		entryIDs: make(map[string][]cron.EntryID),
 	}
 }
 
@@ -37,40 +37,36 @@ func (f *cronFacade) Stop() {
 func (f *cronFacade) Delete(key string) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	entryID, ok := f.entryIDs[key]
-	if !ok {
-		return
+	for _, entryID := range f.entryIDs[key] {
+		f.cron.Remove(entryID)
 	}
-	f.cron.Remove(entryID)
 	delete(f.entryIDs, key)
 }
 
-func (f *cronFacade) AddJob(key, schedule string, cwoc *cronWfOperationCtx) (ScheduledTimeFunc, error) {
+// AddJob adds a job for one of the schedules of the key
+func (f *cronFacade) AddJob(key string, schedule cron.Schedule, job *scheduleJob) ScheduledTimeFunc {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	entryID, err := f.cron.AddJob(schedule, cwoc)
-	if err != nil {
-		return nil, err
-	}
-	f.entryIDs[key] = entryID
+	entryID := f.cron.Schedule(schedule, job)
+	f.entryIDs[key] = append(f.entryIDs[key], entryID)
 
 	// Return a function to return the last scheduled time
 	return func() time.Time {
 		return f.cron.Entry(entryID).Prev
-	}, nil
+	}
 }
 
 func (f *cronFacade) Load(key string) (*cronWfOperationCtx, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	entryID, ok := f.entryIDs[key]
+	entryIDs, ok := f.entryIDs[key]
 	if !ok {
 		return nil, fmt.Errorf("entry ID for %s not found", key)
 	}
-	entry := f.cron.Entry(entryID).Job
-	cwoc, ok := entry.(*cronWfOperationCtx)
+	entry := f.cron.Entry(entryIDs[0]).Job
+	job, ok := entry.(*scheduleJob)
 	if !ok {
-		return nil, fmt.Errorf("job entry ID for %s was not a *cronWfOperationCtx, was %v", key, reflect.TypeOf(entry))
+		return nil, fmt.Errorf("job entry ID for %s was not a *scheduleJob, was %v", key, reflect.TypeOf(entry))
 	}
-	return cwoc, nil
+	return job.woc, nil
 }
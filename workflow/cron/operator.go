@@ -5,20 +5,22 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
-	"github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	typed "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
@@ -27,24 +29,38 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
+// maxRecentRuns is the number of the most recently completed workflows that are recorded in the status
+const maxRecentRuns = 10
+
 type cronWfOperationCtx struct {
 	// CronWorkflow is the CronWorkflow to be run
 	name        string
 	cronWf      *v1alpha1.CronWorkflow
 	wfClientset versioned.Interface
+	kubeClient  kubernetes.Interface
 	wfClient    typed.WorkflowInterface
 	cronWfIf    typed.CronWorkflowInterface
 	log         *log.Entry
 	metrics     *metrics.Metrics
+	// runLock serializes the runs of the schedules of the CronWorkflow
+	runLock sync.Mutex
+}
+
+// scheduleJob runs a CronWorkflow on one of its schedules
+// It fits the github.com/robfig/cron.Job interface
+type scheduleJob struct {
+	woc      *cronWfOperationCtx
+	schedule v1alpha1.CronWorkflowSchedule
 	// scheduledTimeFunc returns the last scheduled time when it is called
 	scheduledTimeFunc ScheduledTimeFunc
 }
 
-func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface, metrics *metrics.Metrics) *cronWfOperationCtx {
+func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface, kubeClient kubernetes.Interface, metrics *metrics.Metrics) *cronWfOperationCtx {
 	return &cronWfOperationCtx{
 		name:        cronWorkflow.ObjectMeta.Name,
 		cronWf:      cronWorkflow,
 		wfClientset: wfClientset,
+		kubeClient:  kubeClient,
 		wfClient:    wfClientset.ArgoprojV1alpha1().Workflows(cronWorkflow.Namespace),
 		cronWfIf:    wfClientset.ArgoprojV1alpha1().CronWorkflows(cronWorkflow.Namespace),
 		log: log.WithFields(log.Fields{
@@ -52,30 +68,38 @@ func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset vers
 			"namespace": cronWorkflow.ObjectMeta.Namespace,
 		}),
 		metrics: metrics,
+	}
+}
+
+func newScheduleJob(woc *cronWfOperationCtx, schedule v1alpha1.CronWorkflowSchedule) *scheduleJob {
+	return &scheduleJob{
+		woc:      woc,
+		schedule: schedule,
 		// inferScheduledTime returns an inferred scheduled time based on the current time and only works if it is called
 		// within 59 seconds of the scheduled time. Here it acts as a placeholder until it is replaced by a similar
 		// function that returns the last scheduled time deterministically from the cron engine. Since we are only able
 		// to generate the latter function after the job is scheduled, there is a tiny chance that the job is run before
 		// the deterministic function is supplanted. If that happens, we use the infer function as the next-best thing
-		scheduledTimeFunc: inferScheduledTime,
+		scheduledTimeFunc: func() time.Time { return inferScheduledTime(GetJitterOffset(woc.cronWf)) },
 	}
 }
 
-// Run handles the running of a cron workflow
-// It fits the github.com/robfig/cron.Job interface
-func (woc *cronWfOperationCtx) Run() {
+// Run handles the running of a cron workflow on the schedule
+func (j *scheduleJob) Run() {
 	ctx := context.Background()
-	woc.run(ctx, woc.scheduledTimeFunc())
+	j.woc.run(ctx, j.schedule, j.scheduledTimeFunc())
 }
 
-func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Time) {
+func (woc *cronWfOperationCtx) run(ctx context.Context, schedule v1alpha1.CronWorkflowSchedule, scheduledRuntime time.Time) {
+	woc.runLock.Lock()
+	defer woc.runLock.Unlock()
 	defer woc.persistUpdate(ctx)
 
-	woc.log.Infof("Running %s", woc.name)
+	woc.log.Infof("Running %s on schedule %q", woc.name, schedule.Name)
 
 	// If the cron workflow has a schedule that was just updated, update its annotation
 	if woc.cronWf.IsUsingNewSchedule() {
-		woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
+		woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleFingerprint())
 	}
 
 	err := woc.validateCronWorkflow()
@@ -83,6 +107,31 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 		return
 	}
 
+	// a time that is due on several schedules is only run once, on the first of them, but counts as scheduled on all of
+	// them
+	schedules, err := GetSchedulesDue(woc.cronWf, scheduledRuntime)
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSpecError, fmt.Sprint(err))
+		return
+	}
+	if len(schedules) == 0 {
+		schedules = []v1alpha1.CronWorkflowSchedule{schedule}
+	}
+	schedule = schedules[0]
+
+	// a suspended CronWorkflow is not run, rather than skipped, by enforceRuntimePolicy
+	if !woc.cronWf.Spec.Suspend {
+		windows, err := GetBlackoutWindows(ctx, woc.kubeClient, woc.cronWf)
+		if err != nil {
+			woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to get blackout windows: %s", err))
+			return
+		}
+		if window := FindBlackoutWindow(windows, scheduledRuntime); window != nil {
+			woc.skip(schedules, scheduledRuntime, window)
+			return
+		}
+	}
+
 	proceed, err := woc.enforceRuntimePolicy(ctx)
 	if err != nil {
 		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Concurrency policy error: %s", err))
@@ -91,12 +140,17 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 		return
 	}
 
-	wf := common.ConvertCronWorkflowToWorkflowWithProperties(woc.cronWf, getChildWorkflowName(woc.cronWf.Name, scheduledRuntime), scheduledRuntime)
+	wf, err := NewScheduledWorkflow(woc.cronWf, schedule, scheduledRuntime)
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to submit Workflow: %s", err))
+		return
+	}
 
 	runWf, err := util.SubmitWorkflow(ctx, woc.wfClient, woc.wfClientset, woc.cronWf.Namespace, wf, &v1alpha1.SubmitOpts{})
 	if err != nil {
 		// If the workflow already exists (i.e. this is a duplicate submission), do not report an error
 		if errors.IsAlreadyExists(err) {
+			woc.setLastScheduledTime(schedules, scheduledRuntime)
 			return
 		}
 		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to submit Workflow: %s", err))
@@ -104,10 +158,45 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 	}
 
 	woc.cronWf.Status.Active = append(woc.cronWf.Status.Active, getWorkflowObjectReference(wf, runWf))
-	woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: scheduledRuntime}
+	woc.setLastScheduledTime(schedules, scheduledRuntime)
+	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
+	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSkipped)
+}
+
+// skip records that the run was skipped as it is in the blackout window. The run still counts as scheduled, so that
+// it is not run later as a missed run.
+func (woc *cronWfOperationCtx) skip(schedules []v1alpha1.CronWorkflowSchedule, scheduledRuntime time.Time, window *v1alpha1.BlackoutWindow) {
+	message := fmt.Sprintf("Skipped the run scheduled at %s as it is in blackout window %q", scheduledRuntime.Format(time.RFC3339), window.Name)
+	woc.log.Info(message)
+	woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+		Type:    v1alpha1.ConditionTypeSkipped,
+		Message: message,
+		Status:  v1.ConditionTrue,
+	})
+	woc.setLastScheduledTime(schedules, scheduledRuntime)
 	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
 }
 
+func (woc *cronWfOperationCtx) setLastScheduledTime(schedules []v1alpha1.CronWorkflowSchedule, scheduledRuntime time.Time) {
+	if woc.cronWf.Status.LastScheduledTime == nil || scheduledRuntime.After(woc.cronWf.Status.LastScheduledTime.Time) {
+		woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: scheduledRuntime}
+	}
+	lastScheduledTimes := make(map[string]v1.Time)
+	for _, s := range schedules {
+		lastScheduledTimes[s.Name] = v1.Time{Time: scheduledRuntime}
+	}
+	// only the times of the current schedules are kept
+	for _, s := range woc.cronWf.Spec.GetSchedules() {
+		if _, ok := lastScheduledTimes[s.Name]; ok {
+			continue
+		}
+		if t, ok := woc.cronWf.Status.LastScheduledTimes[s.Name]; ok {
+			lastScheduledTimes[s.Name] = t
+		}
+	}
+	woc.cronWf.Status.LastScheduledTimes = lastScheduledTimes
+}
+
 func (woc *cronWfOperationCtx) validateCronWorkflow() error {
 	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(woc.wfClientset.ArgoprojV1alpha1().WorkflowTemplates(woc.cronWf.Namespace))
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(woc.wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
@@ -134,11 +223,36 @@ func getWorkflowObjectReference(wf *v1alpha1.Workflow, runWf *v1alpha1.Workflow)
 }
 
 func (woc *cronWfOperationCtx) persistUpdate(ctx context.Context) {
-	woc.patch(ctx, map[string]interface{}{"status": woc.cronWf.Status, "metadata": map[string]interface{}{"annotations": woc.cronWf.Annotations}})
+	// the runs recorded by reconcileActiveWfs are not patched, so that they are not overwritten if this CronWorkflow is
+	// out of date
+	woc.patch(ctx, map[string]interface{}{
+		"status": map[string]interface{}{
+			"active":             woc.cronWf.Status.Active,
+			"lastScheduledTime":  woc.cronWf.Status.LastScheduledTime,
+			"lastScheduledTimes": woc.cronWf.Status.LastScheduledTimes,
+			"conditions":         woc.cronWf.Status.Conditions,
+		},
+		"metadata": map[string]interface{}{"annotations": woc.cronWf.Annotations},
+	})
 }
 
-func (woc *cronWfOperationCtx) persistUpdateActiveWorkflows(ctx context.Context) {
-	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"active": woc.cronWf.Status.Active}})
+func (woc *cronWfOperationCtx) persistUpdateActiveWorkflows(ctx context.Context, stopped, resumed bool) {
+	patch := map[string]interface{}{
+		"status": map[string]interface{}{
+			"active":              woc.cronWf.Status.Active,
+			"recentRuns":          woc.cronWf.Status.RecentRuns,
+			"succeeded":           woc.cronWf.Status.Succeeded,
+			"failed":              woc.cronWf.Status.Failed,
+			"consecutiveFailures": woc.cronWf.Status.ConsecutiveFailures,
+		},
+	}
+	if stopped {
+		patch["spec"] = map[string]interface{}{"suspend": true}
+	}
+	if stopped || resumed {
+		patch["status"].(map[string]interface{})["conditions"] = woc.cronWf.Status.Conditions
+	}
+	woc.patch(ctx, patch)
 }
 
 func (woc *cronWfOperationCtx) patch(ctx context.Context, patch map[string]interface{}) {
@@ -168,6 +282,14 @@ func (woc *cronWfOperationCtx) enforceRuntimePolicy(ctx context.Context) (bool,
 	}
 
 	if woc.cronWf.Spec.ConcurrencyPolicy != "" {
+		switch woc.cronWf.Spec.ConcurrencyPolicy {
+		case v1alpha1.ForbidConcurrent, v1alpha1.ReplaceConcurrent:
+			// the active Workflows are only reconciled periodically, and do not include those created by
+			// `argo cron backfill` since then
+			if err := woc.addRunningWorkflows(ctx); err != nil {
+				return false, err
+			}
+		}
 		switch woc.cronWf.Spec.ConcurrencyPolicy {
 		case v1alpha1.AllowConcurrent, "":
 			// Do nothing
@@ -191,6 +313,24 @@ func (woc *cronWfOperationCtx) enforceRuntimePolicy(ctx context.Context) (bool,
 	return true, nil
 }
 
+// addRunningWorkflows adds the Workflows of the CronWorkflow that are running to its active Workflows
+func (woc *cronWfOperationCtx) addRunningWorkflows(ctx context.Context) error {
+	wfList, err := woc.wfClient.List(ctx, v1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s,%s!=true", common.LabelKeyCronWorkflow, woc.cronWf.Name, common.LabelKeyCompleted)})
+	if err != nil {
+		return err
+	}
+	for i := range wfList.Items {
+		wf := &wfList.Items[i]
+		if owner := v1.GetControllerOf(wf); owner == nil || owner.UID != woc.cronWf.UID {
+			continue
+		}
+		if !woc.cronWf.Status.HasActiveUID(wf.UID) && !wf.Status.Fulfilled() {
+			woc.cronWf.Status.Active = append(woc.cronWf.Status.Active, getWorkflowObjectReference(wf, wf))
+		}
+	}
+	return nil
+}
+
 func (woc *cronWfOperationCtx) terminateOutstandingWorkflows(ctx context.Context) error {
 	for _, wfObjectRef := range woc.cronWf.Status.Active {
 		woc.log.Infof("stopping '%s'", wfObjectRef.Name)
@@ -207,72 +347,88 @@ func (woc *cronWfOperationCtx) terminateOutstandingWorkflows(ctx context.Context
 }
 
 func (woc *cronWfOperationCtx) runOutstandingWorkflows(ctx context.Context) (bool, error) {
-	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTime, schedule, err := woc.shouldOutstandingWorkflowsBeRun()
 	if err != nil {
 		return false, err
 	}
 	if !missedExecutionTime.IsZero() {
-		woc.run(ctx, missedExecutionTime)
+		woc.run(ctx, *schedule, missedExecutionTime)
 		return true, nil
 	}
 	return false, nil
 }
 
-func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (time.Time, error) {
+// shouldOutstandingWorkflowsBeRun returns the earliest execution time that was missed across all the schedules and is
+// still within StartingDeadlineSeconds, and its schedule
+func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (time.Time, *v1alpha1.CronWorkflowSchedule, error) {
 	// If the CronWorkflow schedule was just updated, then do not run any outstanding workflows.
 	if woc.cronWf.IsUsingNewSchedule() {
-		return time.Time{}, nil
+		return time.Time{}, nil, nil
 	}
-	// If this CronWorkflow has been run before, check if we have missed any scheduled executions
-	if woc.cronWf.Status.LastScheduledTime != nil {
-		var now time.Time
-		var cronSchedule cron.Schedule
-		if woc.cronWf.Spec.Timezone != "" {
-			loc, err := time.LoadLocation(woc.cronWf.Spec.Timezone)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("invalid timezone '%s': %s", woc.cronWf.Spec.Timezone, err)
-			}
-			now = time.Now().In(loc)
-
-			cronScheduleString := woc.cronWf.Spec.GetScheduleString()
-			cronSchedule, err = cron.ParseStandard(cronScheduleString)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("unable to form timezone schedule '%s': %s", cronScheduleString, err)
-			}
-		} else {
-			var err error
-			now = time.Now()
-			cronSchedule, err = cron.ParseStandard(woc.cronWf.Spec.Schedule)
-			if err != nil {
-				return time.Time{}, err
-			}
+	var missedExecutionTime time.Time
+	var missedSchedule *v1alpha1.CronWorkflowSchedule
+	for _, schedule := range woc.cronWf.Spec.GetSchedules() {
+		schedule := schedule
+		t, err := woc.getMissedExecutionTime(schedule)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		if !t.IsZero() && (missedExecutionTime.IsZero() || t.Before(missedExecutionTime)) {
+			missedExecutionTime = t
+			missedSchedule = &schedule
 		}
+	}
+	return missedExecutionTime, missedSchedule, nil
+}
 
-		var missedExecutionTime time.Time
-		nextScheduledRunTime := cronSchedule.Next(woc.cronWf.Status.LastScheduledTime.Time)
-		// Workflow should have ran
-		for nextScheduledRunTime.Before(now) {
-			missedExecutionTime = nextScheduledRunTime
-			nextScheduledRunTime = cronSchedule.Next(missedExecutionTime)
+// getMissedExecutionTime returns the latest execution time of the schedule that was missed, if it is within
+// StartingDeadlineSeconds
+func (woc *cronWfOperationCtx) getMissedExecutionTime(schedule v1alpha1.CronWorkflowSchedule) (time.Time, error) {
+	lastScheduledTime := woc.cronWf.Status.GetLastScheduledTime(schedule.Name)
+	// If this CronWorkflow has been run before, check if we have missed any scheduled executions
+	if lastScheduledTime == nil {
+		return time.Time{}, nil
+	}
+	now := time.Now()
+	if schedule.Timezone != "" {
+		loc, err := time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timezone '%s': %s", schedule.Timezone, err)
 		}
+		now = now.In(loc)
+	}
+	cronSchedule, err := ParseSchedule(schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
 
-		// We missed the latest execution time
-		if !missedExecutionTime.IsZero() {
-			// if missedExecutionTime is within StartDeadlineSeconds, We are still within the deadline window, run the Workflow
-			if woc.cronWf.Spec.StartingDeadlineSeconds != nil && now.Before(missedExecutionTime.Add(time.Duration(*woc.cronWf.Spec.StartingDeadlineSeconds)*time.Second)) {
-				woc.log.Infof("%s missed an execution at %s and is within StartingDeadline", woc.cronWf.Name, missedExecutionTime.Format("Mon Jan _2 15:04:05 2006"))
-				return missedExecutionTime, nil
-			}
+	// the runs are dispatched after their scheduled times by the jitter offset
+	offset := GetJitterOffset(woc.cronWf)
+	var missedExecutionTime time.Time
+	nextScheduledRunTime := cronSchedule.Next(lastScheduledTime.Time)
+	// Workflow should have ran
+	for nextScheduledRunTime.Add(offset).Before(now) {
+		missedExecutionTime = nextScheduledRunTime
+		nextScheduledRunTime = cronSchedule.Next(missedExecutionTime)
+	}
+
+	// We missed the latest execution time
+	if !missedExecutionTime.IsZero() {
+		// if missedExecutionTime is within StartDeadlineSeconds, We are still within the deadline window, run the Workflow
+		if woc.cronWf.Spec.StartingDeadlineSeconds != nil && now.Before(missedExecutionTime.Add(offset).Add(time.Duration(*woc.cronWf.Spec.StartingDeadlineSeconds)*time.Second)) {
+			woc.log.Infof("%s missed an execution at %s on schedule %q and is within StartingDeadline", woc.cronWf.Name, missedExecutionTime.Format("Mon Jan _2 15:04:05 2006"), schedule.Name)
+			return missedExecutionTime, nil
 		}
 	}
 	return time.Time{}, nil
 }
 
 func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows []v1alpha1.Workflow) error {
-	updated := false
-	currentWfsFulfilled := make(map[types.UID]bool)
-	for _, wf := range workflows {
-		currentWfsFulfilled[wf.UID] = wf.Status.Fulfilled()
+	resumed := woc.resetStopStrategy()
+	updated := resumed
+	currentWfs := make(map[types.UID]*v1alpha1.Workflow)
+	for i, wf := range workflows {
+		currentWfs[wf.UID] = &workflows[i]
 
 		if !woc.cronWf.Status.HasActiveUID(wf.UID) && !wf.Status.Fulfilled() {
 			updated = true
@@ -281,19 +437,150 @@ func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows
 	}
 
 	for _, objectRef := range woc.cronWf.Status.Active {
-		if fulfilled, found := currentWfsFulfilled[objectRef.UID]; !found || fulfilled {
+		wf, found := currentWfs[objectRef.UID]
+		if !found || wf.Status.Fulfilled() {
 			updated = true
 			woc.removeFromActiveList(objectRef.UID)
 		}
 	}
 
+	// workflows can complete before they are added to the active workflows, e.g. backfilled runs, so every completed
+	// workflow is recorded, not only the active ones; the phase of a workflow that was deleted is unknown, so it is not
+	var completedWfs []*v1alpha1.Workflow
+	for i, wf := range workflows {
+		if wf.Labels[common.LabelKeyCronWorkflow] == woc.cronWf.Name && wf.Status.Fulfilled() && !woc.isRecorded(&wf) {
+			completedWfs = append(completedWfs, &workflows[i])
+		}
+	}
+	if len(completedWfs) > 0 {
+		updated = true
+	}
+
+	sort.SliceStable(completedWfs, func(i, j int) bool {
+		return completedWfs[i].Status.FinishedAt.Before(&completedWfs[j].Status.FinishedAt)
+	})
+	for _, wf := range completedWfs {
+		woc.recordRun(wf)
+	}
+	stopped := len(completedWfs) > 0 && woc.enforceStopStrategy()
+
 	if updated {
-		woc.persistUpdateActiveWorkflows(ctx)
+		woc.persistUpdateActiveWorkflows(ctx, stopped, resumed)
 	}
 
 	return nil
 }
 
+// isRecorded returns whether the completed workflow has been recorded when this CronWorkflow was last reconciled, in
+// which case it is either one of the recent runs, or older than all of them once they are full
+func (woc *cronWfOperationCtx) isRecorded(wf *v1alpha1.Workflow) bool {
+	recentRuns := woc.cronWf.Status.RecentRuns
+	for _, run := range recentRuns {
+		if run.Name == wf.Name {
+			return true
+		}
+	}
+	if len(recentRuns) < maxRecentRuns {
+		return false
+	}
+	for _, run := range recentRuns {
+		if !wf.Status.FinishedAt.Before(&run.FinishedAt) {
+			return false
+		}
+	}
+	return true
+}
+
+// recordRun records the completed workflow in the recent runs and counters of the status
+func (woc *cronWfOperationCtx) recordRun(wf *v1alpha1.Workflow) {
+	status := &woc.cronWf.Status
+	run := v1alpha1.CronWorkflowRun{
+		Name:       wf.Name,
+		Phase:      wf.Status.Phase,
+		FinishedAt: wf.Status.FinishedAt,
+		Duration:   v1.Duration{Duration: wf.Status.GetDuration()},
+	}
+	if scheduledTime, err := time.Parse(time.RFC3339, wf.Annotations[common.AnnotationKeyCronWfScheduledTime]); err == nil {
+		run.ScheduledTime = &v1.Time{Time: scheduledTime}
+	}
+	status.RecentRuns = append([]v1alpha1.CronWorkflowRun{run}, status.RecentRuns...)
+	if len(status.RecentRuns) > maxRecentRuns {
+		status.RecentRuns = status.RecentRuns[:maxRecentRuns]
+	}
+	if wf.Status.Successful() {
+		status.Succeeded++
+		status.ConsecutiveFailures = 0
+	} else {
+		status.Failed++
+		status.ConsecutiveFailures++
+	}
+}
+
+// enforceStopStrategy suspends the CronWorkflow if its stop strategy is met, and returns whether it did
+func (woc *cronWfOperationCtx) enforceStopStrategy() bool {
+	strategy := woc.cronWf.Spec.StopStrategy
+	if strategy == nil || woc.cronWf.Spec.Suspend {
+		return false
+	}
+	status := woc.cronWf.Status
+	var reason string
+	if strategy.ConsecutiveFailures > 0 && status.ConsecutiveFailures >= int64(strategy.ConsecutiveFailures) {
+		reason = fmt.Sprintf("%d consecutive failures", status.ConsecutiveFailures)
+	} else if strategy.Expression != "" {
+		env := map[string]interface{}{
+			"cronworkflow": map[string]interface{}{
+				"succeeded":           status.Succeeded,
+				"failed":              status.Failed,
+				"consecutiveFailures": status.ConsecutiveFailures,
+			},
+		}
+		stop, err := argoexpr.EvalBool(strategy.Expression, env)
+		if err != nil {
+			woc.log.WithError(err).Error("failed to evaluate the stop strategy")
+			return false
+		}
+		if stop {
+			reason = fmt.Sprintf("stop strategy expression %q was true", strategy.Expression)
+		}
+	}
+	if reason == "" {
+		return false
+	}
+	message := fmt.Sprintf("Suspended after %s", reason)
+	woc.log.Info(message)
+	woc.cronWf.Spec.Suspend = true
+	woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+		Type:    v1alpha1.ConditionTypeStopped,
+		Message: message,
+		Status:  v1.ConditionTrue,
+	})
+	return true
+}
+
+// resetStopStrategy resets the counters of the status and removes the Stopped condition if the CronWorkflow was resumed
+// after it was suspended by its stop strategy, so that it is not suspended again by the Workflows that stopped it, and
+// returns whether it did
+func (woc *cronWfOperationCtx) resetStopStrategy() bool {
+	if woc.cronWf.Spec.Suspend {
+		return false
+	}
+	stopped := false
+	for _, condition := range woc.cronWf.Status.Conditions {
+		if condition.Type == v1alpha1.ConditionTypeStopped {
+			stopped = true
+		}
+	}
+	if !stopped {
+		return false
+	}
+	woc.log.Info("Resetting the counters of the status as it was resumed after it was stopped")
+	woc.cronWf.Status.Succeeded = 0
+	woc.cronWf.Status.Failed = 0
+	woc.cronWf.Status.ConsecutiveFailures = 0
+	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeStopped)
+	return true
+}
+
 func (woc *cronWfOperationCtx) removeFromActiveList(uid types.UID) {
 	var newActive []corev1.ObjectReference
 	for _, ref := range woc.cronWf.Status.Active {
@@ -379,17 +666,14 @@ func (woc *cronWfOperationCtx) reportCronWorkflowError(conditionType v1alpha1.Co
 	}
 }
 
-func inferScheduledTime() time.Time {
-	// Infer scheduled runtime by getting current time and zeroing out current seconds and nanoseconds
+func inferScheduledTime(jitterOffset time.Duration) time.Time {
+	// Infer scheduled runtime by getting current time, less the jitter offset it is dispatched after, and zeroing out
+	// current seconds and nanoseconds
 	// This works because the finest possible scheduled runtime is a minute. It is unlikely to ever be used, since this
 	// function is quickly supplanted by a deterministic function from the cron engine.
-	now := time.Now().UTC()
+	now := time.Now().UTC().Add(-jitterOffset)
 	scheduledTime := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), 0, 0, now.Location())
 
 	log.Infof("inferred scheduled time: %s", scheduledTime)
 	return scheduledTime
 }
-
-func getChildWorkflowName(cronWorkflowName string, scheduledRuntime time.Time) string {
-	return fmt.Sprintf("%s-%d", cronWorkflowName, scheduledRuntime.Unix())
-}
@@ -2,13 +2,20 @@ package cron
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/argoproj/pkg/humanize"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -76,10 +83,10 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
 	woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
-	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTime, _, err := woc.shouldOutstandingWorkflowsBeRun()
 	assert.NoError(t, err)
 	// The missedExecutionTime should be the last complete minute mark, which we can get with inferScheduledTime
-	assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTime.Unix())
+	assert.Equal(t, inferScheduledTime(0).Unix(), missedExecutionTime.Unix())
 
 	// StartingDeadlineSeconds is not after the current second, so cron should not be run
 	startingDeadlineSeconds = int64(25)
@@ -88,14 +95,14 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTime, _, err = woc.shouldOutstandingWorkflowsBeRun()
 	assert.NoError(t, err)
 	assert.True(t, missedExecutionTime.IsZero())
 
 	// Same test, but simulate a change to the schedule immediately prior by setting a different last-used-schedule annotation
 	// In this case, since a schedule change is detected, not workflow should be run
 	woc.cronWf.SetSchedule("0 * * * *")
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTime, _, err = woc.shouldOutstandingWorkflowsBeRun()
 	assert.NoError(t, err)
 	assert.True(t, missedExecutionTime.IsZero())
 
@@ -117,10 +124,10 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 	}
 	// Reset last-used-schedule as if the current schedule has been used before
 	woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTime, _, err = woc.shouldOutstandingWorkflowsBeRun()
 	assert.NoError(t, err)
 	// The missedExecutionTime should be the last complete minute mark, which we can get with inferScheduledTime
-	assert.Equal(t, inferScheduledTime().Unix(), missedExecutionTime.Unix())
+	assert.Equal(t, inferScheduledTime(0).Unix(), missedExecutionTime.Unix())
 
 	// StartingDeadlineSeconds is not after the current second, so cron should not be run
 	startingDeadlineSeconds = int64(25)
@@ -129,14 +136,14 @@ func TestRunOutstandingWorkflows(t *testing.T) {
 		cronWf: &cronWf,
 		log:    logrus.WithFields(logrus.Fields{}),
 	}
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTime, _, err = woc.shouldOutstandingWorkflowsBeRun()
 	assert.NoError(t, err)
 	assert.True(t, missedExecutionTime.IsZero())
 
 	// Same test, but simulate a change to the schedule immediately prior by setting a different last-used-schedule annotation
 	// In this case, since a schedule change is detected, not workflow should be run
 	woc.cronWf.SetSchedule("0 * * * *")
-	missedExecutionTime, err = woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTime, _, err = woc.shouldOutstandingWorkflowsBeRun()
 	assert.NoError(t, err)
 	assert.True(t, missedExecutionTime.IsZero())
 }
@@ -182,15 +189,14 @@ func TestCronWorkflowConditionSubmissionError(t *testing.T) {
 	cs := fake.NewSimpleClientset()
 	testMetrics := metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{})
 	woc := &cronWfOperationCtx{
-		wfClientset:       cs,
-		wfClient:          cs.ArgoprojV1alpha1().Workflows(""),
-		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(""),
-		cronWf:            &cronWf,
-		log:               logrus.WithFields(logrus.Fields{}),
-		metrics:           testMetrics,
-		scheduledTimeFunc: inferScheduledTime,
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
 	}
-	woc.Run()
+	newScheduleJob(woc, woc.cronWf.Spec.GetSchedules()[0]).Run()
 
 	assert.Len(t, woc.cronWf.Status.Conditions, 1)
 	submissionErrorCond := woc.cronWf.Status.Conditions[0]
@@ -260,15 +266,14 @@ func TestScheduleTimeParam(t *testing.T) {
 	cs := fake.NewSimpleClientset()
 	testMetrics := metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{})
 	woc := &cronWfOperationCtx{
-		wfClientset:       cs,
-		wfClient:          cs.ArgoprojV1alpha1().Workflows(""),
-		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(""),
-		cronWf:            &cronWf,
-		log:               logrus.WithFields(logrus.Fields{}),
-		metrics:           testMetrics,
-		scheduledTimeFunc: inferScheduledTime,
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
 	}
-	woc.Run()
+	newScheduleJob(woc, woc.cronWf.Spec.GetSchedules()[0]).Run()
 	wsl, err := cs.ArgoprojV1alpha1().Workflows("").List(context.Background(), v1.ListOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, wsl.Items.Len(), 1)
@@ -310,16 +315,15 @@ func TestLastUsedSchedule(t *testing.T) {
 	cs := fake.NewSimpleClientset()
 	testMetrics := metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{})
 	woc := &cronWfOperationCtx{
-		wfClientset:       cs,
-		wfClient:          cs.ArgoprojV1alpha1().Workflows(""),
-		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(""),
-		cronWf:            &cronWf,
-		log:               logrus.WithFields(logrus.Fields{}),
-		metrics:           testMetrics,
-		scheduledTimeFunc: inferScheduledTime,
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(""),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(""),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     testMetrics,
 	}
 
-	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun()
+	missedExecutionTime, _, err := woc.shouldOutstandingWorkflowsBeRun()
 	if assert.NoError(t, err) {
 		assert.Equal(t, time.Time{}, missedExecutionTime)
 	}
@@ -391,8 +395,321 @@ func TestMissedScheduleAfterCronScheduleWithForbid(t *testing.T) {
 			log:    logrus.WithFields(logrus.Fields{}),
 		}
 		woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleString())
-		missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun()
+		missedExecutionTime, _, err := woc.shouldOutstandingWorkflowsBeRun()
 		assert.NoError(t, err)
 		assert.True(t, missedExecutionTime.IsZero())
 	})
 }
+
+func TestForbidWithBackfilledWorkflow(t *testing.T) {
+	ctx := context.Background()
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.ConcurrencyPolicy = v1alpha1.ForbidConcurrent
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	// a Workflow created by `argo cron backfill`, that is not active in the status yet
+	backfilled, err := NewScheduledWorkflow(&cronWf, cronWf.Spec.GetSchedules()[0], start.Add(-time.Hour))
+	require.NoError(t, err)
+	backfilled.Namespace = cronWf.Namespace
+	backfilled.UID = "backfilled"
+	cs := fake.NewSimpleClientset(&cronWf, backfilled)
+	woc := newCronWfOperationCtx(&cronWf, cs, nil, metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}))
+
+	woc.run(ctx, cronWf.Spec.GetSchedules()[0], start)
+	_, err = cs.ArgoprojV1alpha1().Workflows("argo").Get(ctx, GetChildWorkflowName(cronWf.Name, start), v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err), "a run is forbidden while a backfilled Workflow is running")
+	if assert.Len(t, woc.cronWf.Status.Active, 1) {
+		assert.Equal(t, backfilled.Name, woc.cronWf.Status.Active[0].Name)
+	}
+
+	backfilled.Labels[common.LabelKeyCompleted] = "true"
+	backfilled.Status.Phase = v1alpha1.WorkflowSucceeded
+	_, err = cs.ArgoprojV1alpha1().Workflows("argo").Update(ctx, backfilled, v1.UpdateOptions{})
+	require.NoError(t, err)
+	woc.cronWf.Status.Active = nil
+	woc.run(ctx, cronWf.Spec.GetSchedules()[0], start.Add(time.Minute))
+	_, err = cs.ArgoprojV1alpha1().Workflows("argo").Get(ctx, GetChildWorkflowName(cronWf.Name, start.Add(time.Minute)), v1.GetOptions{})
+	assert.NoError(t, err, "completed backfilled Workflows are not active")
+}
+
+var multipleSchedules = `apiVersion: argoproj.io/v1alpha1
+kind: CronWorkflow
+metadata:
+  name: hello-world
+  namespace: argo
+spec:
+  schedules:
+    - name: hourly
+      schedule: "0 * * * *"
+    - name: daily
+      schedule: "0 0 * * *"
+      timezone: UTC
+      parameters:
+        - name: message
+          value: daily
+  startingDeadlineSeconds: 86400
+  workflowSpec:
+    entrypoint: whalesay
+    arguments:
+      parameters:
+        - name: message
+          value: hourly
+    templates:
+      - name: whalesay
+        container:
+          image: docker/whalesay:latest
+`
+
+func TestMultipleSchedules(t *testing.T) {
+	ctx := context.Background()
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(multipleSchedules), &cronWf)
+	cronWf.SetSchedule(cronWf.Spec.GetScheduleString())
+	now := time.Now().UTC()
+	cronWf.Status.LastScheduledTime = &v1.Time{Time: now.Add(-3 * time.Hour)}
+	cronWf.Status.LastScheduledTimes = map[string]v1.Time{"daily": {Time: now.Add(-72 * time.Hour)}}
+	cs := fake.NewSimpleClientset(&cronWf)
+	woc := newCronWfOperationCtx(&cronWf, cs, nil, metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}))
+
+	// the missed runs are the last hour on the hourly schedule, and the last midnight on the daily schedule
+	midnight := now.Truncate(24 * time.Hour)
+	missedExecutionTime, schedule, err := woc.shouldOutstandingWorkflowsBeRun()
+	assert.NoError(t, err)
+	assert.Equal(t, midnight.Unix(), missedExecutionTime.Unix(), "the earliest missed run is run first")
+	if assert.NotNil(t, schedule) && now.Hour() > 0 {
+		assert.Equal(t, "daily", schedule.Name)
+	}
+
+	// midnight is also due on the hourly schedule, so it is run on that schedule, whichever of them fires
+	woc.run(ctx, cronWf.Spec.GetSchedules()[1], midnight)
+	wf, err := cs.ArgoprojV1alpha1().Workflows("argo").Get(ctx, GetChildWorkflowName(cronWf.Name, midnight), v1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "hourly", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+	}
+	assert.Equal(t, midnight.Unix(), woc.cronWf.Status.LastScheduledTimes["daily"].Unix())
+	assert.Equal(t, midnight.Unix(), woc.cronWf.Status.LastScheduledTimes["hourly"].Unix())
+	lastScheduledTime := now.Add(-3 * time.Hour)
+	if midnight.After(lastScheduledTime) {
+		lastScheduledTime = midnight
+	}
+	assert.Equal(t, lastScheduledTime.Unix(), woc.cronWf.Status.LastScheduledTime.Unix(), "the last scheduled time is the latest of all the schedules")
+
+	woc.run(ctx, cronWf.Spec.GetSchedules()[0], midnight)
+	wfs, err := cs.ArgoprojV1alpha1().Workflows("argo").List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, wfs.Items, 1, "a time that is due on several schedules is only run once")
+	assert.Empty(t, woc.cronWf.Status.Conditions)
+
+	// a time that is only due on the hourly schedule
+	oneAM := midnight.Add(time.Hour)
+	woc.run(ctx, cronWf.Spec.GetSchedules()[0], oneAM)
+	wf, err = cs.ArgoprojV1alpha1().Workflows("argo").Get(ctx, GetChildWorkflowName(cronWf.Name, oneAM), v1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "hourly", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+	}
+	assert.Equal(t, midnight.Unix(), woc.cronWf.Status.LastScheduledTimes["daily"].Unix())
+	assert.Equal(t, oneAM.Unix(), woc.cronWf.Status.LastScheduledTimes["hourly"].Unix())
+}
+
+func TestBlackoutWindows(t *testing.T) {
+	ctx := context.Background()
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.BlackoutCalendars = []apiv1.ConfigMapKeySelector{{LocalObjectReference: apiv1.LocalObjectReference{Name: "holidays"}, Key: "calendar.ics"}}
+	cs := fake.NewSimpleClientset(&cronWf)
+	kubeClient := kubefake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Name: "holidays", Namespace: "argo"},
+		Data: map[string]string{"calendar.ics": `BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:Christmas Day
+DTSTART;VALUE=DATE:20231225
+END:VEVENT
+END:VCALENDAR`},
+	})
+	woc := newCronWfOperationCtx(&cronWf, cs, kubeClient, metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}))
+
+	christmas := time.Date(2023, 12, 25, 12, 0, 0, 0, time.UTC)
+	woc.run(ctx, cronWf.Spec.GetSchedules()[0], christmas)
+	wfs, err := cs.ArgoprojV1alpha1().Workflows("argo").List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, wfs.Items)
+	if assert.Len(t, woc.cronWf.Status.Conditions, 1) {
+		assert.Equal(t, v1alpha1.ConditionTypeSkipped, woc.cronWf.Status.Conditions[0].Type)
+		assert.Contains(t, woc.cronWf.Status.Conditions[0].Message, `blackout window "Christmas Day"`)
+	}
+	assert.Equal(t, christmas.Unix(), woc.cronWf.Status.LastScheduledTime.Unix(), "a skipped run is not run later as a missed run")
+
+	boxingDay := christmas.AddDate(0, 0, 1)
+	woc.run(ctx, cronWf.Spec.GetSchedules()[0], boxingDay)
+	wfs, err = cs.ArgoprojV1alpha1().Workflows("argo").List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, wfs.Items, 1)
+	assert.Empty(t, woc.cronWf.Status.Conditions)
+
+	woc.cronWf.Spec.BlackoutCalendars[0].Name = "missing"
+	woc.run(ctx, cronWf.Spec.GetSchedules()[0], boxingDay.AddDate(0, 0, 1))
+	wfs, err = cs.ArgoprojV1alpha1().Workflows("argo").List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, wfs.Items, 1, "runs are not run when the blackout calendars cannot be read")
+	if assert.Len(t, woc.cronWf.Status.Conditions, 1) {
+		assert.Equal(t, v1alpha1.ConditionTypeSubmissionError, woc.cronWf.Status.Conditions[0].Type)
+	}
+}
+
+func TestRunHistoryAndStopStrategy(t *testing.T) {
+	ctx := context.Background()
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{ConsecutiveFailures: 2}
+	cs := fake.NewSimpleClientset(&cronWf)
+	woc := newCronWfOperationCtx(&cronWf, cs, nil, metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}))
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newWorkflow := func(i int, phase v1alpha1.WorkflowPhase) v1alpha1.Workflow {
+		scheduledTime := start.Add(time.Duration(i) * time.Hour)
+		return v1alpha1.Workflow{
+			ObjectMeta: v1.ObjectMeta{
+				Name:        GetChildWorkflowName(cronWf.Name, scheduledTime),
+				UID:         types.UID(fmt.Sprint(i)),
+				Labels:      map[string]string{common.LabelKeyCronWorkflow: cronWf.Name},
+				Annotations: map[string]string{common.AnnotationKeyCronWfScheduledTime: scheduledTime.Format(time.RFC3339)},
+			},
+			Status: v1alpha1.WorkflowStatus{
+				Phase:      phase,
+				StartedAt:  v1.NewTime(scheduledTime),
+				FinishedAt: v1.NewTime(scheduledTime.Add(time.Minute)),
+			},
+		}
+	}
+	complete := func(wfs ...v1alpha1.Workflow) {
+		for _, wf := range wfs {
+			woc.cronWf.Status.Active = append(woc.cronWf.Status.Active, getWorkflowObjectReference(&wf, &wf))
+		}
+		require.NoError(t, woc.reconcileActiveWfs(ctx, wfs))
+	}
+
+	// the workflows are recorded in the order they finished
+	complete(newWorkflow(1, v1alpha1.WorkflowFailed), newWorkflow(0, v1alpha1.WorkflowSucceeded))
+	status := woc.cronWf.Status
+	assert.Empty(t, status.Active)
+	assert.Equal(t, int64(1), status.Succeeded)
+	assert.Equal(t, int64(1), status.Failed)
+	assert.Equal(t, int64(1), status.ConsecutiveFailures)
+	if assert.Len(t, status.RecentRuns, 2) {
+		run := status.RecentRuns[0]
+		assert.Equal(t, v1alpha1.WorkflowFailed, run.Phase)
+		assert.Equal(t, start.Add(time.Hour).Unix(), run.ScheduledTime.Unix())
+		assert.Equal(t, time.Minute, run.Duration.Duration)
+	}
+	assert.False(t, woc.cronWf.Spec.Suspend)
+
+	complete(newWorkflow(2, v1alpha1.WorkflowError))
+	assert.Equal(t, int64(2), woc.cronWf.Status.ConsecutiveFailures)
+	assert.True(t, woc.cronWf.Spec.Suspend, "the cron workflow is suspended after 2 consecutive failures")
+	if assert.Len(t, woc.cronWf.Status.Conditions, 1) {
+		assert.Equal(t, v1alpha1.ConditionTypeStopped, woc.cronWf.Status.Conditions[0].Type)
+	}
+
+	// the counters are reset when it is resumed, so the next failure does not suspend it again
+	woc.cronWf.Spec.Suspend = false
+	_, err := cs.ArgoprojV1alpha1().CronWorkflows("argo").Update(ctx, woc.cronWf, v1.UpdateOptions{})
+	require.NoError(t, err)
+	complete()
+	status = woc.cronWf.Status
+	assert.Equal(t, int64(0), status.Succeeded)
+	assert.Equal(t, int64(0), status.Failed)
+	assert.Equal(t, int64(0), status.ConsecutiveFailures)
+	assert.Empty(t, status.Conditions)
+	assert.Len(t, status.RecentRuns, 3, "the recent runs are kept")
+	persisted, err := cs.ArgoprojV1alpha1().CronWorkflows("argo").Get(ctx, cronWf.Name, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), persisted.Status.ConsecutiveFailures)
+	assert.Empty(t, persisted.Status.Conditions)
+	complete(newWorkflow(3, v1alpha1.WorkflowFailed))
+	assert.Equal(t, int64(1), woc.cronWf.Status.ConsecutiveFailures)
+	assert.False(t, woc.cronWf.Spec.Suspend)
+
+	woc.cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{Expression: "cronworkflow.succeeded >= 3"}
+	_, err = cs.ArgoprojV1alpha1().CronWorkflows("argo").Update(ctx, woc.cronWf, v1.UpdateOptions{})
+	require.NoError(t, err)
+	for i := 4; i < 4+maxRecentRuns; i++ {
+		complete(newWorkflow(i, v1alpha1.WorkflowSucceeded))
+		if i < 6 {
+			assert.False(t, woc.cronWf.Spec.Suspend)
+		}
+	}
+	assert.True(t, woc.cronWf.Spec.Suspend, "the cron workflow is suspended once the expression is true")
+	assert.Equal(t, int64(0), woc.cronWf.Status.ConsecutiveFailures)
+	assert.Len(t, woc.cronWf.Status.RecentRuns, maxRecentRuns)
+	assert.Equal(t, GetChildWorkflowName(cronWf.Name, start.Add(time.Duration(3+maxRecentRuns)*time.Hour)), woc.cronWf.Status.RecentRuns[0].Name)
+
+	// a workflow that completes before it is added to the active workflows, e.g. a backfilled run, is recorded once,
+	// and the workflows that were recorded, or are older than the recent runs, are not recorded again
+	var wfs []v1alpha1.Workflow
+	for i := 0; i < 4+maxRecentRuns; i++ {
+		wfs = append(wfs, newWorkflow(i, v1alpha1.WorkflowSucceeded))
+	}
+	wfs = append(wfs, newWorkflow(4+maxRecentRuns, v1alpha1.WorkflowFailed))
+	succeeded := woc.cronWf.Status.Succeeded
+	for i := 0; i < 2; i++ {
+		require.NoError(t, woc.reconcileActiveWfs(ctx, wfs))
+		assert.Equal(t, succeeded, woc.cronWf.Status.Succeeded)
+		assert.Equal(t, int64(1), woc.cronWf.Status.ConsecutiveFailures)
+		assert.Len(t, woc.cronWf.Status.RecentRuns, maxRecentRuns)
+		assert.Equal(t, wfs[4+maxRecentRuns].Name, woc.cronWf.Status.RecentRuns[0].Name)
+	}
+}
+
+func TestMissedScheduleWithJitter(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.Schedule = "0 * * * *"
+	cronWf.Spec.Timezone = "UTC"
+	cronWf.Spec.Jitter = &v1.Duration{Duration: time.Hour}
+	cronWf.Spec.StartingDeadlineSeconds = pointer.Int64(2 * 60 * 60)
+	cronWf.SetSchedule(cronWf.Spec.GetScheduleFingerprint())
+	offset := GetJitterOffset(&cronWf)
+	now := time.Now().UTC()
+	// the last run was two hours before the latest time the cron workflow was scheduled at and dispatched by now
+	lastRun := now.Add(-offset).Truncate(time.Hour).Add(-2 * time.Hour)
+	cronWf.Status.LastScheduledTime = &v1.Time{Time: lastRun}
+	woc := &cronWfOperationCtx{cronWf: &cronWf, log: logrus.WithFields(logrus.Fields{})}
+
+	missedExecutionTime, _, err := woc.shouldOutstandingWorkflowsBeRun()
+	require.NoError(t, err)
+	assert.Equal(t, lastRun.Add(2*time.Hour).Unix(), missedExecutionTime.Unix(), "the missed run is its scheduled time, which was dispatched after the jitter offset")
+
+	// changing the jitter changes when the runs are dispatched, like changing the schedule
+	cronWf.Spec.Jitter = &v1.Duration{Duration: 2 * time.Hour}
+	assert.True(t, cronWf.IsUsingNewSchedule())
+	missedExecutionTime, _, err = woc.shouldOutstandingWorkflowsBeRun()
+	require.NoError(t, err)
+	assert.True(t, missedExecutionTime.IsZero())
+}
+
+func TestRunWithJitter(t *testing.T) {
+	ctx := context.Background()
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	cronWf.Spec.Jitter = &v1.Duration{Duration: time.Hour}
+	cronWf.Spec.BlackoutWindows = []v1alpha1.BlackoutWindow{{Name: "freeze", Start: v1.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), End: v1.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC)}}
+	cs := fake.NewSimpleClientset(&cronWf)
+	woc := newCronWfOperationCtx(&cronWf, cs, nil, metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}))
+	require.Greater(t, GetJitterOffset(&cronWf), time.Duration(0))
+
+	// the run is dispatched after the jitter offset, but it is named and labelled with its scheduled time
+	scheduledTime := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	woc.run(ctx, cronWf.Spec.GetSchedules()[0], scheduledTime)
+	wf, err := cs.ArgoprojV1alpha1().Workflows("argo").Get(ctx, GetChildWorkflowName(cronWf.Name, scheduledTime), v1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, scheduledTime.Format(time.RFC3339), wf.Annotations[common.AnnotationKeyCronWfScheduledTime])
+	}
+	assert.Equal(t, scheduledTime.Unix(), woc.cronWf.Status.LastScheduledTime.Unix())
+	assert.Equal(t, woc.cronWf.Spec.GetScheduleFingerprint(), woc.cronWf.GetLatestSchedule())
+
+	// the blackout windows apply to the scheduled time, even if the run is dispatched after the window ends
+	woc.run(ctx, cronWf.Spec.GetSchedules()[0], time.Date(2023, 1, 2, 23, 59, 0, 0, time.UTC))
+	if assert.Len(t, woc.cronWf.Status.Conditions, 1) {
+		assert.Equal(t, v1alpha1.ConditionTypeSkipped, woc.cronWf.Status.Conditions[0].Type)
+	}
+}
@@ -0,0 +1,156 @@
+package cron
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// jitteredSchedule delays the times of a schedule by an offset, which is used to dispatch the runs of the schedule
+type jitteredSchedule struct {
+	cron.Schedule
+	offset time.Duration
+}
+
+func (s *jitteredSchedule) Next(t time.Time) time.Time {
+	next := s.Schedule.Next(t.Add(-s.offset))
+	if next.IsZero() {
+		return next
+	}
+	return next.Add(s.offset)
+}
+
+// ParseSchedule parses one of the schedules of a CronWorkflow. Its times are the scheduled times of the runs, which
+// are not delayed by the jitter offset of the CronWorkflow.
+func ParseSchedule(schedule v1alpha1.CronWorkflowSchedule) (cron.Schedule, error) {
+	cronSchedule, err := cron.ParseStandard(schedule.GetScheduleString())
+	if err != nil {
+		return nil, fmt.Errorf("unable to form schedule '%s': %w", schedule.GetScheduleString(), err)
+	}
+	return cronSchedule, nil
+}
+
+// delaySchedule returns the schedule with its times delayed by the offset
+func delaySchedule(cronSchedule cron.Schedule, offset time.Duration) cron.Schedule {
+	if offset <= 0 {
+		return cronSchedule
+	}
+	return &jitteredSchedule{Schedule: cronSchedule, offset: offset}
+}
+
+// GetJitterOffset returns how long the runs of the CronWorkflow are dispatched after their scheduled times. It is a
+// whole number of seconds less than the jitter, derived from the namespace and name of the CronWorkflow, so that it is
+// the same for every run.
+func GetJitterOffset(cronWf *v1alpha1.CronWorkflow) time.Duration {
+	if cronWf.Spec.Jitter == nil || cronWf.Spec.Jitter.Duration < time.Second {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(cronWf.Namespace + "/" + cronWf.Name))
+	return time.Duration(h.Sum64()%uint64(cronWf.Spec.Jitter.Duration/time.Second)) * time.Second
+}
+
+// ScheduledRun is a time at which a CronWorkflow is scheduled to run on one of its schedules
+type ScheduledRun struct {
+	Schedule v1alpha1.CronWorkflowSchedule
+	Time     time.Time
+}
+
+// GetScheduledRuns returns the runs of the CronWorkflow that are scheduled between from and to inclusive, on all of its
+// schedules, in order, skipping the runs that are in the blackout windows. If several schedules are due at the same
+// time, only the run on the first of them is returned, as the CronWorkflow only runs once.
+func GetScheduledRuns(cronWf *v1alpha1.CronWorkflow, windows []v1alpha1.BlackoutWindow, from, to time.Time) ([]ScheduledRun, error) {
+	var runs []ScheduledRun
+	scheduled := make(map[int64]bool)
+	for _, schedule := range cronWf.Spec.GetSchedules() {
+		cronSchedule, err := ParseSchedule(schedule)
+		if err != nil {
+			return nil, err
+		}
+		// the first run is strictly after the time passed to Next, and schedules have a granularity of a second
+		for t := cronSchedule.Next(from.Add(-time.Second)); !t.IsZero() && !t.After(to); t = cronSchedule.Next(t) {
+			if scheduled[t.Unix()] {
+				continue
+			}
+			scheduled[t.Unix()] = true
+			if FindBlackoutWindow(windows, t) != nil {
+				continue
+			}
+			runs = append(runs, ScheduledRun{Schedule: schedule, Time: t})
+		}
+	}
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].Time.Before(runs[j].Time) })
+	return runs, nil
+}
+
+// GetSchedulesDue returns the schedules of the CronWorkflow that are due at the time, in order. The CronWorkflow only
+// runs once at such a time, on the first of them, whichever of them fires first.
+func GetSchedulesDue(cronWf *v1alpha1.CronWorkflow, t time.Time) ([]v1alpha1.CronWorkflowSchedule, error) {
+	var schedules []v1alpha1.CronWorkflowSchedule
+	for _, schedule := range cronWf.Spec.GetSchedules() {
+		cronSchedule, err := ParseSchedule(schedule)
+		if err != nil {
+			return nil, err
+		}
+		if cronSchedule.Next(t.Add(-time.Second)).Unix() == t.Unix() {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return schedules, nil
+}
+
+// NewScheduledWorkflow returns the Workflow that the CronWorkflow runs at the scheduled time on the schedule
+func NewScheduledWorkflow(cronWf *v1alpha1.CronWorkflow, schedule v1alpha1.CronWorkflowSchedule, scheduledTime time.Time) (*v1alpha1.Workflow, error) {
+	wf := common.ConvertCronWorkflowToWorkflowWithProperties(cronWf, GetChildWorkflowName(cronWf.Name, scheduledTime), scheduledTime)
+	submitOpts := &v1alpha1.SubmitOpts{}
+	for _, param := range schedule.Parameters {
+		submitOpts.Parameters = append(submitOpts.Parameters, fmt.Sprintf("%s=%s", param.Name, param.Value.String()))
+	}
+	if err := util.ApplySubmitOpts(wf, submitOpts); err != nil {
+		return nil, err
+	}
+	return wf, nil
+}
+
+// GetChildWorkflowName returns the name of the Workflow that the CronWorkflow runs at the scheduled time
+func GetChildWorkflowName(cronWorkflowName string, scheduledRuntime time.Time) string {
+	return fmt.Sprintf("%s-%d", cronWorkflowName, scheduledRuntime.Unix())
+}
+
+// GetUpcomingRuns returns the next n runs of the CronWorkflow after from, on all of its schedules, in order, skipping the
+// runs that are in the blackout windows
+func GetUpcomingRuns(cronWf *v1alpha1.CronWorkflow, windows []v1alpha1.BlackoutWindow, from time.Time, n int) ([]ScheduledRun, error) {
+	var runs []ScheduledRun
+	scheduled := make(map[int64]bool)
+	for _, schedule := range cronWf.Spec.GetSchedules() {
+		cronSchedule, err := ParseSchedule(schedule)
+		if err != nil {
+			return nil, err
+		}
+		for t, found := cronSchedule.Next(from), 0; !t.IsZero() && found < n; {
+			// skip to the first time that the schedule is due after the window ends
+			if window := FindBlackoutWindow(windows, t); window != nil {
+				t = cronSchedule.Next(window.End.Add(-time.Second))
+				continue
+			}
+			if !scheduled[t.Unix()] {
+				scheduled[t.Unix()] = true
+				runs = append(runs, ScheduledRun{Schedule: schedule, Time: t})
+				found++
+			}
+			t = cronSchedule.Next(t)
+		}
+	}
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].Time.Before(runs[j].Time) })
+	if len(runs) > n {
+		runs = runs[:n]
+	}
+	return runs, nil
+}
@@ -0,0 +1,144 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestGetScheduledRuns(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: v1alpha1.CronWorkflowSpec{
+			Schedule:  "0 */6 * * *",
+			Timezone:  "UTC",
+			Schedules: []v1alpha1.CronWorkflowSchedule{{Name: "noon", Schedule: "0 12 * * *"}},
+		},
+	}
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	runs, err := GetScheduledRuns(cronWf, nil, from, from.Add(24*time.Hour))
+	require.NoError(t, err)
+	var times []string
+	for _, run := range runs {
+		times = append(times, run.Time.UTC().Format("2006-01-02T15:04")+" "+run.Schedule.Name)
+	}
+	assert.Equal(t, []string{
+		"2023-01-01T00:00 CRON_TZ=UTC 0 */6 * * *",
+		"2023-01-01T06:00 CRON_TZ=UTC 0 */6 * * *",
+		"2023-01-01T12:00 CRON_TZ=UTC 0 */6 * * *",
+		"2023-01-01T18:00 CRON_TZ=UTC 0 */6 * * *",
+		"2023-01-02T00:00 CRON_TZ=UTC 0 */6 * * *",
+	}, times, "the range is inclusive, and runs due on several schedules are only returned once")
+
+	runs, err = GetScheduledRuns(cronWf, nil, from.Add(7*time.Hour), from.Add(11*time.Hour))
+	assert.NoError(t, err)
+	assert.Empty(t, runs)
+
+	windows := []v1alpha1.BlackoutWindow{{Start: metav1.NewTime(from.Add(6 * time.Hour)), End: metav1.NewTime(from.Add(13 * time.Hour))}}
+	runs, err = GetScheduledRuns(cronWf, windows, from, from.Add(24*time.Hour))
+	require.NoError(t, err)
+	times = nil
+	for _, run := range runs {
+		times = append(times, run.Time.UTC().Format("2006-01-02T15:04"))
+	}
+	assert.Equal(t, []string{"2023-01-01T00:00", "2023-01-01T18:00", "2023-01-02T00:00"}, times, "runs in the blackout windows are skipped")
+
+	cronWf.Spec.Schedule = "not a schedule"
+	_, err = GetScheduledRuns(cronWf, nil, from, from.Add(time.Hour))
+	assert.Error(t, err)
+}
+
+func TestNewScheduledWorkflow(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf", UID: "my-uid"},
+		Spec: v1alpha1.CronWorkflowSpec{
+			WorkflowSpec: v1alpha1.WorkflowSpec{Arguments: v1alpha1.Arguments{Parameters: []v1alpha1.Parameter{{Name: "message", Value: v1alpha1.AnyStringPtr("hello")}}}},
+		},
+	}
+	schedule := v1alpha1.CronWorkflowSchedule{Parameters: []v1alpha1.Parameter{{Name: "message", Value: v1alpha1.AnyStringPtr("goodbye")}}}
+	scheduledTime := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	wf, err := NewScheduledWorkflow(cronWf, schedule, scheduledTime)
+	require.NoError(t, err)
+	assert.Equal(t, "my-cwf-1672574400", wf.Name)
+	assert.Equal(t, "2023-01-01T12:00:00Z", wf.Annotations[common.AnnotationKeyCronWfScheduledTime])
+	assert.Equal(t, "my-cwf", wf.Labels[common.LabelKeyCronWorkflow])
+	assert.Equal(t, "goodbye", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+	if assert.Len(t, wf.OwnerReferences, 1) {
+		assert.Equal(t, "my-uid", string(wf.OwnerReferences[0].UID))
+	}
+}
+
+func TestGetUpcomingRuns(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: v1alpha1.CronWorkflowSpec{
+			Schedule:  "0 0 * * *",
+			Timezone:  "UTC",
+			Schedules: []v1alpha1.CronWorkflowSchedule{{Name: "noon", Schedule: "0 12 * * *"}},
+		},
+	}
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	windows := []v1alpha1.BlackoutWindow{{
+		Start: metav1.NewTime(from.Add(6 * time.Hour)),
+		End:   metav1.NewTime(from.Add(72 * time.Hour)),
+	}}
+	runs, err := GetUpcomingRuns(cronWf, windows, from, 4)
+	require.NoError(t, err)
+	var times []string
+	for _, run := range runs {
+		times = append(times, run.Time.UTC().Format("2006-01-02T15:04")+" "+run.Schedule.Name)
+	}
+	assert.Equal(t, []string{
+		"2023-01-04T00:00 CRON_TZ=UTC 0 0 * * *",
+		"2023-01-04T12:00 noon",
+		"2023-01-05T00:00 CRON_TZ=UTC 0 0 * * *",
+		"2023-01-05T12:00 noon",
+	}, times, "the runs after from that are not in the blackout windows")
+}
+
+func TestJitter(t *testing.T) {
+	newCronWorkflow := func(name string) *v1alpha1.CronWorkflow {
+		return &v1alpha1.CronWorkflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argo"},
+			Spec: v1alpha1.CronWorkflowSpec{
+				Schedule: "0 * * * *",
+				Timezone: "UTC",
+				Jitter:   &metav1.Duration{Duration: 30 * time.Minute},
+			},
+		}
+	}
+	cronWf := newCronWorkflow("my-cwf")
+	offset := GetJitterOffset(cronWf)
+	assert.Equal(t, offset, GetJitterOffset(newCronWorkflow("my-cwf")), "the offset is deterministic")
+	assert.Less(t, offset, 30*time.Minute)
+	assert.Zero(t, offset%time.Second)
+	offsets := map[time.Duration]bool{}
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		offsets[GetJitterOffset(newCronWorkflow(name))] = true
+	}
+	assert.Greater(t, len(offsets), 1, "the offsets of cron workflows are spread")
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule, err := ParseSchedule(cronWf.Spec.GetSchedules()[0])
+	require.NoError(t, err)
+	assert.Equal(t, from, schedule.Next(from.Add(-time.Second)), "the scheduled times are not delayed")
+	dispatchSchedule := delaySchedule(schedule, offset)
+	assert.Equal(t, from.Add(offset), dispatchSchedule.Next(from.Add(-time.Second)))
+	assert.Equal(t, from.Add(time.Hour+offset), dispatchSchedule.Next(from.Add(offset)))
+
+	runs, err := GetScheduledRuns(cronWf, nil, from, from.Add(2*time.Hour))
+	require.NoError(t, err)
+	if assert.Len(t, runs, 3) {
+		assert.Equal(t, from, runs[0].Time)
+		assert.Equal(t, from.Add(time.Hour), runs[1].Time)
+	}
+
+	cronWf.Spec.Jitter = nil
+	assert.Zero(t, GetJitterOffset(cronWf))
+}
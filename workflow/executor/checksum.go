@@ -0,0 +1,94 @@
+package executor
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/argoproj/argo-workflows/v3/util/file"
+)
+
+// artifactChecksum returns the sha256 checksum of the artifact staged at the path. The checksum of a directory, or of a
+// tarball, is computed from the names and contents of its files, so that it does not depend on their modification
+// times, and an unchanged artifact has the same checksum every time it is produced.
+func artifactChecksum(localArtPath string, tarball bool) (string, error) {
+	info, err := os.Stat(localArtPath)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	switch {
+	case info.IsDir():
+		err = hashDir(h, localArtPath)
+	case tarball:
+		err = hashTarball(h, localArtPath)
+	default:
+		err = hashFile(h, localArtPath)
+	}
+	if err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFile(h hash.Hash, path string) error {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	_, err = io.Copy(h, f)
+	return err
+}
+
+// hashDir hashes the relative paths and contents of the files in the directory, in lexical order
+func hashDir(h hash.Hash, dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		_, _ = h.Write([]byte(rel + "\x00"))
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return hashFile(h, path)
+	})
+}
+
+// hashTarball hashes the names and contents of the entries of the gzipped tarball, in the order of the tarball
+func hashTarball(h hash.Hash, path string) error {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	gzr, err := file.GetGzipReader(f)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = gzr.Close() }()
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		_, _ = h.Write([]byte(header.Name + "\x00" + header.Linkname + "\x00"))
+		if header.Typeflag == tar.TypeReg {
+			if _, err := io.Copy(h, tr); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package executor
+
+import (
+	"bufio"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+)
+
+func TestArtifactChecksum(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	assert.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("hello"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("world"), 0o600))
+
+	tarball := func(name string) string {
+		path := filepath.Join(dir, name)
+		f, err := os.Create(path)
+		assert.NoError(t, err)
+		w := bufio.NewWriter(f)
+		assert.NoError(t, archive.TarGzToWriter(src, gzip.DefaultCompression, w))
+		assert.NoError(t, f.Close())
+		return path
+	}
+
+	t.Run("File", func(t *testing.T) {
+		checksum, err := artifactChecksum(filepath.Join(src, "a.txt"), false)
+		assert.NoError(t, err)
+		assert.Equal(t, "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", checksum)
+	})
+	t.Run("Directory", func(t *testing.T) {
+		checksum, err := artifactChecksum(src, false)
+		assert.NoError(t, err)
+		assert.Regexp(t, "^sha256:[0-9a-f]{64}$", checksum)
+	})
+	t.Run("Tarball", func(t *testing.T) {
+		first, err := artifactChecksum(tarball("first.tgz"), true)
+		assert.NoError(t, err)
+
+		// the checksum does not depend on the modification times
+		later := time.Now().Add(time.Hour)
+		assert.NoError(t, os.Chtimes(filepath.Join(src, "a.txt"), later, later))
+		second, err := artifactChecksum(tarball("second.tgz"), true)
+		assert.NoError(t, err)
+		assert.Equal(t, first, second)
+
+		assert.NoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("changed"), 0o600))
+		third, err := artifactChecksum(tarball("third.tgz"), true)
+		assert.NoError(t, err)
+		assert.NotEqual(t, first, third)
+	})
+}
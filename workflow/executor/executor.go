@@ -318,6 +318,12 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 		}
 		return err
 	}
+	// the checksum is best effort, it is only used to derive automatic memoization keys
+	checksum, err := artifactChecksum(localArtPath, art.Archive == nil || art.Archive.Tar != nil)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to compute the checksum of artifact %s", art.Name)
+	}
+	art.Checksum = checksum
 	return we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
 }
 
@@ -28,6 +28,9 @@ import (
 
 var ticker *time.Ticker = time.NewTicker(50 * time.Millisecond)
 
+// archivePendingRequeueDelay is how long to wait before trying again to delete a workflow that is pending archiving
+const archivePendingRequeueDelay = time.Minute
+
 type Controller struct {
 	wfclientset      wfclientset.Interface
 	wfInformer       cache.SharedIndexInformer
@@ -35,26 +38,26 @@ type Controller struct {
 	clock            clock.WithTickerAndDelayedExecution
 	metrics          *metrics.Metrics
 	orderedQueueLock sync.Mutex
-	orderedQueue     map[wfv1.WorkflowPhase]*gcHeap
+	orderedQueue     map[retentionKey]*gcHeap
 	retentionPolicy  *config.RetentionPolicy
+	retentionRules   []retentionRule
+	// archiveSpooled returns whether workflows that fail to be archived are spooled and retried, in which case
+	// workflows pending archiving are not deleted until they are archived
+	archiveSpooled func() bool
 }
 
 // NewController returns a new workflow ttl controller
-func NewController(wfClientset wfclientset.Interface, wfInformer cache.SharedIndexInformer, metrics *metrics.Metrics, retentionPolicy *config.RetentionPolicy) *Controller {
-
-	orderedQueue := map[wfv1.WorkflowPhase]*gcHeap{
-		wfv1.WorkflowFailed:    NewHeap(),
-		wfv1.WorkflowError:     NewHeap(),
-		wfv1.WorkflowSucceeded: NewHeap(),
-	}
+func NewController(wfClientset wfclientset.Interface, wfInformer cache.SharedIndexInformer, metrics *metrics.Metrics, retentionPolicy *config.RetentionPolicy, archiveSpooled func() bool) *Controller {
 	controller := &Controller{
 		wfclientset:     wfClientset,
 		wfInformer:      wfInformer,
 		workqueue:       metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "workflow_ttl_queue"),
 		clock:           clock.RealClock{},
 		metrics:         metrics,
-		orderedQueue:    orderedQueue,
+		orderedQueue:    map[retentionKey]*gcHeap{},
 		retentionPolicy: retentionPolicy,
+		retentionRules:  newRetentionRules(retentionPolicy),
+		archiveSpooled:  archiveSpooled,
 	}
 
 	wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
@@ -84,6 +87,10 @@ func NewController(wfClientset wfclientset.Interface, wfInformer cache.SharedInd
 			},
 		},
 	})
+
+	wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: controller.retentionDequeue,
+	})
 	return controller
 }
 
@@ -101,13 +108,48 @@ func (c *Controller) retentionEnqueue(obj interface{}) {
 
 	switch phase := wfv1.WorkflowPhase(un.GetLabels()[common.LabelKeyPhase]); phase {
 	case wfv1.WorkflowSucceeded, wfv1.WorkflowFailed, wfv1.WorkflowError:
+		key, maxWorkflows := c.retention(un, phase)
 		c.orderedQueueLock.Lock()
-		heap.Push(c.orderedQueue[phase], un)
-		c.runGC(phase)
+		if c.orderedQueue[key] == nil {
+			c.orderedQueue[key] = NewHeap()
+		}
+		heap.Push(c.orderedQueue[key], un)
+		c.runGC(key, maxWorkflows)
 		c.orderedQueueLock.Unlock()
 	}
 }
 
+// retentionDequeue removes a deleted workflow from the workflows it is retained with, and removes them if there are
+// none left, so that groups of workflows do not accumulate as their templates are deleted
+func (c *Controller) retentionDequeue(obj interface{}) {
+	if c.retentionPolicy == nil {
+		return
+	}
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	switch phase := wfv1.WorkflowPhase(un.GetLabels()[common.LabelKeyPhase]); phase {
+	case wfv1.WorkflowSucceeded, wfv1.WorkflowFailed, wfv1.WorkflowError:
+		key, _ := c.retention(un, phase)
+		c.orderedQueueLock.Lock()
+		defer c.orderedQueueLock.Unlock()
+		h := c.orderedQueue[key]
+		if h == nil {
+			return
+		}
+		if i := h.index(un); i >= 0 {
+			heap.Remove(h, i)
+		}
+		if h.Len() == 0 {
+			delete(c.orderedQueue, key)
+		}
+	}
+}
+
 func (c *Controller) Run(stopCh <-chan struct{}, workflowGCWorkers int) error {
 	defer runtimeutil.HandleCrash()
 	defer c.workqueue.ShutDown()
@@ -136,27 +178,22 @@ func (c *Controller) runWorker() {
 	}
 }
 
-// retentionGC queues workflows for deletion based upon the retention policy.
-func (c *Controller) runGC(phase wfv1.WorkflowPhase) {
+// runGC queues workflows for deletion based upon the retention policy, or only logs them in dry-run mode.
+func (c *Controller) runGC(key retentionKey, maxWorkflows int) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
-	var maxWorkflows int
-	switch phase {
-	case wfv1.WorkflowSucceeded:
-		maxWorkflows = c.retentionPolicy.Completed
-	case wfv1.WorkflowFailed:
-		maxWorkflows = c.retentionPolicy.Failed
-	case wfv1.WorkflowError:
-		maxWorkflows = c.retentionPolicy.Errored
-	default:
-		return
-	}
-
-	for c.orderedQueue[phase].Len() > maxWorkflows {
-		key, _ := cache.MetaNamespaceKeyFunc(heap.Pop(c.orderedQueue[phase]))
-		log.Infof("Queueing %v workflow %s for delete due to max rention(%d workflows)", phase, key, maxWorkflows)
-		c.workqueue.Add(key)
+	for c.orderedQueue[key].Len() > maxWorkflows {
+		wfKey, _ := cache.MetaNamespaceKeyFunc(heap.Pop(c.orderedQueue[key]))
+		if c.retentionPolicy.DryRun {
+			log.Infof("Dry-run: would delete %s workflow %s due to max retention(%d workflows)", c.describe(key), wfKey, maxWorkflows)
+			continue
+		}
+		log.Infof("Queueing %s workflow %s for delete due to max rention(%d workflows)", c.describe(key), wfKey, maxWorkflows)
+		c.workqueue.Add(wfKey)
 		<-ticker.C
 	}
+	if c.orderedQueue[key].Len() == 0 {
+		delete(c.orderedQueue, key)
+	}
 }
 
 // processNextWorkItem will read a single work item off the workqueue and
@@ -205,6 +242,17 @@ func (c *Controller) deleteWorkflow(ctx context.Context, key string) error {
 	// It should be impossible for a workflow to have been queue without a valid key.
 	namespace, name, _ := cache.SplitMetaNamespaceKey(key)
 
+	// A workflow may have been retried and completed again since it was queued, and it must not be deleted until it
+	// has been archived again, e.g. while the database of the archive is unavailable. Without the spool, a workflow
+	// that failed to be archived is never archived, so it is deleted anyway.
+	if obj, exists, err := c.wfInformer.GetStore().GetByKey(key); err == nil && exists && c.archiveSpooled() {
+		if un, ok := obj.(*unstructured.Unstructured); ok && un.GetLabels()[common.LabelKeyWorkflowArchivingStatus] == "Pending" {
+			log.Infof("Not deleting workflow '%s' until it has been archived, requeueing in %v", key, archivePendingRequeueDelay)
+			c.workqueue.AddAfter(key, archivePendingRequeueDelay)
+			return nil
+		}
+	}
+
 	// Any workflow that was queued must need deleting, therefore we do not check the expiry again.
 	log.Infof("Deleting garbage collected workflow '%s'", key)
 	err := c.wfclientset.ArgoprojV1alpha1().Workflows(namespace).Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: commonutil.GetDeletePropagation()})
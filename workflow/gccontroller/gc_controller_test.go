@@ -2,18 +2,23 @@ package gccontroller
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	testingclock "k8s.io/utils/clock/testing"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
@@ -347,11 +352,13 @@ func newTTLController() *Controller {
 	wfclientset := fakewfclientset.NewSimpleClientset()
 	wfInformer := cache.NewSharedIndexInformer(nil, nil, 0, nil)
 	return &Controller{
-		wfclientset: wfclientset,
-		wfInformer:  wfInformer,
-		clock:       clock,
-		workqueue:   workqueue.NewDelayingQueue(),
-		metrics:     metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
+		wfclientset:    wfclientset,
+		wfInformer:     wfInformer,
+		clock:          clock,
+		workqueue:      workqueue.NewDelayingQueue(),
+		metrics:        metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
+		orderedQueue:   map[retentionKey]*gcHeap{},
+		archiveSpooled: func() bool { return true },
 	}
 }
 
@@ -567,3 +574,125 @@ func TestGetTTLStrategy(t *testing.T) {
 		assert.Nil(t, ttl)
 	})
 }
+
+func TestDeleteWorkflowPendingArchiving(t *testing.T) {
+	ctx := context.Background()
+	controller := newTTLController()
+	wf := wfv1.MustUnmarshalWorkflow([]byte(succeededWf))
+	wf.Labels = map[string]string{common.LabelKeyWorkflowArchivingStatus: "Pending"}
+	_, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+	require.NoError(t, err)
+	un, err := util.ToUnstructured(wf)
+	require.NoError(t, err)
+	require.NoError(t, controller.wfInformer.GetStore().Add(un))
+	key, _ := cache.MetaNamespaceKeyFunc(un)
+
+	require.NoError(t, controller.deleteWorkflow(ctx, key))
+	_, err = controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err, "a workflow pending archiving is not deleted")
+
+	un.SetLabels(map[string]string{common.LabelKeyWorkflowArchivingStatus: "Archived"})
+	require.NoError(t, controller.wfInformer.GetStore().Update(un))
+	require.NoError(t, controller.deleteWorkflow(ctx, key))
+	_, err = controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+
+	t.Run("NoSpool", func(t *testing.T) {
+		controller.archiveSpooled = func() bool { return false }
+		_, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+		require.NoError(t, err)
+		un.SetLabels(map[string]string{common.LabelKeyWorkflowArchivingStatus: "Pending"})
+		require.NoError(t, controller.wfInformer.GetStore().Update(un))
+		require.NoError(t, controller.deleteWorkflow(ctx, key))
+		_, err = controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+		assert.True(t, apierr.IsNotFound(err), "a workflow pending archiving is deleted when it is not spooled")
+	})
+}
+
+func TestRetentionPolicy(t *testing.T) {
+	pointer := func(i int) *int { return &i }
+	newWorkflow := func(name, namespace string, phase wfv1.WorkflowPhase, created time.Time, labels map[string]string) *unstructured.Unstructured {
+		un := &unstructured.Unstructured{}
+		un.SetName(name)
+		un.SetNamespace(namespace)
+		un.SetCreationTimestamp(metav1.Time{Time: created})
+		workflowLabels := map[string]string{common.LabelKeyPhase: string(phase)}
+		for k, v := range labels {
+			workflowLabels[k] = v
+		}
+		un.SetLabels(workflowLabels)
+		return un
+	}
+	queued := func(controller *Controller) []string {
+		var keys []string
+		for controller.workqueue.Len() > 0 {
+			key, _ := controller.workqueue.Get()
+			controller.workqueue.Done(key)
+			keys = append(keys, key.(string))
+		}
+		return keys
+	}
+	now := time.Now()
+
+	t.Run("Global", func(t *testing.T) {
+		controller := newTTLController()
+		controller.retentionPolicy = &config.RetentionPolicy{Completed: 1, Failed: 5}
+		controller.retentionEnqueue(newWorkflow("old", "my-ns", wfv1.WorkflowSucceeded, now.Add(-time.Hour), nil))
+		controller.retentionEnqueue(newWorkflow("new", "my-ns", wfv1.WorkflowSucceeded, now, nil))
+		controller.retentionEnqueue(newWorkflow("failed", "my-ns", wfv1.WorkflowFailed, now, nil))
+		controller.retentionEnqueue(newWorkflow("errored", "my-ns", wfv1.WorkflowError, now, nil))
+		assert.ElementsMatch(t, []string{"my-ns/old", "my-ns/errored"}, queued(controller))
+	})
+	t.Run("Rules", func(t *testing.T) {
+		controller := newTTLController()
+		controller.retentionPolicy = &config.RetentionPolicy{
+			Completed: 10,
+			Failed:    1,
+			Rules: []config.RetentionRule{
+				{Name: "per-template", GroupBy: "workflowTemplate", Failed: pointer(2)},
+				{Name: "my-cron", Namespace: "my-ns", CronWorkflow: "my-cron", Completed: pointer(0)},
+			},
+		}
+		controller.retentionRules = newRetentionRules(controller.retentionPolicy)
+		busy := map[string]string{common.LabelKeyWorkflowTemplate: "busy"}
+		for i := 0; i < 4; i++ {
+			controller.retentionEnqueue(newWorkflow(fmt.Sprintf("busy-%d", i), "my-ns", wfv1.WorkflowFailed, now.Add(time.Duration(i)*time.Minute), busy))
+		}
+		controller.retentionEnqueue(newWorkflow("rare", "my-ns", wfv1.WorkflowFailed, now.Add(-time.Hour), map[string]string{common.LabelKeyWorkflowTemplate: "rare"}))
+		controller.retentionEnqueue(newWorkflow("busy-other-ns", "other-ns", wfv1.WorkflowFailed, now.Add(-time.Hour), busy))
+		controller.retentionEnqueue(newWorkflow("no-template-old", "my-ns", wfv1.WorkflowFailed, now.Add(-time.Hour), nil))
+		controller.retentionEnqueue(newWorkflow("no-template-new", "my-ns", wfv1.WorkflowFailed, now, nil))
+		cron := map[string]string{common.LabelKeyCronWorkflow: "my-cron"}
+		controller.retentionEnqueue(newWorkflow("cron-succeeded", "my-ns", wfv1.WorkflowSucceeded, now, cron))
+		controller.retentionEnqueue(newWorkflow("cron-other-ns", "other-ns", wfv1.WorkflowSucceeded, now, cron))
+		controller.retentionEnqueue(newWorkflow("cron-failed", "my-ns", wfv1.WorkflowFailed, now.Add(time.Hour), cron))
+		assert.ElementsMatch(t, []string{"my-ns/busy-0", "my-ns/busy-1", "my-ns/no-template-old", "my-ns/cron-succeeded", "my-ns/no-template-new"}, queued(controller))
+
+		// the workflows of a group are removed when they are deleted, or when none are retained
+		assert.NotContains(t, controller.orderedQueue, retentionKey{rule: 1, phase: wfv1.WorkflowSucceeded})
+		rareKey := retentionKey{rule: 0, namespace: "my-ns", group: "rare", phase: wfv1.WorkflowFailed}
+		if assert.Contains(t, controller.orderedQueue, rareKey) {
+			controller.retentionDequeue(newWorkflow("rare", "my-ns", wfv1.WorkflowFailed, now.Add(-time.Hour), map[string]string{common.LabelKeyWorkflowTemplate: "rare"}))
+			assert.NotContains(t, controller.orderedQueue, rareKey)
+		}
+		busyKey := retentionKey{rule: 0, namespace: "my-ns", group: "busy", phase: wfv1.WorkflowFailed}
+		controller.retentionDequeue(cache.DeletedFinalStateUnknown{Obj: newWorkflow("busy-2", "my-ns", wfv1.WorkflowFailed, now, busy)})
+		if assert.Contains(t, controller.orderedQueue, busyKey) {
+			assert.Equal(t, 1, controller.orderedQueue[busyKey].Len())
+		}
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		controller := newTTLController()
+		controller.retentionPolicy = &config.RetentionPolicy{DryRun: true}
+		controller.retentionEnqueue(newWorkflow("my-wf", "my-ns", wfv1.WorkflowSucceeded, now, nil))
+		assert.Empty(t, queued(controller))
+	})
+	t.Run("InvalidSelector", func(t *testing.T) {
+		rules := newRetentionRules(&config.RetentionPolicy{Rules: []config.RetentionRule{
+			{Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bad"}}}},
+		}})
+		_, ok := rules[0].matches(newWorkflow("my-wf", "my-ns", wfv1.WorkflowSucceeded, now, nil))
+		assert.False(t, ok)
+		assert.Equal(t, "0", rules[0].name)
+	})
+}
@@ -5,29 +5,48 @@ import (
 )
 
 type gcHeap struct {
-	heap  []*unstructured.Unstructured
-	dedup map[string]bool
+	heap []*unstructured.Unstructured
+	// indexes are the indexes of the workflows in the heap, keyed by namespace/name, so that they are pushed once and
+	// found without scanning the heap
+	indexes map[string]int
 }
 
 func NewHeap() *gcHeap {
 	return &gcHeap{
-		heap:  make([]*unstructured.Unstructured, 0),
-		dedup: make(map[string]bool),
+		heap:    make([]*unstructured.Unstructured, 0),
+		indexes: make(map[string]int),
 	}
 }
 
+func heapKey(un *unstructured.Unstructured) string {
+	return un.GetNamespace() + "/" + un.GetName()
+}
+
 func (h *gcHeap) Len() int { return len(h.heap) }
 func (h *gcHeap) Less(i, j int) bool {
 	return h.heap[j].GetCreationTimestamp().After((h.heap[i].GetCreationTimestamp().Time))
 }
-func (h *gcHeap) Swap(i, j int) { h.heap[i], h.heap[j] = h.heap[j], h.heap[i] }
+func (h *gcHeap) Swap(i, j int) {
+	h.heap[i], h.heap[j] = h.heap[j], h.heap[i]
+	h.indexes[heapKey(h.heap[i])] = i
+	h.indexes[heapKey(h.heap[j])] = j
+}
 
 func (h *gcHeap) Push(x interface{}) {
-	if _, ok := h.dedup[x.(*unstructured.Unstructured).GetName()]; ok {
+	un := x.(*unstructured.Unstructured)
+	if _, ok := h.indexes[heapKey(un)]; ok {
 		return
 	}
-	h.dedup[x.(*unstructured.Unstructured).GetName()] = true
-	h.heap = append(h.heap, x.(*unstructured.Unstructured))
+	h.indexes[heapKey(un)] = len(h.heap)
+	h.heap = append(h.heap, un)
+}
+
+// index returns the index of the workflow in the heap, or -1 if it is not in the heap
+func (h *gcHeap) index(un *unstructured.Unstructured) int {
+	if i, ok := h.indexes[heapKey(un)]; ok {
+		return i
+	}
+	return -1
 }
 
 func (h *gcHeap) Pop() interface{} {
@@ -35,6 +54,6 @@ func (h *gcHeap) Pop() interface{} {
 	n := len(old)
 	x := old[n-1]
 	h.heap = old[0 : n-1]
-	delete(h.dedup, x.GetName())
+	delete(h.indexes, heapKey(x))
 	return x
 }
@@ -24,8 +24,8 @@ metadata:
 	now := time.Now()
 	wf.SetCreationTimestamp(v1.Time{Time: now})
 	queue := &gcHeap{
-		heap:  []*unstructured.Unstructured{wf},
-		dedup: make(map[string]bool),
+		heap:    []*unstructured.Unstructured{wf},
+		indexes: make(map[string]int),
 	}
 	heap.Init(queue)
 	assert.Equal(t, 1, queue.Len())
@@ -70,8 +70,8 @@ metadata:
 	now := time.Now()
 	wf.SetCreationTimestamp(v1.Time{Time: now})
 	queue := &gcHeap{
-		heap:  []*unstructured.Unstructured{},
-		dedup: make(map[string]bool),
+		heap:    []*unstructured.Unstructured{},
+		indexes: make(map[string]int),
 	}
 	heap.Push(queue, wf)
 	assert.Equal(t, 1, queue.Len())
@@ -92,3 +92,32 @@ metadata:
 	assert.Equal(t, 1, queue.Len())
 
 }
+
+func TestIndexOfPriorityQueue(t *testing.T) {
+	now := time.Now()
+	queue := NewHeap()
+	for i, name := range []string{"c", "a", "d", "b"} {
+		wf := &unstructured.Unstructured{}
+		wf.SetNamespace("my-ns")
+		wf.SetName(name)
+		wf.SetCreationTimestamp(v1.Time{Time: now.Add(time.Duration(i) * time.Second)})
+		heap.Push(queue, wf)
+	}
+	other := &unstructured.Unstructured{}
+	other.SetNamespace("other-ns")
+	other.SetName("a")
+	assert.Equal(t, -1, queue.index(other))
+	a := &unstructured.Unstructured{}
+	a.SetNamespace("my-ns")
+	a.SetName("a")
+	heap.Remove(queue, queue.index(a))
+	assert.Equal(t, -1, queue.index(a))
+	for _, name := range []string{"c", "d", "b"} {
+		wf := heap.Pop(queue).(*unstructured.Unstructured)
+		assert.Equal(t, name, wf.GetName())
+		for i, x := range queue.heap {
+			assert.Equal(t, i, queue.index(x))
+		}
+	}
+	assert.Empty(t, queue.indexes)
+}
@@ -0,0 +1,132 @@
+package gccontroller
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// retentionKey identifies the workflows that are retained together
+type retentionKey struct {
+	// rule is the index of the rule, or -1 for the counts of the retention policy
+	rule      int
+	namespace string
+	group     string
+	phase     wfv1.WorkflowPhase
+}
+
+type retentionRule struct {
+	config.RetentionRule
+	name     string
+	selector labels.Selector
+	groupBy  string
+}
+
+func newRetentionRules(policy *config.RetentionPolicy) []retentionRule {
+	if policy == nil {
+		return nil
+	}
+	rules := make([]retentionRule, len(policy.Rules))
+	for i, r := range policy.Rules {
+		rule := retentionRule{RetentionRule: r, name: r.Name, selector: labels.Everything(), groupBy: r.GroupBy}
+		if rule.name == "" {
+			rule.name = fmt.Sprintf("%d", i)
+		}
+		if r.Selector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(r.Selector)
+			if err != nil {
+				log.WithError(err).Errorf("Invalid selector of retention rule %s, the rule matches no workflows", rule.name)
+				selector = labels.Nothing()
+			}
+			rule.selector = selector
+		}
+		if label, ok := common.GroupByLabels[r.GroupBy]; ok {
+			rule.groupBy = label
+		}
+		rules[i] = rule
+	}
+	return rules
+}
+
+// matches returns whether the rule matches the workflow, and the group of the workflow
+func (r *retentionRule) matches(un *unstructured.Unstructured) (string, bool) {
+	workflowLabels := un.GetLabels()
+	if r.Namespace != "" && r.Namespace != un.GetNamespace() {
+		return "", false
+	}
+	for label, value := range map[string]string{
+		common.LabelKeyWorkflowTemplate:        r.WorkflowTemplate,
+		common.LabelKeyClusterWorkflowTemplate: r.ClusterWorkflowTemplate,
+		common.LabelKeyCronWorkflow:            r.CronWorkflow,
+	} {
+		if value != "" && workflowLabels[label] != value {
+			return "", false
+		}
+	}
+	if !r.selector.Matches(labels.Set(workflowLabels)) {
+		return "", false
+	}
+	if r.groupBy == "" {
+		return "", true
+	}
+	group, ok := workflowLabels[r.groupBy]
+	return group, ok
+}
+
+func (r *retentionRule) maxWorkflows(phase wfv1.WorkflowPhase) *int {
+	switch phase {
+	case wfv1.WorkflowSucceeded:
+		return r.Completed
+	case wfv1.WorkflowFailed:
+		return r.Failed
+	case wfv1.WorkflowError:
+		return r.Errored
+	}
+	return nil
+}
+
+// retention returns the key of the workflows that the workflow is retained with, and how many of them to keep
+func (c *Controller) retention(un *unstructured.Unstructured, phase wfv1.WorkflowPhase) (retentionKey, int) {
+	for i := range c.retentionRules {
+		rule := &c.retentionRules[i]
+		maxWorkflows := rule.maxWorkflows(phase)
+		if maxWorkflows == nil {
+			continue
+		}
+		if group, ok := rule.matches(un); ok {
+			key := retentionKey{rule: i, group: group, phase: phase}
+			if rule.groupBy != "" {
+				key.namespace = un.GetNamespace()
+			}
+			return key, *maxWorkflows
+		}
+	}
+	key := retentionKey{rule: -1, phase: phase}
+	switch phase {
+	case wfv1.WorkflowSucceeded:
+		return key, c.retentionPolicy.Completed
+	case wfv1.WorkflowFailed:
+		return key, c.retentionPolicy.Failed
+	default:
+		return key, c.retentionPolicy.Errored
+	}
+}
+
+// describe describes the workflows that are retained together in the logs
+func (c *Controller) describe(key retentionKey) string {
+	if key.rule < 0 {
+		return string(key.phase)
+	}
+	s := fmt.Sprintf("%s (retention rule %s", key.phase, c.retentionRules[key.rule].name)
+	if key.namespace != "" {
+		s = fmt.Sprintf("%s, namespace %s, group %s", s, key.namespace, key.group)
+	}
+	return s + ")"
+}
@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	ArchiveFailuresMetric = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: argoNamespace,
+			Subsystem: workflowsSubsystem,
+			Name:      "archive_failures_total",
+			Help:      "Number of times a workflow failed to be archived, including retries from the spool. https://argoproj.github.io/argo-workflows/metrics/#argo_workflows_archive_failures_total",
+		},
+	)
+	ArchiveSpoolDepthMetric = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: argoNamespace,
+			Subsystem: workflowsSubsystem,
+			Name:      "archive_spool_depth",
+			Help:      "Number of workflows in the spool waiting to be archived. https://argoproj.github.io/argo-workflows/metrics/#argo_workflows_archive_spool_depth",
+		},
+	)
+)
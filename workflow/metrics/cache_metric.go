@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	MemoizationCacheHitsMetric = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: argoNamespace,
+			Subsystem: workflowsSubsystem,
+			Name:      "memoization_cache_hits_total",
+			Help:      "Number of memoized nodes whose outputs were found in the cache. https://argoproj.github.io/argo-workflows/metrics/#argo_workflows_memoization_cache_hits_total",
+		},
+		[]string{"namespace", "type", "name"},
+	)
+	MemoizationCacheMissesMetric = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: argoNamespace,
+			Subsystem: workflowsSubsystem,
+			Name:      "memoization_cache_misses_total",
+			Help:      "Number of memoized nodes whose outputs were not found in the cache, or had expired. https://argoproj.github.io/argo-workflows/metrics/#argo_workflows_memoization_cache_misses_total",
+		},
+		[]string{"namespace", "type", "name"},
+	)
+)
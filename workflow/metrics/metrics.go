@@ -58,6 +58,7 @@ type Metrics struct {
 	defaultMetricDescs map[string]bool
 	metricNameHelps    map[string]string
 	logMetric          *prometheus.CounterVec
+	semaphoreStates    func() []SemaphoreState
 }
 
 func (m *Metrics) Levels() []log.Level {
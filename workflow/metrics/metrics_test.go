@@ -175,3 +175,26 @@ func TestRealTimeMetricDeletion(t *testing.T) {
 	assert.Empty(t, m.workflows["456"])
 	assert.Len(t, m.customMetrics, 1)
 }
+
+func TestSemaphoreMetrics(t *testing.T) {
+	m := New(ServerConfig{}, ServerConfig{})
+	collect := func() []float64 {
+		ch := make(chan prometheus.Metric, 10)
+		m.collectSemaphoreMetrics(ch)
+		close(ch)
+		var values []float64
+		for metric := range ch {
+			values = append(values, *write(metric).Gauge.Value)
+		}
+		return values
+	}
+	assert.Empty(t, collect(), "no metrics before the locks are known")
+
+	var states []SemaphoreState
+	m.SetSemaphoreStatesFunc(func() []SemaphoreState { return states })
+	states = []SemaphoreState{{Namespace: "argo", Kind: "ConfigMap", Name: "my-config/workflow", Holders: 1, Pending: 2, Limit: 3}}
+	assert.Equal(t, []float64{1, 2, 3}, collect())
+
+	states = nil
+	assert.Empty(t, collect(), "the metrics only include the current locks")
+}
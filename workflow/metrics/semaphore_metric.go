@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SemaphoreState is the state of a synchronization lock reported by the semaphore metrics
+type SemaphoreState struct {
+	Namespace string
+	Kind      string
+	// Name is the name of the lock, suffixed with its key if it has one, e.g. "my-config/workflow"
+	Name    string
+	Holders int
+	Pending int
+	Limit   int
+}
+
+var (
+	semaphoreLabels      = []string{"namespace", "kind", "name"}
+	semaphoreHoldersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(argoNamespace, workflowsSubsystem, "semaphore_holders"),
+		"Number of workflows and templates holding a synchronization lock. https://argoproj.github.io/argo-workflows/metrics/#argo_workflows_semaphore_holders",
+		semaphoreLabels, nil,
+	)
+	semaphorePendingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(argoNamespace, workflowsSubsystem, "semaphore_pending"),
+		"Number of workflows and templates waiting for a synchronization lock. https://argoproj.github.io/argo-workflows/metrics/#argo_workflows_semaphore_pending",
+		semaphoreLabels, nil,
+	)
+	semaphoreLimitDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(argoNamespace, workflowsSubsystem, "semaphore_limit"),
+		"Limit of a synchronization lock. https://argoproj.github.io/argo-workflows/metrics/#argo_workflows_semaphore_limit",
+		semaphoreLabels, nil,
+	)
+)
+
+// SetSemaphoreStatesFunc sets the function that returns the state of the synchronization locks. The semaphore metrics
+// are built from the current locks every time that the metrics are collected.
+func (m *Metrics) SetSemaphoreStatesFunc(getStates func() []SemaphoreState) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.semaphoreStates = getStates
+}
+
+func describeSemaphoreMetrics(ch chan<- *prometheus.Desc) {
+	ch <- semaphoreHoldersDesc
+	ch <- semaphorePendingDesc
+	ch <- semaphoreLimitDesc
+}
+
+func (m *Metrics) collectSemaphoreMetrics(ch chan<- prometheus.Metric) {
+	m.mutex.RLock()
+	getStates := m.semaphoreStates
+	m.mutex.RUnlock()
+	if getStates == nil {
+		return
+	}
+	for _, state := range getStates() {
+		ch <- prometheus.MustNewConstMetric(semaphoreHoldersDesc, prometheus.GaugeValue, float64(state.Holders), state.Namespace, state.Kind, state.Name)
+		ch <- prometheus.MustNewConstMetric(semaphorePendingDesc, prometheus.GaugeValue, float64(state.Pending), state.Namespace, state.Kind, state.Name)
+		ch <- prometheus.MustNewConstMetric(semaphoreLimitDesc, prometheus.GaugeValue, float64(state.Limit), state.Namespace, state.Kind, state.Name)
+	}
+}
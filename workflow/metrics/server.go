@@ -100,6 +100,11 @@ func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
 	K8sRequestTotalMetric.Describe(ch)
 	PodMissingMetric.Describe(ch)
 	WorkflowConditionMetric.Describe(ch)
+	describeSemaphoreMetrics(ch)
+	MemoizationCacheHitsMetric.Describe(ch)
+	MemoizationCacheMissesMetric.Describe(ch)
+	ArchiveFailuresMetric.Describe(ch)
+	ArchiveSpoolDepthMetric.Describe(ch)
 }
 
 func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
@@ -110,6 +115,11 @@ func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
 	K8sRequestTotalMetric.Collect(ch)
 	PodMissingMetric.Collect(ch)
 	WorkflowConditionMetric.Collect(ch)
+	m.collectSemaphoreMetrics(ch)
+	MemoizationCacheHitsMetric.Collect(ch)
+	MemoizationCacheMissesMetric.Collect(ch)
+	ArchiveFailuresMetric.Collect(ch)
+	ArchiveSpoolDepthMetric.Collect(ch)
 }
 
 func (m *Metrics) garbageCollector(ctx context.Context) {
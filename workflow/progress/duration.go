@@ -0,0 +1,70 @@
+package progress
+
+import (
+	"math"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// minWeight is the weight of a node without an estimated duration, so that if no node has an estimated duration, the
+// duration progress is the same as the count progress
+const minWeight = time.Second
+
+// UpdateDurationProgress updates the workflow's progress percentage and estimated remaining duration, weighting each
+// executable node by its estimated duration. It must be called after UpdateProgress, as it uses the progress of the
+// nodes.
+func UpdateDurationProgress(wf *wfv1.Workflow, now time.Time) {
+	var total, done float64
+	for _, node := range wf.Status.Nodes {
+		if !executable(node.Type) {
+			continue
+		}
+		weight := node.EstimatedDuration.ToDuration()
+		if weight < minWeight {
+			weight = minWeight
+		}
+		total += weight.Seconds()
+		done += weight.Seconds() * nodeFraction(node, now)
+	}
+	if total == 0 {
+		wf.Status.ProgressPercent = 0
+		wf.Status.EstimatedRemainingDuration = 0
+		return
+	}
+	fraction := done / total
+	wf.Status.ProgressPercent = int32(math.Floor(fraction * 100))
+	wf.Status.EstimatedRemainingDuration = remainingDuration(wf, fraction, now)
+}
+
+// nodeFraction returns the fraction of the node that is complete. A completed node is complete, regardless of whether
+// it succeeded. A running node that has reported its own progress is as complete as it reported, otherwise it is as
+// complete as the fraction of its estimated duration that has elapsed, but never complete.
+func nodeFraction(node wfv1.NodeStatus, now time.Time) float64 {
+	if node.Fulfilled() {
+		return 1
+	}
+	if node.Progress.IsValid() && node.Progress.N() > 0 {
+		return float64(node.Progress.N()) / float64(node.Progress.M())
+	}
+	if node.Phase != wfv1.NodeRunning || node.StartedAt.IsZero() || node.EstimatedDuration <= 0 {
+		return 0
+	}
+	return math.Min(now.Sub(node.StartedAt.Time).Seconds()/node.EstimatedDuration.ToDuration().Seconds(), 0.99)
+}
+
+// remainingDuration extrapolates the remaining duration of the workflow from the rate at which it has progressed. Until
+// it has progressed, the remaining duration is what remains of its estimated duration.
+func remainingDuration(wf *wfv1.Workflow, fraction float64, now time.Time) wfv1.EstimatedDuration {
+	if wf.Status.Fulfilled() || wf.Status.StartedAt.IsZero() {
+		return 0
+	}
+	elapsed := now.Sub(wf.Status.StartedAt.Time)
+	if fraction > 0 {
+		return wfv1.NewEstimatedDuration(time.Duration(float64(elapsed) * (1 - fraction) / fraction))
+	}
+	if remaining := wf.Status.EstimatedDuration.ToDuration() - elapsed; remaining > 0 {
+		return wfv1.NewEstimatedDuration(remaining)
+	}
+	return 0
+}
@@ -0,0 +1,58 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestUpdateDurationProgress(t *testing.T) {
+	now := time.Now()
+	started := metav1.Time{Time: now.Add(-time.Hour)}
+	t.Run("WeightedByEstimatedDuration", func(t *testing.T) {
+		// 99 one second steps have completed, and a three hour step has been running for an hour
+		nodes := wfv1.Nodes{
+			"dag":  {Type: wfv1.NodeTypeDAG, Phase: wfv1.NodeRunning},
+			"long": {Type: wfv1.NodeTypePod, Phase: wfv1.NodeRunning, StartedAt: started, EstimatedDuration: wfv1.NewEstimatedDuration(3 * time.Hour)},
+		}
+		for i := 0; i < 99; i++ {
+			nodes[string(rune('a'+i))] = wfv1.NodeStatus{Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, EstimatedDuration: 1}
+		}
+		wf := &wfv1.Workflow{Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning, StartedAt: started, Nodes: nodes}}
+		UpdateProgress(wf)
+		UpdateDurationProgress(wf, now)
+		assert.Equal(t, wfv1.Progress("99/100"), wf.Status.Progress)
+		// (99s + 1h) / (99s + 3h) is 33.9%
+		assert.Equal(t, int32(33), wf.Status.ProgressPercent)
+		assert.InDelta(t, 7007, wf.Status.EstimatedRemainingDuration.ToDuration().Seconds(), 60)
+	})
+	t.Run("SelfReported", func(t *testing.T) {
+		wf := &wfv1.Workflow{Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning, StartedAt: started, Nodes: wfv1.Nodes{
+			"pod-1": {Type: wfv1.NodeTypePod, Phase: wfv1.NodeRunning, StartedAt: started, EstimatedDuration: wfv1.NewEstimatedDuration(time.Hour), Progress: "75/100"},
+			"pod-2": {Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, EstimatedDuration: wfv1.NewEstimatedDuration(time.Hour)},
+		}}}
+		UpdateDurationProgress(wf, now)
+		assert.Equal(t, int32(87), wf.Status.ProgressPercent)
+	})
+	t.Run("NoEstimates", func(t *testing.T) {
+		wf := &wfv1.Workflow{Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning, StartedAt: started, EstimatedDuration: wfv1.NewEstimatedDuration(3 * time.Hour), Nodes: wfv1.Nodes{
+			"pod-1": {Type: wfv1.NodeTypePod, Phase: wfv1.NodeRunning, StartedAt: started},
+			"pod-2": {Type: wfv1.NodeTypePod, Phase: wfv1.NodePending},
+		}}}
+		UpdateDurationProgress(wf, now)
+		assert.Zero(t, wf.Status.ProgressPercent)
+		assert.InDelta(t, 2*time.Hour.Seconds(), wf.Status.EstimatedRemainingDuration.ToDuration().Seconds(), 60)
+	})
+	t.Run("Completed", func(t *testing.T) {
+		wf := &wfv1.Workflow{Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed, StartedAt: started, Nodes: wfv1.Nodes{
+			"pod-1": {Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed},
+		}}}
+		UpdateDurationProgress(wf, now)
+		assert.Equal(t, int32(100), wf.Status.ProgressPercent)
+		assert.Zero(t, wf.Status.EstimatedRemainingDuration)
+	})
+}
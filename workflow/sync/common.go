@@ -3,14 +3,15 @@ package sync
 import "time"
 
 type Semaphore interface {
-	acquire(holderKey string) bool
+	acquire(holderKey string, weight int) bool
 	tryAcquire(holderKey string) (bool, string)
 	release(key string) bool
-	addToQueue(holderKey string, priority int32, creationTime time.Time)
+	addToQueue(holderKey string, priority int32, creationTime time.Time, weight int)
 	removeFromQueue(holderKey string)
 	getCurrentHolders() []string
 	getCurrentPending() []string
 	getName() string
 	getLimit() int
+	getWeight(holderKey string) int
 	resize(n int) bool
 }
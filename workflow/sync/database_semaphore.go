@@ -0,0 +1,324 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+)
+
+// databaseSemaphore is a semaphore whose limit, holders and waiters are stored in the database, so that it is
+// shared by every controller using the same database. The holders and waiters of this controller are the rows
+// with its controller name.
+type databaseSemaphore struct {
+	name         string
+	limit        int
+	isMutex      bool
+	syncDB       *syncDatabase
+	nextWorkflow NextWorkflow
+	log          *log.Entry
+}
+
+var _ Semaphore = &databaseSemaphore{}
+
+func newDatabaseSemaphore(name string, limit int, nextWorkflow NextWorkflow, syncDB *syncDatabase) *databaseSemaphore {
+	return &databaseSemaphore{
+		name:         name,
+		limit:        limit,
+		syncDB:       syncDB,
+		nextWorkflow: nextWorkflow,
+		log: log.WithFields(log.Fields{
+			"databaseSemaphore": name,
+		}),
+	}
+}
+
+func newDatabaseMutex(name string, nextWorkflow NextWorkflow, syncDB *syncDatabase) *databaseSemaphore {
+	return &databaseSemaphore{
+		name:         name,
+		limit:        1,
+		isMutex:      true,
+		syncDB:       syncDB,
+		nextWorkflow: nextWorkflow,
+		log: log.WithFields(log.Fields{
+			"databaseMutex": name,
+		}),
+	}
+}
+
+func (s *databaseSemaphore) getName() string {
+	return s.name
+}
+
+func (s *databaseSemaphore) getLimit() int {
+	return s.limit
+}
+
+// getWeight returns one, as database semaphores do not support weights
+func (s *databaseSemaphore) getWeight(holderKey string) int {
+	return 1
+}
+
+func (s *databaseSemaphore) getCurrentHolders() []string {
+	return s.holderKeys(true)
+}
+
+func (s *databaseSemaphore) getCurrentPending() []string {
+	return s.holderKeys(false)
+}
+
+func (s *databaseSemaphore) holderKeys(held bool) []string {
+	var records []syncStateRecord
+	err := s.syncDB.session.
+		Select("holderkey").
+		From(syncStateTableName).
+		Where(s.controllerCond()).
+		And(db.Cond{"held": held}).
+		OrderBy("-priority", "creationtime").
+		All(&records)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get lock state from database")
+		return nil
+	}
+	var keys []string
+	for _, r := range records {
+		keys = append(keys, r.HolderKey)
+	}
+	return keys
+}
+
+func (s *databaseSemaphore) controllerCond() db.Cond {
+	return db.Cond{"name": s.name, "controller": s.syncDB.controller}
+}
+
+// resize only updates the cached limit, the limit that is enforced is always read from the database
+func (s *databaseSemaphore) resize(n int) bool {
+	if s.isMutex {
+		return false
+	}
+	if s.limit != n {
+		s.log.Infof("%s semaphore resized from %d to %d", s.name, s.limit, n)
+		s.limit = n
+	}
+	return true
+}
+
+func (s *databaseSemaphore) release(key string) bool {
+	rs, err := s.syncDB.session.
+		DeleteFrom(syncStateTableName).
+		Where(s.controllerCond()).
+		And(db.Cond{"holderkey": key, "held": true}).
+		Exec()
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to release lock held by %s", key)
+		return false
+	}
+	if rowsAffected, err := rs.RowsAffected(); err == nil && rowsAffected > 0 {
+		s.log.Infof("Lock has been released by %s", key)
+		s.notifyWaiters()
+	}
+	return true
+}
+
+// notifyWaiters enqueues the workflows of this controller that are next in line for the lock, where the number of
+// workflows is the availability of the lock. Waiters of other controllers are enqueued when those controllers poll.
+func (s *databaseSemaphore) notifyWaiters() {
+	holders, pending, err := s.syncDB.lockState(s.syncDB.session, s.name)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get lock state from database")
+		return
+	}
+	triggerCount := s.limit - len(holders)
+	if len(pending) < triggerCount {
+		triggerCount = len(pending)
+	}
+	for idx := 0; idx < triggerCount; idx++ {
+		if pending[idx].Controller != s.syncDB.controller {
+			continue
+		}
+		wfKey := workflowKey(&item{key: pending[idx].HolderKey})
+		s.log.Debugf("Enqueue the workflow %s", wfKey)
+		s.nextWorkflow(wfKey)
+	}
+}
+
+// acquire records the holder unconditionally, it is used to restore holders from workflow statuses.
+// Database semaphores do not support weights.
+func (s *databaseSemaphore) acquire(holderKey string, weight int) bool {
+	err := s.syncDB.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		_, err := sess.
+			DeleteFrom(syncStateTableName).
+			Where(s.controllerCond()).
+			And(db.Cond{"holderkey": holderKey}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = sess.Collection(syncStateTableName).Insert(&syncStateRecord{
+			Name:         s.name,
+			Controller:   s.syncDB.controller,
+			HolderKey:    holderKey,
+			Held:         true,
+			CreationTime: time.Now().UTC(),
+		})
+		return err
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to record lock holder %s", holderKey)
+		return false
+	}
+	return true
+}
+
+// addToQueue adds the holderkey into the database queue that maintains the priority order to acquire the lock.
+func (s *databaseSemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time, weight int) {
+	exists, err := s.syncDB.session.
+		Collection(syncStateTableName).
+		Find(s.controllerCond()).
+		And(db.Cond{"holderkey": holderKey}).
+		Exists()
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to add %s into queue", holderKey)
+		return
+	}
+	if exists {
+		return
+	}
+	_, err = s.syncDB.session.Collection(syncStateTableName).Insert(&syncStateRecord{
+		Name:         s.name,
+		Controller:   s.syncDB.controller,
+		HolderKey:    holderKey,
+		Priority:     priority,
+		CreationTime: creationTime.UTC(),
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to add %s into queue", holderKey)
+		return
+	}
+	s.log.Debugf("Added into queue: %s", holderKey)
+}
+
+// removeFromQueue removes the waiter from the database queue, and enqueues the workflows of this controller that are
+// now next in line
+func (s *databaseSemaphore) removeFromQueue(holderKey string) {
+	rs, err := s.syncDB.session.
+		DeleteFrom(syncStateTableName).
+		Where(s.controllerCond()).
+		And(db.Cond{"holderkey": holderKey, "held": false}).
+		Exec()
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to remove %s from queue", holderKey)
+		return
+	}
+	s.log.Debugf("Removed from queue: %s", holderKey)
+	if rowsAffected, err := rs.RowsAffected(); err == nil && rowsAffected > 0 {
+		s.notifyWaiters()
+	}
+}
+
+func (s *databaseSemaphore) tryAcquire(holderKey string) (bool, string) {
+	var acquired, alreadyHeld bool
+	var waitingMsg string
+	err := s.syncDB.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		limit, err := s.lockLimit(sess)
+		if err != nil {
+			return err
+		}
+		s.limit = limit
+		holders, pending, err := s.syncDB.lockState(sess, s.name)
+		if err != nil {
+			return err
+		}
+		for _, h := range holders {
+			if h.Controller == s.syncDB.controller && h.HolderKey == holderKey {
+				s.log.Debugf("%s is already holding a lock", holderKey)
+				acquired = true
+				alreadyHeld = true
+				return nil
+			}
+		}
+
+		waitingMsg = fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d", s.name, limit-len(holders), limit)
+		if len(holders) >= limit {
+			return nil
+		}
+		// Check whether requested holdkey is in front of priority queue across all controllers.
+		if len(pending) > 0 {
+			front := pending[0]
+			if front.Controller != s.syncDB.controller || (front.HolderKey != holderKey && !isSameWorkflowNodeKeys(holderKey, front.HolderKey)) {
+				if front.Controller == s.syncDB.controller {
+					s.nextWorkflow(workflowKey(&item{key: front.HolderKey}))
+				}
+				return nil
+			}
+		}
+
+		rs, err := sess.
+			Update(syncStateTableName).
+			Set("held", true).
+			Where(s.controllerCond()).
+			And(db.Cond{"holderkey": holderKey}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := rs.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			_, err = sess.Collection(syncStateTableName).Insert(&syncStateRecord{
+				Name:         s.name,
+				Controller:   s.syncDB.controller,
+				HolderKey:    holderKey,
+				Held:         true,
+				CreationTime: time.Now().UTC(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		acquired = true
+		s.log.Infof("%s acquired by %s. Lock availability: %d/%d", s.name, holderKey, limit-len(holders)-1, limit)
+		return nil
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to acquire lock for %s", holderKey)
+		return false, fmt.Sprintf("Waiting for %s lock. Failed to access the database: %v", s.name, err)
+	}
+	if acquired && !alreadyHeld {
+		s.notifyWaiters()
+	}
+	return acquired, waitingMsg
+}
+
+// lockLimit reads the limit of the lock and locks its row until the end of the transaction, serializing
+// acquisitions of the same lock by different controllers. A mutex creates its row on first use.
+func (s *databaseSemaphore) lockLimit(sess sqlbuilder.Tx) (int, error) {
+	limit := &syncLimitRecord{}
+	query := sess.
+		Select("name", "sizelimit").
+		From(syncLimitTableName).
+		Where(db.Cond{"name": s.name})
+	// SQLite cannot lock rows, but serializes transactions instead
+	if sqldb.SupportsSelectForUpdate(s.syncDB.session) {
+		query = query.Amend(func(query string) string { return query + " FOR UPDATE" })
+	}
+	err := query.One(limit)
+	if err == db.ErrNoMoreRows && s.isMutex {
+		_, err = sess.Collection(syncLimitTableName).Insert(&syncLimitRecord{Name: s.name, SizeLimit: 1})
+		return 1, err
+	}
+	if err == db.ErrNoMoreRows {
+		return 0, fmt.Errorf("database semaphore '%s' has no limit in table %s", s.name, syncLimitTableName)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return limit.SizeLimit, nil
+}
@@ -0,0 +1,165 @@
+package sync
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"upper.io/db.v3"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+)
+
+func newTestSyncDatabase(t *testing.T, controllers ...string) []*syncDatabase {
+	session, tableName, err := sqldb.CreateSQLiteDBSession(&config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "argo.db"), TableName: "argo_workflows"}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	require.NoError(t, sqldb.NewMigrate(session, "default", tableName).Exec(context.Background()))
+	var dbs []*syncDatabase
+	for _, controller := range controllers {
+		d := newSyncDatabase(session, controller, time.Minute)
+		require.NoError(t, d.heartbeat())
+		dbs = append(dbs, d)
+	}
+	return dbs
+}
+
+func TestDatabaseMutex(t *testing.T) {
+	dbs := newTestSyncDatabase(t, "controller-1", "controller-2")
+	m1 := newDatabaseMutex("default/Mutex/my-mutex", func(string) {}, dbs[0])
+	m2 := newDatabaseMutex("default/Mutex/my-mutex", func(string) {}, dbs[1])
+
+	now := time.Now()
+	m1.addToQueue("default/wf-1", 0, now, 1)
+	m2.addToQueue("default/wf-2", 0, now.Add(time.Second), 1)
+	acquired, _ := m2.tryAcquire("default/wf-2")
+	assert.False(t, acquired, "wf-1 is first in the queue")
+	acquired, _ = m1.tryAcquire("default/wf-1")
+	assert.True(t, acquired)
+	assert.Equal(t, []string{"default/wf-1"}, m1.getCurrentHolders())
+	assert.Equal(t, []string{"default/wf-2"}, m2.getCurrentPending())
+
+	acquired, msg := m2.tryAcquire("default/wf-2")
+	assert.False(t, acquired)
+	assert.NotEmpty(t, msg)
+
+	assert.True(t, m1.release("default/wf-1"))
+	acquired, _ = m2.tryAcquire("default/wf-2")
+	assert.True(t, acquired)
+}
+
+// expireHeartbeat makes the lease of the controller look expired
+func expireHeartbeat(t *testing.T, d *syncDatabase, controller string) {
+	_, err := d.session.
+		Update(syncControllersTableName).
+		Set("heartbeat", db.Raw("datetime('now', '-1 hour')")).
+		Where(db.Cond{"controller": controller}).
+		Exec()
+	require.NoError(t, err)
+}
+
+func TestDatabaseSemaphoreQueueOrder(t *testing.T) {
+	dbs := newTestSyncDatabase(t, "controller-1", "controller-2")
+	_, err := dbs[0].session.Collection(syncLimitTableName).Insert(&syncLimitRecord{Name: "default/Semaphore/my-sem", SizeLimit: 1})
+	require.NoError(t, err)
+	var notified []string
+	s1 := newDatabaseSemaphore("default/Semaphore/my-sem", 1, func(key string) { notified = append(notified, key) }, dbs[0])
+	s2 := newDatabaseSemaphore("default/Semaphore/my-sem", 1, func(string) {}, dbs[1])
+
+	now := time.Now()
+	s1.addToQueue("default/wf-low", 0, now, 1)
+	s2.addToQueue("default/wf-high", 10, now.Add(time.Second), 1)
+	s1.addToQueue("default/wf-low", 0, now, 1)
+	assert.Equal(t, []string{"default/wf-low"}, s1.getCurrentPending(), "adding a waiter twice is a no-op")
+
+	acquired, _ := s1.tryAcquire("default/wf-low")
+	assert.False(t, acquired, "a higher priority waiter of another controller is first in the queue")
+	acquired, _ = s2.tryAcquire("default/wf-high")
+	assert.True(t, acquired)
+	acquired, msg := s1.tryAcquire("default/wf-low")
+	assert.False(t, acquired)
+	assert.Equal(t, "Waiting for default/Semaphore/my-sem lock. Lock status: 0/1", msg)
+
+	notified = nil
+	assert.True(t, s2.release("default/wf-high"))
+	assert.Empty(t, notified, "controllers only enqueue their own waiters")
+	s1.notifyWaiters()
+	assert.Equal(t, []string{"default/wf-low"}, notified)
+	acquired, _ = s1.tryAcquire("default/wf-low")
+	assert.True(t, acquired)
+
+	s2.addToQueue("default/wf-other", 0, now, 1)
+	s2.removeFromQueue("default/wf-other")
+	assert.Empty(t, s2.getCurrentPending())
+}
+
+func TestDatabaseSemaphoreNoLimit(t *testing.T) {
+	dbs := newTestSyncDatabase(t, "controller-1")
+	s := newDatabaseSemaphore("default/Semaphore/missing", 1, func(string) {}, dbs[0])
+	acquired, msg := s.tryAcquire("default/wf-1")
+	assert.False(t, acquired)
+	assert.Contains(t, msg, "database semaphore 'default/Semaphore/missing' has no limit in table argo_sync_limit")
+	_, err := dbs[0].getLimit("default/Semaphore/missing")
+	assert.Error(t, err)
+}
+
+func TestExpireInactiveControllers(t *testing.T) {
+	dbs := newTestSyncDatabase(t, "controller-1", "controller-2")
+	m1 := newDatabaseMutex("default/Mutex/my-mutex", func(string) {}, dbs[0])
+	m2 := newDatabaseMutex("default/Mutex/my-mutex", func(string) {}, dbs[1])
+	acquired, _ := m2.tryAcquire("default/wf-2")
+	require.True(t, acquired)
+	acquired, _ = m1.tryAcquire("default/wf-1")
+	require.False(t, acquired, "controller-2 holds the mutex")
+
+	expireHeartbeat(t, dbs[0], "controller-2")
+	active, err := dbs[0].activeControllers(dbs[0].session)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"controller-1": true}, active)
+	holders, _, err := dbs[0].lockState(dbs[0].session, "default/Mutex/my-mutex")
+	require.NoError(t, err)
+	assert.Empty(t, holders, "the holders of an inactive controller are ignored")
+	acquired, _ = m1.tryAcquire("default/wf-1")
+	assert.True(t, acquired)
+
+	require.NoError(t, dbs[0].expireInactiveControllers())
+	n, err := dbs[0].session.Collection(syncStateTableName).Find(db.Cond{"controller": "controller-2"}).Count()
+	require.NoError(t, err)
+	assert.Zero(t, n, "the holders of an inactive controller are deleted")
+	n, err = dbs[0].session.Collection(syncControllersTableName).Find(db.Cond{"controller": "controller-2"}).Count()
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	expireHeartbeat(t, dbs[0], "controller-1")
+	require.NoError(t, dbs[0].expireInactiveControllers())
+	assert.Equal(t, []string{"default/wf-1"}, m1.getCurrentHolders(), "a controller never expires itself")
+
+	require.NoError(t, dbs[1].heartbeat())
+	active, err = dbs[0].activeControllers(dbs[0].session)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"controller-1": true, "controller-2": true}, active, "a heartbeat re-registers the controller")
+}
+
+func TestReleaseAll(t *testing.T) {
+	dbs := newTestSyncDatabase(t, "controller-1", "controller-2")
+	m1 := newDatabaseMutex("default/Mutex/my-mutex", func(string) {}, dbs[0])
+	m2 := newDatabaseMutex("default/Mutex/my-mutex", func(string) {}, dbs[1])
+	acquired, _ := m1.tryAcquire("default/wf-1")
+	require.True(t, acquired)
+	m1.addToQueue("default/wf-3", 0, time.Now(), 1)
+	m2.addToQueue("default/wf-2", 0, time.Now(), 1)
+
+	// on restart, the holders are released and then restored from the workflow statuses
+	require.NoError(t, dbs[0].releaseAll())
+	assert.Empty(t, m1.getCurrentHolders())
+	assert.Empty(t, m1.getCurrentPending())
+	assert.Equal(t, []string{"default/wf-2"}, m2.getCurrentPending(), "the rows of other controllers are kept")
+	assert.True(t, m1.acquire("default/wf-1", 1))
+	assert.Equal(t, []string{"default/wf-1"}, m1.getCurrentHolders())
+	acquired, _ = m2.tryAcquire("default/wf-2")
+	assert.False(t, acquired)
+}
@@ -0,0 +1,263 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	argoErr "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/slice"
+)
+
+// LockInfo is the state of a lock known to the Manager
+type LockInfo struct {
+	// Name is the encoded name of the lock, e.g. "argo/ConfigMap/my-config/workflow"
+	Name  string
+	Limit int
+	// Available is the number of permits that are not held
+	Available int
+	// Holders are the keys of the workflows and nodes holding the lock
+	Holders []string
+	// Pending are the keys of the workflows and nodes waiting for the lock, in the order in which they acquire it
+	Pending []string
+	// Weights are the number of permits held, or requested, by the holders and waiters that need more than one
+	Weights map[string]int
+}
+
+// GetWeight returns the number of permits held, or requested, by the holder or waiter
+func (l LockInfo) GetWeight(key string) int {
+	if weight, ok := l.Weights[key]; ok {
+		return weight
+	}
+	return 1
+}
+
+// GetLocks returns the state of every lock known to the Manager, ordered by name. The state of database locks is
+// read from the database, and includes the holders and waiters of every active controller.
+func (cm *Manager) GetLocks() []LockInfo {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	var locks []LockInfo
+	for name, lock := range cm.syncLockMap {
+		if _, ok := lock.(*databaseSemaphore); ok {
+			continue
+		}
+		info := LockInfo{
+			Name:    name,
+			Limit:   lock.getLimit(),
+			Holders: lock.getCurrentHolders(),
+			Pending: lock.getCurrentPending(),
+		}
+		held := 0
+		for _, key := range info.Holders {
+			held += lock.getWeight(key)
+		}
+		info.Available = available(info.Limit, held)
+		for _, key := range append(append([]string{}, info.Holders...), info.Pending...) {
+			if weight := lock.getWeight(key); weight > 1 {
+				if info.Weights == nil {
+					info.Weights = make(map[string]int)
+				}
+				info.Weights[key] = weight
+			}
+		}
+		sort.Strings(info.Holders)
+		locks = append(locks, info)
+	}
+	if cm.syncDB != nil {
+		states, err := cm.syncDB.lockStates()
+		if err != nil {
+			log.WithError(err).Error("Failed to get the state of database locks")
+		}
+		for name, state := range states {
+			info := LockInfo{
+				Name:      name,
+				Limit:     state.limit,
+				Available: available(state.limit, len(state.holders)),
+			}
+			for _, r := range state.holders {
+				info.Holders = append(info.Holders, r.HolderKey)
+			}
+			for _, r := range state.pending {
+				info.Pending = append(info.Pending, r.HolderKey)
+			}
+			sort.Strings(info.Holders)
+			locks = append(locks, info)
+		}
+	}
+	sort.Slice(locks, func(i, j int) bool { return locks[i].Name < locks[j].Name })
+	return locks
+}
+
+// available returns the number of permits of a lock that are not held, which is zero when a lock has been resized
+// below the number of permits held
+func available(limit, held int) int {
+	if held > limit {
+		return 0
+	}
+	return limit - held
+}
+
+// GetConfigMapSyncLimitFunc returns a GetSyncLimit that reads the limit of a semaphore from its ConfigMap
+func GetConfigMapSyncLimitFunc(ctx context.Context, kubeclientset kubernetes.Interface) GetSyncLimit {
+	return func(lockKey string) (int, error) {
+		lockName, err := DecodeLockName(lockKey)
+		if err != nil {
+			return 0, err
+		}
+		configMap, err := kubeclientset.CoreV1().ConfigMaps(lockName.Namespace).Get(ctx, lockName.ResourceName, metav1.GetOptions{})
+		if err != nil {
+			return 0, err
+		}
+
+		value, found := configMap.Data[lockName.Key]
+		if !found {
+			return 0, argoErr.New(argoErr.CodeBadRequest, fmt.Sprintf("Sync configuration key '%s' not found in ConfigMap", lockName.Key))
+		}
+		return strconv.Atoi(value)
+	}
+}
+
+// NewLockManagerFromWorkflows returns a Manager with the holders and waiters recorded in the statuses of the
+// workflows. It is used to inspect the locks outside of the controller, and never enqueues workflows.
+// If session is not nil, the state of database locks is read from it, ignoring controllers that have not sent a
+// heartbeat for leaseDuration. The Manager never writes to the database.
+func NewLockManagerFromWorkflows(getSyncLimit GetSyncLimit, wfs []wfv1.Workflow, session sqlbuilder.Database, leaseDuration time.Duration) *Manager {
+	cm := NewLockManager(getSyncLimit, func(string) {}, func(string) bool { return true })
+	cm.Initialize(wfs)
+	for i := range wfs {
+		cm.restoreWaiters(&wfs[i])
+	}
+	// database locks are only read from the database, so the database is not used until the workflows are restored
+	if session != nil {
+		cm.syncDB = newSyncDatabase(session, "", leaseDuration)
+	}
+	return cm
+}
+
+// restoreWaiters adds the workflow, and its nodes, that are waiting for a lock to the queue of the lock
+func (cm *Manager) restoreWaiters(wf *wfv1.Workflow) {
+	if wf.Status.Synchronization == nil {
+		return
+	}
+	spec := wf.GetExecSpec()
+	var priority int32
+	if wf.Spec.Priority != nil {
+		priority = *wf.Spec.Priority
+	}
+
+	if spec.Synchronization != nil && isWaiting(wf.Status.Synchronization, spec.Synchronization, wf.Name, wf.Namespace) {
+		if lockName, err := GetLockName(spec.Synchronization, wf.Namespace); err == nil {
+			lockKey := lockName.EncodeName()
+			cm.enqueueWaiter(lockKey, getHolderKey(wf, ""), priority, wf.CreationTimestamp.Time, waiterWeight(spec.Synchronization, lockKey, wf.Namespace))
+		}
+	}
+	for _, node := range wf.Status.Nodes {
+		if node.SynchronizationStatus != nil && node.SynchronizationStatus.Waiting != "" {
+			var syncRef *wfv1.Synchronization
+			if tmpl := wf.GetTemplateByName(node.TemplateName); tmpl != nil {
+				syncRef = tmpl.Synchronization
+			}
+			lockKey := node.SynchronizationStatus.Waiting
+			cm.enqueueWaiter(lockKey, getHolderKey(wf, node.ID), priority, wf.CreationTimestamp.Time, waiterWeight(syncRef, lockKey, wf.Namespace))
+		}
+	}
+
+	// the limit of a rate limit is only in the spec
+	syncRefs := []*wfv1.Synchronization{spec.Synchronization}
+	for _, tmpl := range spec.Templates {
+		syncRefs = append(syncRefs, tmpl.Synchronization)
+	}
+	for _, syncRef := range syncRefs {
+		if syncRef == nil || syncRef.RateLimit == nil {
+			continue
+		}
+		lockName, err := GetLockName(syncRef, wf.Namespace)
+		if err != nil {
+			continue
+		}
+		if lock, ok := cm.syncLockMap[lockName.EncodeName()]; ok {
+			_ = checkAndUpdateRateLimit(lock, syncRef.RateLimit)
+		}
+	}
+}
+
+// waiterWeight returns the number of permits that a waiter requests from the lock. The weight of a waiter is not
+// recorded in the status, so it is read from the synchronization of the workflow or template, and is one if it
+// cannot be determined, e.g. it is an expression.
+func waiterWeight(syncRef *wfv1.Synchronization, lockKey, namespace string) int {
+	if syncRef == nil || syncRef.Semaphore == nil {
+		return 1
+	}
+	lockName, err := GetLockName(syncRef, namespace)
+	if err != nil || lockName.EncodeName() != lockKey {
+		return 1
+	}
+	weight, err := getSemaphoreWeight(syncRef.Semaphore)
+	if err != nil {
+		return 1
+	}
+	return weight
+}
+
+func (cm *Manager) enqueueWaiter(lockKey, holderKey string, priority int32, creationTime time.Time, weight int) {
+	lock, ok := cm.syncLockMap[lockKey]
+	if !ok {
+		lockName, err := DecodeLockName(lockKey)
+		if err != nil {
+			return
+		}
+		switch lockName.Kind {
+		case LockKindMutex, LockKindDatabaseMutex:
+			lock, err = cm.initializeMutex(lockKey)
+		case LockKindRateLimit:
+			lock = NewRateLimit(lockKey, 0, 0, cm.nextWorkflow)
+		default:
+			lock, err = cm.initializeSemaphore(lockKey)
+		}
+		if err != nil {
+			return
+		}
+		cm.syncLockMap[lockKey] = lock
+	}
+	lock.addToQueue(holderKey, priority, creationTime, weight)
+}
+
+// isWaiting returns true if the workflow is waiting for its workflow-level lock. The status records the locks that
+// the workflow has waited for, so the workflow is waiting if it is not also holding the lock.
+func isWaiting(status *wfv1.SynchronizationStatus, syncRef *wfv1.Synchronization, wfName, namespace string) bool {
+	lockName, err := GetLockName(syncRef, namespace)
+	if err != nil {
+		return false
+	}
+	lockKey := lockName.EncodeName()
+	var semaphoreStatus *wfv1.SemaphoreStatus
+	switch syncRef.GetType() {
+	case wfv1.SynchronizationTypeMutex:
+		if status.Mutex == nil {
+			return false
+		}
+		i, _ := status.Mutex.GetWaiting(lockKey)
+		_, holding := status.Mutex.GetHolding(lockKey)
+		return i >= 0 && holding.Holder != wfName
+	case wfv1.SynchronizationTypeSemaphore:
+		semaphoreStatus = status.Semaphore
+	case wfv1.SynchronizationTypeRateLimit:
+		semaphoreStatus = status.RateLimit
+	}
+	if semaphoreStatus == nil {
+		return false
+	}
+	i, _ := semaphoreStatus.GetWaiting(lockKey)
+	_, holding := semaphoreStatus.GetHolding(lockKey)
+	return i >= 0 && !slice.ContainsString(holding.Holders, wfName)
+}
@@ -0,0 +1,128 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/intstr"
+)
+
+func TestGetLocks(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	var cm v1.ConfigMap
+	wfv1.MustUnmarshal([]byte(configMap), &cm)
+	_, err := kube.CoreV1().ConfigMaps("default").Create(context.Background(), &cm, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	syncLimitFunc := GetConfigMapSyncLimitFunc(context.Background(), kube)
+	concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {}, WorkflowExistenceFunc)
+
+	now := time.Now()
+	wf := wfv1.MustUnmarshalWorkflow(wfWithSemaphore)
+	wf.CreationTimestamp = metav1.Time{Time: now}
+	wf1 := wf.DeepCopy()
+	wf1.Name = "one"
+	wf1.CreationTimestamp = metav1.Time{Time: now.Add(time.Second)}
+	wf2 := wf.DeepCopy()
+	wf2.Name = "two"
+	wf2.CreationTimestamp = metav1.Time{Time: now.Add(2 * time.Second)}
+	priority := int32(5)
+	wf2.Spec.Priority = &priority
+	for _, w := range []*wfv1.Workflow{wf, wf1, wf2} {
+		_, _, _, err := concurrenyMgr.TryAcquire(w, "", w.Spec.Synchronization)
+		assert.NoError(t, err)
+	}
+
+	expected := []LockInfo{{
+		Name:    "default/ConfigMap/my-config/workflow",
+		Limit:   1,
+		Holders: []string{"default/hello-world"},
+		Pending: []string{"default/two", "default/one"},
+	}}
+	assert.Equal(t, expected, concurrenyMgr.GetLocks())
+
+	t.Run("FromWorkflows", func(t *testing.T) {
+		snapshot := NewLockManagerFromWorkflows(syncLimitFunc, []wfv1.Workflow{*wf2, *wf1, *wf}, nil, 0)
+		assert.Equal(t, expected, snapshot.GetLocks())
+	})
+}
+
+func TestGetLocksWeighted(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	var cm v1.ConfigMap
+	wfv1.MustUnmarshal([]byte(configMap), &cm)
+	cm.Data["workflow"] = "4"
+	_, err := kube.CoreV1().ConfigMaps("default").Create(context.Background(), &cm, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	syncLimitFunc := GetConfigMapSyncLimitFunc(context.Background(), kube)
+	concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {}, WorkflowExistenceFunc)
+
+	wf := wfv1.MustUnmarshalWorkflow(wfWithSemaphore)
+	wf.Spec.Synchronization.Semaphore.Weight = intstr.ParsePtr("3")
+	wf1 := wf.DeepCopy()
+	wf1.Name = "two"
+	wf1.CreationTimestamp = metav1.Time{Time: time.Now()}
+	wf1.Spec.Synchronization.Semaphore.Weight = intstr.ParsePtr("2")
+	for _, w := range []*wfv1.Workflow{wf, wf1} {
+		_, _, _, err := concurrenyMgr.TryAcquire(w, "", w.Spec.Synchronization)
+		assert.NoError(t, err)
+	}
+
+	expected := []LockInfo{{
+		Name:      "default/ConfigMap/my-config/workflow",
+		Limit:     4,
+		Available: 1,
+		Holders:   []string{"default/hello-world"},
+		Pending:   []string{"default/two"},
+		Weights:   map[string]int{"default/hello-world": 3, "default/two": 2},
+	}}
+	assert.Equal(t, expected, concurrenyMgr.GetLocks())
+
+	t.Run("FromWorkflows", func(t *testing.T) {
+		snapshot := NewLockManagerFromWorkflows(syncLimitFunc, []wfv1.Workflow{*wf1, *wf}, nil, 0)
+		assert.Equal(t, expected, snapshot.GetLocks())
+	})
+}
+
+func TestGetLocksDatabase(t *testing.T) {
+	dbs := newTestSyncDatabase(t, "controller-1", "controller-2", "controller-3")
+	_, err := dbs[0].session.Collection(syncLimitTableName).Insert(&syncLimitRecord{Name: "default/Database/my-sem", SizeLimit: 2})
+	require.NoError(t, err)
+	s1 := newDatabaseSemaphore("default/Database/my-sem", 2, func(string) {}, dbs[0])
+	s2 := newDatabaseSemaphore("default/Database/my-sem", 2, func(string) {}, dbs[1])
+	s3 := newDatabaseSemaphore("default/Database/my-sem", 2, func(string) {}, dbs[2])
+	now := time.Now()
+	assert.True(t, s1.acquire("default/one", 1))
+	s2.addToQueue("default/two", 0, now, 1)
+	s1.addToQueue("default/three", 0, now.Add(time.Second), 1)
+	assert.True(t, s3.acquire("default/gone", 1))
+	expireHeartbeat(t, dbs[0], "controller-3")
+
+	expected := []LockInfo{{
+		Name:      "default/Database/my-sem",
+		Limit:     2,
+		Available: 1,
+		Holders:   []string{"default/one"},
+		Pending:   []string{"default/two", "default/three"},
+	}}
+
+	t.Run("Controller", func(t *testing.T) {
+		cm := NewLockManagerWithDatabase(nil, func(string) {}, WorkflowExistenceFunc, dbs[0].session, "controller-1", time.Minute)
+		cm.syncLockMap["default/Database/my-sem"] = newDatabaseSemaphore("default/Database/my-sem", 1, func(string) {}, cm.syncDB)
+		assert.Equal(t, expected, cm.GetLocks(), "the limit and the holders and waiters of other controllers are read from the database")
+	})
+	t.Run("FromWorkflows", func(t *testing.T) {
+		snapshot := NewLockManagerFromWorkflows(nil, nil, dbs[0].session, time.Minute)
+		assert.Equal(t, expected, snapshot.GetLocks())
+		var records []syncControllerRecord
+		require.NoError(t, dbs[0].session.Select("controller").From(syncControllersTableName).All(&records))
+		assert.Len(t, records, 3, "the snapshot does not write to the database")
+	})
+}
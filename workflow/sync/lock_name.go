@@ -11,8 +11,11 @@ import (
 type LockKind string
 
 const (
-	LockKindConfigMap LockKind = "ConfigMap"
-	LockKindMutex     LockKind = "Mutex"
+	LockKindConfigMap     LockKind = "ConfigMap"
+	LockKindMutex         LockKind = "Mutex"
+	LockKindDatabase      LockKind = "Database"
+	LockKindDatabaseMutex LockKind = "DatabaseMutex"
+	LockKindRateLimit     LockKind = "RateLimit"
 )
 
 type LockName struct {
@@ -37,9 +40,17 @@ func GetLockName(sync *v1alpha1.Synchronization, namespace string) (*LockName, e
 		if sync.Semaphore.ConfigMapKeyRef != nil {
 			return NewLockName(namespace, sync.Semaphore.ConfigMapKeyRef.Name, sync.Semaphore.ConfigMapKeyRef.Key, LockKindConfigMap), nil
 		}
-		return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef")
+		if sync.Semaphore.Database != nil {
+			return NewLockName(namespace, sync.Semaphore.Database.Key, "", LockKindDatabase), nil
+		}
+		return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef or Database")
 	case v1alpha1.SynchronizationTypeMutex:
+		if sync.Mutex.Database {
+			return NewLockName(namespace, sync.Mutex.Name, "", LockKindDatabaseMutex), nil
+		}
 		return NewLockName(namespace, sync.Mutex.Name, "", LockKindMutex), nil
+	case v1alpha1.SynchronizationTypeRateLimit:
+		return NewLockName(namespace, sync.RateLimit.Name, "", LockKindRateLimit), nil
 	default:
 		return nil, fmt.Errorf("cannot get LockName for a Sync of Unknown type")
 	}
@@ -56,7 +67,7 @@ func DecodeLockName(lockName string) (*LockName, error) {
 	namespace := items[0]
 
 	switch lockKind {
-	case LockKindMutex:
+	case LockKindMutex, LockKindDatabase, LockKindDatabaseMutex, LockKindRateLimit:
 		lock = LockName{Namespace: namespace, Kind: lockKind, ResourceName: items[2]}
 	case LockKindConfigMap:
 		components := strings.Split(items[2], "/")
@@ -78,7 +89,7 @@ func DecodeLockName(lockName string) (*LockName, error) {
 }
 
 func (ln *LockName) EncodeName() string {
-	if ln.Kind == LockKindMutex {
+	if ln.Kind != LockKindConfigMap {
 		return ln.ValidateEncoding(fmt.Sprintf("%s/%s/%s", ln.Namespace, ln.Kind, ln.ResourceName))
 	}
 	return ln.ValidateEncoding(fmt.Sprintf("%s/%s/%s/%s", ln.Namespace, ln.Kind, ln.ResourceName, ln.Key))
@@ -110,3 +121,8 @@ func (ln *LockName) ValidateEncoding(encoding string) string {
 	}
 	return encoding
 }
+
+// IsDatabase returns true if the lock is stored in the database rather than in controller memory
+func (ln *LockName) IsDatabase() bool {
+	return ln.Kind == LockKindDatabase || ln.Kind == LockKindDatabaseMutex
+}
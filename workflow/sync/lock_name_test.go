@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestDecodeLockName(t *testing.T) {
@@ -56,6 +58,32 @@ func TestDecodeLockName(t *testing.T) {
 				return true
 			},
 		},
+		{
+			"TestDatabaseLockNamesWork",
+			args{"default/Database/foo"},
+			&LockName{
+				Namespace:    "default",
+				ResourceName: "foo",
+				Key:          "",
+				Kind:         LockKindDatabase,
+			},
+			func(t assert.TestingT, err error, i ...interface{}) bool {
+				return true
+			},
+		},
+		{
+			"TestDatabaseMutexLockNamesWork",
+			args{"default/DatabaseMutex/foo"},
+			&LockName{
+				Namespace:    "default",
+				ResourceName: "foo",
+				Key:          "",
+				Kind:         LockKindDatabaseMutex,
+			},
+			func(t assert.TestingT, err error, i ...interface{}) bool {
+				return true
+			},
+		},
 		{
 			"TestConfigMapKeysCannotContainSlashes",
 			args{"default/ConfigMap/foo/bar/baz/qux"},
@@ -76,3 +104,31 @@ func TestDecodeLockName(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLockName(t *testing.T) {
+	t.Run("DatabaseSemaphore", func(t *testing.T) {
+		lockName, err := GetLockName(&v1alpha1.Synchronization{Semaphore: &v1alpha1.SemaphoreRef{Database: &v1alpha1.SyncDatabaseRef{Key: "foo"}}}, "default")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "default/Database/foo", lockName.EncodeName())
+			assert.True(t, lockName.IsDatabase())
+		}
+	})
+	t.Run("DatabaseMutex", func(t *testing.T) {
+		lockName, err := GetLockName(&v1alpha1.Synchronization{Mutex: &v1alpha1.Mutex{Name: "foo", Database: true}}, "default")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "default/DatabaseMutex/foo", lockName.EncodeName())
+			assert.True(t, lockName.IsDatabase())
+		}
+	})
+	t.Run("Mutex", func(t *testing.T) {
+		lockName, err := GetLockName(&v1alpha1.Synchronization{Mutex: &v1alpha1.Mutex{Name: "foo"}}, "default")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "default/Mutex/foo", lockName.EncodeName())
+			assert.False(t, lockName.IsDatabase())
+		}
+	})
+	t.Run("SemaphoreWithoutRef", func(t *testing.T) {
+		_, err := GetLockName(&v1alpha1.Synchronization{Semaphore: &v1alpha1.SemaphoreRef{}}, "default")
+		assert.Error(t, err)
+	})
+}
@@ -36,6 +36,11 @@ func (m *PriorityMutex) getLimit() int {
 	return m.mutex.limit
 }
 
+// getWeight returns one, as a mutex has a single permit
+func (m *PriorityMutex) getWeight(holderKey string) int {
+	return 1
+}
+
 func (m *PriorityMutex) getCurrentHolders() []string {
 	return m.mutex.getCurrentHolders()
 }
@@ -50,16 +55,16 @@ func (m *PriorityMutex) release(key string) bool {
 	return m.mutex.release(key)
 }
 
-func (m *PriorityMutex) acquire(holderKey string) bool {
+func (m *PriorityMutex) acquire(holderKey string, weight int) bool {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	return m.mutex.acquire(holderKey)
+	return m.mutex.acquire(holderKey, 1)
 }
 
-func (m *PriorityMutex) addToQueue(holderKey string, priority int32, creationTime time.Time) {
+func (m *PriorityMutex) addToQueue(holderKey string, priority int32, creationTime time.Time, weight int) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	m.mutex.addToQueue(holderKey, priority, creationTime)
+	m.mutex.addToQueue(holderKey, priority, creationTime, 1)
 }
 
 func (m *PriorityMutex) removeFromQueue(holderKey string) {
@@ -0,0 +1,206 @@
+package sync
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitSemaphore admits at most limit holders per period, using a token bucket that holds at most limit tokens
+// and is refilled at limit/period. Holders do not return their token when they release the lock.
+type RateLimitSemaphore struct {
+	name         string
+	limit        int
+	period       time.Duration
+	limiter      *rate.Limiter
+	pending      *priorityQueue
+	lockHolder   map[string]bool
+	lock         *sync.Mutex
+	nextWorkflow NextWorkflow
+	retry        *time.Timer
+	log          *log.Entry
+}
+
+var _ Semaphore = &RateLimitSemaphore{}
+
+// NewRateLimit creates a rate limit with a full bucket. A rate limit with a zero limit admits nobody until it is
+// resized, this is used to restore holders on start-up before the limit is known.
+func NewRateLimit(name string, limit int, period time.Duration, nextWorkflow NextWorkflow) *RateLimitSemaphore {
+	return &RateLimitSemaphore{
+		name:         name,
+		limit:        limit,
+		period:       period,
+		limiter:      rate.NewLimiter(ratePerSecond(limit, period), limit),
+		pending:      &priorityQueue{itemByKey: make(map[string]*item)},
+		lockHolder:   make(map[string]bool),
+		lock:         &sync.Mutex{},
+		nextWorkflow: nextWorkflow,
+		log: log.WithFields(log.Fields{
+			"rateLimit": name,
+		}),
+	}
+}
+
+func ratePerSecond(limit int, period time.Duration) rate.Limit {
+	if period <= 0 {
+		return 0
+	}
+	return rate.Limit(float64(limit) / period.Seconds())
+}
+
+func (s *RateLimitSemaphore) getName() string {
+	return s.name
+}
+
+func (s *RateLimitSemaphore) getLimit() int {
+	return s.limit
+}
+
+func (s *RateLimitSemaphore) getPeriod() time.Duration {
+	return s.period
+}
+
+// getWeight returns one, as every holder takes a single token
+func (s *RateLimitSemaphore) getWeight(holderKey string) int {
+	return 1
+}
+
+// getCurrentPending returns the waiters in the order in which they are admitted
+func (s *RateLimitSemaphore) getCurrentPending() []string {
+	var keys []string
+	for _, item := range s.pending.sorted() {
+		keys = append(keys, item.key)
+	}
+	return keys
+}
+
+func (s *RateLimitSemaphore) getCurrentHolders() []string {
+	var keys []string
+	for k := range s.lockHolder {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (s *RateLimitSemaphore) resize(n int) bool {
+	return s.update(n, s.period)
+}
+
+// update changes the limit and period of the rate limit, the tokens already in the bucket are kept
+func (s *RateLimitSemaphore) update(limit int, period time.Duration) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.limit == limit && s.period == period {
+		return true
+	}
+	s.log.Infof("%s rate limit changed from %d per %v to %d per %v", s.name, s.limit, s.period, limit, period)
+	s.limit = limit
+	s.period = period
+	s.limiter.SetLimit(ratePerSecond(limit, period))
+	s.limiter.SetBurst(limit)
+	return true
+}
+
+func (s *RateLimitSemaphore) release(key string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.lockHolder[key]; ok {
+		delete(s.lockHolder, key)
+		s.log.Infof("Rate limit has been released by %s", key)
+	}
+	return true
+}
+
+// acquire records the holder, taking a token if one is available. It is used to restore holders on start-up, who
+// have already been admitted. Every holder takes a single token, whatever its weight.
+func (s *RateLimitSemaphore) acquire(holderKey string, weight int) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.limiter.Allow()
+	s.lockHolder[holderKey] = true
+	return true
+}
+
+// addToQueue adds the holderkey into priority queue that maintains the priority order to acquire the lock.
+func (s *RateLimitSemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time, weight int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.lockHolder[holderKey]; ok {
+		s.log.Debugf("Rate limit is already acquired by %s", holderKey)
+		return
+	}
+
+	s.pending.add(holderKey, priority, creationTime)
+	s.log.Debugf("Added into queue: %s", holderKey)
+}
+
+func (s *RateLimitSemaphore) removeFromQueue(holderKey string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pending.remove(holderKey)
+	s.log.Debugf("Removed from queue: %s", holderKey)
+}
+
+func (s *RateLimitSemaphore) tryAcquire(holderKey string) (bool, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.lockHolder[holderKey]; ok {
+		s.log.Debugf("%s is already admitted by rate limit", holderKey)
+		return true, ""
+	}
+
+	waitingMsg := fmt.Sprintf("Waiting for %s rate limit. Rate limit: %d per %v", s.name, s.limit, s.period)
+
+	// Check whether requested holdkey is in front of priority queue.
+	// If it is not a front key, it needs to wait for its turn.
+	if s.pending.Len() > 0 {
+		item := s.pending.peek()
+		if holderKey != item.key && !isSameWorkflowNodeKeys(holderKey, item.key) {
+			s.scheduleNotify()
+			return false, waitingMsg
+		}
+	}
+
+	if s.limiter.Allow() {
+		s.lockHolder[holderKey] = true
+		s.pending.remove(holderKey)
+		s.log.Infof("%s admitted by %s rate limit", holderKey, s.name)
+		if s.pending.Len() > 0 {
+			s.scheduleNotify()
+		}
+		return true, ""
+	}
+	s.scheduleNotify()
+	return false, waitingMsg
+}
+
+// scheduleNotify enqueues the workflow in front of the queue once the next token is available.
+func (s *RateLimitSemaphore) scheduleNotify() {
+	if s.retry != nil || s.limit <= 0 {
+		return
+	}
+	var delay time.Duration
+	if tokens := s.limiter.Tokens(); tokens < 1 {
+		delay = time.Duration((1 - tokens) / float64(s.limiter.Limit()) * float64(time.Second))
+	}
+	s.retry = time.AfterFunc(delay, s.notifyWaiters)
+}
+
+func (s *RateLimitSemaphore) notifyWaiters() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.retry = nil
+	if s.pending.Len() > 0 {
+		wfKey := workflowKey(s.pending.peek())
+		s.log.Debugf("Enqueue the workflow %s", wfKey)
+		s.nextWorkflow(wfKey)
+	}
+}
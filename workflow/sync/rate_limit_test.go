@@ -0,0 +1,113 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestRateLimitTryAcquire(t *testing.T) {
+	nextWorkflow := func(key string) {}
+
+	s := NewRateLimit("foo", 2, time.Hour, nextWorkflow)
+	now := time.Now()
+	s.addToQueue("default/wf-01", 0, now, 1)
+	s.addToQueue("default/wf-02", 0, now.Add(time.Second), 1)
+	s.addToQueue("default/wf-03", 10, now.Add(2*time.Second), 1)
+
+	// verify the higher priority workflow is admitted first
+	var acquired bool
+	acquired, _ = s.tryAcquire("default/wf-01")
+	assert.False(t, acquired)
+	acquired, _ = s.tryAcquire("default/wf-03")
+	assert.True(t, acquired)
+	acquired, _ = s.tryAcquire("default/wf-01")
+	assert.True(t, acquired)
+	// the bucket is empty, and releasing does not return tokens
+	assert.True(t, s.release("default/wf-03"))
+	acquired, msg := s.tryAcquire("default/wf-02")
+	assert.False(t, acquired)
+	assert.Equal(t, "Waiting for foo rate limit. Rate limit: 2 per 1h0m0s", msg)
+	// already admitted holders stay admitted
+	acquired, _ = s.tryAcquire("default/wf-01")
+	assert.True(t, acquired)
+}
+
+func TestRateLimitNotifyWaiters(t *testing.T) {
+	notified := make(chan string, 1)
+	s := NewRateLimit("foo", 1, 100*time.Millisecond, func(key string) { notified <- key })
+	now := time.Now()
+	s.addToQueue("default/wf-01", 0, now, 1)
+	s.addToQueue("default/wf-02", 0, now.Add(time.Second), 1)
+
+	acquired, _ := s.tryAcquire("default/wf-01")
+	assert.True(t, acquired)
+	acquired, _ = s.tryAcquire("default/wf-02")
+	assert.False(t, acquired)
+
+	select {
+	case key := <-notified:
+		assert.Equal(t, "default/wf-02", key)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "waiter was not notified when a token became available")
+	}
+	acquired, _ = s.tryAcquire("default/wf-02")
+	assert.True(t, acquired)
+}
+
+func TestRateLimitUpdate(t *testing.T) {
+	s := NewRateLimit("foo", 0, 0, func(key string) {})
+	assert.True(t, s.acquire("default/wf-01", 1))
+	acquired, _ := s.tryAcquire("default/wf-02")
+	assert.False(t, acquired)
+
+	s.update(5, time.Hour)
+	assert.Equal(t, 5, s.getLimit())
+	assert.Equal(t, time.Hour, s.getPeriod())
+	// a restored rate limit starts with an empty bucket
+	acquired, _ = s.tryAcquire("default/wf-02")
+	assert.False(t, acquired)
+}
+
+func TestRateLimitWfLevel(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	concurrenyMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {}, WorkflowExistenceFunc)
+	wf := wfv1.MustUnmarshalWorkflow(wfWithMutex)
+	wf.Spec.Synchronization = &wfv1.Synchronization{RateLimit: &wfv1.RateLimit{Name: "my-rate-limit", Limit: 1, Period: &metav1.Duration{Duration: time.Hour}}}
+	wf1 := wf.DeepCopy()
+	wf1.Name = "two"
+
+	status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.True(t, status)
+	assert.True(t, wfUpdate)
+	if assert.NotNil(t, wf.Status.Synchronization.RateLimit) {
+		assert.Equal(t, []wfv1.SemaphoreHolding{{Semaphore: "default/RateLimit/my-rate-limit", Holders: []string{wf.Name}}}, wf.Status.Synchronization.RateLimit.Holding)
+	}
+
+	status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, msg)
+	assert.False(t, status)
+	assert.True(t, wfUpdate)
+
+	rateLimit := concurrenyMgr.syncLockMap["default/RateLimit/my-rate-limit"]
+	assert.Len(t, rateLimit.getCurrentPending(), 1)
+	concurrenyMgr.ReleaseAll(wf)
+	concurrenyMgr.ReleaseAll(wf1)
+	assert.Empty(t, rateLimit.getCurrentHolders())
+	assert.Empty(t, rateLimit.getCurrentPending())
+
+	t.Run("InvalidLimit", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Synchronization.RateLimit.Limit = 0
+		_, _, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		assert.EqualError(t, err, "rate limit 'my-rate-limit' must have a limit greater than zero")
+	})
+}
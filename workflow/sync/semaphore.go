@@ -15,7 +15,7 @@ type PrioritySemaphore struct {
 	limit        int
 	pending      *priorityQueue
 	semaphore    *sema.Weighted
-	lockHolder   map[string]bool
+	lockHolder   map[string]int
 	lock         *sync.Mutex
 	nextWorkflow NextWorkflow
 	log          *log.Entry
@@ -29,7 +29,7 @@ func NewSemaphore(name string, limit int, nextWorkflow NextWorkflow, lockType st
 		limit:        limit,
 		pending:      &priorityQueue{itemByKey: make(map[string]*item)},
 		semaphore:    sema.NewWeighted(int64(limit)),
-		lockHolder:   make(map[string]bool),
+		lockHolder:   make(map[string]int),
 		lock:         &sync.Mutex{},
 		nextWorkflow: nextWorkflow,
 		log: log.WithFields(log.Fields{
@@ -46,9 +46,10 @@ func (s *PrioritySemaphore) getLimit() int {
 	return s.limit
 }
 
+// getCurrentPending returns the waiters in the order in which they acquire the lock
 func (s *PrioritySemaphore) getCurrentPending() []string {
 	var keys []string
-	for _, item := range s.pending.items {
+	for _, item := range s.pending.sorted() {
 		keys = append(keys, item.key)
 	}
 	return keys
@@ -62,11 +63,33 @@ func (s *PrioritySemaphore) getCurrentHolders() []string {
 	return keys
 }
 
+// getWeight returns the number of permits held, or requested, by the holder or waiter
+func (s *PrioritySemaphore) getWeight(holderKey string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if weight, ok := s.lockHolder[holderKey]; ok {
+		return weight
+	}
+	if item, ok := s.pending.itemByKey[holderKey]; ok {
+		return itemWeight(item)
+	}
+	return 1
+}
+
+// heldPermits returns the number of permits taken by the current holders
+func (s *PrioritySemaphore) heldPermits() int {
+	held := 0
+	for _, weight := range s.lockHolder {
+		held += weight
+	}
+	return held
+}
+
 func (s *PrioritySemaphore) resize(n int) bool {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	cur := len(s.lockHolder)
+	cur := s.heldPermits()
 	// downward case, acquired n locks
 	if cur > n {
 		cur = n
@@ -85,16 +108,21 @@ func (s *PrioritySemaphore) resize(n int) bool {
 func (s *PrioritySemaphore) release(key string) bool {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	if _, ok := s.lockHolder[key]; ok {
+	if weight, ok := s.lockHolder[key]; ok {
 		delete(s.lockHolder, key)
 		// When semaphore resized downward
 		// Remove the excess holders from map once the done.
-		if len(s.lockHolder) >= s.limit {
+		held := s.heldPermits()
+		if held >= s.limit {
 			return true
 		}
 
-		s.semaphore.Release(1)
-		availableLocks := s.limit - len(s.lockHolder)
+		// only the permits that were within the limit were acquired from the semaphore
+		if held+weight > s.limit {
+			weight = s.limit - held
+		}
+		s.semaphore.Release(int64(weight))
+		availableLocks := s.limit - held
 		s.log.Infof("Lock has been released by %s. Available locks: %d", key, availableLocks)
 		if s.pending.Len() > 0 {
 			s.notifyWaiters()
@@ -103,21 +131,31 @@ func (s *PrioritySemaphore) release(key string) bool {
 	return true
 }
 
-// notifyWaiters enqueues the next N workflows who are waiting for the semaphore to the workqueue,
-// where N is the availability of the semaphore. If semaphore is out of capacity, this does nothing.
+// notifyWaiters enqueues the next workflows who are waiting for the semaphore to the workqueue, in priority order,
+// for as long as the availability of the semaphore covers their weights. It stops at the first waiter whose weight
+// is not covered, so that a waiter that needs many permits is not starved by waiters that need fewer.
+// If semaphore is out of capacity, this does nothing.
 func (s *PrioritySemaphore) notifyWaiters() {
-	triggerCount := s.limit - len(s.lockHolder)
-	if s.pending.Len() < triggerCount {
-		triggerCount = s.pending.Len()
-	}
-	for idx := 0; idx < triggerCount; idx++ {
-		item := s.pending.items[idx]
+	available := s.limit - s.heldPermits()
+	for _, item := range s.pending.sorted() {
+		weight := itemWeight(item)
+		if weight > available {
+			break
+		}
+		available -= weight
 		wfKey := workflowKey(item)
 		s.log.Debugf("Enqueue the workflow %s", wfKey)
 		s.nextWorkflow(wfKey)
 	}
 }
 
+func itemWeight(i *item) int {
+	if i.weight > 0 {
+		return i.weight
+	}
+	return 1
+}
+
 // workflowKey formulates the proper workqueue key given a semaphore queue item
 func workflowKey(i *item) string {
 	parts := strings.Split(i.key, "/")
@@ -130,7 +168,8 @@ func workflowKey(i *item) string {
 }
 
 // addToQueue adds the holderkey into priority queue that maintains the priority order to acquire the lock.
-func (s *PrioritySemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time) {
+// The weight is the number of permits that the holder needs.
+func (s *PrioritySemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time, weight int) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -140,20 +179,27 @@ func (s *PrioritySemaphore) addToQueue(holderKey string, priority int32, creatio
 	}
 
 	s.pending.add(holderKey, priority, creationTime)
+	s.pending.itemByKey[holderKey].weight = weight
 	s.log.Debugf("Added into queue: %s", holderKey)
 }
 
+// removeFromQueue removes the holderkey from the priority queue. If it was in front of the queue, for example because
+// it timed out waiting for the lock, the waiters that are now next in line are enqueued.
 func (s *PrioritySemaphore) removeFromQueue(holderKey string) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	wasFront := s.pending.Len() > 0 && s.pending.peek().key == holderKey
 	s.pending.remove(holderKey)
 	s.log.Debugf("Removed from queue: %s", holderKey)
+	if wasFront && s.pending.Len() > 0 {
+		s.notifyWaiters()
+	}
 }
 
-func (s *PrioritySemaphore) acquire(holderKey string) bool {
-	if s.semaphore.TryAcquire(1) {
-		s.lockHolder[holderKey] = true
+func (s *PrioritySemaphore) acquire(holderKey string, weight int) bool {
+	if s.semaphore.TryAcquire(int64(weight)) {
+		s.lockHolder[holderKey] = weight
 		return true
 	}
 	return false
@@ -180,7 +226,15 @@ func (s *PrioritySemaphore) tryAcquire(holderKey string) (bool, string) {
 	}
 	var nextKey string
 
-	waitingMsg := fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d", s.name, s.limit-len(s.lockHolder), s.limit)
+	weight := 1
+	if item, ok := s.pending.itemByKey[holderKey]; ok {
+		weight = itemWeight(item)
+	}
+
+	waitingMsg := fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d", s.name, s.limit-s.heldPermits(), s.limit)
+	if weight > 1 {
+		waitingMsg = fmt.Sprintf("%s. Requested permits: %d", waitingMsg, weight)
+	}
 
 	// Check whether requested holdkey is in front of priority queue.
 	// If it is in front position, it will allow to acquire lock.
@@ -189,16 +243,16 @@ func (s *PrioritySemaphore) tryAcquire(holderKey string) (bool, string) {
 		item := s.pending.peek()
 		if holderKey != nextKey && !isSameWorkflowNodeKeys(holderKey, item.key) {
 			// Enqueue the front workflow if lock is available
-			if len(s.lockHolder) < s.limit {
+			if s.heldPermits()+itemWeight(item) <= s.limit {
 				s.nextWorkflow(workflowKey(item))
 			}
 			return false, waitingMsg
 		}
 	}
 
-	if s.acquire(holderKey) {
-		s.pending.pop()
-		s.log.Infof("%s acquired by %s. Lock availability: %d/%d", s.name, holderKey, s.limit-len(s.lockHolder), s.limit)
+	if s.acquire(holderKey, weight) {
+		s.pending.remove(holderKey)
+		s.log.Infof("%s acquired by %s. Lock availability: %d/%d", s.name, holderKey, s.limit-s.heldPermits(), s.limit)
 		s.notifyWaiters()
 		return true, ""
 	}
@@ -27,10 +27,10 @@ func TestTryAcquire(t *testing.T) {
 
 	s := NewSemaphore("foo", 2, nextWorkflow, "semaphore")
 	now := time.Now()
-	s.addToQueue("default/wf-01", 0, now)
-	s.addToQueue("default/wf-02", 0, now.Add(time.Second))
-	s.addToQueue("default/wf-03", 0, now.Add(2*time.Second))
-	s.addToQueue("default/wf-04", 0, now.Add(3*time.Second))
+	s.addToQueue("default/wf-01", 0, now, 1)
+	s.addToQueue("default/wf-02", 0, now.Add(time.Second), 1)
+	s.addToQueue("default/wf-03", 0, now.Add(2*time.Second), 1)
+	s.addToQueue("default/wf-04", 0, now.Add(3*time.Second), 1)
 
 	// verify only the first in line is allowed to acquired the semaphore
 	var acquired bool
@@ -60,11 +60,11 @@ func TestNotifyWaitersAcquire(t *testing.T) {
 
 	s := NewSemaphore("foo", 3, nextWorkflow, "semaphore")
 	now := time.Now()
-	s.addToQueue("default/wf-04", 0, now.Add(3*time.Second))
-	s.addToQueue("default/wf-02", 0, now.Add(time.Second))
-	s.addToQueue("default/wf-01", 0, now)
-	s.addToQueue("default/wf-05", 0, now.Add(4*time.Second))
-	s.addToQueue("default/wf-03", 0, now.Add(2*time.Second))
+	s.addToQueue("default/wf-04", 0, now.Add(3*time.Second), 1)
+	s.addToQueue("default/wf-02", 0, now.Add(time.Second), 1)
+	s.addToQueue("default/wf-01", 0, now, 1)
+	s.addToQueue("default/wf-05", 0, now.Add(4*time.Second), 1)
+	s.addToQueue("default/wf-03", 0, now.Add(2*time.Second), 1)
 
 	acquired, _ := s.tryAcquire("default/wf-01")
 	assert.True(t, acquired)
@@ -95,8 +95,8 @@ func TestNotifyWorkflowFromTemplateSemaphore(t *testing.T) {
 
 	s := NewSemaphore("foo", 2, nextWorkflow, "semaphore")
 	now := time.Now()
-	s.addToQueue("default/wf-01/nodeid-123", 0, now)
-	s.addToQueue("default/wf-02/nodeid-456", 0, now.Add(time.Second))
+	s.addToQueue("default/wf-01/nodeid-123", 0, now, 1)
+	s.addToQueue("default/wf-02/nodeid-456", 0, now.Add(time.Second), 1)
 
 	acquired, _ := s.tryAcquire("default/wf-01/nodeid-123")
 	assert.True(t, acquired)
@@ -104,3 +104,73 @@ func TestNotifyWorkflowFromTemplateSemaphore(t *testing.T) {
 	assert.Len(t, notified, 1)
 	assert.True(t, notified["default/wf-02"])
 }
+
+// TestTryAcquireWeighted verifies a waiter that needs many permits is not starved by waiters that need fewer
+func TestTryAcquireWeighted(t *testing.T) {
+	notified := make(map[string]bool)
+	nextWorkflow := func(key string) {
+		notified[key] = true
+	}
+
+	s := NewSemaphore("foo", 4, nextWorkflow, "semaphore")
+	now := time.Now()
+	s.addToQueue("default/wf-01", 0, now, 3)
+	s.addToQueue("default/wf-02", 0, now.Add(time.Second), 2)
+	s.addToQueue("default/wf-03", 0, now.Add(2*time.Second), 1)
+	s.addToQueue("default/wf-04", 0, now.Add(3*time.Second), 1)
+
+	acquired, _ := s.tryAcquire("default/wf-01")
+	assert.True(t, acquired)
+	assert.Empty(t, notified)
+	// only one permit is left, which wf-03 may not take ahead of wf-02
+	acquired, msg := s.tryAcquire("default/wf-02")
+	assert.False(t, acquired)
+	assert.Equal(t, "Waiting for foo lock. Lock status: 1/4. Requested permits: 2", msg)
+	acquired, _ = s.tryAcquire("default/wf-03")
+	assert.False(t, acquired)
+
+	assert.True(t, s.release("default/wf-01"))
+	assert.Len(t, notified, 3)
+	assert.True(t, notified["default/wf-02"])
+	assert.True(t, notified["default/wf-03"])
+	assert.True(t, notified["default/wf-04"])
+
+	acquired, _ = s.tryAcquire("default/wf-02")
+	assert.True(t, acquired)
+	acquired, _ = s.tryAcquire("default/wf-03")
+	assert.True(t, acquired)
+	acquired, _ = s.tryAcquire("default/wf-04")
+	assert.True(t, acquired)
+	assert.Equal(t, 4, s.heldPermits())
+}
+
+func TestResizeWeighted(t *testing.T) {
+	s := NewSemaphore("foo", 4, func(key string) {}, "semaphore")
+	assert.True(t, s.acquire("default/wf-01", 3))
+	assert.True(t, s.resize(2))
+	assert.False(t, s.acquire("default/wf-02", 1))
+	// releasing the over-limit holder frees the whole semaphore
+	assert.True(t, s.release("default/wf-01"))
+	assert.True(t, s.acquire("default/wf-02", 2))
+}
+
+// TestRemoveFromQueueNotifiesWaiters verifies the waiters behind a waiter that timed out are enqueued
+func TestRemoveFromQueueNotifiesWaiters(t *testing.T) {
+	notified := make(map[string]bool)
+	nextWorkflow := func(key string) {
+		notified[key] = true
+	}
+
+	s := NewSemaphore("foo", 2, nextWorkflow, "semaphore")
+	now := time.Now()
+	assert.True(t, s.acquire("default/wf-01", 1))
+	s.addToQueue("default/wf-02", 0, now, 2)
+	s.addToQueue("default/wf-03", 0, now.Add(time.Second), 1)
+
+	s.removeFromQueue("default/wf-03")
+	assert.Empty(t, notified)
+	s.addToQueue("default/wf-03", 0, now.Add(time.Second), 1)
+	s.removeFromQueue("default/wf-02")
+	assert.Len(t, notified, 1)
+	assert.True(t, notified["default/wf-03"])
+}
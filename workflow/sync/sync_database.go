@@ -0,0 +1,247 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+)
+
+const (
+	syncLimitTableName       = "argo_sync_limit"
+	syncStateTableName       = "argo_sync_state"
+	syncControllersTableName = "argo_sync_controllers"
+)
+
+type syncLimitRecord struct {
+	Name      string `db:"name"`
+	SizeLimit int    `db:"sizelimit"`
+}
+
+type syncStateRecord struct {
+	Name         string    `db:"name"`
+	Controller   string    `db:"controller"`
+	HolderKey    string    `db:"holderkey"`
+	Held         bool      `db:"held"`
+	Priority     int32     `db:"priority"`
+	CreationTime time.Time `db:"creationtime"`
+}
+
+type syncControllerRecord struct {
+	Controller string `db:"controller"`
+}
+
+// syncDatabase is the state shared by all the database locks of a controller.
+// Every controller using the same database records a heartbeat, and the holders and waiters of a controller whose
+// heartbeat is older than the lease duration are ignored, and eventually deleted.
+type syncDatabase struct {
+	session       sqlbuilder.Database
+	controller    string
+	leaseDuration time.Duration
+}
+
+func newSyncDatabase(session sqlbuilder.Database, controller string, leaseDuration time.Duration) *syncDatabase {
+	return &syncDatabase{session: session, controller: controller, leaseDuration: leaseDuration}
+}
+
+func (d *syncDatabase) leaseExpiry() string {
+	return sqldb.Ago(d.session, d.leaseDuration)
+}
+
+func (d *syncDatabase) heartbeatColumn() string {
+	return sqldb.Timestamp(d.session, "heartbeat")
+}
+
+// heartbeat records that this controller is alive.
+func (d *syncDatabase) heartbeat() error {
+	rs, err := d.session.
+		Update(syncControllersTableName).
+		Set("heartbeat", db.Raw("current_timestamp")).
+		Where(db.Cond{"controller": d.controller}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+	_, err = d.session.
+		InsertInto(syncControllersTableName).
+		Columns("controller").
+		Values(d.controller).
+		Exec()
+	return err
+}
+
+// expireInactiveControllers deletes the holders and waiters of every controller whose lease has expired.
+func (d *syncDatabase) expireInactiveControllers() error {
+	var inactive []syncControllerRecord
+	err := d.session.
+		Select("controller").
+		From(syncControllersTableName).
+		Where(db.Cond{"controller <>": d.controller}).
+		And(d.heartbeatColumn() + " < " + d.leaseExpiry()).
+		All(&inactive)
+	if err != nil {
+		return err
+	}
+	for _, c := range inactive {
+		err := d.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+			_, err := sess.
+				DeleteFrom(syncStateTableName).
+				Where(db.Cond{"controller": c.Controller}).
+				Exec()
+			if err != nil {
+				return err
+			}
+			_, err = sess.
+				DeleteFrom(syncControllersTableName).
+				Where(db.Cond{"controller": c.Controller}).
+				Exec()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		log.WithField("controller", c.Controller).Info("Released database locks of inactive controller")
+	}
+	return nil
+}
+
+// activeControllers returns the controllers whose lease has not expired, which always includes this controller.
+func (d *syncDatabase) activeControllers(sess sqlbuilder.SQLBuilder) (map[string]bool, error) {
+	var active []syncControllerRecord
+	err := sess.
+		Select("controller").
+		From(syncControllersTableName).
+		Where(d.heartbeatColumn() + " >= " + d.leaseExpiry()).
+		All(&active)
+	if err != nil {
+		return nil, err
+	}
+	controllers := map[string]bool{d.controller: true}
+	for _, c := range active {
+		controllers[c.Controller] = true
+	}
+	return controllers, nil
+}
+
+// lockState returns the holders and the waiters (in priority order) of a lock, ignoring those of inactive controllers.
+func (d *syncDatabase) lockState(sess sqlbuilder.SQLBuilder, name string) (holders []syncStateRecord, pending []syncStateRecord, err error) {
+	active, err := d.activeControllers(sess)
+	if err != nil {
+		return nil, nil, err
+	}
+	var records []syncStateRecord
+	err = sess.
+		Select("name", "controller", "holderkey", "held", "priority", "creationtime").
+		From(syncStateTableName).
+		Where(db.Cond{"name": name}).
+		OrderBy("-priority", "creationtime").
+		All(&records)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, r := range records {
+		if !active[r.Controller] {
+			continue
+		}
+		if r.Held {
+			holders = append(holders, r)
+		} else {
+			pending = append(pending, r)
+		}
+	}
+	return holders, pending, nil
+}
+
+// dbLockState is the state of a database lock, shared by every active controller
+type dbLockState struct {
+	limit   int
+	holders []syncStateRecord
+	pending []syncStateRecord
+}
+
+// lockStates returns the state of every database lock that is held or waited for by an active controller, the
+// waiters of each lock are in priority order.
+func (d *syncDatabase) lockStates() (map[string]*dbLockState, error) {
+	active, err := d.activeControllers(d.session)
+	if err != nil {
+		return nil, err
+	}
+	var records []syncStateRecord
+	err = d.session.
+		Select("name", "controller", "holderkey", "held", "priority", "creationtime").
+		From(syncStateTableName).
+		OrderBy("-priority", "creationtime").
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	states := make(map[string]*dbLockState)
+	for _, r := range records {
+		if !active[r.Controller] {
+			continue
+		}
+		state, ok := states[r.Name]
+		if !ok {
+			state = &dbLockState{}
+			states[r.Name] = state
+		}
+		if r.Held {
+			state.holders = append(state.holders, r)
+		} else {
+			state.pending = append(state.pending, r)
+		}
+	}
+	var limits []syncLimitRecord
+	err = d.session.
+		Select("name", "sizelimit").
+		From(syncLimitTableName).
+		All(&limits)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range limits {
+		if state, ok := states[l.Name]; ok {
+			state.limit = l.SizeLimit
+		}
+	}
+	return states, nil
+}
+
+// getLimit returns the size limit of a database semaphore.
+func (d *syncDatabase) getLimit(name string) (int, error) {
+	limit := &syncLimitRecord{}
+	err := d.session.
+		Select("name", "sizelimit").
+		From(syncLimitTableName).
+		Where(db.Cond{"name": name}).
+		One(limit)
+	if err == db.ErrNoMoreRows {
+		return 0, fmt.Errorf("database semaphore '%s' has no limit in table %s", name, syncLimitTableName)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return limit.SizeLimit, nil
+}
+
+// releaseAll deletes all the holders and waiters of this controller. Holders are subsequently re-acquired
+// from the workflow statuses, and waiters re-queued when their workflows are next reconciled.
+func (d *syncDatabase) releaseAll() error {
+	_, err := d.session.
+		DeleteFrom(syncStateTableName).
+		Where(db.Cond{"controller": d.controller}).
+		Exec()
+	return err
+}
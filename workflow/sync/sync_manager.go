@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	"upper.io/db.v3/lib/sqlbuilder"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/intstr"
 )
 
 type (
@@ -23,16 +26,29 @@ type Manager struct {
 	nextWorkflow NextWorkflow
 	getSyncLimit GetSyncLimit
 	isWFDeleted  IsWorkflowDeleted
+	syncDB       *syncDatabase
 }
 
 func NewLockManager(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow, isWFDeleted IsWorkflowDeleted) *Manager {
-	return &Manager{
+	return NewLockManagerWithDatabase(getSyncLimit, nextWorkflow, isWFDeleted, nil, "", 0)
+}
+
+// NewLockManagerWithDatabase creates a Manager that also supports database semaphores and mutexes, which are shared
+// by every controller using the same database. Each controller must have a unique controllerName, and its locks are
+// released by the other controllers when it has not sent a heartbeat for leaseDuration.
+// If session is nil, database locks cannot be used.
+func NewLockManagerWithDatabase(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow, isWFDeleted IsWorkflowDeleted, session sqlbuilder.Database, controllerName string, leaseDuration time.Duration) *Manager {
+	cm := &Manager{
 		syncLockMap:  make(map[string]Semaphore),
 		lock:         &sync.Mutex{},
 		nextWorkflow: nextWorkflow,
 		getSyncLimit: getSyncLimit,
 		isWFDeleted:  isWFDeleted,
 	}
+	if session != nil {
+		cm.syncDB = newSyncDatabase(session, controllerName, leaseDuration)
+	}
+	return cm
 }
 
 func (cm *Manager) getWorkflowKey(key string) (string, error) {
@@ -66,7 +82,45 @@ func (cm *Manager) CheckWorkflowExistence() {
 	}
 }
 
+// Heartbeat records that this controller is alive in the database, and releases the database locks of
+// controllers whose lease has expired.
+func (cm *Manager) Heartbeat() {
+	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
+
+	if cm.syncDB == nil {
+		return
+	}
+	if err := cm.syncDB.heartbeat(); err != nil {
+		log.WithError(err).Error("Failed to record synchronization heartbeat")
+		return
+	}
+	if err := cm.syncDB.expireInactiveControllers(); err != nil {
+		log.WithError(err).Error("Failed to expire inactive controllers")
+	}
+}
+
+// PollDatabaseLocks enqueues the waiting workflows of database locks which may have been released by other controllers.
+func (cm *Manager) PollDatabaseLocks() {
+	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
+
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	for _, lock := range cm.syncLockMap {
+		if dbLock, ok := lock.(*databaseSemaphore); ok {
+			dbLock.notifyWaiters()
+		}
+	}
+}
+
 func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
+	if cm.syncDB != nil {
+		cm.Heartbeat()
+		if err := cm.syncDB.releaseAll(); err != nil {
+			log.WithError(err).Error("Failed to reset database locks")
+		}
+	}
+
 	for _, wf := range wfs {
 		if wf.Status.Synchronization == nil {
 			continue
@@ -77,7 +131,8 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 
 				semaphore := cm.syncLockMap[holding.Semaphore]
 				if semaphore == nil {
-					semaphore, err := cm.initializeSemaphore(holding.Semaphore)
+					var err error
+					semaphore, err = cm.initializeSemaphore(holding.Semaphore)
 					if err != nil {
 						log.Warnf("cannot initialize semaphore '%s': %v", holding.Semaphore, err)
 						continue
@@ -87,22 +142,44 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 
 				for _, holders := range holding.Holders {
 					resourceKey := getResourceKey(wf.Namespace, wf.Name, holders)
-					if semaphore != nil && semaphore.acquire(resourceKey) {
+					if semaphore != nil && semaphore.acquire(resourceKey, int(holding.GetHolderWeight(holders))) {
 						log.Infof("Lock acquired by %s from %s", resourceKey, holding.Semaphore)
 					}
 				}
 			}
 		}
 
+		if wf.Status.Synchronization.RateLimit != nil {
+			for _, holding := range wf.Status.Synchronization.RateLimit.Holding {
+				rateLimit := cm.syncLockMap[holding.Semaphore]
+				if rateLimit == nil {
+					// the limit is not known until the rate limit is next used, until then it admits nobody
+					rateLimit = NewRateLimit(holding.Semaphore, 0, 0, cm.nextWorkflow)
+					cm.syncLockMap[holding.Semaphore] = rateLimit
+				}
+
+				for _, holders := range holding.Holders {
+					resourceKey := getResourceKey(wf.Namespace, wf.Name, holders)
+					if rateLimit.acquire(resourceKey, 1) {
+						log.Infof("Rate limit acquired by %s from %s", resourceKey, holding.Semaphore)
+					}
+				}
+			}
+		}
+
 		if wf.Status.Synchronization.Mutex != nil {
 			for _, holding := range wf.Status.Synchronization.Mutex.Holding {
 
 				mutex := cm.syncLockMap[holding.Mutex]
 				if mutex == nil {
-					mutex := cm.initializeMutex(holding.Mutex)
+					mutex, err := cm.initializeMutex(holding.Mutex)
+					if err != nil {
+						log.Warnf("cannot initialize mutex '%s': %v", holding.Mutex, err)
+						continue
+					}
 					if holding.Holder != "" {
 						resourceKey := getResourceKey(wf.Namespace, wf.Name, holding.Holder)
-						mutex.acquire(resourceKey)
+						mutex.acquire(resourceKey, 1)
 					}
 					cm.syncLockMap[holding.Mutex] = mutex
 				}
@@ -134,7 +211,9 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 		case wfv1.SynchronizationTypeSemaphore:
 			lock, err = cm.initializeSemaphore(lockKey)
 		case wfv1.SynchronizationTypeMutex:
-			lock = cm.initializeMutex(lockKey)
+			lock, err = cm.initializeMutex(lockKey)
+		case wfv1.SynchronizationTypeRateLimit:
+			lock, err = cm.initializeRateLimit(lockKey, syncLockRef.RateLimit)
 		default:
 			return false, false, "", fmt.Errorf("unknown Synchronization Type")
 		}
@@ -144,11 +223,29 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 		cm.syncLockMap[lockKey] = lock
 	}
 
+	weight := 1
 	if syncLockRef.GetType() == wfv1.SynchronizationTypeSemaphore {
 		err := cm.checkAndUpdateSemaphoreSize(lock)
 		if err != nil {
 			return false, false, "", err
 		}
+		weight, err = getSemaphoreWeight(syncLockRef.Semaphore)
+		if err != nil {
+			return false, false, "", err
+		}
+		if weight > 1 && syncLockName.IsDatabase() {
+			return false, false, "", fmt.Errorf("database semaphore '%s' does not support a weight", lockKey)
+		}
+		if weight > lock.getLimit() {
+			return false, false, "", fmt.Errorf("semaphore weight %d is greater than the limit %d of semaphore '%s'", weight, lock.getLimit(), lockKey)
+		}
+	}
+
+	if syncLockRef.GetType() == wfv1.SynchronizationTypeRateLimit {
+		err := checkAndUpdateRateLimit(lock, syncLockRef.RateLimit)
+		if err != nil {
+			return false, false, "", err
+		}
 	}
 
 	holderKey := getHolderKey(wf, nodeName)
@@ -159,13 +256,18 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 		priority = 0
 	}
 	creationTime := wf.CreationTimestamp
-	lock.addToQueue(holderKey, priority, creationTime.Time)
+	lock.addToQueue(holderKey, priority, creationTime.Time, weight)
 
 	ensureInit(wf, syncLockRef.GetType())
 	currentHolders := cm.getCurrentLockHolders(lockKey)
 	acquired, msg := lock.tryAcquire(holderKey)
 	if acquired {
-		updated := wf.Status.Synchronization.GetStatus(syncLockRef.GetType()).LockAcquired(holderKey, lockKey, currentHolders)
+		var updated bool
+		if syncLockRef.GetType() == wfv1.SynchronizationTypeSemaphore {
+			updated = wf.Status.Synchronization.Semaphore.LockAcquiredWithWeight(holderKey, lockKey, currentHolders, int32(weight))
+		} else {
+			updated = wf.Status.Synchronization.GetStatus(syncLockRef.GetType()).LockAcquired(holderKey, lockKey, currentHolders)
+		}
 		return true, updated, "", nil
 	}
 
@@ -233,6 +335,32 @@ func (cm *Manager) ReleaseAll(wf *wfv1.Workflow) bool {
 		wf.Status.Synchronization.Semaphore = nil
 	}
 
+	if wf.Status.Synchronization.RateLimit != nil {
+		for _, holding := range wf.Status.Synchronization.RateLimit.Holding {
+			syncLockHolder := cm.syncLockMap[holding.Semaphore]
+			if syncLockHolder == nil {
+				continue
+			}
+
+			for _, holderKey := range holding.Holders {
+				resourceKey := getResourceKey(wf.Namespace, wf.Name, holderKey)
+				syncLockHolder.release(resourceKey)
+				wf.Status.Synchronization.RateLimit.LockReleased(holderKey, holding.Semaphore)
+			}
+		}
+
+		// Remove the pending Workflow level rate limit keys
+		for _, waiting := range wf.Status.Synchronization.RateLimit.Waiting {
+			syncLockHolder := cm.syncLockMap[waiting.Semaphore]
+			if syncLockHolder == nil {
+				continue
+			}
+			resourceKey := getResourceKey(wf.Namespace, wf.Name, wf.Name)
+			syncLockHolder.removeFromQueue(resourceKey)
+		}
+		wf.Status.Synchronization.RateLimit = nil
+	}
+
 	if wf.Status.Synchronization.Mutex != nil {
 		for _, holding := range wf.Status.Synchronization.Mutex.Holding {
 			syncLockHolder := cm.syncLockMap[holding.Mutex]
@@ -283,6 +411,9 @@ func ensureInit(wf *wfv1.Workflow, lockType wfv1.SynchronizationType) {
 	if lockType == wfv1.SynchronizationTypeMutex && wf.Status.Synchronization.Mutex == nil {
 		wf.Status.Synchronization.Mutex = &wfv1.MutexStatus{}
 	}
+	if lockType == wfv1.SynchronizationTypeRateLimit && wf.Status.Synchronization.RateLimit == nil {
+		wf.Status.Synchronization.RateLimit = &wfv1.SemaphoreStatus{}
+	}
 }
 
 func getHolderKey(wf *wfv1.Workflow, nodeName string) string {
@@ -311,20 +442,97 @@ func (cm *Manager) getCurrentLockHolders(lockName string) []string {
 	return nil
 }
 
+// isDatabaseLock returns true if the lock is a database lock, returning an error if database locks are not enabled
+func (cm *Manager) isDatabaseLock(lockKey string) (bool, error) {
+	lockName, err := DecodeLockName(lockKey)
+	if err != nil || !lockName.IsDatabase() {
+		return false, nil
+	}
+	if cm.syncDB == nil {
+		return true, fmt.Errorf("cannot use database lock '%s' as database synchronization is not configured", lockKey)
+	}
+	return true, nil
+}
+
+func (cm *Manager) getLimit(lockKey string) (int, error) {
+	isDatabase, err := cm.isDatabaseLock(lockKey)
+	if err != nil {
+		return 0, err
+	}
+	if isDatabase {
+		return cm.syncDB.getLimit(lockKey)
+	}
+	return cm.getSyncLimit(lockKey)
+}
+
 func (cm *Manager) initializeSemaphore(semaphoreName string) (Semaphore, error) {
-	limit, err := cm.getSyncLimit(semaphoreName)
+	limit, err := cm.getLimit(semaphoreName)
 	if err != nil {
 		return nil, err
 	}
+	if isDatabase, _ := cm.isDatabaseLock(semaphoreName); isDatabase {
+		return newDatabaseSemaphore(semaphoreName, limit, cm.nextWorkflow, cm.syncDB), nil
+	}
 	return NewSemaphore(semaphoreName, limit, cm.nextWorkflow, "semaphore"), nil
 }
 
-func (cm *Manager) initializeMutex(mutexName string) Semaphore {
-	return NewMutex(mutexName, cm.nextWorkflow)
+func (cm *Manager) initializeMutex(mutexName string) (Semaphore, error) {
+	isDatabase, err := cm.isDatabaseLock(mutexName)
+	if err != nil {
+		return nil, err
+	}
+	if isDatabase {
+		return newDatabaseMutex(mutexName, cm.nextWorkflow, cm.syncDB), nil
+	}
+	return NewMutex(mutexName, cm.nextWorkflow), nil
+}
+
+// getSemaphoreWeight returns the number of permits to acquire, any expression in the weight has already been
+// resolved by template substitution
+func getSemaphoreWeight(semaphore *wfv1.SemaphoreRef) (int, error) {
+	weight, err := intstr.Int(semaphore.Weight)
+	if err != nil {
+		return 0, fmt.Errorf("invalid semaphore weight: %w", err)
+	}
+	if weight == nil {
+		return 1, nil
+	}
+	if *weight < 1 {
+		return 0, fmt.Errorf("semaphore weight %d must be greater than zero", *weight)
+	}
+	return *weight, nil
+}
+
+func validateRateLimit(rateLimit *wfv1.RateLimit) error {
+	if rateLimit.Limit <= 0 {
+		return fmt.Errorf("rate limit '%s' must have a limit greater than zero", rateLimit.Name)
+	}
+	if rateLimit.GetPeriod() <= 0 {
+		return fmt.Errorf("rate limit '%s' must have a period greater than zero", rateLimit.Name)
+	}
+	return nil
+}
+
+func (cm *Manager) initializeRateLimit(rateLimitName string, rateLimit *wfv1.RateLimit) (Semaphore, error) {
+	if err := validateRateLimit(rateLimit); err != nil {
+		return nil, err
+	}
+	return NewRateLimit(rateLimitName, int(rateLimit.Limit), rateLimit.GetPeriod(), cm.nextWorkflow), nil
+}
+
+// checkAndUpdateRateLimit updates the rate limit when a workflow or template uses a different limit or period
+func checkAndUpdateRateLimit(lock Semaphore, rateLimit *wfv1.RateLimit) error {
+	if err := validateRateLimit(rateLimit); err != nil {
+		return err
+	}
+	if r, ok := lock.(*RateLimitSemaphore); ok {
+		r.update(int(rateLimit.Limit), rateLimit.GetPeriod())
+	}
+	return nil
 }
 
 func (cm *Manager) isSemaphoreSizeChanged(semaphore Semaphore) (bool, int, error) {
-	limit, err := cm.getSyncLimit(semaphore.getName())
+	limit, err := cm.getLimit(semaphore.getName())
 	if err != nil {
 		return false, semaphore.getLimit(), err
 	}
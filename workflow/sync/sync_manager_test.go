@@ -17,6 +17,7 @@ import (
 	argoErr "github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/intstr"
 )
 
 const configMap = `
@@ -664,6 +665,81 @@ func TestMutexWfLevel(t *testing.T) {
 	})
 }
 
+func TestSemaphoreWeight(t *testing.T) {
+	assert := assert.New(t)
+	kube := fake.NewSimpleClientset()
+	var cm v1.ConfigMap
+	wfv1.MustUnmarshal([]byte(configMap), &cm)
+	cm.Data["workflow"] = "4"
+
+	ctx := context.Background()
+	_, err := kube.CoreV1().ConfigMaps("default").Create(ctx, &cm, metav1.CreateOptions{})
+	assert.NoError(err)
+
+	concurrenyMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {}, WorkflowExistenceFunc)
+	wf := wfv1.MustUnmarshalWorkflow(wfWithSemaphore)
+	wf.Spec.Synchronization.Semaphore.Weight = intstr.ParsePtr("3")
+	wf1 := wf.DeepCopy()
+	wf1.Name = "two"
+	wf1.Spec.Synchronization.Semaphore.Weight = intstr.ParsePtr("2")
+
+	status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+	assert.NoError(err)
+	assert.Empty(msg)
+	assert.True(status)
+	assert.True(wfUpdate)
+	assert.Equal([]wfv1.SemaphoreHolding{{Semaphore: "default/ConfigMap/my-config/workflow", Holders: []string{"hello-world"}, HolderWeights: map[string]int32{"hello-world": 3}}}, wf.Status.Synchronization.Semaphore.Holding)
+
+	status, _, msg, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+	assert.NoError(err)
+	assert.Equal("Waiting for default/ConfigMap/my-config/workflow lock. Lock status: 1/4. Requested permits: 2", msg)
+	assert.False(status)
+
+	// restore the holder with its weight
+	newMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {}, WorkflowExistenceFunc)
+	newMgr.Initialize([]wfv1.Workflow{*wf})
+	semaphore := newMgr.syncLockMap["default/ConfigMap/my-config/workflow"].(*PrioritySemaphore)
+	assert.Equal(3, semaphore.heldPermits())
+
+	concurrenyMgr.Release(wf, "", wf.Spec.Synchronization)
+	assert.Equal([]wfv1.SemaphoreHolding{{Semaphore: "default/ConfigMap/my-config/workflow", Holders: []string{}, HolderWeights: map[string]int32{}}}, wf.Status.Synchronization.Semaphore.Holding)
+	status, _, _, err = concurrenyMgr.TryAcquire(wf1, "", wf1.Spec.Synchronization)
+	assert.NoError(err)
+	assert.True(status)
+
+	t.Run("WeightGreaterThanLimit", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Synchronization.Semaphore.Weight = intstr.ParsePtr("5")
+		_, _, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		assert.EqualError(err, "semaphore weight 5 is greater than the limit 4 of semaphore 'default/ConfigMap/my-config/workflow'")
+	})
+	t.Run("InvalidWeight", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Synchronization.Semaphore.Weight = intstr.ParsePtr("{{inputs.parameters.gpus}}")
+		_, _, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		assert.EqualError(err, "invalid semaphore weight: value '{{inputs.parameters.gpus}}' cannot be resolved to an int")
+	})
+}
+
+func TestDatabaseLockWithoutDatabase(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	concurrenyMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {}, WorkflowExistenceFunc)
+	t.Run("Mutex", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(wfWithMutex)
+		wf.Spec.Synchronization.Mutex.Database = true
+		status, _, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		assert.EqualError(t, err, "cannot use database lock 'default/DatabaseMutex/my-mutex' as database synchronization is not configured")
+		assert.False(t, status)
+	})
+	t.Run("Semaphore", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(wfWithSemaphore)
+		wf.Spec.Synchronization.Semaphore = &wfv1.SemaphoreRef{Database: &wfv1.SyncDatabaseRef{Key: "my-semaphore"}}
+		status, _, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+		assert.EqualError(t, err, "cannot use database lock 'default/Database/my-semaphore' as database synchronization is not configured")
+		assert.False(t, status)
+	})
+}
+
 func TestCheckWorkflowExistence(t *testing.T) {
 	assert := assert.New(t)
 	kube := fake.NewSimpleClientset()
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"container/heap"
+	"sort"
 	"sync"
 	"time"
 
@@ -31,6 +32,9 @@ type QueueFunc func(Key)
 type BucketKey = string
 type BucketFunc func(Key) BucketKey
 
+// ShareFunc returns the share of the parallelism of a tenant
+type ShareFunc func(BucketKey) int
+
 var SingleBucket BucketFunc = func(key Key) BucketKey { return "" }
 var NamespaceBucket BucketFunc = func(key Key) BucketKey {
 	namespace, _, _ := cache.SplitMetaNamespaceKey(key)
@@ -44,6 +48,10 @@ type throttler struct {
 	pending     map[BucketKey]*priorityQueue
 	lock        *sync.Mutex
 	parallelism int
+	// tenantFunc and shareFunc are only set in fair share mode, where there is a pending queue per tenant
+	tenantFunc BucketFunc
+	shareFunc  ShareFunc
+	tenants    map[Key]BucketKey
 }
 
 type bucket map[Key]bool
@@ -62,6 +70,24 @@ func NewThrottler(parallelism int, bucketFunc BucketFunc, queue QueueFunc) Throt
 	}
 }
 
+// NewFairShareThrottler returns a throttle that only runs `parallelism` items at once, which are shared between
+// tenants in proportion to their shares. An item is admitted from the tenant that runs the fewest items relative to
+// its share, so a tenant may use the share of the tenants that have no pending items. When an item may need
+// processing, `queue` is invoked.
+func NewFairShareThrottler(parallelism int, tenantFunc BucketFunc, shareFunc ShareFunc, queue QueueFunc) Throttler {
+	return &throttler{
+		queue:       queue,
+		bucketFunc:  SingleBucket,
+		inProgress:  make(buckets),
+		pending:     make(map[BucketKey]*priorityQueue),
+		lock:        &sync.Mutex{},
+		parallelism: parallelism,
+		tenantFunc:  tenantFunc,
+		shareFunc:   shareFunc,
+		tenants:     make(map[Key]BucketKey),
+	}
+}
+
 func (t *throttler) Init(wfs []wfv1.Workflow) error {
 	t.lock.Lock()
 	defer t.lock.Unlock()
@@ -92,10 +118,16 @@ func (t *throttler) Add(key Key, priority int32, creationTime time.Time) {
 		return
 	}
 	bucketKey := t.bucketFunc(key)
-	if _, ok := t.pending[bucketKey]; !ok {
-		t.pending[bucketKey] = &priorityQueue{itemByKey: make(map[string]*item)}
+	queueKey := bucketKey
+	if t.isFairShare() {
+		// the tenant is recorded, so that the item is removed from the same queue if its tenant changes
+		queueKey = t.tenantOf(key)
+		t.tenants[key] = queueKey
 	}
-	t.pending[bucketKey].add(key, priority, creationTime)
+	if _, ok := t.pending[queueKey]; !ok {
+		t.pending[queueKey] = &priorityQueue{itemByKey: make(map[string]*item)}
+	}
+	t.pending[queueKey].add(key, priority, creationTime)
 	t.queueThrottled(bucketKey)
 }
 
@@ -120,8 +152,16 @@ func (t *throttler) Remove(key Key) {
 	if x, ok := t.inProgress[bucketKey]; ok {
 		delete(x, key)
 	}
-	if x, ok := t.pending[bucketKey]; ok {
+	queueKey := bucketKey
+	if t.isFairShare() {
+		queueKey = t.tenantOf(key)
+		delete(t.tenants, key)
+	}
+	if x, ok := t.pending[queueKey]; ok {
 		x.remove(key)
+		if x.Len() == 0 {
+			delete(t.pending, queueKey)
+		}
 	}
 	t.queueThrottled(bucketKey)
 }
@@ -131,6 +171,10 @@ func (t *throttler) queueThrottled(bucketKey BucketKey) {
 		t.inProgress[bucketKey] = make(bucket)
 	}
 	inProgress := t.inProgress[bucketKey]
+	if t.isFairShare() {
+		t.queueFairShare(inProgress)
+		return
+	}
 	pending, ok := t.pending[bucketKey]
 	for ok && pending.Len() > 0 && t.parallelism > len(inProgress) {
 		key := pending.pop().key
@@ -139,10 +183,72 @@ func (t *throttler) queueThrottled(bucketKey BucketKey) {
 	}
 }
 
+func (t *throttler) isFairShare() bool {
+	return t.tenantFunc != nil
+}
+
+func (t *throttler) tenantOf(key Key) BucketKey {
+	if tenant, ok := t.tenants[key]; ok {
+		return tenant
+	}
+	return t.tenantFunc(key)
+}
+
+// queueFairShare admits items until the parallelism is reached, each time from the tenant with pending items that runs
+// the fewest items relative to its share. Ties are broken by the priority and creation time of the next item of each
+// tenant.
+func (t *throttler) queueFairShare(inProgress bucket) {
+	running := make(map[BucketKey]int)
+	for key := range inProgress {
+		running[t.tenantOf(key)]++
+	}
+	for t.parallelism > len(inProgress) {
+		var next BucketKey
+		var nextQueue *priorityQueue
+		for tenant, pending := range t.pending {
+			if pending.Len() == 0 {
+				continue
+			}
+			if nextQueue == nil || t.isBehind(tenant, pending, next, nextQueue, running) {
+				next, nextQueue = tenant, pending
+			}
+		}
+		if nextQueue == nil {
+			return
+		}
+		key := nextQueue.pop().key
+		// tenants come and go, so their queues are removed once empty
+		if nextQueue.Len() == 0 {
+			delete(t.pending, next)
+		}
+		inProgress[key] = true
+		running[next]++
+		t.queue(key)
+	}
+}
+
+// isBehind returns true if tenant a should be admitted before tenant b
+func (t *throttler) isBehind(a BucketKey, aPending *priorityQueue, b BucketKey, bPending *priorityQueue, running map[BucketKey]int) bool {
+	// compare running[a]/share(a) with running[b]/share(b), without dividing
+	aUsage, bUsage := running[a]*t.shareFunc(b), running[b]*t.shareFunc(a)
+	if aUsage != bUsage {
+		return aUsage < bUsage
+	}
+	aNext, bNext := aPending.peek(), bPending.peek()
+	if aNext.priority != bNext.priority {
+		return aNext.priority > bNext.priority
+	}
+	if !aNext.creationTime.Equal(bNext.creationTime) {
+		return aNext.creationTime.Before(bNext.creationTime)
+	}
+	return a < b
+}
+
 type item struct {
 	key          string
 	creationTime time.Time
 	priority     int32
+	weight       int
 	index        int
 }
 
@@ -177,6 +283,19 @@ func (pq *priorityQueue) remove(key Key) {
 	}
 }
 
+// sorted returns the items in the order in which they are popped, without changing the queue
+func (pq *priorityQueue) sorted() []*item {
+	items := make([]*item, len(pq.items))
+	copy(items, pq.items)
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].priority == items[j].priority {
+			return items[i].creationTime.Before(items[j].creationTime)
+		}
+		return items[i].priority > items[j].priority
+	})
+	return items
+}
+
 func (pq priorityQueue) Len() int { return len(pq.items) }
 
 func (pq priorityQueue) Less(i, j int) bool {
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -150,3 +151,71 @@ status:
 	assert.Equal(t, "default/d", queuedKey)
 	assert.True(t, throttler.Admit("default/d"))
 }
+
+func TestFairShare(t *testing.T) {
+	shares := map[BucketKey]int{"a": 1, "b": 1, "c": 2}
+	throttler := NewFairShareThrottler(4, NamespaceBucket, func(tenant BucketKey) int { return shares[tenant] }, func(key string) {})
+
+	// tenant a submits many workflows first, and may use the whole parallelism while nobody else is waiting
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		throttler.Add(fmt.Sprintf("a/%d", i), 0, now.Add(time.Duration(i)*time.Second))
+	}
+	for i := 0; i < 4; i++ {
+		assert.True(t, throttler.Admit(fmt.Sprintf("a/%d", i)))
+	}
+	assert.False(t, throttler.Admit("a/4"))
+
+	// the freed share goes to the tenants that are behind their share, even though their workflows are newer
+	throttler.Add("b/0", 0, now.Add(time.Minute))
+	throttler.Add("c/0", 0, now.Add(time.Minute))
+	throttler.Add("c/1", 0, now.Add(time.Minute))
+	throttler.Remove("a/0")
+	throttler.Remove("a/1")
+	throttler.Remove("a/2")
+	assert.True(t, throttler.Admit("b/0"))
+	assert.True(t, throttler.Admit("c/0"))
+	assert.True(t, throttler.Admit("c/1"))
+	assert.False(t, throttler.Admit("a/4"))
+
+	// a runs 1 of its share of 1, b runs 1 of 1 and c runs 2 of 2, so a and b are level and a's workflow is older
+	throttler.Add("b/1", 0, now.Add(time.Minute))
+	throttler.Remove("c/0")
+	assert.True(t, throttler.Admit("a/4"))
+	assert.False(t, throttler.Admit("b/1"))
+
+	// removing a pending workflow does not admit it
+	throttler.Remove("a/5")
+	throttler.Remove("c/1")
+	assert.True(t, throttler.Admit("b/1"))
+	assert.False(t, throttler.Admit("a/5"))
+
+	// the queues of tenants without pending workflows are removed
+	pending := pendingQueues(throttler)
+	assert.Len(t, pending, 1)
+	for i := 6; i < 10; i++ {
+		throttler.Remove(fmt.Sprintf("a/%d", i))
+	}
+	assert.Empty(t, pending)
+}
+
+func pendingQueues(t Throttler) map[BucketKey]*priorityQueue {
+	return t.(*throttler).pending
+}
+
+func TestFairShareInit(t *testing.T) {
+	throttler := NewFairShareThrottler(2, NamespaceBucket, func(tenant BucketKey) int { return 1 }, func(key string) {})
+	var wfs []wfv1.Workflow
+	for _, name := range []string{"0", "1"} {
+		wfs = append(wfs, wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: "a", Name: name}, Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning}})
+	}
+	assert.NoError(t, throttler.Init(wfs))
+	assert.True(t, throttler.Admit("a/0"))
+	assert.True(t, throttler.Admit("a/1"))
+
+	throttler.Add("a/2", 0, time.Now())
+	throttler.Add("b/0", 0, time.Now().Add(time.Minute))
+	throttler.Remove("a/0")
+	assert.True(t, throttler.Admit("b/0"))
+	assert.False(t, throttler.Admit("a/2"))
+}
@@ -11,6 +11,7 @@ import (
 
 	"golang.org/x/exp/maps"
 
+	"github.com/antonmedv/expr"
 	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -256,6 +257,12 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if _, err := wf.Spec.PodGC.GetLabelSelector(); err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "podGC.labelSelector invalid: %v", err)
 	}
+	if err := validateSynchronization("synchronization", wf.Spec.Synchronization); err != nil {
+		return err
+	}
+	if wf.Spec.Synchronization != nil && wf.Spec.Synchronization.FallbackTemplate != "" {
+		return errors.New(errors.CodeBadRequest, "synchronization.fallbackTemplate is only supported by template-level synchronization")
+	}
 
 	// Check if all templates can be resolved.
 	// If the Workflow is using a WorkflowTemplateRef, then the templates of the referred WorkflowTemplate will be validated.
@@ -341,8 +348,24 @@ func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamesp
 		return fmt.Errorf("cron workflow name %q must not be more than 52 characters long (currently %d)", cronWf.Name, len(cronWf.Name))
 	}
 
-	if _, err := cron.ParseStandard(cronWf.Spec.Schedule); err != nil {
-		return errors.Errorf(errors.CodeBadRequest, "cron schedule is malformed: %s", err)
+	schedules := cronWf.Spec.GetSchedules()
+	if len(schedules) == 0 {
+		return errors.New(errors.CodeBadRequest, "cron workflow must have a schedule")
+	}
+	scheduleNames := make(map[string]bool)
+	for _, schedule := range schedules {
+		if _, err := cron.ParseStandard(schedule.GetScheduleString()); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "cron schedule is malformed: %s", err)
+		}
+		if scheduleNames[schedule.Name] {
+			return errors.Errorf(errors.CodeBadRequest, "cron schedule name %q is not unique", schedule.Name)
+		}
+		scheduleNames[schedule.Name] = true
+		for _, param := range schedule.Parameters {
+			if param.Name == "" || param.Value == nil {
+				return errors.Errorf(errors.CodeBadRequest, "parameters of cron schedule %q must have a name and a value", schedule.Name)
+			}
+		}
 	}
 
 	switch cronWf.Spec.ConcurrencyPolicy {
@@ -356,6 +379,32 @@ func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamesp
 		return errors.Errorf(errors.CodeBadRequest, "startingDeadlineSeconds must be positive")
 	}
 
+	if cronWf.Spec.Jitter != nil && cronWf.Spec.Jitter.Duration < 0 {
+		return errors.Errorf(errors.CodeBadRequest, "jitter must be positive")
+	}
+
+	for i, window := range cronWf.Spec.BlackoutWindows {
+		if !window.End.After(window.Start.Time) {
+			return errors.Errorf(errors.CodeBadRequest, "blackoutWindows[%d] must end after it starts", i)
+		}
+	}
+	for i, calendar := range cronWf.Spec.BlackoutCalendars {
+		if calendar.Name == "" || calendar.Key == "" {
+			return errors.Errorf(errors.CodeBadRequest, "blackoutCalendars[%d] must have a name and a key", i)
+		}
+	}
+
+	if strategy := cronWf.Spec.StopStrategy; strategy != nil {
+		if strategy.ConsecutiveFailures < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "stopStrategy.consecutiveFailures must be positive")
+		}
+		if strategy.Expression != "" {
+			if _, err := expr.Compile(strategy.Expression); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "stopStrategy.expression is invalid: %s", err)
+			}
+		}
+	}
+
 	wf := common.ConvertCronWorkflowToWorkflow(cronWf)
 
 	err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
@@ -466,6 +515,21 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 			return err
 		}
 	}
+	if err := validateSynchronization(fmt.Sprintf("templates.%s.synchronization", newTmpl.Name), newTmpl.Synchronization); err != nil {
+		return err
+	}
+	if newTmpl.Synchronization != nil && newTmpl.Synchronization.FallbackTemplate != "" {
+		fallbackTemplate := newTmpl.Synchronization.FallbackTemplate
+		if fallbackTemplate == newTmpl.Name {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.synchronization.fallbackTemplate cannot be the template itself", newTmpl.Name)
+		}
+		if _, err := tmplCtx.GetTemplateByName(fallbackTemplate); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.synchronization.fallbackTemplate '%s' undefined", newTmpl.Name, fallbackTemplate)
+		}
+	}
+	if err := validateMemoize(fmt.Sprintf("templates.%s.memoize", newTmpl.Name), newTmpl.Memoize); err != nil {
+		return err
+	}
 	if newTmpl.Metrics != nil {
 		for _, metric := range newTmpl.Metrics.Prometheus {
 			if !metrics.IsValidMetricName(metric.Name) {
@@ -766,6 +830,69 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 	return nil
 }
 
+func validateSynchronization(prefix string, sync *wfv1.Synchronization) error {
+	if sync == nil {
+		return nil
+	}
+	if sync.Semaphore != nil && sync.Semaphore.Timeout != nil && sync.Semaphore.Timeout.Duration <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "%s.semaphore.timeout must be a positive duration", prefix)
+	}
+	if sync.Mutex != nil && sync.Mutex.Timeout != nil && sync.Mutex.Timeout.Duration <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "%s.mutex.timeout must be a positive duration", prefix)
+	}
+	if err := validateRateLimit(prefix, sync); err != nil {
+		return err
+	}
+	if sync.FallbackTemplate != "" && sync.GetTimeout() <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "%s.fallbackTemplate requires a semaphore or mutex timeout", prefix)
+	}
+	if sync.Semaphore == nil || sync.Semaphore.Weight == nil {
+		return nil
+	}
+	weight := sync.Semaphore.Weight
+	if !intstr.IsValidIntOrArgoVariable(weight) && !placeholderGenerator.IsPlaceholder(weight.StrVal) {
+		return errors.Errorf(errors.CodeBadRequest, "%s.semaphore.weight must be a positive integer > 0 or an argo variable", prefix)
+	}
+	if i, err := intstr.Int(weight); err == nil && i != nil && *i < 1 {
+		return errors.Errorf(errors.CodeBadRequest, "%s.semaphore.weight must be a positive integer > 0 or an argo variable", prefix)
+	}
+	return nil
+}
+
+func validateRateLimit(prefix string, sync *wfv1.Synchronization) error {
+	rateLimit := sync.RateLimit
+	if rateLimit == nil {
+		return nil
+	}
+	// a template or workflow only uses one kind of lock
+	if sync.Semaphore != nil || sync.Mutex != nil {
+		return errors.Errorf(errors.CodeBadRequest, "%s.rateLimit cannot be combined with a semaphore or mutex", prefix)
+	}
+	if rateLimit.Name == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s.rateLimit.name is required", prefix)
+	}
+	if rateLimit.Limit < 1 {
+		return errors.Errorf(errors.CodeBadRequest, "%s.rateLimit.limit must be a positive integer > 0", prefix)
+	}
+	if rateLimit.Period != nil && rateLimit.Period.Duration <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "%s.rateLimit.period must be a positive duration", prefix)
+	}
+	return nil
+}
+
+func validateMemoize(prefix string, memoize *wfv1.Memoize) error {
+	if memoize == nil || memoize.Cache == nil {
+		return nil
+	}
+	if (memoize.Cache.ConfigMap == nil) == (memoize.Cache.ArtifactRepository == nil) {
+		return errors.Errorf(errors.CodeBadRequest, "%s.cache must have exactly one of configMap or artifactRepository", prefix)
+	}
+	if memoize.Cache.ArtifactRepository != nil && memoize.Cache.ArtifactRepository.Name == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s.cache.artifactRepository.name is required", prefix)
+	}
+	return nil
+}
+
 func validateArguments(prefix string, arguments wfv1.Arguments, allowEmptyValues bool) error {
 	err := validateArgumentsFieldNames(prefix, arguments)
 	if err != nil {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -1174,6 +1176,143 @@ func TestValidActiveDeadlineSeconds(t *testing.T) {
 	}
 }
 
+var semaphoreWeight = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: semaphore-weight-
+spec:
+  entrypoint: pass
+  arguments:
+    parameters:
+    - name: weight
+      value: "2"
+  templates:
+  - name: pass
+    synchronization:
+      semaphore:
+        configMapKeyRef:
+          name: my-config
+          key: template
+        weight: 0
+    container:
+      image: alpine:latest
+`
+
+func TestValidSemaphoreWeight(t *testing.T) {
+	err := validate(semaphoreWeight)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.pass.synchronization.semaphore.weight must be a positive integer > 0")
+	}
+	err = validate(strings.Replace(semaphoreWeight, "weight: 0", `weight: "{{workflow.parameters.weight}}"`, 1))
+	assert.NoError(t, err)
+	err = validate(strings.Replace(semaphoreWeight, "weight: 0", "weight: 3", 1))
+	assert.NoError(t, err)
+}
+
+var mutexTimeoutWithFallback = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: mutex-timeout-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    synchronization:
+      mutex:
+        name: nightly
+        timeout: 10m
+      fallbackTemplate: skip
+    container:
+      image: alpine:latest
+  - name: skip
+    container:
+      image: alpine:latest
+`
+
+func TestSynchronizationTimeout(t *testing.T) {
+	err := validate(mutexTimeoutWithFallback)
+	assert.NoError(t, err)
+	err = validate(strings.Replace(mutexTimeoutWithFallback, "fallbackTemplate: skip", "fallbackTemplate: missing", 1))
+	assert.EqualError(t, err, "templates.main.synchronization.fallbackTemplate 'missing' undefined")
+	err = validate(strings.Replace(mutexTimeoutWithFallback, "fallbackTemplate: skip", "fallbackTemplate: main", 1))
+	assert.EqualError(t, err, "templates.main.synchronization.fallbackTemplate cannot be the template itself")
+	err = validate(strings.Replace(mutexTimeoutWithFallback, "timeout: 10m", "timeout: 0s", 1))
+	assert.EqualError(t, err, "templates.main.synchronization.mutex.timeout must be a positive duration")
+	err = validate(strings.Replace(mutexTimeoutWithFallback, "        timeout: 10m\n", "", 1))
+	assert.EqualError(t, err, "templates.main.synchronization.fallbackTemplate requires a semaphore or mutex timeout")
+}
+
+var rateLimited = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: rate-limited-
+spec:
+  entrypoint: main
+  synchronization:
+    rateLimit:
+      name: api-calls
+      limit: 10
+      period: 1m
+  templates:
+  - name: main
+    container:
+      image: alpine:latest
+`
+
+func TestRateLimit(t *testing.T) {
+	err := validate(rateLimited)
+	assert.NoError(t, err)
+	err = validate(strings.Replace(rateLimited, "      period: 1m\n", "", 1))
+	assert.NoError(t, err, "the period defaults to 1m")
+	err = validate(strings.Replace(rateLimited, "limit: 10", "limit: 0", 1))
+	assert.EqualError(t, err, "synchronization.rateLimit.limit must be a positive integer > 0")
+	err = validate(strings.Replace(rateLimited, "limit: 10", "limit: -1", 1))
+	assert.EqualError(t, err, "synchronization.rateLimit.limit must be a positive integer > 0")
+	err = validate(strings.Replace(rateLimited, "period: 1m", "period: 0s", 1))
+	assert.EqualError(t, err, "synchronization.rateLimit.period must be a positive duration")
+	err = validate(strings.Replace(rateLimited, "period: 1m", "period: -1m", 1))
+	assert.EqualError(t, err, "synchronization.rateLimit.period must be a positive duration")
+	err = validate(strings.Replace(rateLimited, "name: api-calls", `name: ""`, 1))
+	assert.EqualError(t, err, "synchronization.rateLimit.name is required")
+	err = validate(strings.Replace(rateLimited, "  synchronization:\n", "  synchronization:\n    mutex:\n      name: my-mutex\n", 1))
+	assert.EqualError(t, err, "synchronization.rateLimit cannot be combined with a semaphore or mutex")
+
+	templateRateLimited := strings.Replace(rateLimited, "  synchronization:\n    rateLimit:\n      name: api-calls\n      limit: 10\n      period: 1m\n", "", 1)
+	templateRateLimited = strings.Replace(templateRateLimited, "  - name: main\n", "  - name: main\n    synchronization:\n      rateLimit:\n        name: api-calls\n        limit: 0\n", 1)
+	err = validate(templateRateLimited)
+	assert.EqualError(t, err, "templates.main.synchronization.rateLimit.limit must be a positive integer > 0")
+}
+
+var memoizeWithArtifactRepositoryCache = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoized-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    memoize:
+      key: "{{workflow.name}}"
+      cache:
+        artifactRepository:
+          name: my-cache
+    container:
+      image: alpine:latest
+`
+
+func TestMemoizeCache(t *testing.T) {
+	err := validate(memoizeWithArtifactRepositoryCache)
+	assert.NoError(t, err)
+	err = validate(strings.Replace(memoizeWithArtifactRepositoryCache, "          name: my-cache\n", "          name: my-cache\n        configMap:\n          name: my-cache\n", 1))
+	assert.EqualError(t, err, "templates.main.memoize.cache must have exactly one of configMap or artifactRepository")
+	err = validate(strings.Replace(memoizeWithArtifactRepositoryCache, "name: my-cache", "name: \"\"", 1))
+	assert.EqualError(t, err, "templates.main.memoize.cache.artifactRepository.name is required")
+}
+
 var leafWithParallelism = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -3113,3 +3252,37 @@ func TestSubstituteGlobalVariablesLabelsAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCronWorkflowSchedules(t *testing.T) {
+	newCronWorkflow := func(spec wfv1.CronWorkflowSpec) *wfv1.CronWorkflow {
+		spec.WorkflowSpec = wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Container: &corev1.Container{Image: "alpine"}}}}
+		return &wfv1.CronWorkflow{ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"}, Spec: spec}
+	}
+	for _, test := range []struct {
+		name string
+		spec wfv1.CronWorkflowSpec
+		err  string
+	}{
+		{"Schedule", wfv1.CronWorkflowSpec{Schedule: "* * * * *"}, ""},
+		{"Schedules", wfv1.CronWorkflowSpec{Schedules: []wfv1.CronWorkflowSchedule{{Schedule: "0 9 * * 1-5"}, {Schedule: "0 12 * * 6", Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}}}}}, ""},
+		{"NoSchedule", wfv1.CronWorkflowSpec{}, "cron workflow must have a schedule"},
+		{"Malformed", wfv1.CronWorkflowSpec{Schedules: []wfv1.CronWorkflowSchedule{{Schedule: "not a schedule"}}}, "cron schedule is malformed: expected exactly 5 fields, found 3: [not a schedule]"},
+		{"InvalidTimezone", wfv1.CronWorkflowSpec{Schedules: []wfv1.CronWorkflowSchedule{{Schedule: "* * * * *", Timezone: "Nowhere"}}}, "cron schedule is malformed: provided bad location Nowhere: unknown time zone Nowhere"},
+		{"NotUnique", wfv1.CronWorkflowSpec{Schedule: "* * * * *", Schedules: []wfv1.CronWorkflowSchedule{{Schedule: "* * * * *"}}}, "cron schedule name \"* * * * *\" is not unique"},
+		{"ParameterWithoutValue", wfv1.CronWorkflowSpec{Schedules: []wfv1.CronWorkflowSchedule{{Name: "my-schedule", Schedule: "* * * * *", Parameters: []wfv1.Parameter{{Name: "message"}}}}}, "parameters of cron schedule \"my-schedule\" must have a name and a value"},
+		{"BlackoutWindowEndsBeforeStart", wfv1.CronWorkflowSpec{Schedule: "* * * * *", BlackoutWindows: []wfv1.BlackoutWindow{{Start: metav1.Unix(1, 0), End: metav1.Unix(0, 0)}}}, "blackoutWindows[0] must end after it starts"},
+		{"BlackoutCalendarWithoutKey", wfv1.CronWorkflowSpec{Schedule: "* * * * *", BlackoutCalendars: []corev1.ConfigMapKeySelector{{LocalObjectReference: corev1.LocalObjectReference{Name: "holidays"}}}}, "blackoutCalendars[0] must have a name and a key"},
+		{"NegativeJitter", wfv1.CronWorkflowSpec{Schedule: "* * * * *", Jitter: &metav1.Duration{Duration: -time.Minute}}, "jitter must be positive"},
+		{"StopStrategy", wfv1.CronWorkflowSpec{Schedule: "* * * * *", StopStrategy: &wfv1.StopStrategy{ConsecutiveFailures: 3, Expression: "cronworkflow.failed >= 10"}}, ""},
+		{"InvalidStopStrategyExpression", wfv1.CronWorkflowSpec{Schedule: "* * * * *", StopStrategy: &wfv1.StopStrategy{Expression: "cronworkflow.failed >="}}, "stopStrategy.expression is invalid: unexpected token EOF (1:22)\n | cronworkflow.failed >=\n | .....................^"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, newCronWorkflow(test.spec))
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}